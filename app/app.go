@@ -570,6 +570,10 @@ func New(
 		precompiles.StatefulContracts(
 			&app.FungibleKeeper,
 			app.StakingKeeper,
+			&app.EmissionsKeeper,
+			&app.CrosschainKeeper,
+			app.BankKeeper,
+			&app.DistrKeeper,
 			appCodec,
 			storetypes.TransientGasConfig(),
 		),
@@ -587,6 +591,7 @@ func New(
 		app.ObserverKeeper,
 		app.AuthorityKeeper,
 	)
+	app.FungibleKeeper.SetPrecompileABIs(precompiles.ContractABIs())
 
 	app.CrosschainKeeper = *crosschainkeeper.NewKeeper(
 		appCodec,
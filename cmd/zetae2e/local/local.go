@@ -321,6 +321,12 @@ func localE2ETest(cmd *cobra.Command, _ []string) {
 				e2etests.TestPrecompilesPrototypeThroughContractName,
 				e2etests.TestPrecompilesStakingName,
 				e2etests.TestPrecompilesStakingThroughContractName,
+				e2etests.TestPrecompilesEmissionsName,
+				e2etests.TestPrecompilesCrosschainName,
+				e2etests.TestPrecompilesBankName,
+				e2etests.TestPrecompilesBankThroughContractName,
+				e2etests.TestPrecompilesDistributionName,
+				e2etests.TestPrecompilesDistributionThroughContractName,
 			}
 		}
 
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.10;
+
+// @dev Forwards any call to the target contract, the target sees this contract as the caller
+// This allows to test precompiled contracts called through a contract without a dedicated test contract
+// The target can be updated to call several precompiled contracts from the same address
+// The target is stored in the first storage slot, TestForwarder.bin is a minimal assembly equivalent of this contract
+contract TestForwarder {
+    address private target;
+
+    constructor(address _target) {
+        target = _target;
+    }
+
+    function setTarget(address _target) external {
+        target = _target;
+    }
+
+    fallback() external payable {
+        address _target = target;
+        assembly {
+            calldatacopy(0, 0, calldatasize())
+            let success := call(gas(), _target, callvalue(), 0, calldatasize(), 0, 0)
+            returndatacopy(0, 0, returndatasize())
+            switch success
+            case 0 {
+                revert(0, returndatasize())
+            }
+            default {
+                return(0, returndatasize())
+            }
+        }
+    }
+
+    receive() external payable {}
+}
@@ -0,0 +1,8 @@
+//go:generate sh -c "solc  TestForwarder.sol  --combined-json abi,bin | jq '.contracts.\"TestForwarder.sol:TestForwarder\"'  > TestForwarder.json"
+//go:generate sh -c "cat TestForwarder.json | jq .abi > TestForwarder.abi"
+//go:generate sh -c "cat TestForwarder.json | jq .bin  | tr -d '\"'  > TestForwarder.bin"
+//go:generate sh -c "abigen --abi TestForwarder.abi --bin TestForwarder.bin  --pkg testforwarder --type TestForwarder --out TestForwarder.go"
+
+package testforwarder
+
+var _ TestForwarder
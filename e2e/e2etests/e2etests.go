@@ -155,10 +155,16 @@ const (
 	/*
 	 Stateful precompiled contracts tests
 	*/
-	TestPrecompilesPrototypeName                = "precompile_contracts_prototype"
-	TestPrecompilesPrototypeThroughContractName = "precompile_contracts_prototype_through_contract"
-	TestPrecompilesStakingName                  = "precompile_contracts_staking"
-	TestPrecompilesStakingThroughContractName   = "precompile_contracts_staking_through_contract"
+	TestPrecompilesPrototypeName                   = "precompile_contracts_prototype"
+	TestPrecompilesPrototypeThroughContractName    = "precompile_contracts_prototype_through_contract"
+	TestPrecompilesStakingName                     = "precompile_contracts_staking"
+	TestPrecompilesStakingThroughContractName      = "precompile_contracts_staking_through_contract"
+	TestPrecompilesEmissionsName                   = "precompile_contracts_emissions"
+	TestPrecompilesCrosschainName                  = "precompile_contracts_crosschain"
+	TestPrecompilesBankName                        = "precompile_contracts_bank"
+	TestPrecompilesBankThroughContractName         = "precompile_contracts_bank_through_contract"
+	TestPrecompilesDistributionName                = "precompile_contracts_distribution"
+	TestPrecompilesDistributionThroughContractName = "precompile_contracts_distribution_through_contract"
 )
 
 // AllE2ETests is an ordered list of all e2e tests
@@ -877,4 +883,40 @@ var AllE2ETests = []runner.E2ETest{
 		[]runner.ArgDefinition{},
 		TestPrecompilesStakingThroughContract,
 	),
+	runner.NewE2ETest(
+		TestPrecompilesEmissionsName,
+		"test stateful precompiled contracts emissions",
+		[]runner.ArgDefinition{},
+		TestPrecompilesEmissions,
+	),
+	runner.NewE2ETest(
+		TestPrecompilesCrosschainName,
+		"test stateful precompiled contracts crosschain",
+		[]runner.ArgDefinition{},
+		TestPrecompilesCrosschain,
+	),
+	runner.NewE2ETest(
+		TestPrecompilesBankName,
+		"test stateful precompiled contracts bank",
+		[]runner.ArgDefinition{},
+		TestPrecompilesBank,
+	),
+	runner.NewE2ETest(
+		TestPrecompilesBankThroughContractName,
+		"test stateful precompiled contracts bank through contract",
+		[]runner.ArgDefinition{},
+		TestPrecompilesBankThroughContract,
+	),
+	runner.NewE2ETest(
+		TestPrecompilesDistributionName,
+		"test stateful precompiled contracts distribution",
+		[]runner.ArgDefinition{},
+		TestPrecompilesDistribution,
+	),
+	runner.NewE2ETest(
+		TestPrecompilesDistributionThroughContractName,
+		"test stateful precompiled contracts distribution through contract",
+		[]runner.ArgDefinition{},
+		TestPrecompilesDistributionThroughContract,
+	),
 }
@@ -0,0 +1,87 @@
+package e2etests
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/e2e/runner"
+	"github.com/zeta-chain/node/e2e/utils"
+	"github.com/zeta-chain/node/precompiles/bank"
+	"github.com/zeta-chain/node/testutil/sample"
+)
+
+func TestPrecompilesBank(r *runner.E2ERunner, args []string) {
+	require.Len(r, args, 0, "No arguments expected")
+
+	bankContract, err := bank.NewIBank(bank.ContractAddress, r.ZEVMClient)
+	require.NoError(r, err, "Failed to create bank contract caller")
+
+	previousGasLimit := r.ZEVMAuth.GasLimit
+	r.ZEVMAuth.GasLimit = 10000000
+	defer func() {
+		r.ZEVMAuth.GasLimit = previousGasLimit
+	}()
+
+	// balance read through the precompile matches the bank module balance
+	balance, err := bankContract.BalanceOf(&bind.CallOpts{}, r.ZEVMAuth.From, config.BaseDenom)
+	require.NoError(r, err)
+	bankBalance, err := r.BankClient.Balance(r.Ctx, &banktypes.QueryBalanceRequest{
+		Address: sdk.AccAddress(r.ZEVMAuth.From.Bytes()).String(),
+		Denom:   config.BaseDenom,
+	})
+	require.NoError(r, err)
+	require.Equal(r, bankBalance.Balance.Amount.String(), balance.String())
+
+	// total supply read through the precompile matches the bank module supply
+	supply, err := bankContract.TotalSupply(&bind.CallOpts{}, config.BaseDenom)
+	require.NoError(r, err)
+	bankSupply, err := r.BankClient.SupplyOf(r.Ctx, &banktypes.QuerySupplyOfRequest{Denom: config.BaseDenom})
+	require.NoError(r, err)
+	require.Equal(r, bankSupply.Amount.Amount.String(), supply.String())
+
+	// send azeta to a new account
+	recipient := sample.EthAddress()
+	amount := big.NewInt(1000)
+	tx, err := bankContract.Send(r.ZEVMAuth, recipient, config.BaseDenom, amount)
+	require.NoError(r, err)
+	receipt := utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+	// check that send event was emitted
+	sendEvent, err := bankContract.ParseSend(*receipt.Logs[0])
+	require.NoError(r, err)
+	require.Equal(r, r.ZEVMAuth.From, sendEvent.From)
+	require.Equal(r, recipient, sendEvent.To)
+	require.Equal(r, config.BaseDenom, sendEvent.Denom)
+	require.Equal(r, amount.Int64(), sendEvent.Amount.Int64())
+
+	// check the recipient balance in the bank module and the evm
+	recipientBalance, err := r.BankClient.Balance(r.Ctx, &banktypes.QueryBalanceRequest{
+		Address: sdk.AccAddress(recipient.Bytes()).String(),
+		Denom:   config.BaseDenom,
+	})
+	require.NoError(r, err)
+	require.Equal(r, amount.Int64(), recipientBalance.Balance.Amount.Int64())
+
+	recipientEVMBalance, err := r.ZEVMClient.BalanceAt(r.Ctx, recipient, nil)
+	require.NoError(r, err)
+	require.Equal(r, amount.Int64(), recipientEVMBalance.Int64())
+
+	// sending more than the balance fails
+	tx, err = bankContract.Send(r.ZEVMAuth, recipient, config.BaseDenom, new(big.Int).Mul(supply, big.NewInt(2)))
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusFailed, receipt.Status)
+
+	// sending an invalid denom fails
+	tx, err = bankContract.Send(r.ZEVMAuth, recipient, "!", amount)
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusFailed, receipt.Status)
+}
@@ -0,0 +1,106 @@
+package e2etests
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/e2e/contracts/testforwarder"
+	"github.com/zeta-chain/node/e2e/runner"
+	"github.com/zeta-chain/node/e2e/utils"
+	"github.com/zeta-chain/node/precompiles/bank"
+	"github.com/zeta-chain/node/testutil/sample"
+)
+
+func TestPrecompilesBankThroughContract(r *runner.E2ERunner, args []string) {
+	require.Len(r, args, 0, "No arguments expected")
+
+	// the forwarder calls the bank precompile, the precompile sees the forwarder as the caller
+	forwarderAddr, forwarderTx, forwarder, err := testforwarder.DeployTestForwarder(
+		r.ZEVMAuth,
+		r.ZEVMClient,
+		bank.ContractAddress,
+	)
+	require.NoError(r, err)
+	utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, forwarderTx, r.Logger, r.ReceiptTimeout)
+
+	bankThroughContract, err := bank.NewIBank(forwarderAddr, r.ZEVMClient)
+	require.NoError(r, err)
+	bankContract, err := bank.NewIBank(bank.ContractAddress, r.ZEVMClient)
+	require.NoError(r, err)
+
+	previousGasLimit := r.ZEVMAuth.GasLimit
+	r.ZEVMAuth.GasLimit = 10000000
+	defer func() {
+		r.ZEVMAuth.GasLimit = previousGasLimit
+		r.ZEVMAuth.Value = big.NewInt(0)
+	}()
+
+	// sending from the forwarder fails because it doesn't have any balance yet
+	recipient := sample.EthAddress()
+	tx, err := bankThroughContract.Send(r.ZEVMAuth, recipient, config.BaseDenom, big.NewInt(1))
+	require.NoError(r, err)
+	receipt := utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusFailed, receipt.Status)
+
+	// fund the forwarder with azeta
+	fundAmount := big.NewInt(1000000000000)
+	r.ZEVMAuth.Value = fundAmount
+	tx, err = forwarder.Receive(r.ZEVMAuth)
+	require.NoError(r, err)
+	utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	r.ZEVMAuth.Value = big.NewInt(0)
+
+	// views are forwarded as well
+	balanceBefore, err := bankThroughContract.BalanceOf(&bind.CallOpts{}, forwarderAddr, config.BaseDenom)
+	require.NoError(r, err)
+	require.Equal(r, fundAmount.Int64(), balanceBefore.Int64())
+
+	// send azeta from the forwarder
+	amount := big.NewInt(1000)
+	tx, err = bankThroughContract.Send(r.ZEVMAuth, recipient, config.BaseDenom, amount)
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+	// check that send event was emitted with the forwarder as sender
+	sendEvent, err := bankContract.ParseSend(*receipt.Logs[0])
+	require.NoError(r, err)
+	require.Equal(r, forwarderAddr, sendEvent.From)
+	require.Equal(r, recipient, sendEvent.To)
+	require.Equal(r, amount.Int64(), sendEvent.Amount.Int64())
+
+	// check that the balances are consistent in the bank module and the evm
+	forwarderBalance, err := r.BankClient.Balance(r.Ctx, &banktypes.QueryBalanceRequest{
+		Address: sdk.AccAddress(forwarderAddr.Bytes()).String(),
+		Denom:   config.BaseDenom,
+	})
+	require.NoError(r, err)
+	require.Equal(r, fundAmount.Int64()-amount.Int64(), forwarderBalance.Balance.Amount.Int64())
+
+	forwarderEVMBalance, err := r.ZEVMClient.BalanceAt(r.Ctx, forwarderAddr, nil)
+	require.NoError(r, err)
+	require.Equal(r, fundAmount.Int64()-amount.Int64(), forwarderEVMBalance.Int64())
+
+	recipientBalance, err := r.BankClient.Balance(r.Ctx, &banktypes.QueryBalanceRequest{
+		Address: sdk.AccAddress(recipient.Bytes()).String(),
+		Denom:   config.BaseDenom,
+	})
+	require.NoError(r, err)
+	require.Equal(r, amount.Int64(), recipientBalance.Balance.Amount.Int64())
+
+	// sending more than the forwarder balance fails and doesn't change the balances
+	tx, err = bankThroughContract.Send(r.ZEVMAuth, recipient, config.BaseDenom, fundAmount)
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusFailed, receipt.Status)
+
+	balanceAfterRevert, err := bankContract.BalanceOf(&bind.CallOpts{}, forwarderAddr, config.BaseDenom)
+	require.NoError(r, err)
+	require.Equal(r, fundAmount.Int64()-amount.Int64(), balanceAfterRevert.Int64())
+}
@@ -0,0 +1,54 @@
+package e2etests
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/e2e/runner"
+	"github.com/zeta-chain/node/e2e/utils"
+	"github.com/zeta-chain/node/precompiles/crosschain"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestPrecompilesCrosschain(r *runner.E2ERunner, args []string) {
+	require.Len(r, args, 0, "No arguments expected")
+
+	crosschainContract, err := crosschain.NewICrosschain(crosschain.ContractAddress, r.ZEVMClient)
+	require.NoError(r, err, "Failed to create crosschain contract caller")
+
+	chainID, err := r.EVMClient.ChainID(r.Ctx)
+	require.NoError(r, err, "Error retrieving ChainID")
+
+	// tss address of the evm chain matches the one used by the runner
+	tssAddress, err := crosschainContract.GetTssAddress(&bind.CallOpts{}, chainID.Int64())
+	require.NoError(r, err)
+	require.Equal(r, r.TSSAddress.Hex(), tssAddress)
+
+	// gas price is voted by observers for the evm chain
+	gasPrice, err := crosschainContract.GetGasPrice(&bind.CallOpts{}, chainID.Int64())
+	require.NoError(r, err)
+	require.Positive(r, gasPrice.GasPrice.Int64())
+
+	// pending nonces are consistent
+	pendingNonces, err := crosschainContract.GetPendingNonces(&bind.CallOpts{}, chainID.Int64())
+	require.NoError(r, err)
+	require.LessOrEqual(r, pendingNonces.NonceLow, pendingNonces.NonceHigh)
+
+	// deposit ether and read the created cctx by index and by inbound
+	inboundHash := r.DepositEtherWithAmount(big.NewInt(1e15))
+	cctx := utils.WaitCctxMinedByInboundHash(r.Ctx, inboundHash.Hex(), r.CctxClient, r.Logger, r.CctxTimeout)
+	utils.RequireCCTXStatus(r, cctx, crosschaintypes.CctxStatus_OutboundMined)
+
+	cctxByIndex, err := crosschainContract.GetCctx(&bind.CallOpts{}, cctx.Index)
+	require.NoError(r, err)
+	require.Equal(r, cctx.Index, cctxByIndex.Index)
+	require.EqualValues(r, crosschaintypes.CctxStatus_OutboundMined, cctxByIndex.Status)
+	require.Equal(r, cctx.InboundParams.Amount.BigInt(), cctxByIndex.InboundAmount)
+	require.Equal(r, cctx.GetCurrentOutboundParam().Hash, cctxByIndex.OutboundHash)
+
+	cctxByInbound, err := crosschainContract.GetCctxByInbound(&bind.CallOpts{}, chainID.Int64(), inboundHash.Hex())
+	require.NoError(r, err)
+	require.Equal(r, cctx.Index, cctxByInbound.Index)
+}
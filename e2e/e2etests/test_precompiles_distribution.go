@@ -0,0 +1,113 @@
+package e2etests
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/e2e/runner"
+	"github.com/zeta-chain/node/e2e/utils"
+	"github.com/zeta-chain/node/precompiles/distribution"
+	"github.com/zeta-chain/node/precompiles/staking"
+	"github.com/zeta-chain/node/testutil/sample"
+)
+
+// pendingRewardsTimeout is the maximum time to wait for a delegation to accrue rewards
+const pendingRewardsTimeout = 2 * time.Minute
+
+func TestPrecompilesDistribution(r *runner.E2ERunner, args []string) {
+	require.Len(r, args, 0, "No arguments expected")
+
+	stakingContract, err := staking.NewIStaking(staking.ContractAddress, r.ZEVMClient)
+	require.NoError(r, err, "Failed to create staking contract caller")
+
+	distributionContract, err := distribution.NewIDistribution(distribution.ContractAddress, r.ZEVMClient)
+	require.NoError(r, err, "Failed to create distribution contract caller")
+
+	previousGasLimit := r.ZEVMAuth.GasLimit
+	r.ZEVMAuth.GasLimit = 10000000
+	defer func() {
+		r.ZEVMAuth.GasLimit = previousGasLimit
+	}()
+
+	validators, err := stakingContract.GetAllValidators(&bind.CallOpts{})
+	require.NoError(r, err)
+	require.GreaterOrEqual(r, len(validators), 1)
+
+	CleanValidatorDelegations(r, stakingContract, validators)
+	defer CleanValidatorDelegations(r, stakingContract, validators)
+
+	// pending rewards can't be queried without delegation
+	_, err = distributionContract.GetPendingRewards(&bind.CallOpts{}, r.ZEVMAuth.From, validators[0].OperatorAddress)
+	require.Error(r, err)
+
+	// stake to validator1 to accrue rewards
+	tx, err := stakingContract.Stake(
+		r.ZEVMAuth,
+		r.ZEVMAuth.From,
+		validators[0].OperatorAddress,
+		big.NewInt(1e18),
+	)
+	require.NoError(r, err)
+	utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+
+	waitForPendingRewards(r, distributionContract, r.ZEVMAuth.From, validators[0].OperatorAddress)
+
+	// claiming the rewards of another delegator fails
+	tx, err = distributionContract.ClaimRewards(r.ZEVMAuth, sample.EthAddress(), validators[0].OperatorAddress)
+	require.NoError(r, err)
+	receipt := utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusFailed, receipt.Status)
+
+	// claim rewards
+	tx, err = distributionContract.ClaimRewards(r.ZEVMAuth, r.ZEVMAuth.From, validators[0].OperatorAddress)
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+	// check that claim rewards event was emitted
+	claimEvent, err := distributionContract.ParseClaimRewards(*receipt.Logs[0])
+	require.NoError(r, err)
+	expectedValAddr, err := sdk.ValAddressFromBech32(validators[0].OperatorAddress)
+	require.NoError(r, err)
+	require.Equal(r, r.ZEVMAuth.From, claimEvent.Delegator)
+	require.Equal(r, common.BytesToAddress(expectedValAddr.Bytes()), claimEvent.Validator)
+	require.NotEmpty(r, claimEvent.Rewards)
+	require.Equal(r, config.BaseDenom, claimEvent.Rewards[0].Denom)
+	require.Positive(r, claimEvent.Rewards[0].Amount.Sign())
+
+	// withdrawing the validator commission fails if the caller is not the validator operator
+	tx, err = distributionContract.WithdrawValidatorCommission(r.ZEVMAuth, validators[0].OperatorAddress)
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusFailed, receipt.Status)
+}
+
+// waitForPendingRewards waits until the delegation has accrued rewards in azeta and returns the pending amount
+func waitForPendingRewards(
+	r *runner.E2ERunner,
+	distributionContract *distribution.IDistribution,
+	delegator common.Address,
+	validator string,
+) *big.Int {
+	startTime := time.Now()
+	for {
+		require.False(r, time.Since(startTime) > pendingRewardsTimeout, "waiting for pending rewards timeout")
+
+		rewards, err := distributionContract.GetPendingRewards(&bind.CallOpts{}, delegator, validator)
+		require.NoError(r, err)
+		for _, reward := range rewards {
+			if reward.Denom == config.BaseDenom && reward.Amount.Sign() > 0 {
+				return reward.Amount
+			}
+		}
+
+		time.Sleep(1 * time.Second)
+	}
+}
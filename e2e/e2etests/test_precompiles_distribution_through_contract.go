@@ -0,0 +1,111 @@
+package e2etests
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	"github.com/zeta-chain/node/e2e/contracts/testforwarder"
+	"github.com/zeta-chain/node/e2e/runner"
+	"github.com/zeta-chain/node/e2e/utils"
+	"github.com/zeta-chain/node/precompiles/distribution"
+	"github.com/zeta-chain/node/precompiles/staking"
+)
+
+func TestPrecompilesDistributionThroughContract(r *runner.E2ERunner, args []string) {
+	require.Len(r, args, 0, "No arguments expected")
+
+	// the forwarder stakes and claims the rewards of the delegation, the precompiles see the forwarder as the caller
+	forwarderAddr, forwarderTx, forwarder, err := testforwarder.DeployTestForwarder(
+		r.ZEVMAuth,
+		r.ZEVMClient,
+		staking.ContractAddress,
+	)
+	require.NoError(r, err)
+	utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, forwarderTx, r.Logger, r.ReceiptTimeout)
+
+	stakingThroughContract, err := staking.NewIStaking(forwarderAddr, r.ZEVMClient)
+	require.NoError(r, err)
+	distributionThroughContract, err := distribution.NewIDistribution(forwarderAddr, r.ZEVMClient)
+	require.NoError(r, err)
+	distributionContract, err := distribution.NewIDistribution(distribution.ContractAddress, r.ZEVMClient)
+	require.NoError(r, err)
+
+	previousGasLimit := r.ZEVMAuth.GasLimit
+	r.ZEVMAuth.GasLimit = 10000000
+	defer func() {
+		r.ZEVMAuth.GasLimit = previousGasLimit
+		r.ZEVMAuth.Value = big.NewInt(0)
+	}()
+
+	validators, err := stakingThroughContract.GetAllValidators(&bind.CallOpts{})
+	require.NoError(r, err)
+	require.GreaterOrEqual(r, len(validators), 1)
+
+	// fund the forwarder with azeta
+	stakeAmount := big.NewInt(1e18)
+	r.ZEVMAuth.Value = stakeAmount
+	tx, err := forwarder.Receive(r.ZEVMAuth)
+	require.NoError(r, err)
+	utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	r.ZEVMAuth.Value = big.NewInt(0)
+
+	// stake to validator1 from the forwarder
+	tx, err = stakingThroughContract.Stake(r.ZEVMAuth, forwarderAddr, validators[0].OperatorAddress, stakeAmount)
+	require.NoError(r, err)
+	receipt := utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+	// switch the forwarder to the distribution precompile
+	tx, err = forwarder.SetTarget(r.ZEVMAuth, distribution.ContractAddress)
+	require.NoError(r, err)
+	utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+
+	waitForPendingRewards(r, distributionContract, forwarderAddr, validators[0].OperatorAddress)
+
+	// claiming the rewards of another delegator through the forwarder fails
+	tx, err = distributionThroughContract.ClaimRewards(r.ZEVMAuth, r.ZEVMAuth.From, validators[0].OperatorAddress)
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusFailed, receipt.Status)
+
+	// claim the rewards of the forwarder
+	tx, err = distributionThroughContract.ClaimRewards(r.ZEVMAuth, forwarderAddr, validators[0].OperatorAddress)
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+
+	// check that claim rewards event was emitted with the forwarder as delegator
+	claimEvent, err := distributionContract.ParseClaimRewards(*receipt.Logs[0])
+	require.NoError(r, err)
+	require.Equal(r, forwarderAddr, claimEvent.Delegator)
+	require.NotEmpty(r, claimEvent.Rewards)
+	claimed := claimEvent.Rewards[0].Amount
+
+	// check that the claimed rewards are consistent in the bank module and the evm
+	forwarderBalance, err := r.BankClient.Balance(r.Ctx, &banktypes.QueryBalanceRequest{
+		Address: sdk.AccAddress(forwarderAddr.Bytes()).String(),
+		Denom:   config.BaseDenom,
+	})
+	require.NoError(r, err)
+	require.Equal(r, claimed.String(), forwarderBalance.Balance.Amount.String())
+
+	forwarderEVMBalance, err := r.ZEVMClient.BalanceAt(r.Ctx, forwarderAddr, nil)
+	require.NoError(r, err)
+	require.Equal(r, claimed.String(), forwarderEVMBalance.String())
+
+	// unstake from the forwarder
+	tx, err = forwarder.SetTarget(r.ZEVMAuth, staking.ContractAddress)
+	require.NoError(r, err)
+	utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+
+	tx, err = stakingThroughContract.Unstake(r.ZEVMAuth, forwarderAddr, validators[0].OperatorAddress, stakeAmount)
+	require.NoError(r, err)
+	receipt = utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+}
@@ -0,0 +1,43 @@
+package e2etests
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/e2e/runner"
+	"github.com/zeta-chain/node/e2e/utils"
+	"github.com/zeta-chain/node/precompiles/emissions"
+)
+
+func TestPrecompilesEmissions(r *runner.E2ERunner, args []string) {
+	require.Len(r, args, 0, "No arguments expected")
+
+	emissionsContract, err := emissions.NewIEmissions(emissions.ContractAddress, r.ZEVMClient)
+	require.NoError(r, err, "Failed to create emissions contract caller")
+
+	previousGasLimit := r.ZEVMAuth.GasLimit
+	r.ZEVMAuth.GasLimit = 10000000
+	defer func() {
+		r.ZEVMAuth.GasLimit = previousGasLimit
+	}()
+
+	// params are readable through the precompile
+	params, err := emissionsContract.GetParams(&bind.CallOpts{})
+	require.NoError(r, err)
+	require.NotEmpty(r, params.BlockRewardAmount)
+	require.Positive(r, params.BallotMaturityBlocks)
+
+	// the deployer is not an observer and has no emissions to withdraw
+	available, err := emissionsContract.GetAvailableEmissions(&bind.CallOpts{}, r.ZEVMAuth.From)
+	require.NoError(r, err)
+	require.Equal(r, int64(0), available.Int64())
+
+	// withdrawing emissions fails
+	tx, err := emissionsContract.WithdrawEmissions(r.ZEVMAuth, big.NewInt(1))
+	require.NoError(r, err)
+	receipt := utils.MustWaitForTxReceipt(r.Ctx, r.ZEVMClient, tx, r.Logger, r.ReceiptTimeout)
+	require.Equal(r, ethtypes.ReceiptStatusFailed, receipt.Status)
+}
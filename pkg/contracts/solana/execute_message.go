@@ -0,0 +1,68 @@
+package solana
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/gagliardetto/solana-go"
+	"github.com/pkg/errors"
+)
+
+// ExecuteAccountMeta is an account passed to the program called by the gateway execute instruction
+type ExecuteAccountMeta struct {
+	PublicKey  [32]byte `abi:"publicKey"`
+	IsWritable bool     `abi:"isWritable"`
+}
+
+// ExecuteMsg is the message of a ZEVM call to a Solana program.
+// It is ABI encoded by the ZEVM contract as `abi.encode(AccountMeta[] accounts, bytes data)`
+type ExecuteMsg struct {
+	Accounts []ExecuteAccountMeta
+	Data     []byte
+}
+
+// executeMsgArguments are the ABI arguments of the execute message
+var executeMsgArguments = func() abi.Arguments {
+	accountsType, err := abi.NewType("tuple[]", "", []abi.ArgumentMarshaling{
+		{Name: "publicKey", Type: "bytes32"},
+		{Name: "isWritable", Type: "bool"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	bytesType, err := abi.NewType("bytes", "", nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return abi.Arguments{{Name: "accounts", Type: accountsType}, {Name: "data", Type: bytesType}}
+}()
+
+// DecodeExecuteMsg decodes the ABI encoded execute message
+func DecodeExecuteMsg(message []byte) (ExecuteMsg, error) {
+	var msg ExecuteMsg
+	values, err := executeMsgArguments.Unpack(message)
+	if err != nil {
+		return msg, errors.Wrap(err, "unable to unpack execute message")
+	}
+	if err := executeMsgArguments.Copy(&msg, values); err != nil {
+		return msg, errors.Wrap(err, "unable to copy execute message")
+	}
+
+	return msg, nil
+}
+
+// EncodeExecuteMsg encodes the execute message with ABI
+func EncodeExecuteMsg(msg ExecuteMsg) ([]byte, error) {
+	return executeMsgArguments.Pack(msg.Accounts, msg.Data)
+}
+
+// AccountMetas returns the account metas passed to the program called by the gateway
+func (msg ExecuteMsg) AccountMetas() []*solana.AccountMeta {
+	metas := make([]*solana.AccountMeta, 0, len(msg.Accounts))
+	for _, account := range msg.Accounts {
+		metas = append(metas, &solana.AccountMeta{
+			PublicKey:  solana.PublicKeyFromBytes(account.PublicKey[:]),
+			IsWritable: account.IsWritable,
+		})
+	}
+	return metas
+}
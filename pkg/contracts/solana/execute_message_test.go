@@ -0,0 +1,44 @@
+package solana_test
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/require"
+
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+)
+
+func Test_DecodeExecuteMsg(t *testing.T) {
+	account1 := solana.NewWallet().PublicKey()
+	account2 := solana.NewWallet().PublicKey()
+
+	t.Run("should decode encoded execute message", func(t *testing.T) {
+		msg := contracts.ExecuteMsg{
+			Accounts: []contracts.ExecuteAccountMeta{
+				{PublicKey: account1, IsWritable: true},
+				{PublicKey: account2, IsWritable: false},
+			},
+			Data: []byte("hello program"),
+		}
+		encoded, err := contracts.EncodeExecuteMsg(msg)
+		require.NoError(t, err)
+
+		decoded, err := contracts.DecodeExecuteMsg(encoded)
+		require.NoError(t, err)
+		require.Equal(t, msg, decoded)
+
+		metas := decoded.AccountMetas()
+		require.Len(t, metas, 2)
+		require.Equal(t, account1, metas[0].PublicKey)
+		require.True(t, metas[0].IsWritable)
+		require.False(t, metas[0].IsSigner)
+		require.Equal(t, account2, metas[1].PublicKey)
+		require.False(t, metas[1].IsWritable)
+	})
+
+	t.Run("should fail to decode invalid message", func(t *testing.T) {
+		_, err := contracts.DecodeExecuteMsg([]byte("invalid"))
+		require.ErrorContains(t, err, "unable to unpack execute message")
+	})
+}
@@ -16,6 +16,11 @@ const (
 	// AccountsNumberOfDeposit is the number of accounts required for Solana gateway deposit instruction
 	// [signer, pda, system_program]
 	AccountsNumDeposit = 3
+
+	// AccountsNumExecute is the number of accounts required for Solana gateway execute instruction,
+	// the remaining accounts passed to the destination program follow them
+	// [signer, pda, destination_program]
+	AccountsNumExecute = 3
 )
 
 // DiscriminatorInitialize returns the discriminator for Solana gateway 'initialize' instruction
@@ -38,6 +43,16 @@ func DiscriminatorWithdraw() [8]byte {
 	return [8]byte{183, 18, 70, 156, 148, 109, 161, 34}
 }
 
+// DiscriminatorExecute returns the discriminator for Solana gateway 'execute' instruction
+func DiscriminatorExecute() [8]byte {
+	return [8]byte{130, 221, 242, 154, 13, 193, 189, 29}
+}
+
+// DiscriminatorIncrementNonce returns the discriminator for Solana gateway 'increment_nonce' instruction
+func DiscriminatorIncrementNonce() [8]byte {
+	return [8]byte{84, 149, 209, 233, 228, 66, 195, 237}
+}
+
 // DiscriminatorWithdrawSPL returns the discriminator for Solana gateway 'withdraw_spl_token' instruction
 func DiscriminatorWithdrawSPL() [8]byte {
 	return [8]byte{156, 234, 11, 89, 235, 246, 32}
@@ -105,3 +105,226 @@ func (msg *MsgWithdraw) Signer() (common.Address, error) {
 
 	return RecoverSigner(msgHash[:], msgSig[:])
 }
+
+// MsgExecute is the message for the Solana gateway execute instruction
+type MsgExecute struct {
+	// chainID is the chain ID of Solana chain
+	chainID uint64
+
+	// nonce is the nonce for the execute
+	nonce uint64
+
+	// amount is the lamports amount sent to the destination program
+	amount uint64
+
+	// to is the destination program called by the gateway
+	to solana.PublicKey
+
+	// sender is the ZEVM address of the contract sending the call
+	sender common.Address
+
+	// data is the payload passed to the destination program
+	data []byte
+
+	// remainingAccounts are the accounts passed to the destination program
+	remainingAccounts []*solana.AccountMeta
+
+	// signature is the signature of the message
+	signature [65]byte
+}
+
+// NewMsgExecute returns a new execute message
+func NewMsgExecute(
+	chainID, nonce, amount uint64,
+	to solana.PublicKey,
+	sender common.Address,
+	data []byte,
+	remainingAccounts []*solana.AccountMeta,
+) *MsgExecute {
+	return &MsgExecute{
+		chainID:           chainID,
+		nonce:             nonce,
+		amount:            amount,
+		to:                to,
+		sender:            sender,
+		data:              data,
+		remainingAccounts: remainingAccounts,
+	}
+}
+
+// ChainID returns the chain ID of the message
+func (msg *MsgExecute) ChainID() uint64 {
+	return msg.chainID
+}
+
+// Nonce returns the nonce of the message
+func (msg *MsgExecute) Nonce() uint64 {
+	return msg.nonce
+}
+
+// Amount returns the amount of the message
+func (msg *MsgExecute) Amount() uint64 {
+	return msg.amount
+}
+
+// To returns the destination program of the message
+func (msg *MsgExecute) To() solana.PublicKey {
+	return msg.to
+}
+
+// Sender returns the ZEVM sender of the message
+func (msg *MsgExecute) Sender() common.Address {
+	return msg.sender
+}
+
+// Data returns the payload of the message
+func (msg *MsgExecute) Data() []byte {
+	return msg.data
+}
+
+// RemainingAccounts returns the accounts passed to the destination program
+func (msg *MsgExecute) RemainingAccounts() []*solana.AccountMeta {
+	return msg.remainingAccounts
+}
+
+// Hash packs the execute message and computes the hash
+// The message is prefixed with the instruction name so that its signature can't be used by other instructions
+func (msg *MsgExecute) Hash() [32]byte {
+	message := []byte("execute")
+	buff := make([]byte, 8)
+
+	binary.BigEndian.PutUint64(buff, msg.chainID)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.nonce)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.amount)
+	message = append(message, buff...)
+
+	message = append(message, msg.to.Bytes()...)
+	message = append(message, msg.sender.Bytes()...)
+	message = append(message, msg.data...)
+
+	return crypto.Keccak256Hash(message)
+}
+
+// SetSignature attaches the signature to the message
+func (msg *MsgExecute) SetSignature(signature [65]byte) *MsgExecute {
+	msg.signature = signature
+	return msg
+}
+
+// SigRSV returns the full 65-byte [R+S+V] signature
+func (msg *MsgExecute) SigRSV() [65]byte {
+	return msg.signature
+}
+
+// SigRS returns the 64-byte [R+S] core part of the signature
+func (msg *MsgExecute) SigRS() [64]byte {
+	var sig [64]byte
+	copy(sig[:], msg.signature[:64])
+	return sig
+}
+
+// SigV returns the V part (recovery ID) of the signature
+func (msg *MsgExecute) SigV() uint8 {
+	return msg.signature[64]
+}
+
+// Signer returns the signer of the message
+func (msg *MsgExecute) Signer() (common.Address, error) {
+	msgHash := msg.Hash()
+	msgSig := msg.SigRSV()
+
+	return RecoverSigner(msgHash[:], msgSig[:])
+}
+
+// MsgIncrementNonce is the message for the Solana gateway increment_nonce instruction
+// It consumes the nonce of an outbound that can't be executed, so that the outbound is reverted
+type MsgIncrementNonce struct {
+	// chainID is the chain ID of Solana chain
+	chainID uint64
+
+	// nonce is the nonce to increment
+	nonce uint64
+
+	// amount is the lamports amount of the outbound
+	amount uint64
+
+	// signature is the signature of the message
+	signature [65]byte
+}
+
+// NewMsgIncrementNonce returns a new increment_nonce message
+func NewMsgIncrementNonce(chainID, nonce, amount uint64) *MsgIncrementNonce {
+	return &MsgIncrementNonce{
+		chainID: chainID,
+		nonce:   nonce,
+		amount:  amount,
+	}
+}
+
+// ChainID returns the chain ID of the message
+func (msg *MsgIncrementNonce) ChainID() uint64 {
+	return msg.chainID
+}
+
+// Nonce returns the nonce of the message
+func (msg *MsgIncrementNonce) Nonce() uint64 {
+	return msg.nonce
+}
+
+// Amount returns the amount of the message
+func (msg *MsgIncrementNonce) Amount() uint64 {
+	return msg.amount
+}
+
+// Hash packs the increment_nonce message and computes the hash
+// The message is prefixed with the instruction name so that its signature can't be used by other instructions
+func (msg *MsgIncrementNonce) Hash() [32]byte {
+	message := []byte("increment_nonce")
+	buff := make([]byte, 8)
+
+	binary.BigEndian.PutUint64(buff, msg.chainID)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.nonce)
+	message = append(message, buff...)
+
+	binary.BigEndian.PutUint64(buff, msg.amount)
+	message = append(message, buff...)
+
+	return crypto.Keccak256Hash(message)
+}
+
+// SetSignature attaches the signature to the message
+func (msg *MsgIncrementNonce) SetSignature(signature [65]byte) *MsgIncrementNonce {
+	msg.signature = signature
+	return msg
+}
+
+// SigRSV returns the full 65-byte [R+S+V] signature
+func (msg *MsgIncrementNonce) SigRSV() [65]byte {
+	return msg.signature
+}
+
+// SigRS returns the 64-byte [R+S] core part of the signature
+func (msg *MsgIncrementNonce) SigRS() [64]byte {
+	var sig [64]byte
+	copy(sig[:], msg.signature[:64])
+	return sig
+}
+
+// SigV returns the V part (recovery ID) of the signature
+func (msg *MsgIncrementNonce) SigV() uint8 {
+	return msg.signature[64]
+}
+
+// Signer returns the signer of the message
+func (msg *MsgIncrementNonce) Signer() (common.Address, error) {
+	msgHash := msg.Hash()
+	msgSig := msg.SigRSV()
+
+	return RecoverSigner(msgHash[:], msgSig[:])
+}
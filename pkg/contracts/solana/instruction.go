@@ -106,6 +106,132 @@ func ParseInstructionWithdraw(instruction solana.CompiledInstruction) (*Withdraw
 	return inst, nil
 }
 
+var _ OutboundInstruction = (*ExecuteInstructionParams)(nil)
+
+// ExecuteInstructionParams contains the parameters for a gateway execute instruction
+type ExecuteInstructionParams struct {
+	// Discriminator is the unique identifier for the execute instruction
+	Discriminator [8]byte
+
+	// Amount is the lamports amount sent to the destination program
+	Amount uint64
+
+	// Sender is the ZEVM address of the contract sending the call
+	Sender [20]byte
+
+	// Data is the payload passed to the destination program
+	Data []byte
+
+	// Signature is the ECDSA signature (by TSS) for the execute
+	Signature [64]byte
+
+	// RecoveryID is the recovery ID used to recover the public key from ECDSA signature
+	RecoveryID uint8
+
+	// MessageHash is the hash of the message signed by TSS
+	MessageHash [32]byte
+
+	// Nonce is the nonce for the execute
+	Nonce uint64
+}
+
+// Signer returns the signer of the signature contained
+func (inst *ExecuteInstructionParams) Signer() (signer common.Address, err error) {
+	var signature [65]byte
+	copy(signature[:], inst.Signature[:64])
+	signature[64] = inst.RecoveryID
+
+	return RecoverSigner(inst.MessageHash[:], signature[:])
+}
+
+// GatewayNonce returns the nonce of the instruction
+func (inst *ExecuteInstructionParams) GatewayNonce() uint64 {
+	return inst.Nonce
+}
+
+// TokenAmount returns the amount of the instruction
+func (inst *ExecuteInstructionParams) TokenAmount() uint64 {
+	return inst.Amount
+}
+
+// ParseInstructionExecute tries to parse the instruction as an 'execute'.
+// It returns nil if the instruction can't be parsed as an 'execute'.
+func ParseInstructionExecute(instruction solana.CompiledInstruction) (*ExecuteInstructionParams, error) {
+	// try deserializing instruction as an 'execute'
+	inst := &ExecuteInstructionParams{}
+	err := borsh.Deserialize(inst, instruction.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "error deserializing instruction")
+	}
+
+	// check the discriminator to ensure it's an 'execute' instruction
+	if inst.Discriminator != DiscriminatorExecute() {
+		return nil, fmt.Errorf("not an execute instruction: %v", inst.Discriminator)
+	}
+
+	return inst, nil
+}
+
+var _ OutboundInstruction = (*IncrementNonceInstructionParams)(nil)
+
+// IncrementNonceInstructionParams contains the parameters for a gateway increment_nonce instruction
+type IncrementNonceInstructionParams struct {
+	// Discriminator is the unique identifier for the increment_nonce instruction
+	Discriminator [8]byte
+
+	// Amount is the lamports amount of the outbound
+	Amount uint64
+
+	// Signature is the ECDSA signature (by TSS) for the increment_nonce
+	Signature [64]byte
+
+	// RecoveryID is the recovery ID used to recover the public key from ECDSA signature
+	RecoveryID uint8
+
+	// MessageHash is the hash of the message signed by TSS
+	MessageHash [32]byte
+
+	// Nonce is the nonce to increment
+	Nonce uint64
+}
+
+// Signer returns the signer of the signature contained
+func (inst *IncrementNonceInstructionParams) Signer() (signer common.Address, err error) {
+	var signature [65]byte
+	copy(signature[:], inst.Signature[:64])
+	signature[64] = inst.RecoveryID
+
+	return RecoverSigner(inst.MessageHash[:], signature[:])
+}
+
+// GatewayNonce returns the nonce of the instruction
+func (inst *IncrementNonceInstructionParams) GatewayNonce() uint64 {
+	return inst.Nonce
+}
+
+// TokenAmount returns the amount of the instruction
+func (inst *IncrementNonceInstructionParams) TokenAmount() uint64 {
+	return inst.Amount
+}
+
+// ParseInstructionIncrementNonce tries to parse the instruction as an 'increment_nonce'.
+// It returns nil if the instruction can't be parsed as an 'increment_nonce'.
+func ParseInstructionIncrementNonce(instruction solana.CompiledInstruction) (*IncrementNonceInstructionParams, error) {
+	// try deserializing instruction as an 'increment_nonce'
+	inst := &IncrementNonceInstructionParams{}
+	err := borsh.Deserialize(inst, instruction.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "error deserializing instruction")
+	}
+
+	// check the discriminator to ensure it's an 'increment_nonce' instruction
+	if inst.Discriminator != DiscriminatorIncrementNonce() {
+		return nil, fmt.Errorf("not an increment_nonce instruction: %v", inst.Discriminator)
+	}
+
+	return inst, nil
+}
+
 // RecoverSigner recover the ECDSA signer from given message hash and signature
 func RecoverSigner(msgHash []byte, msgSig []byte) (signer common.Address, err error) {
 	// recover the public key
@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gagliardetto/solana-go"
+	"github.com/near/borsh-go"
 	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
 )
 
@@ -78,3 +81,81 @@ func Test_RecoverSigner(t *testing.T) {
 	require.NotEqual(t, ethcommon.Address{}, signer)
 	require.NotEqual(t, testSigner, signer.String())
 }
+
+// signTestMessage signs the message hash with the private key and returns the [R || S || V] signature
+func signTestMessage(t *testing.T, hash [32]byte) ([65]byte, ethcommon.Address) {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	sig, err := crypto.Sign(hash[:], privKey)
+	require.NoError(t, err)
+
+	var signature [65]byte
+	copy(signature[:], sig)
+	return signature, crypto.PubkeyToAddress(privKey.PublicKey)
+}
+
+func Test_ParseInstructionExecute(t *testing.T) {
+	sender := ethcommon.HexToAddress("0x1111111111111111111111111111111111111111")
+	msg := contracts.NewMsgExecute(902, 3, 1000, solana.NewWallet().PublicKey(), sender, []byte("hello"), nil)
+	signature, signer := signTestMessage(t, msg.Hash())
+	msg.SetSignature(signature)
+
+	data, err := borsh.Serialize(contracts.ExecuteInstructionParams{
+		Discriminator: contracts.DiscriminatorExecute(),
+		Amount:        msg.Amount(),
+		Sender:        msg.Sender(),
+		Data:          msg.Data(),
+		Signature:     msg.SigRS(),
+		RecoveryID:    msg.SigV(),
+		MessageHash:   msg.Hash(),
+		Nonce:         msg.Nonce(),
+	})
+	require.NoError(t, err)
+
+	t.Run("should parse instruction execute", func(t *testing.T) {
+		inst, err := contracts.ParseInstructionExecute(solana.CompiledInstruction{Data: data})
+		require.NoError(t, err)
+		require.EqualValues(t, 3, inst.GatewayNonce())
+		require.EqualValues(t, 1000, inst.TokenAmount())
+		require.Equal(t, []byte("hello"), inst.Data)
+
+		recovered, err := inst.Signer()
+		require.NoError(t, err)
+		require.Equal(t, signer, recovered)
+	})
+
+	t.Run("should return error on discriminator mismatch", func(t *testing.T) {
+		_, err := contracts.ParseInstructionIncrementNonce(solana.CompiledInstruction{Data: data})
+		require.Error(t, err)
+	})
+}
+
+func Test_ParseInstructionIncrementNonce(t *testing.T) {
+	msg := contracts.NewMsgIncrementNonce(902, 3, 1000)
+	signature, signer := signTestMessage(t, msg.Hash())
+	msg.SetSignature(signature)
+
+	// the signature of the increment_nonce message can't be used for an execute
+	msgExecute := contracts.NewMsgExecute(902, 3, 1000, solana.PublicKey{}, ethcommon.Address{}, nil, nil)
+	require.NotEqual(t, msg.Hash(), msgExecute.Hash())
+
+	data, err := borsh.Serialize(contracts.IncrementNonceInstructionParams{
+		Discriminator: contracts.DiscriminatorIncrementNonce(),
+		Amount:        msg.Amount(),
+		Signature:     msg.SigRS(),
+		RecoveryID:    msg.SigV(),
+		MessageHash:   msg.Hash(),
+		Nonce:         msg.Nonce(),
+	})
+	require.NoError(t, err)
+
+	inst, err := contracts.ParseInstructionIncrementNonce(solana.CompiledInstruction{Data: data})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, inst.GatewayNonce())
+	require.EqualValues(t, 1000, inst.TokenAmount())
+
+	recovered, err := inst.Signer()
+	require.NoError(t, err)
+	require.Equal(t, signer, recovered)
+}
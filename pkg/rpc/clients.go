@@ -1,17 +1,21 @@
 package rpc
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	feemarkettypes "github.com/zeta-chain/ethermint/x/feemarket/types"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	etherminttypes "github.com/zeta-chain/node/rpc/types"
 	authoritytypes "github.com/zeta-chain/node/x/authority/types"
@@ -103,3 +107,8 @@ func NewGRPCClients(url string, opts ...grpc.DialOption) (Clients, error) {
 	clientCtx := client.Context{}.WithGRPCClient(grpcConn)
 	return newClients(clientCtx)
 }
+
+// ContextWithHeight returns the context querying the zetacore state at the given height
+func ContextWithHeight(ctx context.Context, height uint64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatUint(height, 10))
+}
@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 	"sort"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -101,6 +102,21 @@ func (c *Clients) GetCctxByNonce(ctx context.Context, chainID int64, nonce uint6
 	return resp.CrossChainTx, nil
 }
 
+// GetGasPrice returns the gas price voted by the observers of a chain
+func (c *Clients) GetGasPrice(ctx context.Context, chainID int64) (types.GasPrice, error) {
+	in := &types.QueryGetGasPriceRequest{Index: strconv.FormatInt(chainID, 10)}
+
+	resp, err := c.Crosschain.GasPrice(ctx, in)
+	switch {
+	case err != nil:
+		return types.GasPrice{}, errors.Wrap(err, "failed to get gas price")
+	case resp.GasPrice == nil:
+		return types.GasPrice{}, errors.Wrapf(types.ErrUnableToGetGasPrice, "chain %d", chainID)
+	}
+
+	return *resp.GasPrice, nil
+}
+
 // ListPendingCCTXWithinRateLimit returns a list of pending cctxs that do not exceed the outbound rate limit
 //   - The max size of the list is crosschainkeeper.MaxPendingCctxs
 //   - The returned `rateLimitExceeded` flag indicates if the rate limit is exceeded or not
@@ -76,6 +76,18 @@ func (c *Clients) GetObserverList(ctx context.Context) ([]string, error) {
 	return resp.Observers, nil
 }
 
+// GetObserverLiveness returns the liveness info of an observer
+func (c *Clients) GetObserverLiveness(ctx context.Context, observerAddress string) (types.ObserverLivenessInfo, error) {
+	in := &types.QueryGetObserverLivenessRequest{ObserverAddress: observerAddress}
+
+	resp, err := c.Observer.ObserverLiveness(ctx, in)
+	if err != nil {
+		return types.ObserverLivenessInfo{}, errors.Wrap(err, "failed to get observer liveness")
+	}
+
+	return resp.ObserverLiveness, nil
+}
+
 // GetBallotByID returns a ballot by ID
 func (c *Clients) GetBallotByID(ctx context.Context, id string) (*types.QueryBallotByIdentifierResponse, error) {
 	in := &types.QueryBallotByIdentifierRequest{BallotIdentifier: id}
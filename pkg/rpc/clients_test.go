@@ -288,6 +288,27 @@ func TestZetacore_GetCctxByNonce(t *testing.T) {
 	require.Equal(t, expectedOutput.CrossChainTx, resp)
 }
 
+func TestZetacore_GetGasPrice(t *testing.T) {
+	ctx := context.Background()
+
+	expectedOutput := crosschaintypes.QueryGetGasPriceResponse{GasPrice: &crosschaintypes.GasPrice{
+		Index:       "7000",
+		ChainId:     7000,
+		BlockNums:   []uint64{100},
+		Prices:      []uint64{20},
+		MedianIndex: 0,
+	}}
+	input := crosschaintypes.QueryGetGasPriceRequest{Index: "7000"}
+	method := "/zetachain.zetacore.crosschain.Query/GasPrice"
+	setupMockServer(t, crosschaintypes.RegisterQueryServer, method, input, expectedOutput)
+
+	client := setupZetacoreClients(t)
+
+	resp, err := client.GetGasPrice(ctx, 7000)
+	require.NoError(t, err)
+	require.Equal(t, *expectedOutput.GasPrice, resp)
+}
+
 func TestZetacore_GetObserverList(t *testing.T) {
 	ctx := context.Background()
 
@@ -309,6 +330,30 @@ func TestZetacore_GetObserverList(t *testing.T) {
 	require.Equal(t, expectedOutput.Observers, resp)
 }
 
+func TestZetacore_GetObserverLiveness(t *testing.T) {
+	ctx := context.Background()
+
+	observerAddress := "zeta19jr7nl82lrktge35f52x9g5y5prmvchmk40zhg"
+	expectedOutput := observertypes.QueryGetObserverLivenessResponse{
+		ObserverLiveness: observertypes.ObserverLivenessInfo{
+			ObserverAddress: observerAddress,
+			MissedVotes:     10,
+			TotalVotes:      100,
+			MissedRatio:     types.MustNewDecFromStr("0.1"),
+			Jailed:          false,
+		},
+	}
+	input := observertypes.QueryGetObserverLivenessRequest{ObserverAddress: observerAddress}
+	method := "/zetachain.zetacore.observer.Query/ObserverLiveness"
+	setupMockServer(t, observertypes.RegisterQueryServer, method, input, expectedOutput)
+
+	client := setupZetacoreClients(t)
+
+	resp, err := client.GetObserverLiveness(ctx, observerAddress)
+	require.NoError(t, err)
+	require.Equal(t, expectedOutput.ObserverLiveness, resp)
+}
+
 func TestZetacore_GetRateLimiterInput(t *testing.T) {
 	ctx := context.Background()
 
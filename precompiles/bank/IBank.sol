@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.26;
+
+/// @dev The IBank contract's address.
+address constant IBANK_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000069; // 105
+
+/// @dev The IBank contract's instance.
+IBank constant IBANK_CONTRACT = IBank(IBANK_PRECOMPILE_ADDRESS);
+
+interface IBank {
+    /// @notice Send event is emitted when send function is called
+    /// @param from Sender address
+    /// @param to Recipient address
+    /// @param denom Denom of the sent coin
+    /// @param amount Sent amount
+    event Send(
+        address indexed from,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @notice Get the balance of an account for a denom
+    /// @param account Account address
+    /// @param denom Coin denom, including IBC denoms
+    /// @return balance Balance of the account
+    function balanceOf(
+        address account,
+        string memory denom
+    ) external view returns (uint256 balance);
+
+    /// @notice Get the total supply of a denom
+    /// @param denom Coin denom, including IBC denoms
+    /// @return supply Total supply of the denom
+    function totalSupply(
+        string memory denom
+    ) external view returns (uint256 supply);
+
+    /// @notice Send coins of a denom from the caller to an address
+    /// @param to Recipient address
+    /// @param denom Coin denom, including IBC denoms
+    /// @param amount Amount to send
+    /// @return success Send success
+    function send(
+        address to,
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+}
@@ -0,0 +1,314 @@
+package bank
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+)
+
+// method names
+const (
+	// write
+	SendMethodName = "send"
+
+	// read
+	BalanceOfMethodName   = "balanceOf"
+	TotalSupplyMethodName = "totalSupply"
+)
+
+var (
+	ABI                 abi.ABI
+	ContractAddress     = common.HexToAddress("0x0000000000000000000000000000000000000069")
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	ViewMethod          = map[[4]byte]bool{}
+)
+
+func init() {
+	initABI()
+}
+
+func initABI() {
+	if err := ABI.UnmarshalJSON([]byte(IBankMetaData.ABI)); err != nil {
+		panic(err)
+	}
+
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	for methodName := range ABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], ABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case SendMethodName:
+			GasRequiredByMethod[methodID] = 10000
+		case BalanceOfMethodName:
+			GasRequiredByMethod[methodID] = 0
+			ViewMethod[methodID] = true
+		case TotalSupplyMethodName:
+			GasRequiredByMethod[methodID] = 0
+			ViewMethod[methodID] = true
+		default:
+			GasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+type Contract struct {
+	ptypes.BaseContract
+
+	bankKeeper  bankkeeper.Keeper
+	cdc         codec.Codec
+	kvGasConfig storetypes.GasConfig
+}
+
+func NewIBankContract(
+	bankKeeper bankkeeper.Keeper,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) *Contract {
+	return &Contract{
+		BaseContract: ptypes.NewBaseContract(ContractAddress),
+		bankKeeper:   bankKeeper,
+		cdc:          cdc,
+		kvGasConfig:  kvGasConfig,
+	}
+}
+
+// Address() is required to implement the PrecompiledContract interface.
+func (c *Contract) Address() common.Address {
+	return ContractAddress
+}
+
+// Abi() is required to implement the PrecompiledContract interface.
+func (c *Contract) Abi() abi.ABI {
+	return ABI
+}
+
+// RequiredGas is required to implement the PrecompiledContract interface.
+// The gas has to be calculated deterministically based on the input.
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	// get methodID (first 4 bytes)
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * c.kvGasConfig.WriteCostPerByte
+	if ViewMethod[methodID] {
+		baseCost = uint64(len(input)) * c.kvGasConfig.ReadCostPerByte
+	}
+
+	if requiredGas, ok := GasRequiredByMethod[methodID]; ok {
+		return requiredGas + baseCost
+	}
+
+	// Can not happen, but return 0 if the method is not found.
+	return 0
+}
+
+// BalanceOf returns the balance of an account for a denom
+func (c *Contract) BalanceOf(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 2,
+		})
+	}
+
+	account, ok := args[0].(common.Address)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[1],
+		}
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+
+	balance := c.bankKeeper.GetBalance(ctx, sdk.AccAddress(account.Bytes()), denom)
+
+	return method.Outputs.Pack(balance.Amount.BigInt())
+}
+
+// TotalSupply returns the total supply of a denom
+func (c *Contract) TotalSupply(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		})
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+
+	supply := c.bankKeeper.GetSupply(ctx, denom)
+
+	return method.Outputs.Pack(supply.Amount.BigInt())
+}
+
+// Send sends coins of a denom from the caller to an address
+func (c *Contract) Send(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 3,
+		})
+	}
+
+	toAddress, ok := args[0].(common.Address)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	denom, ok := args[1].(string)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[1],
+		}
+	}
+
+	amount, ok := args[2].(*big.Int)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[2],
+		}
+	}
+
+	coins := sdk.Coins{sdk.Coin{Denom: denom, Amount: math.NewIntFromBigInt(amount)}}
+	if err := coins.Validate(); err != nil {
+		return nil, err
+	}
+
+	fromAddress := contract.CallerAddress
+
+	msgServer := bankkeeper.NewMsgServerImpl(c.bankKeeper)
+	_, err := msgServer.Send(ctx, &banktypes.MsgSend{
+		FromAddress: sdk.AccAddress(fromAddress.Bytes()).String(),
+		ToAddress:   sdk.AccAddress(toAddress.Bytes()).String(),
+		Amount:      coins,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// balances of the evm denom are also tracked in the stateDB, if sender or recipient is not the origin,
+	// its state might be updated as well in the current transaction, so the amount is manually
+	// reflected in stateDB, to keep it consistent with bank module
+	stateDB := evm.StateDB.(ptypes.ExtStateDB)
+	if denom == config.BaseDenom {
+		if fromAddress != evm.Origin {
+			stateDB.SubBalance(fromAddress, amount)
+		}
+		if toAddress != evm.Origin {
+			stateDB.AddBalance(toAddress, amount)
+		}
+	}
+
+	err = c.AddSendLog(ctx, stateDB, fromAddress, toAddress, denom, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Run is the entrypoint of the precompiled contract, it switches over the input method,
+// and execute them accordingly.
+func (c *Contract) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	// the methods moving funds can't be called in a static call
+	if readOnly && !method.IsConstant() {
+		return nil, ptypes.ErrWriteMethod{
+			Method: method.Name,
+		}
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	stateDB := evm.StateDB.(ptypes.ExtStateDB)
+
+	switch method.Name {
+	case BalanceOfMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.BalanceOf(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case TotalSupplyMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.TotalSupply(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case SendMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.Send(ctx, evm, contract, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+
+	default:
+		return nil, ptypes.ErrInvalidMethod{
+			Method: method.Name,
+		}
+	}
+}
@@ -0,0 +1,375 @@
+package bank
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmdb "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+	"github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func setup(t *testing.T) (sdk.Context, *Contract, abi.ABI, keeper.SDKKeepers, *vm.EVM, *vm.Contract) {
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+
+	cdc := keeper.NewCodec()
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	keys, memKeys, tkeys, allKeys := keeper.StoreKeys()
+	sdkKeepers := keeper.NewSDKKeepersWithKeys(cdc, keys, memKeys, tkeys, allKeys)
+	for _, key := range keys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+	for _, key := range tkeys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+	}
+	for _, key := range memKeys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeMemory, nil)
+	}
+
+	gasConfig := storetypes.TransientGasConfig()
+	ctx := keeper.NewContext(stateStore)
+
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	sdkKeepers.InitGenesis(ctx)
+
+	contract := NewIBankContract(sdkKeepers.BankKeeper, appCodec, gasConfig)
+	require.NotNil(t, contract, "NewIBankContract() should not return a nil contract")
+
+	abi := contract.Abi()
+	require.NotNil(t, abi, "contract ABI should not be nil")
+
+	address := contract.Address()
+	require.NotNil(t, address, "contract address should not be nil")
+
+	mockEVM := vm.NewEVM(
+		vm.BlockContext{},
+		vm.TxContext{},
+		statedb.New(ctx, sdkKeepers.EvmKeeper, statedb.TxConfig{}),
+		&params.ChainConfig{},
+		vm.Config{},
+	)
+	mockVMContract := vm.NewContract(
+		contractRef{address: common.Address{}},
+		contractRef{address: ContractAddress},
+		big.NewInt(0),
+		0,
+	)
+	return ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract
+}
+
+func packInputArgs(t *testing.T, methodID abi.Method, args ...interface{}) []byte {
+	input, err := methodID.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(methodID.ID, input...)
+}
+
+// fundAccount mints the given coins to the account
+func fundAccount(t *testing.T, ctx sdk.Context, sdkKeepers keeper.SDKKeepers, account sdk.AccAddress, coins sdk.Coins) {
+	err := sdkKeepers.BankKeeper.MintCoins(ctx, fungibletypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = sdkKeepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, fungibletypes.ModuleName, account, coins)
+	require.NoError(t, err)
+}
+
+type contractRef struct {
+	address common.Address
+}
+
+func (c contractRef) Address() common.Address {
+	return c.address
+}
+
+func Test_IBankContract(t *testing.T) {
+	_, contract, abi, _, _, _ := setup(t)
+	gasConfig := storetypes.TransientGasConfig()
+
+	t.Run("should check methods are present in ABI", func(t *testing.T) {
+		require.NotNil(t, abi.Methods[SendMethodName], "send method should be present in the ABI")
+		require.NotNil(t, abi.Methods[BalanceOfMethodName], "balanceOf method should be present in the ABI")
+		require.NotNil(t, abi.Methods[TotalSupplyMethodName], "totalSupply method should be present in the ABI")
+	})
+
+	t.Run("should check gas requirements for methods", func(t *testing.T) {
+		var method [4]byte
+
+		t.Run("send", func(t *testing.T) {
+			// ACT
+			send := contract.RequiredGas(abi.Methods[SendMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[SendMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.WriteCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, send)
+		})
+
+		t.Run("balanceOf", func(t *testing.T) {
+			// ACT
+			balanceOf := contract.RequiredGas(abi.Methods[BalanceOfMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[BalanceOfMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.ReadCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, balanceOf)
+		})
+
+		t.Run("totalSupply", func(t *testing.T) {
+			// ACT
+			totalSupply := contract.RequiredGas(abi.Methods[TotalSupplyMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[TotalSupplyMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.ReadCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, totalSupply)
+		})
+
+		t.Run("invalid method", func(t *testing.T) {
+			// ACT
+			gasInvalidMethod := contract.RequiredGas([]byte("invalidMethod"))
+			// ASSERT
+			require.Equal(t, uint64(0), gasInvalidMethod)
+		})
+	})
+}
+
+func Test_InvalidMethod(t *testing.T) {
+	_, _, abi, _, _, _ := setup(t)
+
+	_, doNotExist := abi.Methods["invalidMethod"]
+	require.False(t, doNotExist, "invalidMethod should not be present in the ABI")
+}
+
+func Test_InvalidABI(t *testing.T) {
+	IBankMetaData.ABI = "invalid json"
+	defer func() {
+		if r := recover(); r != nil {
+			require.IsType(t, &json.SyntaxError{}, r, "expected error type: json.SyntaxError, got: %T", r)
+		}
+	}()
+
+	initABI()
+}
+
+func Test_BalanceOf(t *testing.T) {
+	for _, denom := range []string{config.BaseDenom, ibcDenom} {
+		t.Run("should return balance of "+denom, func(t *testing.T) {
+			// ARRANGE
+			ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+			methodID := abi.Methods[BalanceOfMethodName]
+			account := sample.Bech32AccAddress()
+			fundAccount(t, ctx, sdkKeepers, account, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1000))))
+			mockVMContract.Input = packInputArgs(t, methodID, common.BytesToAddress(account.Bytes()), denom)
+
+			// ACT
+			res, err := contract.Run(mockEVM, mockVMContract, false)
+
+			// ASSERT
+			require.NoError(t, err)
+			balance, err := methodID.Outputs.Unpack(res)
+			require.NoError(t, err)
+			require.Equal(t, int64(1000), balance[0].(*big.Int).Int64())
+		})
+	}
+
+	t.Run("should return zero for unknown account", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[BalanceOfMethodName]
+		mockVMContract.Input = packInputArgs(t, methodID, sample.EthAddress(), config.BaseDenom)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		balance, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), balance[0].(*big.Int).Int64())
+	})
+
+	t.Run("should fail if denom is invalid", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[BalanceOfMethodName]
+		mockVMContract.Input = packInputArgs(t, methodID, sample.EthAddress(), "!")
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if account is not eth addr", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _ := setup(t)
+		methodID := abi.Methods[BalanceOfMethodName]
+
+		// ACT
+		_, err := contract.BalanceOf(ctx, &methodID, []interface{}{"account", config.BaseDenom})
+
+		// ASSERT
+		require.ErrorAs(t, err, &ptypes.ErrInvalidArgument{})
+	})
+}
+
+func Test_TotalSupply(t *testing.T) {
+	t.Run("should return total supply", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[TotalSupplyMethodName]
+		fundAccount(t, ctx, sdkKeepers, sample.Bech32AccAddress(), sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000))))
+		fundAccount(t, ctx, sdkKeepers, sample.Bech32AccAddress(), sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(500))))
+		mockVMContract.Input = packInputArgs(t, methodID, ibcDenom)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		supply, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, int64(1500), supply[0].(*big.Int).Int64())
+	})
+
+	t.Run("should fail if wrong args amount", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _ := setup(t)
+		methodID := abi.Methods[TotalSupplyMethodName]
+
+		// ACT
+		_, err := contract.TotalSupply(ctx, &methodID, []interface{}{})
+
+		// ASSERT
+		require.Error(t, err)
+	})
+}
+
+func Test_Send(t *testing.T) {
+	for _, denom := range []string{config.BaseDenom, ibcDenom} {
+		t.Run("should send "+denom, func(t *testing.T) {
+			// ARRANGE
+			ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+			methodID := abi.Methods[SendMethodName]
+			sender := sample.Bech32AccAddress()
+			recipient := sample.Bech32AccAddress()
+			fundAccount(t, ctx, sdkKeepers, sender, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1000))))
+
+			mockVMContract.CallerAddress = common.BytesToAddress(sender.Bytes())
+			mockVMContract.Input = packInputArgs(
+				t,
+				methodID,
+				common.BytesToAddress(recipient.Bytes()),
+				denom,
+				big.NewInt(400),
+			)
+
+			// ACT
+			res, err := contract.Run(mockEVM, mockVMContract, false)
+
+			// ASSERT
+			require.NoError(t, err)
+			success, err := methodID.Outputs.Unpack(res)
+			require.NoError(t, err)
+			require.True(t, success[0].(bool))
+
+			// balances are consistent once the stateDB is committed
+			require.NoError(t, mockEVM.StateDB.(*statedb.StateDB).Commit())
+			require.Equal(t, int64(600), sdkKeepers.BankKeeper.GetBalance(ctx, sender, denom).Amount.Int64())
+			require.Equal(t, int64(400), sdkKeepers.BankKeeper.GetBalance(ctx, recipient, denom).Amount.Int64())
+		})
+	}
+
+	t.Run("should fail in read-only mode", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[SendMethodName]
+		sender := sample.Bech32AccAddress()
+		fundAccount(t, ctx, sdkKeepers, sender, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000))))
+
+		mockVMContract.CallerAddress = common.BytesToAddress(sender.Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, sample.EthAddress(), ibcDenom, big.NewInt(400))
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, true)
+
+		// ASSERT
+		require.ErrorIs(t, err, ptypes.ErrWriteMethod{Method: SendMethodName})
+		require.Equal(t, int64(1000), sdkKeepers.BankKeeper.GetBalance(ctx, sender, ibcDenom).Amount.Int64())
+	})
+
+	t.Run("should fail if balance is insufficient", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[SendMethodName]
+		sender := sample.Bech32AccAddress()
+		fundAccount(t, ctx, sdkKeepers, sender, sdk.NewCoins(sdk.NewCoin(ibcDenom, sdkmath.NewInt(1000))))
+
+		mockVMContract.CallerAddress = common.BytesToAddress(sender.Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, sample.EthAddress(), ibcDenom, big.NewInt(1001))
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if amount is zero", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[SendMethodName]
+		mockVMContract.CallerAddress = sample.EthAddress()
+		mockVMContract.Input = packInputArgs(t, methodID, sample.EthAddress(), ibcDenom, big.NewInt(0))
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if denom is invalid", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[SendMethodName]
+		mockVMContract.CallerAddress = sample.EthAddress()
+		mockVMContract.Input = packInputArgs(t, methodID, sample.EthAddress(), "!", big.NewInt(1))
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if wrong args amount", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[SendMethodName]
+
+		// ACT
+		_, err := contract.Send(ctx, mockEVM, mockVMContract, &methodID, []interface{}{sample.EthAddress()})
+
+		// ASSERT
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,42 @@
+package bank
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/node/precompiles/logs"
+)
+
+const (
+	SendEventName = "Send"
+)
+
+func (c *Contract) AddSendLog(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	from common.Address,
+	to common.Address,
+	denom string,
+	amount *big.Int,
+) error {
+	event := c.Abi().Events[SendEventName]
+
+	// from and to are indexed event params
+	topics, err := logs.MakeTopics(event, []interface{}{from}, []interface{}{to})
+	if err != nil {
+		return err
+	}
+
+	// denom and amount are part of event data
+	data, err := event.Inputs.NonIndexed().Pack(denom, amount)
+	if err != nil {
+		return err
+	}
+
+	logs.AddLog(ctx, c.Address(), stateDB, topics, data)
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package precompiles
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+	fungiblekeeper "github.com/zeta-chain/node/x/fungible/keeper"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// statefulContract defines the methods implemented by all the stateful precompiled contracts.
+type statefulContract interface {
+	vm.PrecompiledContract
+	Abi() abi.ABI
+}
+
+// configuredContract is a stateful precompiled contract with the on-chain config of the fungible module applied.
+// The config can disable the contract or override the gas required by its methods.
+type configuredContract struct {
+	statefulContract
+	config    fungibletypes.PrecompileConfig
+	gasConfig storetypes.GasConfig
+}
+
+// withConfig returns the contract with its on-chain config applied.
+// The contract is returned as is if no config is set, the default enablement and gas of the binary being used.
+func withConfig(
+	ctx sdktypes.Context,
+	fungibleKeeper *fungiblekeeper.Keeper,
+	gasConfig storetypes.GasConfig,
+	contract statefulContract,
+) vm.PrecompiledContract {
+	config, found := fungibleKeeper.GetPrecompileConfig(ctx, contract.Address())
+	if !found {
+		return contract
+	}
+
+	return &configuredContract{
+		statefulContract: contract,
+		config:           config,
+		gasConfig:        gasConfig,
+	}
+}
+
+// RequiredGas returns the gas configured for the called method on top of the cost of the input,
+// or the default gas of the contract if not configured.
+// The cost of the input is charged per byte as the contracts do, reading for view methods and writing otherwise.
+func (c *configuredContract) RequiredGas(input []byte) uint64 {
+	if len(input) >= 4 {
+		contractABI := c.Abi()
+		if method, err := contractABI.MethodById(input[:4]); err == nil {
+			if gas, found := c.config.GasForMethod(method.Name); found {
+				costPerByte := c.gasConfig.WriteCostPerByte
+				if method.IsConstant() {
+					costPerByte = c.gasConfig.ReadCostPerByte
+				}
+				return gas + uint64(len(input))*costPerByte
+			}
+		}
+	}
+
+	return c.statefulContract.RequiredGas(input)
+}
+
+// Run executes the contract if it is enabled.
+func (c *configuredContract) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if !c.config.Enabled {
+		return nil, ptypes.ErrDisabledContract{
+			Contract: c.Address().Hex(),
+		}
+	}
+
+	return c.statefulContract.Run(evm, contract, readonly)
+}
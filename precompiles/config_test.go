@@ -0,0 +1,75 @@
+package precompiles
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+
+	"github.com/zeta-chain/node/precompiles/prototype"
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+	"github.com/zeta-chain/node/testutil/keeper"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+func Test_WithConfig(t *testing.T) {
+	keeper.SetConfig(false)
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+	gasConfig := storetypes.TransientGasConfig()
+
+	bech32ifyMethod := prototype.ABI.Methods[prototype.Bech32ifyMethodName]
+	bech32ToHexMethod := prototype.ABI.Methods[prototype.Bech32ToHexAddrMethodName]
+
+	t.Run("should return the contract if no config is set", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keeper.FungibleKeeper(t)
+		contract := prototype.NewIPrototypeContract(k, appCodec, gasConfig)
+
+		// ACT
+		configured := withConfig(ctx, k, gasConfig, contract)
+
+		// ASSERT
+		require.Equal(t, contract, configured)
+	})
+
+	t.Run("should override the gas of configured methods", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keeper.FungibleKeeper(t)
+		contract := prototype.NewIPrototypeContract(k, appCodec, gasConfig)
+		k.SetPrecompileConfig(ctx, fungibletypes.PrecompileConfig{
+			Address: prototype.ContractAddress.Hex(),
+			Enabled: true,
+			MethodGas: []fungibletypes.PrecompileMethodGas{
+				{Method: prototype.Bech32ifyMethodName, Gas: 42},
+			},
+		})
+
+		// ACT
+		configured := withConfig(ctx, k, gasConfig, contract)
+
+		// ASSERT
+		inputCost := uint64(len(bech32ifyMethod.ID)) * gasConfig.ReadCostPerByte
+		require.EqualValues(t, 42+inputCost, configured.RequiredGas(bech32ifyMethod.ID))
+		require.Equal(t, contract.RequiredGas(bech32ToHexMethod.ID), configured.RequiredGas(bech32ToHexMethod.ID))
+	})
+
+	t.Run("should fail to run a disabled contract", func(t *testing.T) {
+		// ARRANGE
+		k, ctx, _, _ := keeper.FungibleKeeper(t)
+		contract := prototype.NewIPrototypeContract(k, appCodec, gasConfig)
+		k.SetPrecompileConfig(ctx, fungibletypes.PrecompileConfig{
+			Address: prototype.ContractAddress.Hex(),
+			Enabled: false,
+		})
+
+		// ACT
+		configured := withConfig(ctx, k, gasConfig, contract)
+		_, err := configured.Run(nil, &vm.Contract{Input: bech32ToHexMethod.ID}, false)
+
+		// ASSERT
+		require.ErrorIs(t, err, ptypes.ErrDisabledContract{Contract: prototype.ContractAddress.Hex()})
+	})
+}
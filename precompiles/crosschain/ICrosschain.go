@@ -0,0 +1,383 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package crosschain
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// CrossChainTx is an auto generated low-level Go binding around an user-defined struct.
+type CrossChainTx struct {
+	Index               string
+	Status              uint8
+	StatusMessage       string
+	SenderChainId       int64
+	Sender              string
+	InboundHash         string
+	InboundAmount       *big.Int
+	ReceiverChainId     int64
+	Receiver            string
+	OutboundAmount      *big.Int
+	OutboundHash        string
+	OutboundNonce       uint64
+	CoinType            uint8
+	Asset               string
+	LastUpdateTimestamp int64
+}
+
+// ICrosschainMetaData contains all meta data concerning the ICrosschain contract.
+var ICrosschainMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"string\",\"name\":\"index\",\"type\":\"string\"}],\"name\":\"getCctx\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"index\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"statusMessage\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"senderChainId\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"inboundHash\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"inboundAmount\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"receiverChainId\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"receiver\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"outboundAmount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"outboundHash\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"outboundNonce\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"coinType\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"asset\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"lastUpdateTimestamp\",\"type\":\"int64\"}],\"internalType\":\"structCrossChainTx\",\"name\":\"cctx\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int64\",\"name\":\"chainId\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"inboundHash\",\"type\":\"string\"}],\"name\":\"getCctxByInbound\",\"outputs\":[{\"components\":[{\"internalType\":\"string\",\"name\":\"index\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"status\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"statusMessage\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"senderChainId\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"sender\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"inboundHash\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"inboundAmount\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"receiverChainId\",\"type\":\"int64\"},{\"internalType\":\"string\",\"name\":\"receiver\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"outboundAmount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"outboundHash\",\"type\":\"string\"},{\"internalType\":\"uint64\",\"name\":\"outboundNonce\",\"type\":\"uint64\"},{\"internalType\":\"uint8\",\"name\":\"coinType\",\"type\":\"uint8\"},{\"internalType\":\"string\",\"name\":\"asset\",\"type\":\"string\"},{\"internalType\":\"int64\",\"name\":\"lastUpdateTimestamp\",\"type\":\"int64\"}],\"internalType\":\"structCrossChainTx\",\"name\":\"cctx\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int64\",\"name\":\"chainId\",\"type\":\"int64\"}],\"name\":\"getGasPrice\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"priorityFee\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int64\",\"name\":\"chainId\",\"type\":\"int64\"}],\"name\":\"getPendingNonces\",\"outputs\":[{\"internalType\":\"int64\",\"name\":\"nonceLow\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"nonceHigh\",\"type\":\"int64\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"int64\",\"name\":\"chainId\",\"type\":\"int64\"}],\"name\":\"getTssAddress\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"tssAddress\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// ICrosschainABI is the input ABI used to generate the binding from.
+// Deprecated: Use ICrosschainMetaData.ABI instead.
+var ICrosschainABI = ICrosschainMetaData.ABI
+
+// ICrosschain is an auto generated Go binding around an Ethereum contract.
+type ICrosschain struct {
+	ICrosschainCaller     // Read-only binding to the contract
+	ICrosschainTransactor // Write-only binding to the contract
+	ICrosschainFilterer   // Log filterer for contract events
+}
+
+// ICrosschainCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ICrosschainCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ICrosschainTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ICrosschainTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ICrosschainFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ICrosschainFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ICrosschainSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ICrosschainSession struct {
+	Contract     *ICrosschain      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ICrosschainCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ICrosschainCallerSession struct {
+	Contract *ICrosschainCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// ICrosschainTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ICrosschainTransactorSession struct {
+	Contract     *ICrosschainTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// ICrosschainRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ICrosschainRaw struct {
+	Contract *ICrosschain // Generic contract binding to access the raw methods on
+}
+
+// ICrosschainCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ICrosschainCallerRaw struct {
+	Contract *ICrosschainCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ICrosschainTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ICrosschainTransactorRaw struct {
+	Contract *ICrosschainTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewICrosschain creates a new instance of ICrosschain, bound to a specific deployed contract.
+func NewICrosschain(address common.Address, backend bind.ContractBackend) (*ICrosschain, error) {
+	contract, err := bindICrosschain(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ICrosschain{ICrosschainCaller: ICrosschainCaller{contract: contract}, ICrosschainTransactor: ICrosschainTransactor{contract: contract}, ICrosschainFilterer: ICrosschainFilterer{contract: contract}}, nil
+}
+
+// NewICrosschainCaller creates a new read-only instance of ICrosschain, bound to a specific deployed contract.
+func NewICrosschainCaller(address common.Address, caller bind.ContractCaller) (*ICrosschainCaller, error) {
+	contract, err := bindICrosschain(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ICrosschainCaller{contract: contract}, nil
+}
+
+// NewICrosschainTransactor creates a new write-only instance of ICrosschain, bound to a specific deployed contract.
+func NewICrosschainTransactor(address common.Address, transactor bind.ContractTransactor) (*ICrosschainTransactor, error) {
+	contract, err := bindICrosschain(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ICrosschainTransactor{contract: contract}, nil
+}
+
+// NewICrosschainFilterer creates a new log filterer instance of ICrosschain, bound to a specific deployed contract.
+func NewICrosschainFilterer(address common.Address, filterer bind.ContractFilterer) (*ICrosschainFilterer, error) {
+	contract, err := bindICrosschain(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ICrosschainFilterer{contract: contract}, nil
+}
+
+// bindICrosschain binds a generic wrapper to an already deployed contract.
+func bindICrosschain(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ICrosschainMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ICrosschain *ICrosschainRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ICrosschain.Contract.ICrosschainCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ICrosschain *ICrosschainRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ICrosschain.Contract.ICrosschainTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ICrosschain *ICrosschainRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ICrosschain.Contract.ICrosschainTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ICrosschain *ICrosschainCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ICrosschain.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ICrosschain *ICrosschainTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ICrosschain.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ICrosschain *ICrosschainTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ICrosschain.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetCctx is a free data retrieval call binding the contract method 0x023e5d27.
+//
+// Solidity: function getCctx(string index) view returns((string,uint8,string,int64,string,string,uint256,int64,string,uint256,string,uint64,uint8,string,int64) cctx)
+func (_ICrosschain *ICrosschainCaller) GetCctx(opts *bind.CallOpts, index string) (CrossChainTx, error) {
+	var out []interface{}
+	err := _ICrosschain.contract.Call(opts, &out, "getCctx", index)
+
+	if err != nil {
+		return *new(CrossChainTx), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(CrossChainTx)).(*CrossChainTx)
+
+	return out0, err
+
+}
+
+// GetCctx is a free data retrieval call binding the contract method 0x023e5d27.
+//
+// Solidity: function getCctx(string index) view returns((string,uint8,string,int64,string,string,uint256,int64,string,uint256,string,uint64,uint8,string,int64) cctx)
+func (_ICrosschain *ICrosschainSession) GetCctx(index string) (CrossChainTx, error) {
+	return _ICrosschain.Contract.GetCctx(&_ICrosschain.CallOpts, index)
+}
+
+// GetCctx is a free data retrieval call binding the contract method 0x023e5d27.
+//
+// Solidity: function getCctx(string index) view returns((string,uint8,string,int64,string,string,uint256,int64,string,uint256,string,uint64,uint8,string,int64) cctx)
+func (_ICrosschain *ICrosschainCallerSession) GetCctx(index string) (CrossChainTx, error) {
+	return _ICrosschain.Contract.GetCctx(&_ICrosschain.CallOpts, index)
+}
+
+// GetCctxByInbound is a free data retrieval call binding the contract method 0xc3e33587.
+//
+// Solidity: function getCctxByInbound(int64 chainId, string inboundHash) view returns((string,uint8,string,int64,string,string,uint256,int64,string,uint256,string,uint64,uint8,string,int64) cctx)
+func (_ICrosschain *ICrosschainCaller) GetCctxByInbound(opts *bind.CallOpts, chainId int64, inboundHash string) (CrossChainTx, error) {
+	var out []interface{}
+	err := _ICrosschain.contract.Call(opts, &out, "getCctxByInbound", chainId, inboundHash)
+
+	if err != nil {
+		return *new(CrossChainTx), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(CrossChainTx)).(*CrossChainTx)
+
+	return out0, err
+
+}
+
+// GetCctxByInbound is a free data retrieval call binding the contract method 0xc3e33587.
+//
+// Solidity: function getCctxByInbound(int64 chainId, string inboundHash) view returns((string,uint8,string,int64,string,string,uint256,int64,string,uint256,string,uint64,uint8,string,int64) cctx)
+func (_ICrosschain *ICrosschainSession) GetCctxByInbound(chainId int64, inboundHash string) (CrossChainTx, error) {
+	return _ICrosschain.Contract.GetCctxByInbound(&_ICrosschain.CallOpts, chainId, inboundHash)
+}
+
+// GetCctxByInbound is a free data retrieval call binding the contract method 0xc3e33587.
+//
+// Solidity: function getCctxByInbound(int64 chainId, string inboundHash) view returns((string,uint8,string,int64,string,string,uint256,int64,string,uint256,string,uint64,uint8,string,int64) cctx)
+func (_ICrosschain *ICrosschainCallerSession) GetCctxByInbound(chainId int64, inboundHash string) (CrossChainTx, error) {
+	return _ICrosschain.Contract.GetCctxByInbound(&_ICrosschain.CallOpts, chainId, inboundHash)
+}
+
+// GetGasPrice is a free data retrieval call binding the contract method 0xc0a27af8.
+//
+// Solidity: function getGasPrice(int64 chainId) view returns(uint256 gasPrice, uint256 priorityFee)
+func (_ICrosschain *ICrosschainCaller) GetGasPrice(opts *bind.CallOpts, chainId int64) (struct {
+	GasPrice    *big.Int
+	PriorityFee *big.Int
+}, error) {
+	var out []interface{}
+	err := _ICrosschain.contract.Call(opts, &out, "getGasPrice", chainId)
+
+	outstruct := new(struct {
+		GasPrice    *big.Int
+		PriorityFee *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.GasPrice = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.PriorityFee = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetGasPrice is a free data retrieval call binding the contract method 0xc0a27af8.
+//
+// Solidity: function getGasPrice(int64 chainId) view returns(uint256 gasPrice, uint256 priorityFee)
+func (_ICrosschain *ICrosschainSession) GetGasPrice(chainId int64) (struct {
+	GasPrice    *big.Int
+	PriorityFee *big.Int
+}, error) {
+	return _ICrosschain.Contract.GetGasPrice(&_ICrosschain.CallOpts, chainId)
+}
+
+// GetGasPrice is a free data retrieval call binding the contract method 0xc0a27af8.
+//
+// Solidity: function getGasPrice(int64 chainId) view returns(uint256 gasPrice, uint256 priorityFee)
+func (_ICrosschain *ICrosschainCallerSession) GetGasPrice(chainId int64) (struct {
+	GasPrice    *big.Int
+	PriorityFee *big.Int
+}, error) {
+	return _ICrosschain.Contract.GetGasPrice(&_ICrosschain.CallOpts, chainId)
+}
+
+// GetPendingNonces is a free data retrieval call binding the contract method 0x810e3c8a.
+//
+// Solidity: function getPendingNonces(int64 chainId) view returns(int64 nonceLow, int64 nonceHigh)
+func (_ICrosschain *ICrosschainCaller) GetPendingNonces(opts *bind.CallOpts, chainId int64) (struct {
+	NonceLow  int64
+	NonceHigh int64
+}, error) {
+	var out []interface{}
+	err := _ICrosschain.contract.Call(opts, &out, "getPendingNonces", chainId)
+
+	outstruct := new(struct {
+		NonceLow  int64
+		NonceHigh int64
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.NonceLow = *abi.ConvertType(out[0], new(int64)).(*int64)
+	outstruct.NonceHigh = *abi.ConvertType(out[1], new(int64)).(*int64)
+
+	return *outstruct, err
+
+}
+
+// GetPendingNonces is a free data retrieval call binding the contract method 0x810e3c8a.
+//
+// Solidity: function getPendingNonces(int64 chainId) view returns(int64 nonceLow, int64 nonceHigh)
+func (_ICrosschain *ICrosschainSession) GetPendingNonces(chainId int64) (struct {
+	NonceLow  int64
+	NonceHigh int64
+}, error) {
+	return _ICrosschain.Contract.GetPendingNonces(&_ICrosschain.CallOpts, chainId)
+}
+
+// GetPendingNonces is a free data retrieval call binding the contract method 0x810e3c8a.
+//
+// Solidity: function getPendingNonces(int64 chainId) view returns(int64 nonceLow, int64 nonceHigh)
+func (_ICrosschain *ICrosschainCallerSession) GetPendingNonces(chainId int64) (struct {
+	NonceLow  int64
+	NonceHigh int64
+}, error) {
+	return _ICrosschain.Contract.GetPendingNonces(&_ICrosschain.CallOpts, chainId)
+}
+
+// GetTssAddress is a free data retrieval call binding the contract method 0x83563604.
+//
+// Solidity: function getTssAddress(int64 chainId) view returns(string tssAddress)
+func (_ICrosschain *ICrosschainCaller) GetTssAddress(opts *bind.CallOpts, chainId int64) (string, error) {
+	var out []interface{}
+	err := _ICrosschain.contract.Call(opts, &out, "getTssAddress", chainId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetTssAddress is a free data retrieval call binding the contract method 0x83563604.
+//
+// Solidity: function getTssAddress(int64 chainId) view returns(string tssAddress)
+func (_ICrosschain *ICrosschainSession) GetTssAddress(chainId int64) (string, error) {
+	return _ICrosschain.Contract.GetTssAddress(&_ICrosschain.CallOpts, chainId)
+}
+
+// GetTssAddress is a free data retrieval call binding the contract method 0x83563604.
+//
+// Solidity: function getTssAddress(int64 chainId) view returns(string tssAddress)
+func (_ICrosschain *ICrosschainCallerSession) GetTssAddress(chainId int64) (string, error) {
+	return _ICrosschain.Contract.GetTssAddress(&_ICrosschain.CallOpts, chainId)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.26;
+
+/// @dev The ICrosschain contract's address.
+address constant ICROSSCHAIN_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000068; // 104
+
+/// @dev The ICrosschain contract's instance.
+ICrosschain constant ICROSSCHAIN_CONTRACT = ICrosschain(
+    ICROSSCHAIN_PRECOMPILE_ADDRESS
+);
+
+/// @notice Status of a cross-chain transaction
+/// @dev Mirrors the CctxStatus enum of the crosschain module
+enum CctxStatus {
+    PendingInbound,
+    PendingOutbound,
+    OutboundMined,
+    PendingRevert,
+    Reverted,
+    Aborted
+}
+
+/// @notice Cross-chain transaction as stored in the crosschain module
+struct CrossChainTx {
+    string index;
+    uint8 status;
+    string statusMessage;
+    int64 senderChainId;
+    string sender;
+    string inboundHash;
+    uint256 inboundAmount;
+    int64 receiverChainId;
+    string receiver;
+    uint256 outboundAmount;
+    string outboundHash;
+    uint64 outboundNonce;
+    uint8 coinType;
+    string asset;
+    int64 lastUpdateTimestamp;
+}
+
+interface ICrosschain {
+    /// @notice Get a cross-chain transaction by its index
+    /// @param index Index of the cross-chain transaction
+    /// @return cctx Cross-chain transaction
+    function getCctx(
+        string memory index
+    ) external view returns (CrossChainTx calldata cctx);
+
+    /// @notice Get the cross-chain transaction created by an inbound transaction
+    /// @param chainId Chain ID of the inbound transaction
+    /// @param inboundHash Hash of the inbound transaction
+    /// @return cctx Cross-chain transaction
+    function getCctxByInbound(
+        int64 chainId,
+        string memory inboundHash
+    ) external view returns (CrossChainTx calldata cctx);
+
+    /// @notice Get the pending outbound nonces of a chain for the current TSS
+    /// @param chainId Chain ID
+    /// @return nonceLow Lowest pending nonce
+    /// @return nonceHigh Next nonce to be assigned
+    function getPendingNonces(
+        int64 chainId
+    ) external view returns (int64 nonceLow, int64 nonceHigh);
+
+    /// @notice Get the median gas price voted by observers for a chain
+    /// @param chainId Chain ID
+    /// @return gasPrice Median gas price
+    /// @return priorityFee Median priority fee
+    function getGasPrice(
+        int64 chainId
+    ) external view returns (uint256 gasPrice, uint256 priorityFee);
+
+    /// @notice Get the address of the current TSS on a chain
+    /// @param chainId Chain ID
+    /// @return tssAddress TSS address encoded for the chain
+    function getTssAddress(
+        int64 chainId
+    ) external view returns (string memory tssAddress);
+}
@@ -0,0 +1,405 @@
+package crosschain
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/crypto"
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// method names
+const (
+	// read
+	GetCctxMethodName          = "getCctx"
+	GetCctxByInboundMethodName = "getCctxByInbound"
+	GetPendingNoncesMethodName = "getPendingNonces"
+	GetGasPriceMethodName      = "getGasPrice"
+	GetTssAddressMethodName    = "getTssAddress"
+)
+
+var (
+	ABI                 abi.ABI
+	ContractAddress     = common.HexToAddress("0x0000000000000000000000000000000000000068")
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	ViewMethod          = map[[4]byte]bool{}
+)
+
+func init() {
+	initABI()
+}
+
+func initABI() {
+	if err := ABI.UnmarshalJSON([]byte(ICrosschainMetaData.ABI)); err != nil {
+		panic(err)
+	}
+
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	for methodName := range ABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], ABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case GetCctxMethodName,
+			GetCctxByInboundMethodName,
+			GetPendingNoncesMethodName,
+			GetGasPriceMethodName,
+			GetTssAddressMethodName:
+			GasRequiredByMethod[methodID] = 0
+			ViewMethod[methodID] = true
+		default:
+			GasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+type Contract struct {
+	ptypes.BaseContract
+
+	crosschainKeeper crosschainkeeper.Keeper
+	cdc              codec.Codec
+	kvGasConfig      storetypes.GasConfig
+}
+
+func NewICrosschainContract(
+	crosschainKeeper *crosschainkeeper.Keeper,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) *Contract {
+	return &Contract{
+		BaseContract:     ptypes.NewBaseContract(ContractAddress),
+		crosschainKeeper: *crosschainKeeper,
+		cdc:              cdc,
+		kvGasConfig:      kvGasConfig,
+	}
+}
+
+// Address() is required to implement the PrecompiledContract interface.
+func (c *Contract) Address() common.Address {
+	return ContractAddress
+}
+
+// Abi() is required to implement the PrecompiledContract interface.
+func (c *Contract) Abi() abi.ABI {
+	return ABI
+}
+
+// RequiredGas is required to implement the PrecompiledContract interface.
+// The gas has to be calculated deterministically based on the input.
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	// get methodID (first 4 bytes)
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * c.kvGasConfig.WriteCostPerByte
+	if ViewMethod[methodID] {
+		baseCost = uint64(len(input)) * c.kvGasConfig.ReadCostPerByte
+	}
+
+	if requiredGas, ok := GasRequiredByMethod[methodID]; ok {
+		return requiredGas + baseCost
+	}
+
+	// Can not happen, but return 0 if the method is not found.
+	return 0
+}
+
+// GetCctx returns the cctx with the given index
+func (c *Contract) GetCctx(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		})
+	}
+
+	index, ok := args[0].(string)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	cctx, found := c.crosschainKeeper.GetCrossChainTx(ctx, index)
+	if !found {
+		return nil, fmt.Errorf("cctx %s not found", index)
+	}
+
+	return method.Outputs.Pack(toCrossChainTx(cctx))
+}
+
+// GetCctxByInbound returns the cctx created by the inbound with the given hash on the given chain
+func (c *Contract) GetCctxByInbound(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 2,
+		})
+	}
+
+	chainID, ok := args[0].(int64)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	inboundHash, ok := args[1].(string)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[1],
+		}
+	}
+
+	inboundHashToCctx, found := c.crosschainKeeper.GetInboundHashToCctx(ctx, inboundHash)
+	if found {
+		for _, cctxIndex := range inboundHashToCctx.CctxIndex {
+			cctx, found := c.crosschainKeeper.GetCrossChainTx(ctx, cctxIndex)
+			if found && cctx.GetInboundParams().GetSenderChainId() == chainID {
+				return method.Outputs.Pack(toCrossChainTx(cctx))
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("cctx for inbound %s on chain %d not found", inboundHash, chainID)
+}
+
+// GetPendingNonces returns the pending nonces of the current tss for the given chain
+func (c *Contract) GetPendingNonces(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	chainID, err := unpackChainID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	observerKeeper := c.crosschainKeeper.GetObserverKeeper()
+	tss, found := observerKeeper.GetTSS(ctx)
+	if !found {
+		return nil, fmt.Errorf("tss not found")
+	}
+
+	pendingNonces, found := observerKeeper.GetPendingNonces(ctx, tss.TssPubkey, chainID)
+	if !found {
+		return nil, fmt.Errorf("pending nonces for chain %d not found", chainID)
+	}
+
+	return method.Outputs.Pack(pendingNonces.NonceLow, pendingNonces.NonceHigh)
+}
+
+// GetGasPrice returns the median gas price and priority fee for the given chain
+func (c *Contract) GetGasPrice(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	chainID, err := unpackChainID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice, priorityFee, found := c.crosschainKeeper.GetMedianGasValues(ctx, chainID)
+	if !found {
+		return nil, fmt.Errorf("gas price for chain %d not found", chainID)
+	}
+
+	return method.Outputs.Pack(gasPrice.BigInt(), priorityFee.BigInt())
+}
+
+// GetTssAddress returns the address of the current tss for the given chain
+func (c *Contract) GetTssAddress(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	chainID, err := unpackChainID(args)
+	if err != nil {
+		return nil, err
+	}
+
+	observerKeeper := c.crosschainKeeper.GetObserverKeeper()
+	chain, found := observerKeeper.GetSupportedChainFromChainID(ctx, chainID)
+	if !found {
+		return nil, fmt.Errorf("chain %d not supported", chainID)
+	}
+
+	tss, found := observerKeeper.GetTSS(ctx)
+	if !found {
+		return nil, fmt.Errorf("tss not found")
+	}
+
+	// bitcoin chains use a bech32 address derived for the network
+	// all other chains use the evm address of the tss
+	if chain.IsBitcoinChain() {
+		bitcoinParams, err := chains.BitcoinNetParamsFromChainID(chainID)
+		if err != nil {
+			return nil, err
+		}
+		btcAddress, err := crypto.GetTssAddrBTC(tss.TssPubkey, bitcoinParams)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(btcAddress)
+	}
+
+	ethAddress, err := crypto.GetTssAddrEVM(tss.TssPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(ethAddress.Hex())
+}
+
+// Run is the entrypoint of the precompiled contract, it switches over the input method,
+// and execute them accordingly.
+func (c *Contract) Run(evm *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	stateDB := evm.StateDB.(ptypes.ExtStateDB)
+
+	switch method.Name {
+	case GetCctxMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.GetCctx(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case GetCctxByInboundMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.GetCctxByInbound(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case GetPendingNoncesMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.GetPendingNonces(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case GetGasPriceMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.GetGasPrice(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case GetTssAddressMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.GetTssAddress(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	default:
+		return nil, ptypes.ErrInvalidMethod{
+			Method: method.Name,
+		}
+	}
+}
+
+// unpackChainID unpacks the chain id from the arguments of a method taking the chain id as single argument
+func unpackChainID(args []interface{}) (int64, error) {
+	if len(args) != 1 {
+		return 0, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		})
+	}
+
+	chainID, ok := args[0].(int64)
+	if !ok {
+		return 0, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	return chainID, nil
+}
+
+// toCrossChainTx converts a cctx from the crosschain module to its ABI representation
+func toCrossChainTx(cctx crosschaintypes.CrossChainTx) CrossChainTx {
+	inbound := cctx.GetInboundParams()
+	outbound := cctx.GetCurrentOutboundParam()
+	status := cctx.GetCctxStatus()
+
+	// #nosec G115 enum values always in range
+	return CrossChainTx{
+		Index:               cctx.Index,
+		Status:              uint8(status.GetStatus()),
+		StatusMessage:       status.GetStatusMessage(),
+		SenderChainId:       inbound.GetSenderChainId(),
+		Sender:              inbound.GetSender(),
+		InboundHash:         inbound.GetObservedHash(),
+		InboundAmount:       uintToBigInt(inboundAmount(inbound)),
+		ReceiverChainId:     outbound.ReceiverChainId,
+		Receiver:            outbound.Receiver,
+		OutboundAmount:      uintToBigInt(outbound.Amount),
+		OutboundHash:        outbound.Hash,
+		OutboundNonce:       outbound.TssNonce,
+		CoinType:            uint8(inbound.GetCoinType()),
+		Asset:               inbound.GetAsset(),
+		LastUpdateTimestamp: status.GetLastUpdateTimestamp(),
+	}
+}
+
+// inboundAmount returns the amount of the inbound params, which can be nil
+func inboundAmount(inbound *crosschaintypes.InboundParams) math.Uint {
+	if inbound == nil {
+		return math.Uint{}
+	}
+	return inbound.Amount
+}
+
+// uintToBigInt converts a sdk uint to a big int, returning zero for unset values
+func uintToBigInt(u math.Uint) *big.Int {
+	if u.IsNil() {
+		return big.NewInt(0)
+	}
+	return u.BigInt()
+}
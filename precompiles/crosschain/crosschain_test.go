@@ -0,0 +1,503 @@
+package crosschain
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/crypto"
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+	"github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+func setup(t *testing.T) (
+	sdk.Context,
+	*Contract,
+	abi.ABI,
+	*crosschainkeeper.Keeper,
+	keeper.ZetaKeepers,
+	*vm.EVM,
+	*vm.Contract,
+) {
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+
+	k, ctx, sdkKeepers, zetaKeepers := keeper.CrosschainKeeper(t)
+	gasConfig := storetypes.TransientGasConfig()
+
+	contract := NewICrosschainContract(k, appCodec, gasConfig)
+	require.NotNil(t, contract, "NewICrosschainContract() should not return a nil contract")
+
+	abi := contract.Abi()
+	require.NotNil(t, abi, "contract ABI should not be nil")
+
+	address := contract.Address()
+	require.NotNil(t, address, "contract address should not be nil")
+
+	mockEVM := vm.NewEVM(
+		vm.BlockContext{},
+		vm.TxContext{},
+		statedb.New(ctx, sdkKeepers.EvmKeeper, statedb.TxConfig{}),
+		&params.ChainConfig{},
+		vm.Config{},
+	)
+	mockVMContract := vm.NewContract(
+		contractRef{address: common.Address{}},
+		contractRef{address: ContractAddress},
+		big.NewInt(0),
+		0,
+	)
+	return ctx, contract, abi, k, zetaKeepers, mockEVM, mockVMContract
+}
+
+func packInputArgs(t *testing.T, methodID abi.Method, args ...interface{}) []byte {
+	input, err := methodID.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(methodID.ID, input...)
+}
+
+// unpackCrossChainTx unpacks the cctx returned by getCctx and getCctxByInbound
+func unpackCrossChainTx(t *testing.T, methodID abi.Method, res []byte) CrossChainTx {
+	out, err := methodID.Outputs.Unpack(res)
+	require.NoError(t, err)
+
+	var result struct{ Cctx CrossChainTx }
+	require.NoError(t, methodID.Outputs.Copy(&result, out))
+	return result.Cctx
+}
+
+type contractRef struct {
+	address common.Address
+}
+
+func (c contractRef) Address() common.Address {
+	return c.address
+}
+
+func Test_ICrosschainContract(t *testing.T) {
+	_, contract, abi, _, _, _, _ := setup(t)
+	gasConfig := storetypes.TransientGasConfig()
+
+	t.Run("should check methods are present in ABI", func(t *testing.T) {
+		for _, methodName := range []string{
+			GetCctxMethodName,
+			GetCctxByInboundMethodName,
+			GetPendingNoncesMethodName,
+			GetGasPriceMethodName,
+			GetTssAddressMethodName,
+		} {
+			require.NotNil(t, abi.Methods[methodName], "%s method should be present in the ABI", methodName)
+		}
+	})
+
+	t.Run("should check gas requirements for methods", func(t *testing.T) {
+		for _, methodName := range []string{
+			GetCctxMethodName,
+			GetCctxByInboundMethodName,
+			GetPendingNoncesMethodName,
+			GetGasPriceMethodName,
+			GetTssAddressMethodName,
+		} {
+			// ACT
+			gas := contract.RequiredGas(abi.Methods[methodName].ID)
+
+			// ASSERT
+			var method [4]byte
+			copy(method[:], abi.Methods[methodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.ReadCostPerByte
+			require.Equal(
+				t,
+				GasRequiredByMethod[method]+baseCost,
+				gas,
+				"%s method should require %d gas, got %d",
+				methodName,
+				GasRequiredByMethod[method]+baseCost,
+				gas,
+			)
+		}
+	})
+
+	t.Run("invalid method", func(t *testing.T) {
+		// ACT
+		gasInvalidMethod := contract.RequiredGas([]byte("invalidMethod"))
+
+		// ASSERT
+		require.Equal(t, uint64(0), gasInvalidMethod)
+	})
+}
+
+func Test_InvalidMethod(t *testing.T) {
+	_, _, abi, _, _, _, _ := setup(t)
+
+	_, doNotExist := abi.Methods["invalidMethod"]
+	require.False(t, doNotExist, "invalidMethod should not be present in the ABI")
+}
+
+func Test_InvalidABI(t *testing.T) {
+	ICrosschainMetaData.ABI = "invalid json"
+	defer func() {
+		if r := recover(); r != nil {
+			require.IsType(t, &json.SyntaxError{}, r, "expected error type: json.SyntaxError, got: %T", r)
+		}
+	}()
+
+	initABI()
+}
+
+func Test_GetCctx(t *testing.T) {
+	t.Run("should return cctx", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetCctxMethodName]
+		cctx := sample.CrossChainTx(t, "foo")
+		k.SetCrossChainTx(ctx, *cctx)
+		mockVMContract.Input = packInputArgs(t, methodID, cctx.Index)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		got := unpackCrossChainTx(t, methodID, res)
+		require.Equal(t, cctx.Index, got.Index)
+		require.EqualValues(t, cctx.CctxStatus.Status, got.Status)
+		require.Equal(t, cctx.CctxStatus.StatusMessage, got.StatusMessage)
+		require.Equal(t, cctx.InboundParams.SenderChainId, got.SenderChainId)
+		require.Equal(t, cctx.InboundParams.Sender, got.Sender)
+		require.Equal(t, cctx.InboundParams.ObservedHash, got.InboundHash)
+		require.Equal(t, cctx.InboundParams.Amount.BigInt(), got.InboundAmount)
+		require.Equal(t, cctx.GetCurrentOutboundParam().ReceiverChainId, got.ReceiverChainId)
+		require.Equal(t, cctx.GetCurrentOutboundParam().Receiver, got.Receiver)
+		require.Equal(t, cctx.GetCurrentOutboundParam().Amount.BigInt(), got.OutboundAmount)
+		require.Equal(t, cctx.GetCurrentOutboundParam().Hash, got.OutboundHash)
+		require.Equal(t, cctx.GetCurrentOutboundParam().TssNonce, got.OutboundNonce)
+		require.EqualValues(t, cctx.InboundParams.CoinType, got.CoinType)
+		require.Equal(t, cctx.InboundParams.Asset, got.Asset)
+		require.Equal(t, cctx.CctxStatus.LastUpdateTimestamp, got.LastUpdateTimestamp)
+	})
+
+	t.Run("should fail if cctx not found", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetCctxMethodName]
+		mockVMContract.Input = packInputArgs(t, methodID, "foo")
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("should fail if index is not a string", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _, _ := setup(t)
+		methodID := abi.Methods[GetCctxMethodName]
+
+		// ACT
+		_, err := contract.GetCctx(ctx, &methodID, []interface{}{int64(1)})
+
+		// ASSERT
+		require.ErrorAs(t, err, &ptypes.ErrInvalidArgument{})
+	})
+
+	t.Run("should fail if wrong args amount", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _, _ := setup(t)
+		methodID := abi.Methods[GetCctxMethodName]
+
+		// ACT
+		_, err := contract.GetCctx(ctx, &methodID, []interface{}{})
+
+		// ASSERT
+		require.Error(t, err)
+	})
+}
+
+func Test_GetCctxByInbound(t *testing.T) {
+	t.Run("should return cctx for inbound", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetCctxByInboundMethodName]
+
+		// two cctxs created from the same inbound hash on different chains
+		cctx1 := sample.CrossChainTx(t, "foo")
+		cctx2 := sample.CrossChainTx(t, "bar")
+		cctx2.InboundParams.ObservedHash = cctx1.InboundParams.ObservedHash
+		k.SetCrossChainTx(ctx, *cctx1)
+		k.SetCrossChainTx(ctx, *cctx2)
+		k.SetInboundHashToCctx(ctx, crosschaintypes.InboundHashToCctx{
+			InboundHash: cctx1.InboundParams.ObservedHash,
+			CctxIndex:   []string{cctx1.Index, cctx2.Index},
+		})
+		mockVMContract.Input = packInputArgs(
+			t,
+			methodID,
+			cctx2.InboundParams.SenderChainId,
+			cctx2.InboundParams.ObservedHash,
+		)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		got := unpackCrossChainTx(t, methodID, res)
+		require.Equal(t, cctx2.Index, got.Index)
+	})
+
+	t.Run("should fail if no cctx for inbound on chain", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetCctxByInboundMethodName]
+		cctx := sample.CrossChainTx(t, "foo")
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetInboundHashToCctx(ctx, crosschaintypes.InboundHashToCctx{
+			InboundHash: cctx.InboundParams.ObservedHash,
+			CctxIndex:   []string{cctx.Index},
+		})
+		mockVMContract.Input = packInputArgs(
+			t,
+			methodID,
+			cctx.InboundParams.SenderChainId+1,
+			cctx.InboundParams.ObservedHash,
+		)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("should fail if inbound hash unknown", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetCctxByInboundMethodName]
+		mockVMContract.Input = packInputArgs(t, methodID, int64(1), sample.Hash().Hex())
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorContains(t, err, "not found")
+	})
+
+	t.Run("should fail if wrong args amount", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _, _ := setup(t)
+		methodID := abi.Methods[GetCctxByInboundMethodName]
+
+		// ACT
+		_, err := contract.GetCctxByInbound(ctx, &methodID, []interface{}{int64(1)})
+
+		// ASSERT
+		require.Error(t, err)
+	})
+}
+
+func Test_GetPendingNonces(t *testing.T) {
+	t.Run("should return pending nonces", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, zk, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetPendingNoncesMethodName]
+		chainID := chains.Ethereum.ChainId
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   chainID,
+			Tss:       tss.TssPubkey,
+			NonceLow:  10,
+			NonceHigh: 15,
+		})
+		mockVMContract.Input = packInputArgs(t, methodID, chainID)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		out, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, int64(10), out[0].(int64))
+		require.Equal(t, int64(15), out[1].(int64))
+	})
+
+	t.Run("should fail if tss not set", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetPendingNoncesMethodName]
+		mockVMContract.Input = packInputArgs(t, methodID, chains.Ethereum.ChainId)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorContains(t, err, "tss not found")
+	})
+
+	t.Run("should fail if pending nonces not set", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, zk, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetPendingNoncesMethodName]
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		mockVMContract.Input = packInputArgs(t, methodID, chains.Ethereum.ChainId)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorContains(t, err, "pending nonces")
+	})
+}
+
+func Test_GetGasPrice(t *testing.T) {
+	t.Run("should return median gas price", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetGasPriceMethodName]
+		chainID := chains.Ethereum.ChainId
+		k.SetGasPrice(ctx, crosschaintypes.GasPrice{
+			ChainId:      chainID,
+			Signers:      []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()},
+			BlockNums:    []uint64{1, 2, 3},
+			Prices:       []uint64{10, 20, 30},
+			PriorityFees: []uint64{1, 2, 3},
+			MedianIndex:  1,
+		})
+		mockVMContract.Input = packInputArgs(t, methodID, chainID)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		out, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, int64(20), out[0].(*big.Int).Int64())
+		require.Equal(t, int64(2), out[1].(*big.Int).Int64())
+	})
+
+	t.Run("should fail if gas price not set", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetGasPriceMethodName]
+		mockVMContract.Input = packInputArgs(t, methodID, chains.Ethereum.ChainId)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorContains(t, err, "gas price")
+	})
+
+	t.Run("should fail if chain id is not int64", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _, _ := setup(t)
+		methodID := abi.Methods[GetGasPriceMethodName]
+
+		// ACT
+		_, err := contract.GetGasPrice(ctx, &methodID, []interface{}{"1"})
+
+		// ASSERT
+		require.ErrorAs(t, err, &ptypes.ErrInvalidArgument{})
+	})
+}
+
+func Test_GetTssAddress(t *testing.T) {
+	t.Run("should return evm tss address for evm chain", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, zk, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetTssAddressMethodName]
+		chainID := chains.Ethereum.ChainId
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+			ChainParams: []*observertypes.ChainParams{sample.ChainParamsSupported(chainID)},
+		})
+		mockVMContract.Input = packInputArgs(t, methodID, chainID)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		out, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		expected, err := crypto.GetTssAddrEVM(tss.TssPubkey)
+		require.NoError(t, err)
+		require.Equal(t, expected.Hex(), out[0].(string))
+	})
+
+	t.Run("should return btc tss address for bitcoin chain", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, zk, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetTssAddressMethodName]
+		chainID := chains.BitcoinMainnet.ChainId
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+			ChainParams: []*observertypes.ChainParams{sample.ChainParamsSupported(chainID)},
+		})
+		mockVMContract.Input = packInputArgs(t, methodID, chainID)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		out, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		bitcoinParams, err := chains.BitcoinNetParamsFromChainID(chainID)
+		require.NoError(t, err)
+		expected, err := crypto.GetTssAddrBTC(tss.TssPubkey, bitcoinParams)
+		require.NoError(t, err)
+		require.Equal(t, expected, out[0].(string))
+	})
+
+	t.Run("should fail if chain not supported", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, zk, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetTssAddressMethodName]
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		mockVMContract.Input = packInputArgs(t, methodID, chains.Ethereum.ChainId)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorContains(t, err, "not supported")
+	})
+
+	t.Run("should fail if tss not set", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, zk, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetTssAddressMethodName]
+		chainID := chains.Ethereum.ChainId
+		zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+			ChainParams: []*observertypes.ChainParams{sample.ChainParamsSupported(chainID)},
+		})
+		mockVMContract.Input = packInputArgs(t, methodID, chainID)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorContains(t, err, "tss not found")
+	})
+}
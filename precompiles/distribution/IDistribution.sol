@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.26;
+
+/// @dev The IDistribution contract's address.
+address constant IDISTRIBUTION_PRECOMPILE_ADDRESS = 0x000000000000000000000000000000000000006A; // 106
+
+/// @dev The IDistribution contract's instance.
+IDistribution constant IDISTRIBUTION_CONTRACT = IDistribution(
+    IDISTRIBUTION_PRECOMPILE_ADDRESS
+);
+
+/// @notice Amount of a denom
+struct Coin {
+    string denom;
+    uint256 amount;
+}
+
+interface IDistribution {
+    /// @notice ClaimRewards event is emitted when claimRewards function is called
+    /// @param delegator Delegator address
+    /// @param validator Validator address
+    /// @param rewards Claimed rewards
+    event ClaimRewards(
+        address indexed delegator,
+        address indexed validator,
+        Coin[] rewards
+    );
+
+    /// @notice WithdrawValidatorCommission event is emitted when withdrawValidatorCommission function is called
+    /// @param validator Validator address
+    /// @param commission Withdrawn commission
+    event WithdrawValidatorCommission(
+        address indexed validator,
+        Coin[] commission
+    );
+
+    /// @notice Claim the delegation rewards of a delegator from a validator
+    /// @param delegator Delegator address
+    /// @param validator Validator address
+    /// @return success Claim success
+    function claimRewards(
+        address delegator,
+        string memory validator
+    ) external returns (bool success);
+
+    /// @notice Withdraw the accumulated commission of a validator, the caller must be the validator operator
+    /// @param validator Validator address
+    /// @return success Withdrawal success
+    function withdrawValidatorCommission(
+        string memory validator
+    ) external returns (bool success);
+
+    /// @notice Get the pending delegation rewards of a delegator from a validator
+    /// @param delegator Delegator address
+    /// @param validator Validator address
+    /// @return rewards Pending rewards
+    function getPendingRewards(
+        address delegator,
+        string memory validator
+    ) external view returns (Coin[] memory rewards);
+}
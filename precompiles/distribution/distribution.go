@@ -0,0 +1,348 @@
+package distribution
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+)
+
+// method names
+const (
+	// write
+	ClaimRewardsMethodName                = "claimRewards"
+	WithdrawValidatorCommissionMethodName = "withdrawValidatorCommission"
+
+	// read
+	GetPendingRewardsMethodName = "getPendingRewards"
+)
+
+var (
+	ABI                 abi.ABI
+	ContractAddress     = common.HexToAddress("0x000000000000000000000000000000000000006A")
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	ViewMethod          = map[[4]byte]bool{}
+)
+
+func init() {
+	initABI()
+}
+
+func initABI() {
+	if err := ABI.UnmarshalJSON([]byte(IDistributionMetaData.ABI)); err != nil {
+		panic(err)
+	}
+
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	for methodName := range ABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], ABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case ClaimRewardsMethodName:
+			GasRequiredByMethod[methodID] = 10000
+		case WithdrawValidatorCommissionMethodName:
+			GasRequiredByMethod[methodID] = 10000
+		case GetPendingRewardsMethodName:
+			GasRequiredByMethod[methodID] = 0
+			ViewMethod[methodID] = true
+		default:
+			GasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+type Contract struct {
+	ptypes.BaseContract
+
+	distributionKeeper distrkeeper.Keeper
+	cdc                codec.Codec
+	kvGasConfig        storetypes.GasConfig
+}
+
+func NewIDistributionContract(
+	distributionKeeper *distrkeeper.Keeper,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) *Contract {
+	return &Contract{
+		BaseContract:       ptypes.NewBaseContract(ContractAddress),
+		distributionKeeper: *distributionKeeper,
+		cdc:                cdc,
+		kvGasConfig:        kvGasConfig,
+	}
+}
+
+// Address() is required to implement the PrecompiledContract interface.
+func (c *Contract) Address() common.Address {
+	return ContractAddress
+}
+
+// Abi() is required to implement the PrecompiledContract interface.
+func (c *Contract) Abi() abi.ABI {
+	return ABI
+}
+
+// RequiredGas is required to implement the PrecompiledContract interface.
+// The gas has to be calculated deterministically based on the input.
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	// get methodID (first 4 bytes)
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * c.kvGasConfig.WriteCostPerByte
+	if ViewMethod[methodID] {
+		baseCost = uint64(len(input)) * c.kvGasConfig.ReadCostPerByte
+	}
+
+	if requiredGas, ok := GasRequiredByMethod[methodID]; ok {
+		return requiredGas + baseCost
+	}
+
+	// Can not happen, but return 0 if the method is not found.
+	return 0
+}
+
+// GetPendingRewards returns the pending rewards of a delegator from a validator
+func (c *Contract) GetPendingRewards(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 2,
+		})
+	}
+
+	delegatorAddress, ok := args[0].(common.Address)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	validatorAddress, ok := args[1].(string)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[1],
+		}
+	}
+
+	querier := distrkeeper.NewQuerier(c.distributionKeeper)
+	res, err := querier.DelegationRewards(ctx, &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: sdk.AccAddress(delegatorAddress.Bytes()).String(),
+		ValidatorAddress: validatorAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// pending rewards are decimal coins, only the integer part can be claimed
+	rewards, _ := res.Rewards.TruncateDecimal()
+
+	return method.Outputs.Pack(toCoins(rewards))
+}
+
+// ClaimRewards claims the rewards of a delegator from a validator
+func (c *Contract) ClaimRewards(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 2 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 2,
+		})
+	}
+
+	delegatorAddress, ok := args[0].(common.Address)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	if contract.CallerAddress != delegatorAddress {
+		return nil, fmt.Errorf("caller is not delegator address")
+	}
+
+	validatorAddress, ok := args[1].(string)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[1],
+		}
+	}
+
+	delegator := sdk.AccAddress(delegatorAddress.Bytes())
+
+	msgServer := distrkeeper.NewMsgServerImpl(c.distributionKeeper)
+	res, err := msgServer.WithdrawDelegatorReward(ctx, &distrtypes.MsgWithdrawDelegatorReward{
+		DelegatorAddress: delegator.String(),
+		ValidatorAddress: validatorAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// rewards are sent to the withdraw address of the delegator
+	stateDB := evm.StateDB.(ptypes.ExtStateDB)
+	withdrawAddress := common.BytesToAddress(c.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delegator).Bytes())
+	c.addBalance(evm, stateDB, withdrawAddress, res.Amount)
+
+	err = c.AddClaimRewardsLog(ctx, stateDB, delegatorAddress, validatorAddress, res.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// WithdrawValidatorCommission withdraws the commission of a validator, the caller must be the validator operator
+func (c *Contract) WithdrawValidatorCommission(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		})
+	}
+
+	validatorAddress, ok := args[0].(string)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(validatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != common.BytesToAddress(valAddr.Bytes()) {
+		return nil, fmt.Errorf("caller is not validator operator address")
+	}
+
+	msgServer := distrkeeper.NewMsgServerImpl(c.distributionKeeper)
+	res, err := msgServer.WithdrawValidatorCommission(ctx, &distrtypes.MsgWithdrawValidatorCommission{
+		ValidatorAddress: validatorAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// commission is sent to the withdraw address of the validator operator
+	stateDB := evm.StateDB.(ptypes.ExtStateDB)
+	withdrawAddress := common.BytesToAddress(
+		c.distributionKeeper.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr)).Bytes(),
+	)
+	c.addBalance(evm, stateDB, withdrawAddress, res.Amount)
+
+	err = c.AddWithdrawValidatorCommissionLog(ctx, stateDB, validatorAddress, res.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// addBalance reflects the withdrawn evm denom amount in stateDB
+// if the recipient is not the origin, its state might be updated as well in the current transaction,
+// so the amount is manually increased in stateDB, to keep it consistent with bank module
+func (c *Contract) addBalance(evm *vm.EVM, stateDB ptypes.ExtStateDB, recipient common.Address, coins sdk.Coins) {
+	amount := coins.AmountOf(config.BaseDenom)
+	if recipient != evm.Origin && amount.IsPositive() {
+		stateDB.AddBalance(recipient, amount.BigInt())
+	}
+}
+
+// Run is the entrypoint of the precompiled contract, it switches over the input method,
+// and execute them accordingly.
+func (c *Contract) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	// the methods moving funds can't be called in a static call
+	if readOnly && !method.IsConstant() {
+		return nil, ptypes.ErrWriteMethod{
+			Method: method.Name,
+		}
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	stateDB := evm.StateDB.(ptypes.ExtStateDB)
+
+	switch method.Name {
+	case GetPendingRewardsMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.GetPendingRewards(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case ClaimRewardsMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.ClaimRewards(ctx, evm, contract, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case WithdrawValidatorCommissionMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.WithdrawValidatorCommission(ctx, evm, contract, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+
+	default:
+		return nil, ptypes.ErrInvalidMethod{
+			Method: method.Name,
+		}
+	}
+}
+
+// toCoins converts sdk coins to their ABI representation
+func toCoins(coins sdk.Coins) []Coin {
+	res := make([]Coin, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, Coin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.BigInt(),
+		})
+	}
+	return res
+}
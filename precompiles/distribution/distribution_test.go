@@ -0,0 +1,433 @@
+package distribution
+
+import (
+	"encoding/json"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmdb "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+	"github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+func setup(t *testing.T) (sdk.Context, *Contract, abi.ABI, keeper.SDKKeepers, *vm.EVM, *vm.Contract) {
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+
+	cdc := keeper.NewCodec()
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	keys, memKeys, tkeys, allKeys := keeper.StoreKeys()
+	sdkKeepers := keeper.NewSDKKeepersWithKeys(cdc, keys, memKeys, tkeys, allKeys)
+	for _, key := range keys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+	for _, key := range tkeys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+	}
+	for _, key := range memKeys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeMemory, nil)
+	}
+
+	gasConfig := storetypes.TransientGasConfig()
+	ctx := keeper.NewContext(stateStore)
+
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	// distribution hooks are required to track the rewards of delegations
+	sdkKeepers.StakingKeeper.SetHooks(sdkKeepers.DistributionKeeper.Hooks())
+
+	sdkKeepers.InitGenesis(ctx)
+	stakingParams := stakingtypes.DefaultParams()
+	stakingParams.BondDenom = config.BaseDenom
+	require.NoError(t, sdkKeepers.StakingKeeper.SetParams(ctx, stakingParams))
+	require.NoError(t, sdkKeepers.DistributionKeeper.SetParams(ctx, distrtypes.DefaultParams()))
+	sdkKeepers.DistributionKeeper.SetFeePool(ctx, distrtypes.InitialFeePool())
+
+	contract := NewIDistributionContract(&sdkKeepers.DistributionKeeper, appCodec, gasConfig)
+	require.NotNil(t, contract, "NewIDistributionContract() should not return a nil contract")
+
+	abi := contract.Abi()
+	require.NotNil(t, abi, "contract ABI should not be nil")
+
+	address := contract.Address()
+	require.NotNil(t, address, "contract address should not be nil")
+
+	mockEVM := vm.NewEVM(
+		vm.BlockContext{},
+		vm.TxContext{},
+		statedb.New(ctx, sdkKeepers.EvmKeeper, statedb.TxConfig{}),
+		&params.ChainConfig{},
+		vm.Config{},
+	)
+	mockVMContract := vm.NewContract(
+		contractRef{address: common.Address{}},
+		contractRef{address: ContractAddress},
+		big.NewInt(0),
+		0,
+	)
+	return ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract
+}
+
+func packInputArgs(t *testing.T, methodID abi.Method, args ...interface{}) []byte {
+	input, err := methodID.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(methodID.ID, input...)
+}
+
+// setupRewards creates a validator with a delegation from delegator and allocates the given rewards to it
+func setupRewards(
+	t *testing.T,
+	ctx sdk.Context,
+	sdkKeepers keeper.SDKKeepers,
+	delegator sdk.AccAddress,
+	rewards sdkmath.Int,
+) stakingtypes.Validator {
+	// delegations don't accrue rewards in the block they are created, the delegation is created in the previous block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() - 1)
+
+	r := rand.New(rand.NewSource(42))
+	validator := sample.Validator(t, r)
+	sdkKeepers.StakingKeeper.SetValidator(ctx, validator)
+	require.NoError(t, sdkKeepers.DistributionKeeper.Hooks().AfterValidatorCreated(ctx, validator.GetOperator()))
+
+	// delegate to the validator
+	stake := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, sdkmath.NewInt(1000)))
+	require.NoError(t, sdkKeepers.BankKeeper.MintCoins(ctx, fungibletypes.ModuleName, stake))
+	require.NoError(
+		t,
+		sdkKeepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, fungibletypes.ModuleName, delegator, stake),
+	)
+	_, err := sdkKeepers.StakingKeeper.Delegate(
+		ctx,
+		delegator,
+		stake.AmountOf(config.BaseDenom),
+		stakingtypes.Unbonded,
+		validator,
+		true,
+	)
+	require.NoError(t, err)
+
+	// fund the distribution module and allocate the rewards to the validator
+	rewardCoins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, rewards))
+	require.NoError(t, sdkKeepers.BankKeeper.MintCoins(ctx, fungibletypes.ModuleName, rewardCoins))
+	require.NoError(
+		t,
+		sdkKeepers.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			fungibletypes.ModuleName,
+			distrtypes.ModuleName,
+			rewardCoins,
+		),
+	)
+	validator, found := sdkKeepers.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	require.True(t, found)
+	sdkKeepers.DistributionKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewardCoins...))
+
+	return validator
+}
+
+type contractRef struct {
+	address common.Address
+}
+
+func (c contractRef) Address() common.Address {
+	return c.address
+}
+
+func Test_IDistributionContract(t *testing.T) {
+	_, contract, abi, _, _, _ := setup(t)
+	gasConfig := storetypes.TransientGasConfig()
+
+	t.Run("should check methods are present in ABI", func(t *testing.T) {
+		require.NotNil(t, abi.Methods[ClaimRewardsMethodName], "claimRewards method should be present in the ABI")
+		require.NotNil(
+			t,
+			abi.Methods[WithdrawValidatorCommissionMethodName],
+			"withdrawValidatorCommission method should be present in the ABI",
+		)
+		require.NotNil(
+			t,
+			abi.Methods[GetPendingRewardsMethodName],
+			"getPendingRewards method should be present in the ABI",
+		)
+	})
+
+	t.Run("should check gas requirements for methods", func(t *testing.T) {
+		var method [4]byte
+
+		t.Run("claimRewards", func(t *testing.T) {
+			// ACT
+			claimRewards := contract.RequiredGas(abi.Methods[ClaimRewardsMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[ClaimRewardsMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.WriteCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, claimRewards)
+		})
+
+		t.Run("withdrawValidatorCommission", func(t *testing.T) {
+			// ACT
+			withdraw := contract.RequiredGas(abi.Methods[WithdrawValidatorCommissionMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[WithdrawValidatorCommissionMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.WriteCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, withdraw)
+		})
+
+		t.Run("getPendingRewards", func(t *testing.T) {
+			// ACT
+			getPendingRewards := contract.RequiredGas(abi.Methods[GetPendingRewardsMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[GetPendingRewardsMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.ReadCostPerByte
+			require.Equal(t, GasRequiredByMethod[method]+baseCost, getPendingRewards)
+		})
+
+		t.Run("invalid method", func(t *testing.T) {
+			// ACT
+			gasInvalidMethod := contract.RequiredGas([]byte("invalidMethod"))
+			// ASSERT
+			require.Equal(t, uint64(0), gasInvalidMethod)
+		})
+	})
+}
+
+func Test_InvalidMethod(t *testing.T) {
+	_, _, abi, _, _, _ := setup(t)
+
+	_, doNotExist := abi.Methods["invalidMethod"]
+	require.False(t, doNotExist, "invalidMethod should not be present in the ABI")
+}
+
+func Test_InvalidABI(t *testing.T) {
+	IDistributionMetaData.ABI = "invalid json"
+	defer func() {
+		if r := recover(); r != nil {
+			require.IsType(t, &json.SyntaxError{}, r, "expected error type: json.SyntaxError, got: %T", r)
+		}
+	}()
+
+	initABI()
+}
+
+func Test_GetPendingRewards(t *testing.T) {
+	t.Run("should return pending rewards", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetPendingRewardsMethodName]
+		delegator := sample.Bech32AccAddress()
+		validator := setupRewards(t, ctx, sdkKeepers, delegator, sdkmath.NewInt(100))
+
+		mockVMContract.Input = packInputArgs(
+			t,
+			methodID,
+			common.BytesToAddress(delegator.Bytes()),
+			validator.OperatorAddress,
+		)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		var out struct{ Rewards []Coin }
+		require.NoError(t, methodID.Outputs.Copy(&out, mustUnpack(t, methodID, res)))
+		require.Len(t, out.Rewards, 1)
+		require.Equal(t, config.BaseDenom, out.Rewards[0].Denom)
+		require.Equal(t, int64(100), out.Rewards[0].Amount.Int64())
+	})
+
+	t.Run("should fail if delegation doesn't exist", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetPendingRewardsMethodName]
+		validator := setupRewards(t, ctx, sdkKeepers, sample.Bech32AccAddress(), sdkmath.NewInt(100))
+
+		mockVMContract.Input = packInputArgs(t, methodID, sample.EthAddress(), validator.OperatorAddress)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if delegator is not eth addr", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _ := setup(t)
+		methodID := abi.Methods[GetPendingRewardsMethodName]
+
+		// ACT
+		_, err := contract.GetPendingRewards(ctx, &methodID, []interface{}{"delegator", "validator"})
+
+		// ASSERT
+		require.ErrorAs(t, err, &ptypes.ErrInvalidArgument{})
+	})
+}
+
+func Test_ClaimRewards(t *testing.T) {
+	t.Run("should claim rewards", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[ClaimRewardsMethodName]
+		delegator := sample.Bech32AccAddress()
+		validator := setupRewards(t, ctx, sdkKeepers, delegator, sdkmath.NewInt(100))
+
+		delegatorAddr := common.BytesToAddress(delegator.Bytes())
+		mockVMContract.CallerAddress = delegatorAddr
+		mockVMContract.Input = packInputArgs(t, methodID, delegatorAddr, validator.OperatorAddress)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		success := mustUnpack(t, methodID, res)
+		require.True(t, success[0].(bool))
+
+		// rewards are credited once the stateDB is committed
+		require.NoError(t, mockEVM.StateDB.(*statedb.StateDB).Commit())
+		require.Equal(t, int64(100), sdkKeepers.BankKeeper.GetBalance(ctx, delegator, config.BaseDenom).Amount.Int64())
+	})
+
+	t.Run("should fail in read-only mode", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[ClaimRewardsMethodName]
+		delegator := sample.Bech32AccAddress()
+		validator := setupRewards(t, ctx, sdkKeepers, delegator, sdkmath.NewInt(100))
+
+		delegatorAddr := common.BytesToAddress(delegator.Bytes())
+		mockVMContract.CallerAddress = delegatorAddr
+		mockVMContract.Input = packInputArgs(t, methodID, delegatorAddr, validator.OperatorAddress)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, true)
+
+		// ASSERT
+		require.ErrorIs(t, err, ptypes.ErrWriteMethod{Method: ClaimRewardsMethodName})
+	})
+
+	t.Run("should fail if caller is not delegator", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[ClaimRewardsMethodName]
+		delegator := sample.Bech32AccAddress()
+		validator := setupRewards(t, ctx, sdkKeepers, delegator, sdkmath.NewInt(100))
+
+		mockVMContract.CallerAddress = sample.EthAddress()
+		mockVMContract.Input = packInputArgs(
+			t,
+			methodID,
+			common.BytesToAddress(delegator.Bytes()),
+			validator.OperatorAddress,
+		)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if delegation doesn't exist", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[ClaimRewardsMethodName]
+		validator := setupRewards(t, ctx, sdkKeepers, sample.Bech32AccAddress(), sdkmath.NewInt(100))
+
+		delegatorAddr := sample.EthAddress()
+		mockVMContract.CallerAddress = delegatorAddr
+		mockVMContract.Input = packInputArgs(t, methodID, delegatorAddr, validator.OperatorAddress)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if wrong args amount", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[ClaimRewardsMethodName]
+
+		// ACT
+		_, err := contract.ClaimRewards(ctx, mockEVM, mockVMContract, &methodID, []interface{}{sample.EthAddress()})
+
+		// ASSERT
+		require.Error(t, err)
+	})
+}
+
+func Test_WithdrawValidatorCommission(t *testing.T) {
+	t.Run("should fail if caller is not validator operator", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawValidatorCommissionMethodName]
+		validator := setupRewards(t, ctx, sdkKeepers, sample.Bech32AccAddress(), sdkmath.NewInt(100))
+
+		mockVMContract.CallerAddress = sample.EthAddress()
+		mockVMContract.Input = packInputArgs(t, methodID, validator.OperatorAddress)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if validator has no commission", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawValidatorCommissionMethodName]
+		validator := setupRewards(t, ctx, sdkKeepers, sample.Bech32AccAddress(), sdkmath.NewInt(100))
+
+		mockVMContract.CallerAddress = common.BytesToAddress(validator.GetOperator().Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, validator.OperatorAddress)
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorIs(t, err, distrtypes.ErrNoValidatorCommission)
+	})
+
+	t.Run("should fail if validator address is invalid", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawValidatorCommissionMethodName]
+		mockVMContract.Input = packInputArgs(t, methodID, "invalid")
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.Error(t, err)
+	})
+}
+
+func mustUnpack(t *testing.T, method abi.Method, res []byte) []interface{} {
+	out, err := method.Outputs.Unpack(res)
+	require.NoError(t, err)
+	return out
+}
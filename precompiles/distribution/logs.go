@@ -0,0 +1,79 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/node/precompiles/logs"
+)
+
+const (
+	ClaimRewardsEventName                = "ClaimRewards"
+	WithdrawValidatorCommissionEventName = "WithdrawValidatorCommission"
+)
+
+func (c *Contract) AddClaimRewardsLog(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	delegator common.Address,
+	validator string,
+	rewards sdk.Coins,
+) error {
+	event := c.Abi().Events[ClaimRewardsEventName]
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+
+	// delegator and validator are indexed event params
+	topics, err := logs.MakeTopics(
+		event,
+		[]interface{}{delegator},
+		[]interface{}{common.BytesToAddress(valAddr.Bytes())},
+	)
+	if err != nil {
+		return err
+	}
+
+	// rewards are part of event data
+	data, err := event.Inputs.NonIndexed().Pack(toCoins(rewards))
+	if err != nil {
+		return err
+	}
+
+	logs.AddLog(ctx, c.Address(), stateDB, topics, data)
+
+	return nil
+}
+
+func (c *Contract) AddWithdrawValidatorCommissionLog(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	validator string,
+	commission sdk.Coins,
+) error {
+	event := c.Abi().Events[WithdrawValidatorCommissionEventName]
+
+	valAddr, err := sdk.ValAddressFromBech32(validator)
+	if err != nil {
+		return err
+	}
+
+	// validator is indexed event param
+	topics, err := logs.MakeTopics(event, []interface{}{common.BytesToAddress(valAddr.Bytes())})
+	if err != nil {
+		return err
+	}
+
+	// commission is part of event data
+	data, err := event.Inputs.NonIndexed().Pack(toCoins(commission))
+	if err != nil {
+		return err
+	}
+
+	logs.AddLog(ctx, c.Address(), stateDB, topics, data)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.26;
+
+/// @dev The IEmissions contract's address.
+address constant IEMISSIONS_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000067; // 103
+
+/// @dev The IEmissions contract's instance.
+IEmissions constant IEMISSIONS_CONTRACT = IEmissions(
+    IEMISSIONS_PRECOMPILE_ADDRESS
+);
+
+/// @notice Parameters of the emissions module
+struct Params {
+    string validatorEmissionPercentage;
+    string observerEmissionPercentage;
+    string tssSignerEmissionPercentage;
+    uint256 observerSlashAmount;
+    int64 ballotMaturityBlocks;
+    string blockRewardAmount;
+}
+
+interface IEmissions {
+    /// @notice WithdrawEmissions event is emitted when withdrawEmissions function is called
+    /// @param withdrawer Withdrawer address
+    /// @param amount Withdrawn amount
+    event WithdrawEmissions(address indexed withdrawer, uint256 amount);
+
+    /// @notice Get the emissions available for withdrawal for an address
+    /// @param withdrawer Withdrawer address
+    /// @return amount Emissions available for withdrawal
+    function getAvailableEmissions(
+        address withdrawer
+    ) external view returns (uint256 amount);
+
+    /// @notice Withdraw emissions of the caller to its account
+    /// @param amount Amount to withdraw
+    /// @return success Withdrawal success
+    function withdrawEmissions(uint256 amount) external returns (bool success);
+
+    /// @notice Get the parameters of the emissions module
+    /// @return params Emissions parameters
+    function getParams() external view returns (Params calldata params);
+}
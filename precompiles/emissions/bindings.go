@@ -0,0 +1,7 @@
+//go:generate sh -c "solc IEmissions.sol --combined-json abi | jq '.contracts.\"IEmissions.sol:IEmissions\"'  > IEmissions.json"
+//go:generate sh -c "cat IEmissions.json | jq .abi > IEmissions.abi"
+//go:generate sh -c "abigen --abi IEmissions.abi  --pkg emissions --type IEmissions --out IEmissions.go"
+
+package emissions
+
+var _ Contract
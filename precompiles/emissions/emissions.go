@@ -0,0 +1,276 @@
+package emissions
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+	emissionskeeper "github.com/zeta-chain/node/x/emissions/keeper"
+	emissionstypes "github.com/zeta-chain/node/x/emissions/types"
+)
+
+// method names
+const (
+	// write
+	WithdrawEmissionsMethodName = "withdrawEmissions"
+
+	// read
+	GetAvailableEmissionsMethodName = "getAvailableEmissions"
+	GetParamsMethodName             = "getParams"
+)
+
+var (
+	ABI                 abi.ABI
+	ContractAddress     = common.HexToAddress("0x0000000000000000000000000000000000000067")
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	ViewMethod          = map[[4]byte]bool{}
+)
+
+func init() {
+	initABI()
+}
+
+func initABI() {
+	if err := ABI.UnmarshalJSON([]byte(IEmissionsMetaData.ABI)); err != nil {
+		panic(err)
+	}
+
+	GasRequiredByMethod = map[[4]byte]uint64{}
+	for methodName := range ABI.Methods {
+		var methodID [4]byte
+		copy(methodID[:], ABI.Methods[methodName].ID[:4])
+		switch methodName {
+		case WithdrawEmissionsMethodName:
+			GasRequiredByMethod[methodID] = 10000
+		case GetAvailableEmissionsMethodName:
+			GasRequiredByMethod[methodID] = 0
+			ViewMethod[methodID] = true
+		case GetParamsMethodName:
+			GasRequiredByMethod[methodID] = 0
+			ViewMethod[methodID] = true
+		default:
+			GasRequiredByMethod[methodID] = 0
+		}
+	}
+}
+
+type Contract struct {
+	ptypes.BaseContract
+
+	emissionsKeeper emissionskeeper.Keeper
+	cdc             codec.Codec
+	kvGasConfig     storetypes.GasConfig
+}
+
+func NewIEmissionsContract(
+	emissionsKeeper *emissionskeeper.Keeper,
+	cdc codec.Codec,
+	kvGasConfig storetypes.GasConfig,
+) *Contract {
+	return &Contract{
+		BaseContract:    ptypes.NewBaseContract(ContractAddress),
+		emissionsKeeper: *emissionsKeeper,
+		cdc:             cdc,
+		kvGasConfig:     kvGasConfig,
+	}
+}
+
+// Address() is required to implement the PrecompiledContract interface.
+func (c *Contract) Address() common.Address {
+	return ContractAddress
+}
+
+// Abi() is required to implement the PrecompiledContract interface.
+func (c *Contract) Abi() abi.ABI {
+	return ABI
+}
+
+// RequiredGas is required to implement the PrecompiledContract interface.
+// The gas has to be calculated deterministically based on the input.
+func (c *Contract) RequiredGas(input []byte) uint64 {
+	// get methodID (first 4 bytes)
+	var methodID [4]byte
+	copy(methodID[:], input[:4])
+	// base cost to prevent large input size
+	baseCost := uint64(len(input)) * c.kvGasConfig.WriteCostPerByte
+	if ViewMethod[methodID] {
+		baseCost = uint64(len(input)) * c.kvGasConfig.ReadCostPerByte
+	}
+
+	if requiredGas, ok := GasRequiredByMethod[methodID]; ok {
+		return requiredGas + baseCost
+	}
+
+	// Can not happen, but return 0 if the method is not found.
+	return 0
+}
+
+// GetAvailableEmissions returns the emissions that can be withdrawn by the given address
+func (c *Contract) GetAvailableEmissions(
+	ctx sdk.Context,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		})
+	}
+
+	withdrawerAddress, ok := args[0].(common.Address)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	amount := big.NewInt(0)
+	emission, found := c.emissionsKeeper.GetWithdrawableEmission(
+		ctx,
+		sdk.AccAddress(withdrawerAddress.Bytes()).String(),
+	)
+	if found && !emission.Amount.IsNil() {
+		amount = emission.Amount.BigInt()
+	}
+
+	return method.Outputs.Pack(amount)
+}
+
+// GetParams returns the params of the emissions module
+func (c *Contract) GetParams(
+	ctx sdk.Context,
+	method *abi.Method,
+) ([]byte, error) {
+	params, found := c.emissionsKeeper.GetParams(ctx)
+	if !found {
+		return nil, fmt.Errorf("emissions params not found")
+	}
+
+	return method.Outputs.Pack(Params{
+		ValidatorEmissionPercentage: params.ValidatorEmissionPercentage,
+		ObserverEmissionPercentage:  params.ObserverEmissionPercentage,
+		TssSignerEmissionPercentage: params.TssSignerEmissionPercentage,
+		ObserverSlashAmount:         params.ObserverSlashAmount.BigInt(),
+		BallotMaturityBlocks:        params.BallotMaturityBlocks,
+		BlockRewardAmount:           params.BlockRewardAmount.String(),
+	})
+}
+
+// WithdrawEmissions withdraws the emissions of the caller to its account
+func (c *Contract) WithdrawEmissions(
+	ctx sdk.Context,
+	evm *vm.EVM,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, &(ptypes.ErrInvalidNumberOfArgs{
+			Got:    len(args),
+			Expect: 1,
+		})
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok {
+		return nil, ptypes.ErrInvalidArgument{
+			Got: args[0],
+		}
+	}
+
+	withdrawerAddress := contract.CallerAddress
+
+	msgServer := emissionskeeper.NewMsgServerImpl(c.emissionsKeeper)
+	_, err := msgServer.WithdrawEmission(ctx, &emissionstypes.MsgWithdrawEmission{
+		Creator: sdk.AccAddress(withdrawerAddress.Bytes()).String(),
+		Amount:  math.NewIntFromBigInt(amount),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// if caller is not the same as origin it means call is coming through smart contract,
+	// and because state of smart contract calling precompile might be updated as well
+	// manually increase amount in stateDB, so it is properly reflected in bank module
+	stateDB := evm.StateDB.(ptypes.ExtStateDB)
+	if contract.CallerAddress != evm.Origin {
+		stateDB.AddBalance(withdrawerAddress, amount)
+	}
+
+	err = c.AddWithdrawEmissionsLog(ctx, stateDB, withdrawerAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Run is the entrypoint of the precompiled contract, it switches over the input method,
+// and execute them accordingly.
+func (c *Contract) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, err := ABI.MethodById(contract.Input[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	// the emissions can't be withdrawn in a static call
+	if readOnly && !method.IsConstant() {
+		return nil, ptypes.ErrWriteMethod{
+			Method: method.Name,
+		}
+	}
+
+	args, err := method.Inputs.Unpack(contract.Input[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	stateDB := evm.StateDB.(ptypes.ExtStateDB)
+
+	switch method.Name {
+	case GetAvailableEmissionsMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.GetAvailableEmissions(ctx, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case GetParamsMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.GetParams(ctx, method)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+	case WithdrawEmissionsMethodName:
+		var res []byte
+		execErr := stateDB.ExecuteNativeAction(contract.Address(), nil, func(ctx sdk.Context) error {
+			res, err = c.WithdrawEmissions(ctx, evm, contract, method, args)
+			return err
+		})
+		if execErr != nil {
+			return nil, execErr
+		}
+		return res, nil
+
+	default:
+		return nil, ptypes.ErrInvalidMethod{
+			Method: method.Name,
+		}
+	}
+}
@@ -0,0 +1,468 @@
+package emissions
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmdb "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+
+	"github.com/zeta-chain/node/cmd/zetacored/config"
+	ptypes "github.com/zeta-chain/node/precompiles/types"
+	"github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	emissionskeeper "github.com/zeta-chain/node/x/emissions/keeper"
+	emissionstypes "github.com/zeta-chain/node/x/emissions/types"
+)
+
+func setup(t *testing.T) (
+	sdk.Context,
+	*Contract,
+	abi.ABI,
+	*emissionskeeper.Keeper,
+	keeper.SDKKeepers,
+	*vm.EVM,
+	*vm.Contract,
+) {
+	var encoding ethermint.EncodingConfig
+	appCodec := encoding.Codec
+
+	cdc := keeper.NewCodec()
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	keys, memKeys, tkeys, allKeys := keeper.StoreKeys()
+	sdkKeepers := keeper.NewSDKKeepersWithKeys(cdc, keys, memKeys, tkeys, allKeys)
+	for _, key := range keys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	}
+	for _, key := range tkeys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeTransient, nil)
+	}
+	for _, key := range memKeys {
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeMemory, nil)
+	}
+
+	gasConfig := storetypes.TransientGasConfig()
+	ctx := keeper.NewContext(stateStore)
+
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	k := emissionskeeper.NewKeeper(
+		cdc,
+		keys[emissionstypes.StoreKey],
+		keys[emissionstypes.StoreKey],
+		authtypes.FeeCollectorName,
+		sdkKeepers.BankKeeper,
+		sdkKeepers.StakingKeeper,
+		nil,
+		sdkKeepers.AuthKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	require.NoError(t, k.SetParams(ctx, emissionstypes.DefaultParams()))
+
+	contract := NewIEmissionsContract(k, appCodec, gasConfig)
+	require.NotNil(t, contract, "NewIEmissionsContract() should not return a nil contract")
+
+	abi := contract.Abi()
+	require.NotNil(t, abi, "contract ABI should not be nil")
+
+	address := contract.Address()
+	require.NotNil(t, address, "contract address should not be nil")
+
+	mockEVM := vm.NewEVM(
+		vm.BlockContext{},
+		vm.TxContext{},
+		statedb.New(ctx, sdkKeepers.EvmKeeper, statedb.TxConfig{}),
+		&params.ChainConfig{},
+		vm.Config{},
+	)
+	mockVMContract := vm.NewContract(
+		contractRef{address: common.Address{}},
+		contractRef{address: ContractAddress},
+		big.NewInt(0),
+		0,
+	)
+	return ctx, contract, abi, k, sdkKeepers, mockEVM, mockVMContract
+}
+
+func packInputArgs(t *testing.T, methodID abi.Method, args ...interface{}) []byte {
+	input, err := methodID.Inputs.Pack(args...)
+	require.NoError(t, err)
+	return append(methodID.ID, input...)
+}
+
+// fundRewardsPool mints the given amount to the undistributed observer rewards pool
+func fundRewardsPool(t *testing.T, ctx sdk.Context, sdkKeepers keeper.SDKKeepers, amount sdkmath.Int) {
+	coins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, amount))
+	err := sdkKeepers.BankKeeper.MintCoins(ctx, emissionstypes.ModuleName, coins)
+	require.NoError(t, err)
+	err = sdkKeepers.BankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		emissionstypes.ModuleName,
+		emissionstypes.UndistributedObserverRewardsPool,
+		coins,
+	)
+	require.NoError(t, err)
+}
+
+type contractRef struct {
+	address common.Address
+}
+
+func (c contractRef) Address() common.Address {
+	return c.address
+}
+
+func Test_IEmissionsContract(t *testing.T) {
+	_, contract, abi, _, _, _, _ := setup(t)
+	gasConfig := storetypes.TransientGasConfig()
+
+	t.Run("should check methods are present in ABI", func(t *testing.T) {
+		require.NotNil(
+			t,
+			abi.Methods[WithdrawEmissionsMethodName],
+			"withdrawEmissions method should be present in the ABI",
+		)
+		require.NotNil(
+			t,
+			abi.Methods[GetAvailableEmissionsMethodName],
+			"getAvailableEmissions method should be present in the ABI",
+		)
+		require.NotNil(t, abi.Methods[GetParamsMethodName], "getParams method should be present in the ABI")
+	})
+
+	t.Run("should check gas requirements for methods", func(t *testing.T) {
+		var method [4]byte
+
+		t.Run("withdrawEmissions", func(t *testing.T) {
+			// ACT
+			withdraw := contract.RequiredGas(abi.Methods[WithdrawEmissionsMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[WithdrawEmissionsMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.WriteCostPerByte
+			require.Equal(
+				t,
+				GasRequiredByMethod[method]+baseCost,
+				withdraw,
+				"withdrawEmissions method should require %d gas, got %d",
+				GasRequiredByMethod[method]+baseCost,
+				withdraw,
+			)
+		})
+
+		t.Run("getAvailableEmissions", func(t *testing.T) {
+			// ACT
+			available := contract.RequiredGas(abi.Methods[GetAvailableEmissionsMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[GetAvailableEmissionsMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.ReadCostPerByte
+			require.Equal(
+				t,
+				GasRequiredByMethod[method]+baseCost,
+				available,
+				"getAvailableEmissions method should require %d gas, got %d",
+				GasRequiredByMethod[method]+baseCost,
+				available,
+			)
+		})
+
+		t.Run("getParams", func(t *testing.T) {
+			// ACT
+			getParams := contract.RequiredGas(abi.Methods[GetParamsMethodName].ID)
+			// ASSERT
+			copy(method[:], abi.Methods[GetParamsMethodName].ID[:4])
+			baseCost := uint64(len(method)) * gasConfig.ReadCostPerByte
+			require.Equal(
+				t,
+				GasRequiredByMethod[method]+baseCost,
+				getParams,
+				"getParams method should require %d gas, got %d",
+				GasRequiredByMethod[method]+baseCost,
+				getParams,
+			)
+		})
+
+		t.Run("invalid method", func(t *testing.T) {
+			// ARRANGE
+			invalidMethodBytes := []byte("invalidMethod")
+
+			// ACT
+			gasInvalidMethod := contract.RequiredGas(invalidMethodBytes)
+
+			// ASSERT
+			require.Equal(
+				t,
+				uint64(0),
+				gasInvalidMethod,
+				"invalid method should require %d gas, got %d",
+				uint64(0),
+				gasInvalidMethod,
+			)
+		})
+	})
+}
+
+func Test_InvalidMethod(t *testing.T) {
+	_, _, abi, _, _, _, _ := setup(t)
+
+	_, doNotExist := abi.Methods["invalidMethod"]
+	require.False(t, doNotExist, "invalidMethod should not be present in the ABI")
+}
+
+func Test_InvalidABI(t *testing.T) {
+	IEmissionsMetaData.ABI = "invalid json"
+	defer func() {
+		if r := recover(); r != nil {
+			require.IsType(t, &json.SyntaxError{}, r, "expected error type: json.SyntaxError, got: %T", r)
+		}
+	}()
+
+	initABI()
+}
+
+func Test_GetAvailableEmissions(t *testing.T) {
+	t.Run("should return zero if no emissions", func(t *testing.T) {
+		// ARRANGE
+		_, contract, abi, _, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetAvailableEmissionsMethodName]
+		withdrawer := common.BytesToAddress(sample.Bech32AccAddress().Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, withdrawer)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		amount, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), amount[0].(*big.Int).Int64())
+	})
+
+	t.Run("should return available emissions", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetAvailableEmissionsMethodName]
+		withdrawer := sample.Bech32AccAddress()
+		k.SetWithdrawableEmission(ctx, emissionstypes.WithdrawableEmissions{
+			Address: withdrawer.String(),
+			Amount:  sdkmath.NewInt(1000),
+		})
+		mockVMContract.Input = packInputArgs(t, methodID, common.BytesToAddress(withdrawer.Bytes()))
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		amount, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, int64(1000), amount[0].(*big.Int).Int64())
+	})
+
+	t.Run("should fail if withdrawer is not eth addr", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _, _ := setup(t)
+		methodID := abi.Methods[GetAvailableEmissionsMethodName]
+
+		// ACT
+		_, err := contract.GetAvailableEmissions(ctx, &methodID, []interface{}{"withdrawer"})
+
+		// ASSERT
+		require.ErrorAs(t, err, &ptypes.ErrInvalidArgument{})
+	})
+
+	t.Run("should fail if wrong args amount", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, _, _ := setup(t)
+		methodID := abi.Methods[GetAvailableEmissionsMethodName]
+
+		// ACT
+		_, err := contract.GetAvailableEmissions(ctx, &methodID, []interface{}{})
+
+		// ASSERT
+		require.Error(t, err)
+	})
+}
+
+func Test_GetParams(t *testing.T) {
+	t.Run("should return params", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[GetParamsMethodName]
+		mockVMContract.Input = packInputArgs(t, methodID)
+		expected, found := k.GetParams(ctx)
+		require.True(t, found)
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		out, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+
+		var result struct{ Params Params }
+		require.NoError(t, methodID.Outputs.Copy(&result, out))
+		params := result.Params
+		require.Equal(t, expected.ValidatorEmissionPercentage, params.ValidatorEmissionPercentage)
+		require.Equal(t, expected.ObserverEmissionPercentage, params.ObserverEmissionPercentage)
+		require.Equal(t, expected.TssSignerEmissionPercentage, params.TssSignerEmissionPercentage)
+		require.Equal(t, expected.ObserverSlashAmount.BigInt(), params.ObserverSlashAmount)
+		require.Equal(t, expected.BallotMaturityBlocks, params.BallotMaturityBlocks)
+		require.Equal(t, expected.BlockRewardAmount.String(), params.BlockRewardAmount)
+	})
+}
+
+func Test_WithdrawEmissions(t *testing.T) {
+	t.Run("should withdraw emissions", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawEmissionsMethodName]
+		withdrawer := sample.Bech32AccAddress()
+		k.SetWithdrawableEmission(ctx, emissionstypes.WithdrawableEmissions{
+			Address: withdrawer.String(),
+			Amount:  sdkmath.NewInt(1000),
+		})
+		fundRewardsPool(t, ctx, sdkKeepers, sdkmath.NewInt(1000))
+
+		mockVMContract.CallerAddress = common.BytesToAddress(withdrawer.Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(400))
+
+		// ACT
+		res, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.NoError(t, err)
+		success, err := methodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.True(t, success[0].(bool))
+
+		// remaining emissions are available for withdrawal
+		availableMethodID := abi.Methods[GetAvailableEmissionsMethodName]
+		mockVMContract.Input = packInputArgs(t, availableMethodID, common.BytesToAddress(withdrawer.Bytes()))
+		res, err = contract.Run(mockEVM, mockVMContract, false)
+		require.NoError(t, err)
+		amount, err := availableMethodID.Outputs.Unpack(res)
+		require.NoError(t, err)
+		require.Equal(t, int64(600), amount[0].(*big.Int).Int64())
+	})
+
+	t.Run("should fail in read-only mode", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawEmissionsMethodName]
+		withdrawer := sample.Bech32AccAddress()
+		k.SetWithdrawableEmission(ctx, emissionstypes.WithdrawableEmissions{
+			Address: withdrawer.String(),
+			Amount:  sdkmath.NewInt(1000),
+		})
+		fundRewardsPool(t, ctx, sdkKeepers, sdkmath.NewInt(1000))
+
+		mockVMContract.CallerAddress = common.BytesToAddress(withdrawer.Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(400))
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, true)
+
+		// ASSERT
+		require.ErrorIs(t, err, ptypes.ErrWriteMethod{Method: WithdrawEmissionsMethodName})
+		emission, found := k.GetWithdrawableEmission(ctx, withdrawer.String())
+		require.True(t, found)
+		require.Equal(t, int64(1000), emission.Amount.Int64())
+	})
+
+	t.Run("should fail if amount is greater than available emissions", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawEmissionsMethodName]
+		withdrawer := sample.Bech32AccAddress()
+		k.SetWithdrawableEmission(ctx, emissionstypes.WithdrawableEmissions{
+			Address: withdrawer.String(),
+			Amount:  sdkmath.NewInt(1000),
+		})
+		fundRewardsPool(t, ctx, sdkKeepers, sdkmath.NewInt(2000))
+
+		mockVMContract.CallerAddress = common.BytesToAddress(withdrawer.Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(1001))
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorIs(t, err, emissionstypes.ErrUnableToWithdrawEmissions)
+	})
+
+	t.Run("should fail if rewards pool does not have enough balance", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, k, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawEmissionsMethodName]
+		withdrawer := sample.Bech32AccAddress()
+		k.SetWithdrawableEmission(ctx, emissionstypes.WithdrawableEmissions{
+			Address: withdrawer.String(),
+			Amount:  sdkmath.NewInt(1000),
+		})
+
+		mockVMContract.CallerAddress = common.BytesToAddress(withdrawer.Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(1000))
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorIs(t, err, emissionstypes.ErrRewardsPoolDoesNotHaveEnoughBalance)
+	})
+
+	t.Run("should fail if no emissions for caller", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, sdkKeepers, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawEmissionsMethodName]
+		fundRewardsPool(t, ctx, sdkKeepers, sdkmath.NewInt(1000))
+
+		mockVMContract.CallerAddress = common.BytesToAddress(sample.Bech32AccAddress().Bytes())
+		mockVMContract.Input = packInputArgs(t, methodID, big.NewInt(1000))
+
+		// ACT
+		_, err := contract.Run(mockEVM, mockVMContract, false)
+
+		// ASSERT
+		require.ErrorIs(t, err, emissionstypes.ErrUnableToWithdrawEmissions)
+	})
+
+	t.Run("should fail if amount is not big int", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawEmissionsMethodName]
+
+		// ACT
+		_, err := contract.WithdrawEmissions(ctx, mockEVM, mockVMContract, &methodID, []interface{}{int64(1000)})
+
+		// ASSERT
+		require.Error(t, err)
+	})
+
+	t.Run("should fail if wrong args amount", func(t *testing.T) {
+		// ARRANGE
+		ctx, contract, abi, _, _, mockEVM, mockVMContract := setup(t)
+		methodID := abi.Methods[WithdrawEmissionsMethodName]
+
+		// ACT
+		_, err := contract.WithdrawEmissions(ctx, mockEVM, mockVMContract, &methodID, []interface{}{})
+
+		// ASSERT
+		require.Error(t, err)
+	})
+}
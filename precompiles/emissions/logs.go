@@ -0,0 +1,40 @@
+package emissions
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/zeta-chain/node/precompiles/logs"
+)
+
+const (
+	WithdrawEmissionsEventName = "WithdrawEmissions"
+)
+
+func (c *Contract) AddWithdrawEmissionsLog(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	withdrawer common.Address,
+	amount *big.Int,
+) error {
+	event := c.Abi().Events[WithdrawEmissionsEventName]
+
+	// withdrawer is indexed event param
+	topics, err := logs.MakeTopics(event, []interface{}{withdrawer})
+	if err != nil {
+		return err
+	}
+
+	// amount is part of event data
+	data, err := logs.PackBigInt(amount)
+	if err != nil {
+		return err
+	}
+
+	logs.AddLog(ctx, c.Address(), stateDB, topics, data)
+
+	return nil
+}
@@ -4,29 +4,70 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	evmkeeper "github.com/zeta-chain/ethermint/x/evm/keeper"
 
+	"github.com/zeta-chain/node/precompiles/bank"
+	"github.com/zeta-chain/node/precompiles/crosschain"
+	"github.com/zeta-chain/node/precompiles/distribution"
+	"github.com/zeta-chain/node/precompiles/emissions"
 	"github.com/zeta-chain/node/precompiles/prototype"
 	"github.com/zeta-chain/node/precompiles/staking"
+	crosschainkeeper "github.com/zeta-chain/node/x/crosschain/keeper"
+	emissionskeeper "github.com/zeta-chain/node/x/emissions/keeper"
 	fungiblekeeper "github.com/zeta-chain/node/x/fungible/keeper"
 )
 
 // EnabledStatefulContracts contains the list of all enabled stateful precompiles.
 // This is useful for listing and reading from other packages, such as BlockedAddrs() function.
 // Setting to false a contract here will disable it, not being included in the blockchain.
+// An included contract can still be disabled, or have its gas updated, at runtime with the precompile config
+// of the fungible module.
 var EnabledStatefulContracts = map[common.Address]bool{
-	prototype.ContractAddress: true,
-	staking.ContractAddress:   true,
+	prototype.ContractAddress:    true,
+	staking.ContractAddress:      true,
+	emissions.ContractAddress:    true,
+	crosschain.ContractAddress:   true,
+	bank.ContractAddress:         true,
+	distribution.ContractAddress: true,
+}
+
+// ContractABIs returns the ABIs of all the enabled stateful precompiles, indexed by their address.
+// They are provided to the fungible keeper to validate the precompile configs.
+func ContractABIs() map[common.Address]abi.ABI {
+	contractABIs := map[common.Address]abi.ABI{
+		prototype.ContractAddress:    prototype.ABI,
+		staking.ContractAddress:      staking.ABI,
+		emissions.ContractAddress:    emissions.ABI,
+		crosschain.ContractAddress:   crosschain.ABI,
+		bank.ContractAddress:         bank.ABI,
+		distribution.ContractAddress: distribution.ABI,
+	}
+
+	for address := range contractABIs {
+		if !EnabledStatefulContracts[address] {
+			delete(contractABIs, address)
+		}
+	}
+
+	return contractABIs
 }
 
 // StatefulContracts returns all the registered precompiled contracts.
+// The on-chain precompile config is read at execution time and applied to the contracts.
 func StatefulContracts(
 	fungibleKeeper *fungiblekeeper.Keeper,
 	stakingKeeper *stakingkeeper.Keeper,
+	emissionsKeeper *emissionskeeper.Keeper,
+	crosschainKeeper *crosschainkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	distributionKeeper *distrkeeper.Keeper,
 	cdc codec.Codec,
 	gasConfig storetypes.GasConfig,
 ) (precompiledContracts []evmkeeper.CustomContractFn) {
@@ -35,8 +76,8 @@ func StatefulContracts(
 
 	// Define the prototype contract function.
 	if EnabledStatefulContracts[prototype.ContractAddress] {
-		prototypeContract := func(_ sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
-			return prototype.NewIPrototypeContract(fungibleKeeper, cdc, gasConfig)
+		prototypeContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return withConfig(ctx, fungibleKeeper, gasConfig, prototype.NewIPrototypeContract(fungibleKeeper, cdc, gasConfig))
 		}
 
 		// Append the prototype contract to the precompiledContracts slice.
@@ -45,13 +86,53 @@ func StatefulContracts(
 
 	// Define the staking contract function.
 	if EnabledStatefulContracts[staking.ContractAddress] {
-		stakingContract := func(_ sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
-			return staking.NewIStakingContract(stakingKeeper, cdc, gasConfig)
+		stakingContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return withConfig(ctx, fungibleKeeper, gasConfig, staking.NewIStakingContract(stakingKeeper, cdc, gasConfig))
 		}
 
 		// Append the staking contract to the precompiledContracts slice.
 		precompiledContracts = append(precompiledContracts, stakingContract)
 	}
 
+	// Define the emissions contract function.
+	if EnabledStatefulContracts[emissions.ContractAddress] {
+		emissionsContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return withConfig(ctx, fungibleKeeper, gasConfig, emissions.NewIEmissionsContract(emissionsKeeper, cdc, gasConfig))
+		}
+
+		// Append the emissions contract to the precompiledContracts slice.
+		precompiledContracts = append(precompiledContracts, emissionsContract)
+	}
+
+	// Define the crosschain contract function.
+	if EnabledStatefulContracts[crosschain.ContractAddress] {
+		crosschainContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return withConfig(ctx, fungibleKeeper, gasConfig, crosschain.NewICrosschainContract(crosschainKeeper, cdc, gasConfig))
+		}
+
+		// Append the crosschain contract to the precompiledContracts slice.
+		precompiledContracts = append(precompiledContracts, crosschainContract)
+	}
+
+	// Define the bank contract function.
+	if EnabledStatefulContracts[bank.ContractAddress] {
+		bankContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return withConfig(ctx, fungibleKeeper, gasConfig, bank.NewIBankContract(bankKeeper, cdc, gasConfig))
+		}
+
+		// Append the bank contract to the precompiledContracts slice.
+		precompiledContracts = append(precompiledContracts, bankContract)
+	}
+
+	// Define the distribution contract function.
+	if EnabledStatefulContracts[distribution.ContractAddress] {
+		distributionContract := func(ctx sdktypes.Context, _ ethparams.Rules) vm.PrecompiledContract {
+			return withConfig(ctx, fungibleKeeper, gasConfig, distribution.NewIDistributionContract(distributionKeeper, cdc, gasConfig))
+		}
+
+		// Append the distribution contract to the precompiledContracts slice.
+		precompiledContracts = append(precompiledContracts, distributionContract)
+	}
+
 	return precompiledContracts
 }
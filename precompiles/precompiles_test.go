@@ -11,6 +11,8 @@ import (
 )
 
 func Test_StatefulContracts(t *testing.T) {
+	ek, _, _, _ := keeper.EmissionsKeeper(t)
+	ck, _, _, _ := keeper.CrosschainKeeper(t)
 	k, ctx, sdkk, _ := keeper.FungibleKeeper(t)
 	gasConfig := storetypes.TransientGasConfig()
 
@@ -25,7 +27,16 @@ func Test_StatefulContracts(t *testing.T) {
 	}
 
 	// StatefulContracts() should return all the enabled contracts.
-	contracts := StatefulContracts(k, &sdkk.StakingKeeper, appCodec, gasConfig)
+	contracts := StatefulContracts(
+		k,
+		&sdkk.StakingKeeper,
+		ek,
+		ck,
+		sdkk.BankKeeper,
+		&sdkk.DistributionKeeper,
+		appCodec,
+		gasConfig,
+	)
 	require.NotNil(t, contracts, "StatefulContracts() should not return a nil slice")
 	require.Len(t, contracts, expectedContracts, "StatefulContracts() should return all the enabled contracts")
 
@@ -38,3 +49,15 @@ func Test_StatefulContracts(t *testing.T) {
 		require.NotNil(t, contractAddr, "The called contract should have a valid address")
 	}
 }
+
+func Test_ContractABIs(t *testing.T) {
+	contractABIs := ContractABIs()
+
+	for address, enabled := range EnabledStatefulContracts {
+		contractABI, found := contractABIs[address]
+		require.Equal(t, enabled, found)
+		if found {
+			require.NotEmpty(t, contractABI.Methods)
+		}
+	}
+}
@@ -42,3 +42,22 @@ type ErrInvalidMethod struct {
 func (e ErrInvalidMethod) Error() string {
 	return fmt.Sprintf("invalid method: %s", e.Method)
 }
+
+/*
+Contract related errors
+*/
+type ErrDisabledContract struct {
+	Contract string
+}
+
+func (e ErrDisabledContract) Error() string {
+	return fmt.Sprintf("precompiled contract %s is disabled", e.Contract)
+}
+
+type ErrWriteMethod struct {
+	Method string
+}
+
+func (e ErrWriteMethod) Error() string {
+	return fmt.Sprintf("method not allowed in read-only mode: %s", e.Method)
+}
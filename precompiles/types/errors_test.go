@@ -46,3 +46,25 @@ func Test_ErrInvalidMethod(t *testing.T) {
 		t.Errorf("Expected %v, got %v", expect, got)
 	}
 }
+
+func Test_ErrDisabledContract(t *testing.T) {
+	e := ErrDisabledContract{
+		Contract: "foo",
+	}
+	got := e.Error()
+	expect := "precompiled contract foo is disabled"
+	if got != expect {
+		t.Errorf("Expected %v, got %v", expect, got)
+	}
+}
+
+func Test_ErrWriteMethod(t *testing.T) {
+	e := ErrWriteMethod{
+		Method: "foo",
+	}
+	got := e.Error()
+	expect := "method not allowed in read-only mode: foo"
+	if got != expect {
+		t.Errorf("Expected %v, got %v", expect, got)
+	}
+}
@@ -0,0 +1,293 @@
+package addressindexer
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+const (
+	KeyPrefixBlock   = 1
+	KeyPrefixAddress = 2
+)
+
+// Transaction is an indexed transaction of an address
+type Transaction struct {
+	Height int64
+	Index  uint32
+	Hash   common.Hash
+}
+
+// Indexer indexes the EVM transactions by the addresses of their sender and recipient,
+// the address of the contract for a contract creation.
+type Indexer interface {
+	// IndexBlock indexes the transactions of the block
+	IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error
+	// FirstIndexedBlock returns the first indexed block, -1 if the index is empty
+	FirstIndexedBlock() (int64, error)
+	// LastIndexedBlock returns the last indexed block, -1 if the index is empty
+	LastIndexedBlock() (int64, error)
+	// TransactionsBefore returns the transactions of the address in the blocks lower than the height,
+	// in descending order, and if older transactions remain
+	TransactionsBefore(address common.Address, height int64, pageSize int) ([]Transaction, bool, error)
+	// TransactionsAfter returns the transactions of the address in the blocks greater than the height,
+	// in ascending order, and if newer transactions remain
+	TransactionsAfter(address common.Address, height int64, pageSize int) ([]Transaction, bool, error)
+}
+
+var _ Indexer = &KVIndexer{}
+
+// KVIndexer implements the address indexer on a KV store. Every indexed block is recorded with its number of
+// transactions, and the hashes of the transactions are indexed by address, height and index in the block.
+type KVIndexer struct {
+	db        dbm.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewKVIndexer creates a new address indexer on the database
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
+	return &KVIndexer{db, logger, clientCtx}
+}
+
+// IndexBlock indexes the ethereum transactions of the block, including the synthetic ones,
+// with the same indexes as the JSON-RPC. The block is recorded even without transactions, to know the indexed range.
+func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	var ethTxIndex uint32
+	for txIndex, tx := range block.Txs {
+		result := txResults[txIndex]
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(result) {
+			continue
+		}
+
+		for _, indexed := range kv.parseTx(tx, result, txIndex, height) {
+			for _, address := range indexed.addresses {
+				if err := batch.Set(AddressKey(address, height, ethTxIndex), indexed.hash.Bytes()); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d, set address key", height)
+				}
+			}
+			ethTxIndex++
+		}
+	}
+
+	if err := batch.Set(BlockKey(height), sdk.Uint64ToBigEndian(uint64(ethTxIndex))); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set block key", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// indexedTx is an ethereum transaction with the addresses it is indexed by
+type indexedTx struct {
+	hash      common.Hash
+	addresses []common.Address
+}
+
+// parseTx returns the ethereum transactions of the cosmos tx, or its synthetic transaction.
+// It follows EthMsgsFromTendermintBlock of the backend.
+func (kv *KVIndexer) parseTx(
+	txBz tmtypes.Tx,
+	result *abci.ResponseDeliverTx,
+	txIndex int,
+	height int64,
+) []indexedTx {
+	tx, err := kv.clientCtx.TxConfig.TxDecoder()(txBz)
+	if err == nil {
+		var txs []indexedTx
+		for _, msg := range tx.GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				continue
+			}
+			ethTx := ethMsg.AsTransaction()
+			if ethTx == nil {
+				kv.logger.Error("Fail to unpack eth tx", "block", height, "txIndex", txIndex)
+				continue
+			}
+			addresses, err := txAddresses(ethMsg, ethTx)
+			if err != nil {
+				kv.logger.Error("Fail to recover eth tx sender", "err", err, "block", height, "txIndex", txIndex)
+			}
+			txs = append(txs, indexedTx{hash: ethTx.Hash(), addresses: addresses})
+		}
+		if len(txs) > 0 {
+			return txs
+		}
+	}
+
+	_, additional, err := rpctypes.ParseTxBlockResult(result, tx, txIndex, height)
+	if err != nil || additional == nil {
+		return nil
+	}
+	return []indexedTx{{
+		hash:      additional.Hash,
+		addresses: []common.Address{additional.Sender, additional.Recipient},
+	}}
+}
+
+// txAddresses returns the sender and the recipient of the transaction, or the address of the created contract
+func txAddresses(ethMsg *evmtypes.MsgEthereumTx, ethTx *ethtypes.Transaction) ([]common.Address, error) {
+	var addresses []common.Address
+
+	from := common.HexToAddress(ethMsg.From)
+	if ethMsg.From == "" {
+		var signer ethtypes.Signer = ethtypes.HomesteadSigner{}
+		if ethTx.Protected() {
+			signer = ethtypes.LatestSignerForChainID(ethTx.ChainId())
+		}
+		sender, err := ethtypes.Sender(signer, ethTx)
+		if err != nil {
+			if ethTx.To() != nil {
+				addresses = append(addresses, *ethTx.To())
+			}
+			return addresses, err
+		}
+		from = sender
+	}
+	addresses = append(addresses, from)
+
+	if ethTx.To() != nil {
+		return append(addresses, *ethTx.To()), nil
+	}
+	return append(addresses, crypto.CreateAddress(from, ethTx.Nonce())), nil
+}
+
+// FirstIndexedBlock returns the first indexed block, -1 if the index is empty
+func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBlock}, []byte{KeyPrefixBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseHeight(it.Key()[1:]), nil
+}
+
+// LastIndexedBlock returns the last indexed block, -1 if the index is empty
+func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixBlock}, []byte{KeyPrefixBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseHeight(it.Key()[1:]), nil
+}
+
+// TransactionsBefore returns the transactions of the address in the blocks lower than the height, from the latest,
+// until at least pageSize transactions are found. The transactions of a block are never split across pages.
+func (kv *KVIndexer) TransactionsBefore(
+	address common.Address,
+	height int64,
+	pageSize int,
+) ([]Transaction, bool, error) {
+	prefix := AddressPrefix(address)
+	it, err := kv.db.ReverseIterator(prefix, heightPrefix(prefix, height))
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "TransactionsBefore, address %s", address.Hex())
+	}
+	defer it.Close()
+	return collectPage(it, pageSize)
+}
+
+// TransactionsAfter returns the transactions of the address in the blocks greater than the height, from the oldest,
+// until at least pageSize transactions are found. The transactions of a block are never split across pages.
+func (kv *KVIndexer) TransactionsAfter(
+	address common.Address,
+	height int64,
+	pageSize int,
+) ([]Transaction, bool, error) {
+	prefix := AddressPrefix(address)
+	it, err := kv.db.Iterator(heightPrefix(prefix, height+1), prefixEnd(prefix))
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "TransactionsAfter, address %s", address.Hex())
+	}
+	defer it.Close()
+	return collectPage(it, pageSize)
+}
+
+// collectPage collects the transactions of the iterator until the page is full at the end of a block,
+// it returns true if transactions remain
+func collectPage(it dbm.Iterator, pageSize int) ([]Transaction, bool, error) {
+	txs := []Transaction{}
+	for ; it.Valid(); it.Next() {
+		tx := parseAddressKey(it.Key())
+		if len(txs) >= pageSize && tx.Height != txs[len(txs)-1].Height {
+			return txs, true, nil
+		}
+		tx.Hash = common.BytesToHash(it.Value())
+		txs = append(txs, tx)
+	}
+	return txs, false, it.Error()
+}
+
+// BlockKey returns the key of an indexed block
+func BlockKey(height int64) []byte {
+	return heightPrefix([]byte{KeyPrefixBlock}, height)
+}
+
+// AddressPrefix returns the prefix of the keys of the transactions of the address
+func AddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddress}, address.Bytes()...)
+}
+
+// AddressKey returns the key of a transaction of the address
+func AddressKey(address common.Address, height int64, index uint32) []byte {
+	return binary.BigEndian.AppendUint32(heightPrefix(AddressPrefix(address), height), index)
+}
+
+// heightPrefix returns the prefix with the height, the height is big endian to iterate in order
+func heightPrefix(prefix []byte, height int64) []byte {
+	key := make([]byte, 0, len(prefix)+8)
+	// #nosec G115 heights are positive
+	return append(append(key, prefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// prefixEnd returns the end of the iteration over the prefix of an address
+func prefixEnd(prefix []byte) []byte {
+	end := common.CopyBytes(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end
+		}
+	}
+	return nil
+}
+
+// parseAddressKey returns the height and the index of the transaction of an address key
+func parseAddressKey(key []byte) Transaction {
+	return Transaction{
+		Height: parseHeight(key[len(key)-12 : len(key)-4]),
+		Index:  binary.BigEndian.Uint32(key[len(key)-4:]),
+	}
+}
+
+// parseHeight parses a big endian height
+func parseHeight(bz []byte) int64 {
+	// #nosec G115 heights are positive
+	return int64(sdk.BigEndianToUint64(bz))
+}
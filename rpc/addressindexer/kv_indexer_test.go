@@ -0,0 +1,136 @@
+package addressindexer
+
+import (
+	"math/big"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/ethermint/app"
+	"github.com/zeta-chain/ethermint/encoding"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+func TestKVIndexer(t *testing.T) {
+	chainID := big.NewInt(7001)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	alice := crypto.PubkeyToAddress(key.PublicKey)
+	bob := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	carol := common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	clientCtx := client.Context{}.WithTxConfig(encoding.MakeConfig(app.ModuleBasics).TxConfig)
+
+	// newTx returns a tx signed by alice, a contract creation if to is nil
+	newTx := func(nonce uint64, to *common.Address) (tmtypes.Tx, common.Hash) {
+		ethTx, err := ethtypes.SignTx(
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: nonce, To: to, Gas: 21000, GasPrice: big.NewInt(1)}),
+			ethtypes.LatestSignerForChainID(chainID),
+			key,
+		)
+		require.NoError(t, err)
+
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(ethTx))
+		tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "azeta")
+		require.NoError(t, err)
+		bz, err := clientCtx.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return bz, ethTx.Hash()
+	}
+
+	idxer := NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+	t.Run("should return -1 for an empty index", func(t *testing.T) {
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, -1, first)
+
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, -1, last)
+	})
+
+	tx0, hash0 := newTx(0, &bob)
+	tx1, hash1 := newTx(1, &carol)
+	failed, _ := newTx(2, &bob)
+	tx2, hash2 := newTx(2, &bob)
+	tx3, hash3 := newTx(3, nil)
+	contract := crypto.CreateAddress(alice, 3)
+
+	blocks := []struct {
+		height    int64
+		txs       []tmtypes.Tx
+		txResults []*abci.ResponseDeliverTx
+	}{
+		{10, []tmtypes.Tx{tx0, tx1}, []*abci.ResponseDeliverTx{{}, {}}},
+		{11, nil, nil},
+		{12, []tmtypes.Tx{failed, tx2}, []*abci.ResponseDeliverTx{{Code: 1}, {}}},
+		{13, []tmtypes.Tx{tx3}, []*abci.ResponseDeliverTx{{}}},
+	}
+	for _, b := range blocks {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: b.height}, Data: tmtypes.Data{Txs: b.txs}}
+		require.NoError(t, idxer.IndexBlock(block, b.txResults))
+	}
+
+	t.Run("should return the indexed range", func(t *testing.T) {
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 10, first)
+
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 13, last)
+	})
+
+	t.Run("should return the transactions before a block", func(t *testing.T) {
+		txs, hasMore, err := idxer.TransactionsBefore(alice, 100, 1)
+		require.NoError(t, err)
+		require.True(t, hasMore)
+		require.Equal(t, []Transaction{{Height: 13, Index: 0, Hash: hash3}}, txs)
+
+		txs, hasMore, err = idxer.TransactionsBefore(alice, 13, 1)
+		require.NoError(t, err)
+		require.True(t, hasMore)
+		require.Equal(t, []Transaction{{Height: 12, Index: 0, Hash: hash2}}, txs)
+
+		// the transactions of a block are not split
+		txs, hasMore, err = idxer.TransactionsBefore(alice, 12, 1)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []Transaction{{Height: 10, Index: 1, Hash: hash1}, {Height: 10, Index: 0, Hash: hash0}}, txs)
+	})
+
+	t.Run("should return the transactions after a block", func(t *testing.T) {
+		txs, hasMore, err := idxer.TransactionsAfter(bob, 0, 1)
+		require.NoError(t, err)
+		require.True(t, hasMore)
+		require.Equal(t, []Transaction{{Height: 10, Index: 0, Hash: hash0}}, txs)
+
+		txs, hasMore, err = idxer.TransactionsAfter(bob, 10, 10)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []Transaction{{Height: 12, Index: 0, Hash: hash2}}, txs)
+	})
+
+	t.Run("should index the created contracts", func(t *testing.T) {
+		txs, hasMore, err := idxer.TransactionsAfter(contract, 0, 10)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Equal(t, []Transaction{{Height: 13, Index: 0, Hash: hash3}}, txs)
+	})
+
+	t.Run("should return no transactions for an unknown address", func(t *testing.T) {
+		txs, hasMore, err := idxer.TransactionsBefore(common.HexToAddress("0xdead"), 100, 10)
+		require.NoError(t, err)
+		require.False(t, hasMore)
+		require.Empty(t, txs)
+	})
+}
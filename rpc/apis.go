@@ -24,15 +24,20 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	ethermint "github.com/zeta-chain/ethermint/types"
 
+	"github.com/zeta-chain/node/rpc/addressindexer"
 	"github.com/zeta-chain/node/rpc/backend"
+	"github.com/zeta-chain/node/rpc/logindexer"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/debug"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/eth"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/eth/filters"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/miner"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/net"
+	"github.com/zeta-chain/node/rpc/namespaces/ethereum/ots"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/personal"
+	"github.com/zeta-chain/node/rpc/namespaces/ethereum/trace"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/txpool"
 	"github.com/zeta-chain/node/rpc/namespaces/ethereum/web3"
+	"github.com/zeta-chain/node/rpc/namespaces/zeta"
 )
 
 // RPC namespaces and API version
@@ -50,6 +55,12 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+	OtsNamespace      = "ots"
+
+	// ZetaChain namespaces
+
+	ZetaNamespace = "zeta"
 
 	apiVersion = "1.0"
 )
@@ -61,6 +72,8 @@ type APICreator = func(
 	tendermintWebsocketClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
+	logIndexer logindexer.Indexer,
+	addressIndexer addressindexer.Indexer,
 ) []rpc.API
 
 // apiCreators defines the JSON-RPC API namespaces.
@@ -73,6 +86,8 @@ func init() {
 			tmWSClient *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			logIndexer logindexer.Indexer,
+			_ addressindexer.Indexer,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -85,12 +100,20 @@ func init() {
 				{
 					Namespace: EthNamespace,
 					Version:   apiVersion,
-					Service:   filters.NewPublicAPI(ctx.Logger, clientCtx, tmWSClient, evmBackend),
+					Service:   filters.NewPublicAPI(ctx.Logger, clientCtx, tmWSClient, evmBackend, logIndexer),
 					Public:    true,
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, bool, ethermint.EVMTxIndexer) []rpc.API {
+		Web3Namespace: func(
+			*server.Context,
+			client.Context,
+			*rpcclient.WSClient,
+			bool,
+			ethermint.EVMTxIndexer,
+			logindexer.Indexer,
+			addressindexer.Indexer,
+		) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -100,7 +123,15 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		NetNamespace: func(
+			_ *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			_ bool,
+			_ ethermint.EVMTxIndexer,
+			_ logindexer.Indexer,
+			_ addressindexer.Indexer,
+		) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -116,6 +147,8 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			_ logindexer.Indexer,
+			_ addressindexer.Indexer,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -127,7 +160,15 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient, _ bool, _ ethermint.EVMTxIndexer) []rpc.API {
+		TxPoolNamespace: func(
+			ctx *server.Context,
+			_ client.Context,
+			_ *rpcclient.WSClient,
+			_ bool,
+			_ ethermint.EVMTxIndexer,
+			_ logindexer.Indexer,
+			_ addressindexer.Indexer,
+		) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
@@ -142,6 +183,8 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			_ logindexer.Indexer,
+			_ addressindexer.Indexer,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -158,6 +201,8 @@ func init() {
 			_ *rpcclient.WSClient,
 			allowUnprotectedTxs bool,
 			indexer ethermint.EVMTxIndexer,
+			_ logindexer.Indexer,
+			_ addressindexer.Indexer,
 		) []rpc.API {
 			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
 			return []rpc.API{
@@ -169,6 +214,75 @@ func init() {
 				},
 			}
 		},
+		ZetaNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+			_ logindexer.Indexer,
+			_ addressindexer.Indexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: ZetaNamespace,
+					Version:   apiVersion,
+					Service:   zeta.NewAPI(ctx, clientCtx, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+	}
+
+	// the trace namespace is not enabled by default, it has to be selected in the JSON-RPC config
+	if err := RegisterAPINamespace(TraceNamespace, newTraceAPIs); err != nil {
+		panic(err)
+	}
+	// the ots namespace of the Otterscan block explorer is not enabled by default either
+	if err := RegisterAPINamespace(OtsNamespace, newOtsAPIs); err != nil {
+		panic(err)
+	}
+}
+
+// newTraceAPIs creates the trace namespace in the flat trace format of OpenEthereum and Erigon
+func newTraceAPIs(
+	ctx *server.Context,
+	clientCtx client.Context,
+	_ *rpcclient.WSClient,
+	allowUnprotectedTxs bool,
+	indexer ethermint.EVMTxIndexer,
+	_ logindexer.Indexer,
+	_ addressindexer.Indexer,
+) []rpc.API {
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	return []rpc.API{
+		{
+			Namespace: TraceNamespace,
+			Version:   apiVersion,
+			Service:   trace.NewAPI(ctx, evmBackend),
+			Public:    true,
+		},
+	}
+}
+
+// newOtsAPIs creates the ots namespace of the Otterscan block explorer
+func newOtsAPIs(
+	ctx *server.Context,
+	clientCtx client.Context,
+	_ *rpcclient.WSClient,
+	allowUnprotectedTxs bool,
+	indexer ethermint.EVMTxIndexer,
+	_ logindexer.Indexer,
+	addressIndexer addressindexer.Indexer,
+) []rpc.API {
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	return []rpc.API{
+		{
+			Namespace: OtsNamespace,
+			Version:   apiVersion,
+			Service:   ots.NewAPI(ctx, evmBackend, addressIndexer),
+			Public:    true,
+		},
 	}
 }
 
@@ -178,13 +292,23 @@ func GetRPCAPIs(ctx *server.Context,
 	tmWSClient *rpcclient.WSClient,
 	allowUnprotectedTxs bool,
 	indexer ethermint.EVMTxIndexer,
+	logIndexer logindexer.Indexer,
+	addressIndexer addressindexer.Indexer,
 	selectedAPIs []string,
 ) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient, allowUnprotectedTxs, indexer)...)
+			apis = append(apis, creator(
+				ctx,
+				clientCtx,
+				tmWSClient,
+				allowUnprotectedTxs,
+				indexer,
+				logIndexer,
+				addressIndexer,
+			)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
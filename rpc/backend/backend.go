@@ -76,6 +76,7 @@ type EVMBackend interface {
 	RPCEVMTimeout() time.Duration // global timeout for eth_call over rpc: DoS protection
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 	RPCMinGasPrice() int64
+	RPCBlockRangeCap() int32 // global block range cap for eth_getLogs and trace_filter over rpc: DoS protection
 
 	// Sign Tx
 	Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error)
@@ -143,6 +144,7 @@ type EVMBackend interface {
 	GetTxByTxIndex(height int64, txIndex uint) (*ethermint.TxResult, *rpctypes.TxResultAdditionalFields, error)
 	GetTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetBlockReceipts(blockNum rpctypes.BlockNumber) ([]map[string]interface{}, error)
 	GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error)
 	GetTransactionByBlockNumberAndIndex(
 		blockNum rpctypes.BlockNumber,
@@ -155,6 +157,8 @@ type EVMBackend interface {
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNum rpctypes.BlockNumber) ([]*rpctypes.SimBlockResult, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNum rpctypes.BlockNumber) (*rpctypes.AccessListResult, error)
 	GasPrice() (*hexutil.Big, error)
 
 	// Filter API
@@ -169,6 +173,11 @@ type EVMBackend interface {
 		config *evmtypes.TraceConfig,
 		block *tmrpctypes.ResultBlock,
 	) ([]*evmtypes.TxTraceResult, error)
+	TraceCall(
+		args evmtypes.TransactionArgs,
+		blockNum rpctypes.BlockNumber,
+		config *rpctypes.TraceCallConfig,
+	) (interface{}, error)
 }
 
 var _ BackendI = (*Backend)(nil)
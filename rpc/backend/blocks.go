@@ -33,6 +33,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/trie"
 	"github.com/pkg/errors"
+	ethermint "github.com/zeta-chain/ethermint/types"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -316,7 +317,7 @@ func (b *Backend) EthMsgsFromTendermintBlock(
 
 		// if tx can not be decoded or MsgEthereumTx was not found, try to parse it from block results
 		if shouldCheckForSyntheticTx {
-			ethMsg, additional := b.parseSyntheticTxFromBlockResults(txResults, i, tx, block)
+			ethMsg, additional, _ := b.parseSyntheticTxFromBlockResults(txResults, i, tx, block)
 			if ethMsg != nil {
 				ethMsgs = append(ethMsgs, ethMsg)
 				txsAdditional = append(txsAdditional, additional)
@@ -331,17 +332,17 @@ func (b *Backend) parseSyntheticTxFromBlockResults(
 	i int,
 	tx sdk.Tx,
 	block *tmtypes.Block,
-) (*evmtypes.MsgEthereumTx, *rpctypes.TxResultAdditionalFields) {
+) (*evmtypes.MsgEthereumTx, *rpctypes.TxResultAdditionalFields, *ethermint.TxResult) {
 	res, additional, err := rpctypes.ParseTxBlockResult(txResults[i], tx, i, block.Height)
 	// just skip tx if it can not be parsed, so remaining txs from the block are parsed
 	if err != nil {
 		b.logger.Error(err.Error())
-		return nil, nil
+		return nil, nil, nil
 	}
 	if additional == nil || res == nil {
-		return nil, nil
+		return nil, nil, nil
 	}
-	return b.parseSyntethicTxFromAdditionalFields(additional), additional
+	return b.parseSyntethicTxFromAdditionalFields(additional), additional, res
 }
 
 func (b *Backend) parseSyntethicTxFromAdditionalFields(
@@ -0,0 +1,216 @@
+package backend
+
+import (
+	"context"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// TraceCall traces a call on top of the state of the block and returns the result of the tracer.
+// Without overrides, the call is traced by the EVM module like a transaction of the block.
+// With state or block overrides, the call is traced by the simulation of the fungible module.
+func (b *Backend) TraceCall(
+	args evmtypes.TransactionArgs,
+	blockNum rpctypes.BlockNumber,
+	config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	if config == nil || (config.StateOverrides == nil && config.BlockOverrides == nil) {
+		var traceConfig *evmtypes.TraceConfig
+		if config != nil {
+			traceConfig = &config.TraceConfig
+		}
+		return b.traceCallOnChain(args, blockNum, traceConfig)
+	}
+
+	results, err := b.simulate(blockNum, fungibletypes.SimulateArgs{
+		Blocks: []fungibletypes.SimulateBlock{{
+			BlockOverrides: config.BlockOverrides,
+			StateOverrides: config.StateOverrides,
+			Calls:          []evmtypes.TransactionArgs{args},
+		}},
+		InBaseBlock: true,
+		TraceConfig: &config.TraceConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(results[0].Calls[0].Trace, &decodedResult); err != nil {
+		return nil, err
+	}
+	return decodedResult, nil
+}
+
+// CreateAccessList returns the access list of the call on top of the state of the block and the gas used with it.
+// The call is executed by the simulation of the fungible module until its access list no longer changes.
+func (b *Backend) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNum rpctypes.BlockNumber,
+) (*rpctypes.AccessListResult, error) {
+	results, err := b.simulate(blockNum, fungibletypes.SimulateArgs{
+		Blocks:      []fungibletypes.SimulateBlock{{Calls: []evmtypes.TransactionArgs{args}}},
+		InBaseBlock: true,
+		AccessList:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	call := results[0].Calls[0]
+	result := &rpctypes.AccessListResult{
+		AccessList: call.AccessList,
+		GasUsed:    call.GasUsed,
+	}
+	if call.Error != nil {
+		result.Error = call.Error.Message
+	}
+	return result, nil
+}
+
+// traceCallOnChain traces the call with the EVM module as an unsigned transaction following the block
+func (b *Backend) traceCallOnChain(
+	args evmtypes.TransactionArgs,
+	blockNum rpctypes.BlockNumber,
+	config *evmtypes.TraceConfig,
+) (interface{}, error) {
+	blk, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		return nil, errors.New("header not found")
+	}
+	height := blk.Block.Height
+
+	// an unsigned transaction is executed as is, so the gas and the nonce must be set
+	if args.Gas == nil {
+		gas := hexutil.Uint64(b.RPCGasCap())
+		args.Gas = &gas
+	}
+	if args.Nonce == nil {
+		nonce, err := b.GetTransactionCount(args.GetFrom(), rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		args.Nonce = nonce
+	}
+	msg := args.ToTransaction()
+	if msg == nil {
+		return nil, errors.New("invalid call arguments")
+	}
+
+	traceTxRequest := evmtypes.QueryTraceTxRequest{
+		Msg:             msg,
+		TraceConfig:     config,
+		BlockNumber:     height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		ChainId:         b.chainID.Int64(),
+	}
+	traceResult, err := b.queryClient.TraceTx(rpctypes.ContextWithHeight(height), &traceTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+	return decodedResult, nil
+}
+
+// SimulateV1 simulates blocks of calls on top of the state of the block, with optional state and block overrides.
+// The calls are simulated by the fungible module so they run with the zEVM precompiles.
+// The state changes of a call are visible to the following calls and blocks, they're never committed.
+func (b *Backend) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNum rpctypes.BlockNumber,
+) ([]*rpctypes.SimBlockResult, error) {
+	switch {
+	case opts.TraceTransfers:
+		return nil, errors.New("traceTransfers is not supported")
+	case opts.ReturnFullTransactions:
+		return nil, errors.New("returnFullTransactions is not supported")
+	}
+
+	return b.simulate(blockNum, fungibletypes.SimulateArgs{
+		Blocks:     opts.BlockStateCalls,
+		Validation: opts.Validation,
+	})
+}
+
+// simulate runs the simulation of the fungible module on top of the state of the block
+func (b *Backend) simulate(
+	blockNum rpctypes.BlockNumber,
+	args fungibletypes.SimulateArgs,
+) ([]*fungibletypes.SimulateBlockResult, error) {
+	// check the input before querying the block
+	if err := args.Validate(); err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	blk, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.Block == nil {
+		// the error message imitates geth behavior
+		return nil, errors.New("header not found")
+	}
+	height := blk.Block.Height
+
+	ctx := rpctypes.ContextWithHeight(height)
+
+	// Setup context so it may be canceled the simulation has completed
+	// or, in case of unmetered gas, setup a context with a timeout.
+	var cancel context.CancelFunc
+	if timeout := b.RPCEVMTimeout(); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := b.queryClient.Fungible.Simulate(ctx, &fungibletypes.QuerySimulateRequest{
+		Args:            bz,
+		ChainId:         b.chainID.Int64(),
+		BlockNumber:     height,
+		BlockTime:       blk.Block.Time.Unix(),
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*fungibletypes.SimulateBlockResult
+	if err := json.Unmarshal(res.Data, &results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(args.Blocks) {
+		return nil, errors.Errorf("expected %d simulated blocks, got %d", len(args.Blocks), len(results))
+	}
+	for i, block := range results {
+		if len(block.Calls) != len(args.Blocks[i].Calls) {
+			return nil, errors.Errorf("block %d: expected %d simulated calls, got %d",
+				i, len(args.Blocks[i].Calls), len(block.Calls))
+		}
+	}
+	return results, nil
+}
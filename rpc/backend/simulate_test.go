@@ -0,0 +1,174 @@
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	"github.com/zeta-chain/node/rpc/backend/mocks"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// registerSimulate registers the block and the simulation returning the results
+func (suite *BackendTestSuite) registerSimulate(
+	height int64,
+	check func(args fungibletypes.SimulateArgs),
+	results []*fungibletypes.SimulateBlockResult,
+	err error,
+) {
+	client := suite.backend.clientCtx.Client.(*mocks.Client)
+	_, blockErr := RegisterBlock(client, height, nil)
+	suite.Require().NoError(blockErr)
+
+	queryClient := suite.backend.queryClient.Fungible.(*mocks.FungibleQueryClient)
+	call := queryClient.On("Simulate", mock.Anything, mock.MatchedBy(func(req *fungibletypes.QuerySimulateRequest) bool {
+		var args fungibletypes.SimulateArgs
+		if json.Unmarshal(req.Args, &args) != nil || req.BlockNumber != height {
+			return false
+		}
+		check(args)
+		return true
+	}))
+	if err != nil {
+		call.Return(nil, err)
+		return
+	}
+	data, marshalErr := json.Marshal(results)
+	suite.Require().NoError(marshalErr)
+	call.Return(&fungibletypes.QuerySimulateResponse{Data: data}, nil)
+}
+
+func (suite *BackendTestSuite) TestSimulateV1() {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	calls := []evmtypes.TransactionArgs{{From: &from, To: &contract}, {From: &from, To: &contract}}
+
+	suite.Run("simulates the blocks with the fungible module", func() {
+		suite.SetupTest()
+		expected := []*fungibletypes.SimulateBlockResult{{
+			Number: (*hexutil.Big)(common.Big2),
+			Calls: []fungibletypes.SimulateCallResult{
+				{ReturnValue: hexutil.Bytes{}, Logs: []*ethtypes.Log{}, GasUsed: 21000, Status: 1},
+				{ReturnValue: hexutil.Bytes{}, Logs: []*ethtypes.Log{}, GasUsed: 21000, Status: 1},
+			},
+		}}
+		suite.registerSimulate(1, func(args fungibletypes.SimulateArgs) {
+			suite.Require().True(args.Validation)
+			suite.Require().False(args.InBaseBlock)
+			suite.Require().Len(args.Blocks, 1)
+			suite.Require().Len(args.Blocks[0].Calls, 2)
+		}, expected, nil)
+
+		results, err := suite.backend.SimulateV1(rpctypes.SimOpts{
+			BlockStateCalls: []rpctypes.SimBlock{{Calls: calls}},
+			Validation:      true,
+		}, rpctypes.BlockNumber(1))
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, results)
+	})
+
+	suite.Run("returns the error of the simulation", func() {
+		suite.SetupTest()
+		suite.registerSimulate(1, func(fungibletypes.SimulateArgs) {}, nil, errors.New("block 0: call 0: nonce"))
+
+		_, err := suite.backend.SimulateV1(rpctypes.SimOpts{
+			BlockStateCalls: []rpctypes.SimBlock{{Calls: calls}},
+		}, rpctypes.BlockNumber(1))
+		suite.Require().ErrorContains(err, "nonce")
+	})
+
+	suite.Run("rejects a simulation missing calls", func() {
+		suite.SetupTest()
+		suite.registerSimulate(1, func(fungibletypes.SimulateArgs) {}, []*fungibletypes.SimulateBlockResult{{
+			Calls: []fungibletypes.SimulateCallResult{{Status: 1}},
+		}}, nil)
+
+		_, err := suite.backend.SimulateV1(rpctypes.SimOpts{
+			BlockStateCalls: []rpctypes.SimBlock{{Calls: calls}},
+		}, rpctypes.BlockNumber(1))
+		suite.Require().ErrorContains(err, "expected 2 simulated calls")
+	})
+
+	suite.Run("checks the limits before the simulation", func() {
+		suite.SetupTest()
+		_, err := suite.backend.SimulateV1(rpctypes.SimOpts{}, rpctypes.EthLatestBlockNumber)
+		suite.Require().ErrorContains(err, "empty input")
+
+		_, err = suite.backend.SimulateV1(rpctypes.SimOpts{
+			BlockStateCalls: make([]rpctypes.SimBlock, fungibletypes.MaxSimulateBlocks+1),
+		}, rpctypes.EthLatestBlockNumber)
+		suite.Require().ErrorContains(err, "too many blocks")
+
+		_, err = suite.backend.SimulateV1(rpctypes.SimOpts{
+			BlockStateCalls: []rpctypes.SimBlock{{}},
+			TraceTransfers:  true,
+		}, rpctypes.EthLatestBlockNumber)
+		suite.Require().ErrorContains(err, "not supported")
+	})
+}
+
+func (suite *BackendTestSuite) TestTraceCallWithOverrides() {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	code := hexutil.Bytes{0x00}
+	time := hexutil.Uint64(2000)
+
+	suite.SetupTest()
+	suite.registerSimulate(1, func(args fungibletypes.SimulateArgs) {
+		suite.Require().True(args.InBaseBlock)
+		suite.Require().NotNil(args.TraceConfig)
+		suite.Require().Equal("callTracer", args.TraceConfig.Tracer)
+		suite.Require().Equal(&code, (*args.Blocks[0].StateOverrides)[contract].Code)
+		suite.Require().Equal(&time, args.Blocks[0].BlockOverrides.Time)
+	}, []*fungibletypes.SimulateBlockResult{{
+		Calls: []fungibletypes.SimulateCallResult{{Status: 1, Trace: json.RawMessage(`{"type":"CALL"}`)}},
+	}}, nil)
+
+	res, err := suite.backend.TraceCall(
+		evmtypes.TransactionArgs{From: &from, To: &contract},
+		rpctypes.BlockNumber(1),
+		&rpctypes.TraceCallConfig{
+			TraceConfig:    evmtypes.TraceConfig{Tracer: "callTracer"},
+			StateOverrides: &rpctypes.StateOverride{contract: rpctypes.OverrideAccount{Code: &code}},
+			BlockOverrides: &rpctypes.BlockOverrides{Time: &time},
+		},
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(map[string]interface{}{"type": "CALL"}, res)
+}
+
+func (suite *BackendTestSuite) TestCreateAccessList() {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	accessList := ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{{}}}}
+
+	suite.SetupTest()
+	suite.registerSimulate(1, func(args fungibletypes.SimulateArgs) {
+		suite.Require().True(args.InBaseBlock)
+		suite.Require().True(args.AccessList)
+	}, []*fungibletypes.SimulateBlockResult{{
+		Calls: []fungibletypes.SimulateCallResult{{
+			GasUsed:    30000,
+			Status:     0,
+			Error:      &fungibletypes.SimulateCallError{Code: 3, Message: "execution reverted"},
+			AccessList: &accessList,
+		}},
+	}}, nil)
+
+	res, err := suite.backend.CreateAccessList(
+		evmtypes.TransactionArgs{From: &from, To: &contract},
+		rpctypes.BlockNumber(1),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(&rpctypes.AccessListResult{
+		AccessList: &accessList,
+		GasUsed:    30000,
+		Error:      "execution reverted",
+	}, res)
+}
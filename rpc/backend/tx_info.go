@@ -17,6 +17,7 @@ package backend
 
 import (
 	"fmt"
+	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
 	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -226,30 +227,11 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	}
 	cumulativeGasUsed += res.CumulativeGasUsed
 
-	var status hexutil.Uint
-	if res.Failed {
-		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
-	} else {
-		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
-	}
-
 	chainID, err := b.ChainID()
 	if err != nil {
 		return nil, err
 	}
 
-	var from common.Address
-	if additional != nil {
-		from = common.HexToAddress(ethMsg.From)
-	} else if ethMsg.Data != nil {
-		from, err = ethMsg.GetSender(chainID.ToInt())
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		return nil, errors.New("failed to parse receipt")
-	}
-
 	// parse tx logs from events
 	// #nosec G115 always in range
 	logs, err := TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
@@ -277,6 +259,221 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 			return nil, errors.New("can't find index of ethereum tx")
 		}
 	}
+
+	var baseFee *big.Int
+	if _, ok := txData.(*evmtypes.DynamicFeeTx); ok {
+		baseFee, err = b.BaseFee(blockRes)
+		if err != nil {
+			// tolerate the error for pruned node.
+			b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
+		}
+	}
+
+	return b.formatTxReceipt(
+		hash,
+		ethMsg,
+		txData,
+		additional,
+		res,
+		cumulativeGasUsed,
+		logs,
+		common.BytesToHash(resBlock.Block.Header.Hash()),
+		chainID.ToInt(),
+		baseFee,
+	)
+}
+
+// GetBlockReceipts returns the receipts of all the ethereum txs of the block, synthetic txs included.
+// The receipts are assembled in a single pass over the block results.
+func (b *Backend) GetBlockReceipts(blockNum rpctypes.BlockNumber) ([]map[string]interface{}, error) {
+	resBlock, err := b.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		b.logger.Debug("block not found", "height", blockNum.Int64())
+		return nil, nil
+	}
+	block := resBlock.Block
+
+	blockRes, err := b.TendermintBlockResultByNumber(&block.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", block.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	chainID, err := b.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee, err := b.BaseFee(blockRes)
+	if err != nil {
+		// tolerate the error for pruned node.
+		b.logger.Error("fetch basefee failed, node is pruned?", "height", block.Height, "error", err)
+	}
+
+	blockHash := common.BytesToHash(block.Header.Hash())
+	receipts := make([]map[string]interface{}, 0, len(block.Txs))
+
+	// gasUsed is the gas used by the previous txs of the block
+	gasUsed := uint64(0)
+	// ethTxIndex follows the indexes of EthMsgsFromTendermintBlock
+	ethTxIndex := int32(0)
+
+	for i, txBz := range block.Txs {
+		txResult := blockRes.TxsResults[i]
+		if i > 0 {
+			// #nosec G115 always positive
+			gasUsed += uint64(blockRes.TxsResults[i-1].GasUsed)
+		}
+
+		// the same txs as in EthMsgsFromTendermintBlock are included
+		if !rpctypes.TxSuccessOrExceedsBlockGasLimit(txResult) {
+			continue
+		}
+
+		tx, err := b.clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			b.logger.Debug("failed to decode transaction in block", "height", block.Height, "error", err.Error())
+		}
+
+		var ethMsgs []*evmtypes.MsgEthereumTx
+		if err == nil {
+			for _, msg := range tx.GetMsgs() {
+				if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+					ethMsgs = append(ethMsgs, ethMsg)
+				}
+			}
+		}
+
+		// synthetic tx
+		if len(ethMsgs) == 0 {
+			ethMsg, additional, res := b.parseSyntheticTxFromBlockResults(blockRes.TxsResults, i, tx, block)
+			if ethMsg == nil {
+				continue
+			}
+			res.EthTxIndex = ethTxIndex
+			ethTxIndex++
+
+			// #nosec G115 always in range
+			logs, err := TxLogsFromEvents(txResult.Events, int(res.MsgIndex))
+			if err != nil {
+				b.logger.Debug("failed to parse logs", "hash", ethMsg.Hash, "error", err.Error())
+			}
+
+			receipt, err := b.formatTxReceipt(
+				additional.Hash,
+				ethMsg,
+				nil,
+				additional,
+				res,
+				gasUsed+res.CumulativeGasUsed,
+				logs,
+				blockHash,
+				chainID.ToInt(),
+				baseFee,
+			)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+			continue
+		}
+
+		parsedTxs, err := rpctypes.ParseTxResult(txResult, tx)
+		if err != nil {
+			b.logger.Debug("failed to parse tx events", "height", block.Height, "index", i, "error", err.Error())
+			// #nosec G115 always in range
+			ethTxIndex += int32(len(ethMsgs))
+			continue
+		}
+
+		for _, ethMsg := range ethMsgs {
+			hash := ethMsg.AsTransaction().Hash()
+			txIndex := ethTxIndex
+			ethTxIndex++
+
+			parsedTx := parsedTxs.GetTxByHash(hash)
+			if parsedTx == nil {
+				b.logger.Debug("tx not found in block results", "hash", hash.Hex(), "height", block.Height)
+				continue
+			}
+
+			txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+			if err != nil {
+				b.logger.Error("failed to unpack tx data", "error", err.Error())
+				return nil, err
+			}
+
+			logs, err := TxLogsFromEvents(txResult.Events, parsedTx.MsgIndex)
+			if err != nil {
+				b.logger.Debug("failed to parse logs", "hash", hash.Hex(), "error", err.Error())
+			}
+
+			res := &ethermint.TxResult{
+				Height: block.Height,
+				// #nosec G115 always in range
+				TxIndex: uint32(i),
+				// #nosec G115 always in range
+				MsgIndex:   uint32(parsedTx.MsgIndex),
+				EthTxIndex: txIndex,
+				Failed:     parsedTx.Failed,
+				GasUsed:    parsedTx.GasUsed,
+			}
+			receipt, err := b.formatTxReceipt(
+				hash,
+				ethMsg,
+				txData,
+				nil,
+				res,
+				gasUsed+parsedTxs.AccumulativeGasUsed(parsedTx.MsgIndex),
+				logs,
+				blockHash,
+				chainID.ToInt(),
+				baseFee,
+			)
+			if err != nil {
+				return nil, err
+			}
+			receipts = append(receipts, receipt)
+		}
+	}
+
+	return receipts, nil
+}
+
+// formatTxReceipt returns the receipt of the ethereum tx of the block.
+// The tx data is nil for synthetic txs, whose fields are taken from the additional fields.
+func (b *Backend) formatTxReceipt(
+	hash common.Hash,
+	ethMsg *evmtypes.MsgEthereumTx,
+	txData evmtypes.TxData,
+	additional *rpctypes.TxResultAdditionalFields,
+	res *ethermint.TxResult,
+	cumulativeGasUsed uint64,
+	logs []*ethtypes.Log,
+	blockHash common.Hash,
+	chainID *big.Int,
+	baseFee *big.Int,
+) (map[string]interface{}, error) {
+	var status hexutil.Uint
+	if res.Failed {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	} else {
+		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	}
+
+	var from common.Address
+	if additional != nil {
+		from = common.HexToAddress(ethMsg.From)
+	} else if ethMsg.Data != nil {
+		var err error
+		from, err = ethMsg.GetSender(chainID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, errors.New("failed to parse receipt")
+	}
+
 	to := &common.Address{}
 	var txType uint8
 
@@ -304,7 +501,7 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),
 		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
 
@@ -325,14 +522,8 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 			receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 		}
 
-		if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-			baseFee, err := b.BaseFee(blockRes)
-			if err != nil {
-				// tolerate the error for pruned node.
-				b.logger.Error("fetch basefee failed, node is pruned?", "height", res.Height, "error", err)
-			} else {
-				receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
-			}
+		if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok && baseFee != nil {
+			receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.EffectiveGasPrice(baseFee))
 		}
 	}
 	return receipt, nil
@@ -781,3 +781,76 @@ func (suite *BackendTestSuite) TestGetTransactionReceipt() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestGetBlockReceipts() {
+	msgEthereumTx, txBz := suite.buildEthereumTx()
+	txHash := msgEthereumTx.AsTransaction().Hash()
+	from, err := msgEthereumTx.GetSender(suite.backend.chainID)
+	suite.Require().NoError(err)
+
+	syntheticHash := sample.Hash()
+	syntheticBz, syntheticRes := suite.buildSyntheticTxResult(syntheticHash.Hex())
+	syntheticRes.GasUsed = 21000
+
+	// failed tx that is not an ethereum tx
+	failedBz := []byte("failed")
+	failedRes := &abci.ResponseDeliverTx{Code: 5, GasUsed: 100}
+
+	ethRes := &abci.ResponseDeliverTx{
+		Code:    0,
+		GasUsed: 30000,
+		Events: []abci.Event{
+			{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+				{Key: "ethereumTxHash", Value: txHash.Hex()},
+				{Key: "txIndex", Value: "0"},
+				{Key: "amount", Value: "1000"},
+				{Key: "txGasUsed", Value: "30000"},
+				{Key: "txHash", Value: ""},
+				{Key: "recipient", Value: "0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7"},
+			}},
+		},
+	}
+
+	suite.Run("returns the receipts of the real and synthetic txs of the block", func() {
+		suite.SetupTest() // reset
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+		var header metadata.MD
+		RegisterParams(queryClient, &header, 1)
+		RegisterParamsWithoutHeader(queryClient, 1)
+		RegisterBaseFee(queryClient, sdk.NewInt(1))
+		resBlock, err := RegisterBlock(client, 1, []types.Tx{failedBz, txBz, syntheticBz})
+		suite.Require().NoError(err)
+		_, err = RegisterBlockResultsWithTxResults(client, 1, []*abci.ResponseDeliverTx{failedRes, ethRes, &syntheticRes})
+		suite.Require().NoError(err)
+
+		receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumber(1))
+		suite.Require().NoError(err)
+		suite.Require().Len(receipts, 2)
+		blockHash := common.BytesToHash(resBlock.Block.Header.Hash()).Hex()
+
+		suite.Require().Equal(txHash, receipts[0]["transactionHash"])
+		suite.Require().Equal(hexutil.Uint64(0), receipts[0]["transactionIndex"])
+		suite.Require().Equal(hexutil.Uint64(30000), receipts[0]["gasUsed"])
+		suite.Require().Equal(hexutil.Uint64(30100), receipts[0]["cumulativeGasUsed"])
+		suite.Require().Equal(from, receipts[0]["from"])
+		suite.Require().Equal(blockHash, receipts[0]["blockHash"])
+
+		suite.Require().Equal(syntheticHash, receipts[1]["transactionHash"])
+		suite.Require().Equal(hexutil.Uint64(1), receipts[1]["transactionIndex"])
+		suite.Require().Equal(hexutil.Uint64(21000), receipts[1]["gasUsed"])
+		suite.Require().Equal(hexutil.Uint64(51100), receipts[1]["cumulativeGasUsed"])
+		suite.Require().Equal(common.HexToAddress("0x735b14BB79463307AAcBED86DAf3322B1e6226aB"), receipts[1]["from"])
+		suite.Require().Equal(hexutil.Uint(88), receipts[1]["type"])
+	})
+
+	suite.Run("returns nil if the block is not found", func() {
+		suite.SetupTest() // reset
+		client := suite.backend.clientCtx.Client.(*mocks.Client)
+		RegisterBlockError(client, 1)
+
+		receipts, err := suite.backend.GetBlockReceipts(rpctypes.BlockNumber(1))
+		suite.Require().NoError(err)
+		suite.Require().Nil(receipts)
+	})
+}
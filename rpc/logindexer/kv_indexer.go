@@ -0,0 +1,277 @@
+package logindexer
+
+import (
+	"encoding/json"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+const (
+	KeyPrefixBlock   = 1
+	KeyPrefixAddress = 2
+	KeyPrefixTopic   = 3
+
+	// maxLogTopics is the maximum number of topics of a log
+	maxLogTopics = 4
+)
+
+// Indexer indexes the logs of the EVM transactions by address and topic,
+// so the blocks with logs matching a filter are found without walking all the block results.
+type Indexer interface {
+	// IndexBlock indexes the logs of the block
+	IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error
+	// FirstIndexedBlock returns the first indexed block, -1 if the index is empty
+	FirstIndexedBlock() (int64, error)
+	// LastIndexedBlock returns the last indexed block, -1 if the index is empty
+	LastIndexedBlock() (int64, error)
+	// CountIndexedBlocks returns the number of indexed blocks in [from, to]
+	CountIndexedBlocks(from, to int64) (int64, error)
+	// FilterBlocks returns the heights of the blocks in [from, to] that may contain logs
+	// matching the addresses and topics, in ascending order
+	FilterBlocks(from, to int64, addresses []common.Address, topics [][]common.Hash) ([]int64, error)
+}
+
+var _ Indexer = &KVIndexer{}
+
+// KVIndexer implements the log indexer on a KV store. Every indexed block is recorded with its number of logs,
+// and the blocks are indexed by the address and by the topic and position of the topic of their logs.
+type KVIndexer struct {
+	db     dbm.DB
+	logger log.Logger
+}
+
+// NewKVIndexer creates a new log indexer on the database
+func NewKVIndexer(db dbm.DB, logger log.Logger) *KVIndexer {
+	return &KVIndexer{db, logger}
+}
+
+// IndexBlock indexes the logs of the transactions of the block.
+// The block is recorded even without logs, to know the indexed range.
+func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	var count uint64
+	for txIndex, result := range txResults {
+		for _, event := range result.Events {
+			if event.Type != evmtypes.EventTypeTxLog {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key != evmtypes.AttributeKeyTxLog {
+					continue
+				}
+
+				var txLog evmtypes.Log
+				if err := json.Unmarshal([]byte(attr.Value), &txLog); err != nil {
+					kv.logger.Error("Fail to parse log", "err", err, "block", height, "txIndex", txIndex)
+					continue
+				}
+
+				if err := batch.Set(AddressKey(common.HexToAddress(txLog.Address), height), []byte{}); err != nil {
+					return errorsmod.Wrapf(err, "IndexBlock %d, set address key", height)
+				}
+				for position, topic := range txLog.Topics {
+					if err := batch.Set(TopicKey(position, common.HexToHash(topic), height), []byte{}); err != nil {
+						return errorsmod.Wrapf(err, "IndexBlock %d, set topic key", height)
+					}
+				}
+				count++
+			}
+		}
+	}
+
+	if err := batch.Set(BlockKey(height), sdk.Uint64ToBigEndian(count)); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, set block key", height)
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// FirstIndexedBlock returns the first indexed block, -1 if the index is empty
+func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
+	it, err := kv.db.Iterator([]byte{KeyPrefixBlock}, []byte{KeyPrefixBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "FirstIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseHeightFromKey(it.Key()), nil
+}
+
+// LastIndexedBlock returns the last indexed block, -1 if the index is empty
+func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
+	it, err := kv.db.ReverseIterator([]byte{KeyPrefixBlock}, []byte{KeyPrefixBlock + 1})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "LastIndexedBlock")
+	}
+	defer it.Close()
+	if !it.Valid() {
+		return -1, nil
+	}
+	return parseHeightFromKey(it.Key()), nil
+}
+
+// CountIndexedBlocks returns the number of indexed blocks in [from, to].
+// The index may have gaps, the range is fully indexed only if the count is the length of the range.
+func (kv *KVIndexer) CountIndexedBlocks(from, to int64) (int64, error) {
+	if from > to {
+		return 0, nil
+	}
+	it, err := kv.db.Iterator(BlockKey(from), BlockKey(to+1))
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "CountIndexedBlocks")
+	}
+	defer it.Close()
+
+	var count int64
+	for ; it.Valid(); it.Next() {
+		count++
+	}
+	return count, it.Error()
+}
+
+// FilterBlocks returns the heights of the blocks in [from, to] that may contain logs matching the filter.
+// The criteria follow eth_getLogs: any of the addresses, and any of the topics at each position.
+// The logs of the blocks still have to be filtered, a block matching the criteria with different logs is returned.
+func (kv *KVIndexer) FilterBlocks(
+	from, to int64,
+	addresses []common.Address,
+	topics [][]common.Hash,
+) ([]int64, error) {
+	if from > to || len(topics) > maxLogTopics {
+		return []int64{}, nil
+	}
+
+	// nil until a criterion is applied
+	var heights map[int64]bool
+	intersect := func(matches map[int64]bool) {
+		if heights == nil {
+			heights = matches
+			return
+		}
+		for height := range heights {
+			if !matches[height] {
+				delete(heights, height)
+			}
+		}
+	}
+
+	if len(addresses) > 0 {
+		matches := make(map[int64]bool)
+		for _, address := range addresses {
+			if err := kv.loadHeights(AddressPrefix(address), from, to, matches); err != nil {
+				return nil, errorsmod.Wrapf(err, "FilterBlocks, address %s", address.Hex())
+			}
+		}
+		intersect(matches)
+	}
+
+	for position, sub := range topics {
+		// empty rule set == wildcard
+		if len(sub) == 0 {
+			continue
+		}
+		matches := make(map[int64]bool)
+		for _, topic := range sub {
+			if err := kv.loadHeights(TopicPrefix(position, topic), from, to, matches); err != nil {
+				return nil, errorsmod.Wrapf(err, "FilterBlocks, topic %s", topic.Hex())
+			}
+		}
+		intersect(matches)
+	}
+
+	if heights == nil {
+		return kv.blocksWithLogs(from, to)
+	}
+
+	result := make([]int64, 0, len(heights))
+	for height := range heights {
+		result = append(result, height)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result, nil
+}
+
+// loadHeights adds the heights in [from, to] of the keys with the prefix to the matches
+func (kv *KVIndexer) loadHeights(prefix []byte, from, to int64, matches map[int64]bool) error {
+	it, err := kv.db.Iterator(heightKey(prefix, from), heightKey(prefix, to+1))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		matches[parseHeightFromKey(it.Key())] = true
+	}
+	return it.Error()
+}
+
+// blocksWithLogs returns the heights of the blocks in [from, to] with at least one log
+func (kv *KVIndexer) blocksWithLogs(from, to int64) ([]int64, error) {
+	it, err := kv.db.Iterator(BlockKey(from), BlockKey(to+1))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "FilterBlocks")
+	}
+	defer it.Close()
+
+	heights := []int64{}
+	for ; it.Valid(); it.Next() {
+		if sdk.BigEndianToUint64(it.Value()) > 0 {
+			heights = append(heights, parseHeightFromKey(it.Key()))
+		}
+	}
+	return heights, it.Error()
+}
+
+// BlockKey returns the key of an indexed block
+func BlockKey(height int64) []byte {
+	return heightKey([]byte{KeyPrefixBlock}, height)
+}
+
+// AddressPrefix returns the prefix of the keys of the blocks with logs of the address
+func AddressPrefix(address common.Address) []byte {
+	return append([]byte{KeyPrefixAddress}, address.Bytes()...)
+}
+
+// AddressKey returns the key of a block with logs of the address
+func AddressKey(address common.Address, height int64) []byte {
+	return heightKey(AddressPrefix(address), height)
+}
+
+// TopicPrefix returns the prefix of the keys of the blocks with logs of the topic at the position
+func TopicPrefix(position int, topic common.Hash) []byte {
+	// #nosec G115 the position of a topic is lower than 4
+	return append([]byte{KeyPrefixTopic, byte(position)}, topic.Bytes()...)
+}
+
+// TopicKey returns the key of a block with logs of the topic at the position
+func TopicKey(position int, topic common.Hash, height int64) []byte {
+	return heightKey(TopicPrefix(position, topic), height)
+}
+
+// heightKey returns the key of the height under the prefix, the height is big endian to iterate in order
+func heightKey(prefix []byte, height int64) []byte {
+	key := make([]byte, 0, len(prefix)+8)
+	// #nosec G115 heights are positive
+	return append(append(key, prefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// parseHeightFromKey returns the height at the end of a key
+func parseHeightFromKey(key []byte) int64 {
+	// #nosec G115 heights are positive
+	return int64(sdk.BigEndianToUint64(key[len(key)-8:]))
+}
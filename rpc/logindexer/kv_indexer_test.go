@@ -0,0 +1,116 @@
+package logindexer
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+// txLogsResult returns the result of a tx emitting logs of the address with the topics
+func txLogsResult(t *testing.T, address common.Address, topics ...[]common.Hash) *abci.ResponseDeliverTx {
+	event := abci.Event{Type: evmtypes.EventTypeTxLog}
+	for _, logTopics := range topics {
+		txLog := evmtypes.Log{Address: address.Hex()}
+		for _, topic := range logTopics {
+			txLog.Topics = append(txLog.Topics, topic.Hex())
+		}
+		bz, err := json.Marshal(txLog)
+		require.NoError(t, err)
+		event.Attributes = append(event.Attributes, abci.EventAttribute{
+			Key:   evmtypes.AttributeKeyTxLog,
+			Value: string(bz),
+		})
+	}
+	return &abci.ResponseDeliverTx{Events: []abci.Event{event}}
+}
+
+func TestKVIndexer(t *testing.T) {
+	alice := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	transfer := common.HexToHash("0x01")
+	approval := common.HexToHash("0x02")
+	from := common.HexToHash("0xa")
+	to := common.HexToHash("0xb")
+
+	idxer := NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger())
+
+	t.Run("should return -1 for an empty index", func(t *testing.T) {
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, -1, first)
+
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, -1, last)
+	})
+
+	blocks := map[int64][]*abci.ResponseDeliverTx{
+		10: {txLogsResult(t, alice, []common.Hash{transfer, from, to})},
+		11: {{}},
+		12: {{}, txLogsResult(t, bob, []common.Hash{approval, to, from}, []common.Hash{transfer, to, to})},
+		13: {txLogsResult(t, alice, nil)},
+	}
+	for height, txResults := range blocks {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, txResults))
+	}
+
+	t.Run("should return the indexed range", func(t *testing.T) {
+		first, err := idxer.FirstIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 10, first)
+
+		last, err := idxer.LastIndexedBlock()
+		require.NoError(t, err)
+		require.EqualValues(t, 13, last)
+	})
+
+	t.Run("should count the indexed blocks in range", func(t *testing.T) {
+		count, err := idxer.CountIndexedBlocks(11, 12)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, count)
+
+		count, err = idxer.CountIndexedBlocks(1, 100)
+		require.NoError(t, err)
+		require.EqualValues(t, 4, count)
+
+		count, err = idxer.CountIndexedBlocks(12, 11)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, count)
+	})
+
+	tests := []struct {
+		name      string
+		from      int64
+		to        int64
+		addresses []common.Address
+		topics    [][]common.Hash
+		expected  []int64
+	}{
+		{"blocks with logs", 1, 100, nil, nil, []int64{10, 12, 13}},
+		{"blocks with logs in range", 11, 12, nil, nil, []int64{12}},
+		{"address", 1, 100, []common.Address{alice}, nil, []int64{10, 13}},
+		{"any of the addresses", 1, 100, []common.Address{alice, bob}, nil, []int64{10, 12, 13}},
+		{"first topic", 1, 100, nil, [][]common.Hash{{transfer}}, []int64{10, 12}},
+		{"topic at position", 1, 100, nil, [][]common.Hash{{}, {from}}, []int64{10}},
+		{"any of the topics", 1, 100, nil, [][]common.Hash{{}, {}, {from, to}}, []int64{10, 12}},
+		{"address and topic", 1, 100, []common.Address{bob}, [][]common.Hash{{transfer}}, []int64{12}},
+		{"no match", 1, 100, []common.Address{alice}, [][]common.Hash{{approval}}, []int64{}},
+		{"more topics than a log", 1, 100, nil, make([][]common.Hash, 5), []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run("should filter blocks by "+tt.name, func(t *testing.T) {
+			heights, err := idxer.FilterBlocks(tt.from, tt.to, tt.addresses, tt.topics)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, heights)
+		})
+	}
+}
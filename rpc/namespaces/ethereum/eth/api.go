@@ -58,7 +58,7 @@ type EthereumAPI interface {
 		blockNum rpctypes.BlockNumber,
 		idx hexutil.Uint,
 	) (*rpctypes.RPCTransaction, error)
-	// eth_getBlockReceipts
+	GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error)
 
 	// Writing Transactions
 	//
@@ -90,6 +90,11 @@ type EthereumAPI interface {
 		blockNrOrHash rpctypes.BlockNumberOrHash,
 		_ *rpctypes.StateOverride,
 	) (hexutil.Bytes, error)
+	SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]*rpctypes.SimBlockResult, error)
+	CreateAccessList(
+		args evmtypes.TransactionArgs,
+		blockNrOrHash *rpctypes.BlockNumberOrHash,
+	) (*rpctypes.AccessListResult, error)
 
 	// Chain Information
 	//
@@ -216,6 +221,16 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 	return e.backend.GetTransactionReceipt(hash)
 }
 
+// GetBlockReceipts returns the receipts of all the transactions in the block identified by number or hash.
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+	blockNum, err := e.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	return e.backend.GetBlockReceipts(blockNum)
+}
+
 // GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
 func (e *PublicAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
 	e.logger.Debug("eth_getBlockTransactionCountByHash", "hash", hash.Hex())
@@ -320,6 +335,44 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs,
 	return (hexutil.Bytes)(data.Ret), nil
 }
 
+// SimulateV1 simulates blocks of calls on top of the given block, latest if not set,
+// with optional state and block overrides.
+func (e *PublicAPI) SimulateV1(
+	opts rpctypes.SimOpts,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) ([]*rpctypes.SimBlockResult, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.SimulateV1(opts, blockNum)
+}
+
+// CreateAccessList returns the access list of the call on top of the given block, latest if not set,
+// and the gas used by the call with this access list.
+func (e *PublicAPI) CreateAccessList(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash *rpctypes.BlockNumberOrHash,
+) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.backend.BlockNumberFromTendermint(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.CreateAccessList(args, blockNum)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 ///                           Event Logs													          ///
 ///////////////////////////////////////////////////////////////////////////////
@@ -32,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 
+	"github.com/zeta-chain/node/rpc/logindexer"
 	"github.com/zeta-chain/node/rpc/types"
 )
 
@@ -84,9 +85,11 @@ type PublicFilterAPI struct {
 	logger    log.Logger
 	clientCtx client.Context
 	backend   Backend
-	events    *EventSystem
-	filtersMu sync.Mutex
-	filters   map[rpc.ID]*filter
+	// logIndexer is nil if the log indexer is disabled
+	logIndexer logindexer.Indexer
+	events     *EventSystem
+	filtersMu  sync.Mutex
+	filters    map[rpc.ID]*filter
 }
 
 // NewPublicAPI returns a new PublicFilterAPI instance.
@@ -95,14 +98,16 @@ func NewPublicAPI(
 	clientCtx client.Context,
 	tmWSClient *rpcclient.WSClient,
 	backend Backend,
+	logIndexer logindexer.Indexer,
 ) *PublicFilterAPI {
 	logger = logger.With("api", "filter")
 	api := &PublicFilterAPI{
-		logger:    logger,
-		clientCtx: clientCtx,
-		backend:   backend,
-		filters:   make(map[rpc.ID]*filter),
-		events:    NewEventSystem(logger, tmWSClient),
+		logger:     logger,
+		clientCtx:  clientCtx,
+		backend:    backend,
+		logIndexer: logIndexer,
+		filters:    make(map[rpc.ID]*filter),
+		events:     NewEventSystem(logger, tmWSClient),
 	}
 
 	go api.timeoutLoop()
@@ -570,7 +575,7 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 			end = crit.ToBlock.Int64()
 		}
 		// Construct the range filter
-		filter = NewRangeFilter(api.logger, api.backend, begin, end, crit.Addresses, crit.Topics)
+		filter = NewRangeFilter(api.logger, api.backend, api.logIndexer, begin, end, crit.Addresses, crit.Topics)
 	}
 
 	// Run the filter and return all the logs
@@ -632,7 +637,7 @@ func (api *PublicFilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*et
 			end = f.crit.ToBlock.Int64()
 		}
 		// Construct the range filter
-		filter = NewRangeFilter(api.logger, api.backend, begin, end, f.crit.Addresses, f.crit.Topics)
+		filter = NewRangeFilter(api.logger, api.backend, api.logIndexer, begin, end, f.crit.Addresses, f.crit.Topics)
 	}
 	// Run the filter and return all the logs
 	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
@@ -30,6 +30,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/zeta-chain/node/rpc/backend"
+	"github.com/zeta-chain/node/rpc/logindexer"
 	"github.com/zeta-chain/node/rpc/types"
 )
 
@@ -45,6 +46,7 @@ type Filter struct {
 	logger   log.Logger
 	backend  Backend
 	criteria filters.FilterCriteria
+	indexer  logindexer.Indexer // nil if the log indexer is disabled
 
 	bloomFilters [][]BloomIV // Filter the system is matching for
 }
@@ -58,9 +60,11 @@ func NewBlockFilter(logger log.Logger, backend Backend, criteria filters.FilterC
 
 // NewRangeFilter creates a new filter which uses a bloom filter on blocks to
 // figure out whether a particular block is interesting or not.
+// The blocks covered by the log indexer, if not nil, are selected with it instead.
 func NewRangeFilter(
 	logger log.Logger,
 	backend Backend,
+	indexer logindexer.Indexer,
 	begin, end int64,
 	addresses []common.Address,
 	topics [][]common.Hash,
@@ -93,7 +97,9 @@ func NewRangeFilter(
 		Topics:    topics,
 	}
 
-	return newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
+	filter := newFilter(logger, backend, criteria, createBloomFilters(filtersBz, logger))
+	filter.indexer = indexer
+	return filter
 }
 
 // newFilter returns a new Filter
@@ -166,7 +172,12 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(1)
 	}
 
-	if f.criteria.ToBlock.Int64()-f.criteria.FromBlock.Int64() > blockLimit {
+	// the blocks covered by the log indexer are not walked, they don't count in the block range limit
+	heights, walkFrom, err := f.indexedBlocks(f.criteria.FromBlock.Int64(), f.criteria.ToBlock.Int64())
+	if err != nil {
+		return nil, err
+	}
+	if f.criteria.ToBlock.Int64()-walkFrom > blockLimit {
 		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
 	}
 
@@ -177,10 +188,12 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 		f.criteria.ToBlock = big.NewInt(head + maxToOverhang)
 	}
 
-	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
+	for height := walkFrom; height <= to; height++ {
+		heights = append(heights, height)
+	}
 
-	for height := from; height <= to; height++ {
+	for _, height := range heights {
 		blockRes, err := f.backend.TendermintBlockResultByNumber(&height)
 		if err != nil {
 			f.logger.Debug("failed to fetch block result from Tendermint", "height", height, "error", err.Error())
@@ -206,6 +219,46 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// indexedBlocks returns the blocks of the range that may contain matching logs according to the log indexer,
+// and the start of the rest of the range, not covered by the indexer.
+// The indexer is only used if the range starts in the indexed blocks and the indexed part of the range has no gap,
+// to not miss the blocks the indexer skipped.
+func (f *Filter) indexedBlocks(from, to int64) ([]int64, int64, error) {
+	if f.indexer == nil {
+		return nil, from, nil
+	}
+	first, err := f.indexer.FirstIndexedBlock()
+	if err != nil {
+		return nil, 0, err
+	}
+	last, err := f.indexer.LastIndexedBlock()
+	if err != nil {
+		return nil, 0, err
+	}
+	if first == -1 || from < first || from > last {
+		return nil, from, nil
+	}
+
+	end := to
+	if end > last {
+		end = last
+	}
+	count, err := f.indexer.CountIndexedBlocks(from, end)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count the blocks of the log indexer")
+	}
+	if count != end-from+1 {
+		f.logger.Debug("log indexer has gaps in the range, walking the blocks", "from", from, "to", end, "indexed", count)
+		return nil, from, nil
+	}
+
+	heights, err := f.indexer.FilterBlocks(from, end, f.criteria.Addresses, f.criteria.Topics)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to filter blocks with the log indexer")
+	}
+	return heights, end + 1, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(blockRes *tmrpctypes.ResultBlockResults, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -0,0 +1,449 @@
+package ots
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	"github.com/zeta-chain/node/rpc/addressindexer"
+	"github.com/zeta-chain/node/rpc/backend"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+// errAddressIndexerDisabled is returned by the searches of the transactions of an address without the address indexer
+var errAddressIndexerDisabled = errors.New("the address indexer is disabled, enable json-rpc.enable-address-indexer")
+
+// API is the Otterscan API, used by the Otterscan block explorer on top of the standard eth namespace.
+// The traces are converted from the output of the call tracer of the EVM module,
+// and the transactions of an address are searched in the address indexer.
+type API struct {
+	ctx            *server.Context
+	logger         log.Logger
+	backend        backend.EVMBackend
+	addressIndexer addressindexer.Indexer // nil if the address indexer is disabled
+}
+
+// NewAPI creates a new API definition for the Otterscan methods.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+	addressIndexer addressindexer.Indexer,
+) *API {
+	return &API{
+		ctx:            ctx,
+		logger:         ctx.Logger.With("module", "ots"),
+		backend:        backend,
+		addressIndexer: addressIndexer,
+	}
+}
+
+// GetApiLevel returns the level of the Otterscan API implemented by the node
+func (a *API) GetApiLevel() uint64 { //nolint
+	a.logger.Debug("ots_getApiLevel")
+	return apiLevel
+}
+
+// HasCode returns true if the address has code at the block
+func (a *API) HasCode(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (bool, error) {
+	a.logger.Debug("ots_hasCode", "address", address, "block number or hash", blockNrOrHash)
+	code, err := a.backend.GetCode(address, blockNrOrHash)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
+
+// GetInternalOperations returns the value transfers, contract creations and self-destructs
+// made by the contracts called by the transaction
+func (a *API) GetInternalOperations(hash common.Hash) ([]*InternalOperation, error) {
+	a.logger.Debug("ots_getInternalOperations", "hash", hash)
+	frame, err := a.traceTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	return internalOperations(frame), nil
+}
+
+// TraceTransaction returns the calls of the transaction with their depth
+func (a *API) TraceTransaction(hash common.Hash) ([]*TraceEntry, error) {
+	a.logger.Debug("ots_traceTransaction", "hash", hash)
+	frame, err := a.traceTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	return traceEntries(frame), nil
+}
+
+// GetTransactionError returns the revert output of the transaction, empty if it succeeded
+func (a *API) GetTransactionError(hash common.Hash) (hexutil.Bytes, error) {
+	a.logger.Debug("ots_getTransactionError", "hash", hash)
+	frame, err := a.traceTransaction(hash)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Error == "" {
+		return hexutil.Bytes{}, nil
+	}
+	return frame.Output, nil
+}
+
+// GetBlockDetails returns the block without its transactions, with the sum of the fees of its transactions
+func (a *API) GetBlockDetails(blockNum rpctypes.BlockNumber) (*BlockDetails, error) {
+	a.logger.Debug("ots_getBlockDetails", "height", blockNum)
+	block, err := a.backend.GetBlockByNumber(blockNum, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return a.blockDetails(block)
+}
+
+// GetBlockDetailsByHash returns the block without its transactions, with the sum of the fees of its transactions
+func (a *API) GetBlockDetailsByHash(hash common.Hash) (*BlockDetails, error) {
+	a.logger.Debug("ots_getBlockDetailsByHash", "hash", hash)
+	block, err := a.backend.GetBlockByHash(hash, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return a.blockDetails(block)
+}
+
+// GetBlockTransactions returns a page of the transactions of the block with their receipts.
+// The inputs of the transactions are truncated to the method selector and the logs are omitted.
+func (a *API) GetBlockTransactions(
+	blockNum rpctypes.BlockNumber,
+	pageNumber uint64,
+	pageSize uint64,
+) (*BlockTransactions, error) {
+	a.logger.Debug("ots_getBlockTransactions", "height", blockNum, "page", pageNumber, "size", pageSize)
+	block, err := a.backend.GetBlockByNumber(blockNum, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	txs, receipts, err := a.blockTransactions(block)
+	if err != nil {
+		return nil, err
+	}
+
+	start := min(pageNumber*pageSize, uint64(len(txs)))
+	end := min(start+pageSize, uint64(len(txs)))
+	pageTxs := make([]interface{}, 0, end-start)
+	for _, tx := range txs[start:end] {
+		if len(tx.Input) > 4 {
+			truncated := *tx
+			truncated.Input = tx.Input[:4]
+			tx = &truncated
+		}
+		pageTxs = append(pageTxs, tx)
+	}
+	pageReceipts := make([]map[string]interface{}, 0, end-start)
+	for _, receipt := range receipts[start:end] {
+		receipt["logs"] = nil
+		receipt["logsBloom"] = nil
+		pageReceipts = append(pageReceipts, receipt)
+	}
+
+	fullBlock := copyBlock(block)
+	fullBlock["transactionCount"] = len(txs)
+	fullBlock["transactions"] = pageTxs
+	return &BlockTransactions{FullBlock: fullBlock, Receipts: pageReceipts}, nil
+}
+
+// SearchTransactionsBefore returns the transactions of the address in the blocks before the block number,
+// from the latest block if 0. The page has at least pageSize transactions if available,
+// all the transactions of its oldest block are included.
+func (a *API) SearchTransactionsBefore(
+	address common.Address,
+	blockNum uint64,
+	pageSize uint16,
+) (*TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsBefore", "address", address, "height", blockNum, "size", pageSize)
+	if a.addressIndexer == nil {
+		return nil, errAddressIndexerDisabled
+	}
+
+	height := int64(math.MaxInt64)
+	if blockNum > 0 && blockNum < math.MaxInt64 {
+		// #nosec G115 checked above
+		height = int64(blockNum)
+	}
+	txs, hasMore, err := a.addressIndexer.TransactionsBefore(address, height, int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := a.transactionsWithReceipts(txs)
+	if err != nil {
+		return nil, err
+	}
+	result.FirstPage = blockNum == 0
+	result.LastPage = !hasMore
+	return result, nil
+}
+
+// SearchTransactionsAfter returns the transactions of the address in the blocks after the block number,
+// from the first block if 0. The page has at least pageSize transactions if available,
+// all the transactions of its latest block are included. The transactions are in descending order.
+func (a *API) SearchTransactionsAfter(
+	address common.Address,
+	blockNum uint64,
+	pageSize uint16,
+) (*TransactionsWithReceipts, error) {
+	a.logger.Debug("ots_searchTransactionsAfter", "address", address, "height", blockNum, "size", pageSize)
+	if a.addressIndexer == nil {
+		return nil, errAddressIndexerDisabled
+	}
+	if blockNum >= math.MaxInt64 {
+		return &TransactionsWithReceipts{
+			Txs:       []*rpctypes.RPCTransaction{},
+			Receipts:  []map[string]interface{}{},
+			FirstPage: true,
+		}, nil
+	}
+
+	// #nosec G115 checked above
+	txs, hasMore, err := a.addressIndexer.TransactionsAfter(address, int64(blockNum), int(pageSize))
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(txs)-1; i < j; i, j = i+1, j-1 {
+		txs[i], txs[j] = txs[j], txs[i]
+	}
+
+	result, err := a.transactionsWithReceipts(txs)
+	if err != nil {
+		return nil, err
+	}
+	result.FirstPage = !hasMore
+	result.LastPage = blockNum == 0
+	return result, nil
+}
+
+// GetContractCreator returns the transaction and the address that created the contract, nil if the address
+// is not a contract or was not created by a transaction, like the contracts of the genesis.
+// The block of the creation is found with a binary search of the code of the address, it requires the state
+// of the blocks since the creation.
+func (a *API) GetContractCreator(address common.Address) (*ContractCreator, error) {
+	a.logger.Debug("ots_getContractCreator", "address", address)
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G115 block heights are in range
+	lo, hi := int64(1), int64(latest)
+	hasCode, err := a.hasCodeAt(address, hi)
+	if err != nil || !hasCode {
+		return nil, err
+	}
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		hasCode, err := a.hasCodeAt(address, mid)
+		if err != nil {
+			return nil, err
+		}
+		if hasCode {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	msgs, frames, err := a.traceBlock(rpctypes.BlockNumber(hi))
+	if err != nil {
+		return nil, err
+	}
+	for i, frame := range frames {
+		if creation := findCreation(frame, address); creation != nil {
+			return &ContractCreator{Hash: common.HexToHash(msgs[i].Hash), Creator: creation.From}, nil
+		}
+	}
+	return nil, nil
+}
+
+// hasCodeAt returns true if the address has code at the height
+func (a *API) hasCodeAt(address common.Address, height int64) (bool, error) {
+	blockNum := rpctypes.BlockNumber(height)
+	return a.HasCode(address, rpctypes.BlockNumberOrHash{BlockNumber: &blockNum})
+}
+
+// traceTransaction traces the transaction with the call tracer
+func (a *API) traceTransaction(hash common.Hash) (*callFrame, error) {
+	result, err := a.backend.TraceTransaction(hash, callTracerConfig())
+	if err != nil {
+		return nil, err
+	}
+	return parseCallFrame(result)
+}
+
+// traceBlock traces the ethereum transactions of the block with the call tracer
+func (a *API) traceBlock(blockNum rpctypes.BlockNumber) ([]*evmtypes.MsgEthereumTx, []*callFrame, error) {
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil, fmt.Errorf("block %d not found", blockNum)
+	}
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+	msgs, _ := a.backend.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	if len(msgs) == 0 {
+		return nil, nil, nil
+	}
+
+	results, err := a.backend.TraceBlock(blockNum, callTracerConfig(), resBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, nil, fmt.Errorf("traced %d txs instead of %d in block %d", len(results), len(msgs), blockNum)
+	}
+
+	frames := make([]*callFrame, 0, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, nil, fmt.Errorf("failed to trace tx %s: %s", msgs[i].Hash, result.Error)
+		}
+		frame, err := parseCallFrame(result.Result)
+		if err != nil {
+			return nil, nil, err
+		}
+		frames = append(frames, frame)
+	}
+	return msgs, frames, nil
+}
+
+// blockDetails returns the details of the block with its full transactions
+func (a *API) blockDetails(block map[string]interface{}) (*BlockDetails, error) {
+	txs, receipts, err := a.blockTransactions(block)
+	if err != nil {
+		return nil, err
+	}
+
+	totalFees := new(big.Int)
+	for i, tx := range txs {
+		gasUsed, ok := receipts[i]["gasUsed"].(hexutil.Uint64)
+		if !ok || tx.GasPrice == nil {
+			continue
+		}
+		fee := new(big.Int).Mul(tx.GasPrice.ToInt(), new(big.Int).SetUint64(uint64(gasUsed)))
+		totalFees.Add(totalFees, fee)
+	}
+
+	details := copyBlock(block)
+	details["transactionCount"] = len(txs)
+	delete(details, "transactions")
+	details["logsBloom"] = nil
+	return &BlockDetails{
+		Block: details,
+		Issuance: Issuance{
+			BlockReward: new(hexutil.Big),
+			UncleReward: new(hexutil.Big),
+			Issuance:    new(hexutil.Big),
+		},
+		TotalFees: (*hexutil.Big)(totalFees),
+	}, nil
+}
+
+// blockTransactions returns the full transactions of the block with their receipts, in the same order
+func (a *API) blockTransactions(
+	block map[string]interface{},
+) ([]*rpctypes.RPCTransaction, []map[string]interface{}, error) {
+	number, ok := block["number"].(hexutil.Uint64)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid block number type: %T", block["number"])
+	}
+	// #nosec G115 block heights are in range
+	receipts, err := a.backend.GetBlockReceipts(rpctypes.BlockNumber(number))
+	if err != nil {
+		return nil, nil, err
+	}
+	receiptsByHash := make(map[common.Hash]map[string]interface{}, len(receipts))
+	for _, receipt := range receipts {
+		if hash, ok := receipt["transactionHash"].(common.Hash); ok {
+			receiptsByHash[hash] = receipt
+		}
+	}
+
+	blockTxs, _ := block["transactions"].([]interface{})
+	txs := make([]*rpctypes.RPCTransaction, 0, len(blockTxs))
+	txReceipts := make([]map[string]interface{}, 0, len(blockTxs))
+	for _, blockTx := range blockTxs {
+		tx, ok := blockTx.(*rpctypes.RPCTransaction)
+		if !ok {
+			continue
+		}
+		receipt, ok := receiptsByHash[tx.Hash]
+		if !ok {
+			return nil, nil, fmt.Errorf("receipt of tx %s not found", tx.Hash)
+		}
+		txs = append(txs, tx)
+		txReceipts = append(txReceipts, receipt)
+	}
+	return txs, txReceipts, nil
+}
+
+// transactionsWithReceipts returns the indexed transactions with their receipts, including the block timestamp
+func (a *API) transactionsWithReceipts(indexed []addressindexer.Transaction) (*TransactionsWithReceipts, error) {
+	result := &TransactionsWithReceipts{
+		Txs:      make([]*rpctypes.RPCTransaction, 0, len(indexed)),
+		Receipts: make([]map[string]interface{}, 0, len(indexed)),
+	}
+
+	timestamps := make(map[int64]hexutil.Uint64)
+	for _, itx := range indexed {
+		tx, err := a.backend.GetTransactionByHash(itx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := a.backend.GetTransactionReceipt(itx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if tx == nil || receipt == nil {
+			return nil, fmt.Errorf("indexed tx %s not found", itx.Hash)
+		}
+
+		timestamp, ok := timestamps[itx.Height]
+		if !ok {
+			resBlock, err := a.backend.TendermintBlockByNumber(rpctypes.BlockNumber(itx.Height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil {
+				return nil, fmt.Errorf("block %d not found", itx.Height)
+			}
+			// #nosec G115 block times are after the epoch
+			timestamp = hexutil.Uint64(resBlock.Block.Time.Unix())
+			timestamps[itx.Height] = timestamp
+		}
+		receipt["timestamp"] = timestamp
+
+		result.Txs = append(result.Txs, tx)
+		result.Receipts = append(result.Receipts, receipt)
+	}
+	return result, nil
+}
+
+// callTracerConfig returns the trace config of the call tracer
+func callTracerConfig() *evmtypes.TraceConfig {
+	return &evmtypes.TraceConfig{Tracer: "callTracer"}
+}
+
+// copyBlock returns a shallow copy of the block
+func copyBlock(block map[string]interface{}) map[string]interface{} {
+	cp := make(map[string]interface{}, len(block)+1)
+	for k, v := range block {
+		cp[k] = v
+	}
+	return cp
+}
@@ -0,0 +1,104 @@
+package ots
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// parseCallFrame parses the result of the call tracer
+func parseCallFrame(result interface{}) (*callFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// internalOperations returns the internal operations of the transaction of the call frame, in the order of execution.
+// The operations of the failed calls are reverted, they are not returned.
+func internalOperations(frame *callFrame) []*InternalOperation {
+	ops := []*InternalOperation{}
+	if frame.Error != "" {
+		return ops
+	}
+	return appendInternalOperations(ops, frame.Calls)
+}
+
+func appendInternalOperations(ops []*InternalOperation, calls []callFrame) []*InternalOperation {
+	for i := range calls {
+		call := &calls[i]
+		if call.Error != "" {
+			continue
+		}
+
+		var to common.Address
+		if call.To != nil {
+			to = *call.To
+		}
+		value := call.Value
+		if value == nil {
+			value = new(hexutil.Big)
+		}
+		switch vm.StringToOp(call.Type) {
+		case vm.CALL:
+			// only the calls with a value are transfers
+			if value.ToInt().Sign() > 0 {
+				ops = append(ops, &InternalOperation{Type: opTransfer, From: call.From, To: to, Value: value})
+			}
+		case vm.CREATE:
+			ops = append(ops, &InternalOperation{Type: opCreate, From: call.From, To: to, Value: value})
+		case vm.CREATE2:
+			ops = append(ops, &InternalOperation{Type: opCreate2, From: call.From, To: to, Value: value})
+		case vm.SELFDESTRUCT:
+			ops = append(ops, &InternalOperation{Type: opSelfDestruct, From: call.From, To: to, Value: value})
+		}
+		ops = appendInternalOperations(ops, call.Calls)
+	}
+	return ops
+}
+
+// traceEntries returns the calls of the call frame with their depth, in the order of execution
+func traceEntries(frame *callFrame) []*TraceEntry {
+	return appendTraceEntries(nil, frame, 0)
+}
+
+func appendTraceEntries(entries []*TraceEntry, frame *callFrame, depth int) []*TraceEntry {
+	var to common.Address
+	if frame.To != nil {
+		to = *frame.To
+	}
+	entries = append(entries, &TraceEntry{
+		Type:   frame.Type,
+		Depth:  depth,
+		From:   frame.From,
+		To:     to,
+		Value:  frame.Value,
+		Input:  frame.Input,
+		Output: frame.Output,
+	})
+	for i := range frame.Calls {
+		entries = appendTraceEntries(entries, &frame.Calls[i], depth+1)
+	}
+	return entries
+}
+
+// findCreation returns the call of the call frame creating the contract, nil if not found
+func findCreation(frame *callFrame, contract common.Address) *callFrame {
+	op := vm.StringToOp(frame.Type)
+	if (op == vm.CREATE || op == vm.CREATE2) && frame.Error == "" && frame.To != nil && *frame.To == contract {
+		return frame
+	}
+	for i := range frame.Calls {
+		if creation := findCreation(&frame.Calls[i], contract); creation != nil {
+			return creation
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package ots
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+// callTracerResult is the output of the call tracer for a call transferring value to an account,
+// creating a contract that self-destructs, and making a reverted call with a value
+const callTracerResult = `{
+	"type": "CALL",
+	"from": "0x1000000000000000000000000000000000000001",
+	"to": "0x2000000000000000000000000000000000000002",
+	"value": "0xa",
+	"gas": "0x5208",
+	"gasUsed": "0x5000",
+	"input": "0x1234",
+	"output": "0x01",
+	"calls": [
+		{
+			"type": "CALL",
+			"from": "0x2000000000000000000000000000000000000002",
+			"to": "0x5000000000000000000000000000000000000005",
+			"value": "0x2",
+			"gas": "0x100",
+			"gasUsed": "0x100",
+			"input": "0x"
+		},
+		{
+			"type": "CREATE2",
+			"from": "0x2000000000000000000000000000000000000002",
+			"to": "0x3000000000000000000000000000000000000003",
+			"value": "0x0",
+			"gas": "0x1000",
+			"gasUsed": "0x800",
+			"input": "0x6000",
+			"output": "0x00",
+			"calls": [
+				{
+					"type": "SELFDESTRUCT",
+					"from": "0x3000000000000000000000000000000000000003",
+					"to": "0x1000000000000000000000000000000000000001",
+					"value": "0x5",
+					"gas": "0x0",
+					"gasUsed": "0x0",
+					"input": "0x"
+				}
+			]
+		},
+		{
+			"type": "STATICCALL",
+			"from": "0x2000000000000000000000000000000000000002",
+			"to": "0x4000000000000000000000000000000000000004",
+			"gas": "0x100",
+			"gasUsed": "0x100",
+			"input": "0x"
+		},
+		{
+			"type": "CALL",
+			"from": "0x2000000000000000000000000000000000000002",
+			"to": "0x4000000000000000000000000000000000000004",
+			"value": "0x3",
+			"gas": "0x100",
+			"gasUsed": "0x100",
+			"input": "0x",
+			"error": "execution reverted"
+		}
+	]
+}`
+
+// revertedResult is the output of the call tracer for a reverted transaction
+const revertedResult = `{
+	"type": "CALL",
+	"from": "0x1000000000000000000000000000000000000001",
+	"to": "0x2000000000000000000000000000000000000002",
+	"value": "0x0",
+	"gas": "0x5208",
+	"gasUsed": "0x5000",
+	"input": "0x",
+	"output": "0x08c379a0",
+	"error": "execution reverted",
+	"calls": [
+		{
+			"type": "CALL",
+			"from": "0x2000000000000000000000000000000000000002",
+			"to": "0x5000000000000000000000000000000000000005",
+			"value": "0x2",
+			"gas": "0x100",
+			"gasUsed": "0x100",
+			"input": "0x"
+		}
+	]
+}`
+
+func parseResult(t *testing.T, result string) *callFrame {
+	var res interface{}
+	require.NoError(t, json.Unmarshal([]byte(result), &res))
+	frame, err := parseCallFrame(res)
+	require.NoError(t, err)
+	return frame
+}
+
+func TestInternalOperations(t *testing.T) {
+	t.Run("should return the operations of the successful internal calls", func(t *testing.T) {
+		ops := internalOperations(parseResult(t, callTracerResult))
+		require.Equal(t, []*InternalOperation{
+			{
+				Type:  opTransfer,
+				From:  common.HexToAddress("0x2000000000000000000000000000000000000002"),
+				To:    common.HexToAddress("0x5000000000000000000000000000000000000005"),
+				Value: (*hexutil.Big)(hexutil.MustDecodeBig("0x2")),
+			},
+			{
+				Type:  opCreate2,
+				From:  common.HexToAddress("0x2000000000000000000000000000000000000002"),
+				To:    common.HexToAddress("0x3000000000000000000000000000000000000003"),
+				Value: (*hexutil.Big)(hexutil.MustDecodeBig("0x0")),
+			},
+			{
+				Type:  opSelfDestruct,
+				From:  common.HexToAddress("0x3000000000000000000000000000000000000003"),
+				To:    common.HexToAddress("0x1000000000000000000000000000000000000001"),
+				Value: (*hexutil.Big)(hexutil.MustDecodeBig("0x5")),
+			},
+		}, ops)
+	})
+
+	t.Run("should return no operations for a reverted transaction", func(t *testing.T) {
+		require.Empty(t, internalOperations(parseResult(t, revertedResult)))
+	})
+}
+
+func TestTraceEntries(t *testing.T) {
+	entries := traceEntries(parseResult(t, callTracerResult))
+	require.Len(t, entries, 6)
+
+	types := make([]string, 0, len(entries))
+	depths := make([]int, 0, len(entries))
+	for _, entry := range entries {
+		types = append(types, entry.Type)
+		depths = append(depths, entry.Depth)
+	}
+	require.Equal(t, []string{"CALL", "CALL", "CREATE2", "SELFDESTRUCT", "STATICCALL", "CALL"}, types)
+	require.Equal(t, []int{0, 1, 1, 2, 1, 1}, depths)
+
+	require.Equal(t, hexutil.Bytes{0x12, 0x34}, entries[0].Input)
+	require.Equal(t, hexutil.Bytes{0x01}, entries[0].Output)
+	require.Nil(t, entries[4].Value)
+}
+
+func TestFindCreation(t *testing.T) {
+	frame := parseResult(t, callTracerResult)
+
+	creation := findCreation(frame, common.HexToAddress("0x3000000000000000000000000000000000000003"))
+	require.NotNil(t, creation)
+	require.Equal(t, common.HexToAddress("0x2000000000000000000000000000000000000002"), creation.From)
+
+	require.Nil(t, findCreation(frame, common.HexToAddress("0x5000000000000000000000000000000000000005")))
+}
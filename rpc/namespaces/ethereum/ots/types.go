@@ -0,0 +1,87 @@
+package ots
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+// apiLevel is the level of the Otterscan API implemented by the namespace
+const apiLevel = 8
+
+// types of the internal operations
+const (
+	opTransfer     = 0
+	opSelfDestruct = 1
+	opCreate       = 2
+	opCreate2      = 3
+)
+
+// InternalOperation is a value transfer, contract creation or self-destruct made by a contract during a transaction
+type InternalOperation struct {
+	Type  int            `json:"type"`
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// TraceEntry is a call of a transaction with its depth in the call tree
+type TraceEntry struct {
+	Type   string         `json:"type"`
+	Depth  int            `json:"depth"`
+	From   common.Address `json:"from"`
+	To     common.Address `json:"to"`
+	Value  *hexutil.Big   `json:"value"`
+	Input  hexutil.Bytes  `json:"input"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// BlockDetails is a block without its transactions, with its number of transactions and the sum of their fees
+type BlockDetails struct {
+	Block     map[string]interface{} `json:"block"`
+	Issuance  Issuance               `json:"issuance"`
+	TotalFees *hexutil.Big           `json:"totalFees"`
+}
+
+// Issuance is the issuance of a block, there are no block rewards in the zEVM
+type Issuance struct {
+	BlockReward *hexutil.Big `json:"blockReward"`
+	UncleReward *hexutil.Big `json:"uncleReward"`
+	Issuance    *hexutil.Big `json:"issuance"`
+}
+
+// BlockTransactions is a page of the transactions of a block, with their receipts
+type BlockTransactions struct {
+	FullBlock map[string]interface{}   `json:"fullblock"`
+	Receipts  []map[string]interface{} `json:"receipts"`
+}
+
+// TransactionsWithReceipts is a page of the transactions of an address, with their receipts, from the latest.
+// The first page has the latest transactions, the last page the oldest ones.
+type TransactionsWithReceipts struct {
+	Txs       []*rpctypes.RPCTransaction `json:"txs"`
+	Receipts  []map[string]interface{}   `json:"receipts"`
+	FirstPage bool                       `json:"firstPage"`
+	LastPage  bool                       `json:"lastPage"`
+}
+
+// ContractCreator is the transaction and the address that created a contract
+type ContractCreator struct {
+	Hash    common.Hash    `json:"hash"`
+	Creator common.Address `json:"creator"`
+}
+
+// callFrame is the output of the call tracer
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+}
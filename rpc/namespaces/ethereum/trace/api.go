@@ -0,0 +1,257 @@
+package trace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	"github.com/zeta-chain/node/rpc/backend"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+// API is the collection of the trace APIs of OpenEthereum and Erigon.
+// The flat traces are converted from the output of the call tracer of the EVM module.
+type API struct {
+	ctx     *server.Context
+	logger  log.Logger
+	backend backend.EVMBackend
+}
+
+// NewAPI creates a new API definition for the trace methods of the Ethereum service.
+func NewAPI(
+	ctx *server.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:     ctx,
+		logger:  ctx.Logger.With("module", "trace"),
+		backend: backend,
+	}
+}
+
+// Block returns the flat traces of all the transactions of the block
+func (a *API) Block(blockNum rpctypes.BlockNumber) ([]*FlatTrace, error) {
+	a.logger.Debug("trace_block", "height", blockNum)
+	return a.blockTraces(blockNum)
+}
+
+// Transaction returns the flat traces of the transaction
+func (a *API) Transaction(hash common.Hash) ([]*FlatTrace, error) {
+	a.logger.Debug("trace_transaction", "hash", hash)
+	tx, err := a.backend.GetTransactionByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	// pending or unknown transaction
+	if tx == nil || tx.BlockHash == nil || tx.BlockNumber == nil || tx.TransactionIndex == nil {
+		return nil, nil
+	}
+
+	result, err := a.backend.TraceTransaction(hash, callTracerConfig())
+	if err != nil {
+		return nil, err
+	}
+	frame, err := parseCallFrame(result)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := flattenCallFrame(frame)
+	setTxInfo(traces, *tx.BlockHash, tx.BlockNumber.ToInt().Uint64(), hash, uint64(*tx.TransactionIndex))
+	return traces, nil
+}
+
+// Filter returns the flat traces of the block range matching the address filters.
+// The block range is bounded by the block range cap of the JSON-RPC.
+func (a *API) Filter(args FilterArgs) ([]*FlatTrace, error) {
+	a.logger.Debug("trace_filter", "from", args.FromBlock, "to", args.ToBlock)
+	if args.Mode != "" && args.Mode != filterModeUnion && args.Mode != filterModeIntersection {
+		return nil, fmt.Errorf("invalid filter mode %s", args.Mode)
+	}
+
+	latest, err := a.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	from := resolveBlockNumber(args.FromBlock, int64(latest))
+	to := resolveBlockNumber(args.ToBlock, int64(latest))
+	if from > to {
+		return nil, fmt.Errorf("invalid block range: from %d is after to %d", from, to)
+	}
+	if blockLimit := int64(a.backend.RPCBlockRangeCap()); to-from+1 > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+	// genesis is not traceable
+	if from == 0 {
+		from = 1
+	}
+
+	var after uint64
+	if args.After != nil {
+		after = *args.After
+	}
+
+	traces := []*FlatTrace{}
+	for height := from; height <= to; height++ {
+		blockTraces, err := a.blockTraces(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range blockTraces {
+			if !matchTrace(trace, args) {
+				continue
+			}
+			if after > 0 {
+				after--
+				continue
+			}
+			traces = append(traces, trace)
+			if args.Count != nil && uint64(len(traces)) >= *args.Count {
+				return traces, nil
+			}
+		}
+	}
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the block and returns their traces.
+// Only the trace type is supported.
+func (a *API) ReplayBlockTransactions(
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	traceTypes []string,
+) ([]*TraceResults, error) {
+	a.logger.Debug("trace_replayBlockTransactions", "block number or hash", blockNrOrHash, "types", traceTypes)
+	withTrace := false
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case traceTypeTrace:
+			withTrace = true
+		case traceTypeVMTrace, traceTypeStateDiff:
+			return nil, fmt.Errorf("trace type %s is not supported", traceType)
+		default:
+			return nil, fmt.Errorf("invalid trace type %s", traceType)
+		}
+	}
+
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	_, msgs, frames, err := a.traceBlock(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*TraceResults, 0, len(frames))
+	for i, frame := range frames {
+		result := &TraceResults{
+			Output:          frame.Output,
+			TransactionHash: common.HexToHash(msgs[i].Hash),
+		}
+		if withTrace {
+			result.Trace = flattenCallFrame(frame)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// blockTraces returns the flat traces of all the transactions of the block
+func (a *API) blockTraces(blockNum rpctypes.BlockNumber) ([]*FlatTrace, error) {
+	resBlock, msgs, frames, err := a.traceBlock(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	// #nosec G115 always positive
+	height := uint64(resBlock.Block.Height)
+
+	traces := []*FlatTrace{}
+	for i, frame := range frames {
+		txTraces := flattenCallFrame(frame)
+		setTxInfo(txTraces, blockHash, height, common.HexToHash(msgs[i].Hash), uint64(i))
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// traceBlock traces the ethereum transactions of the block with the call tracer
+func (a *API) traceBlock(
+	blockNum rpctypes.BlockNumber,
+) (*tmrpctypes.ResultBlock, []*evmtypes.MsgEthereumTx, []*callFrame, error) {
+	if blockNum == 0 {
+		return nil, nil, nil, errors.New("genesis is not traceable")
+	}
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNum)
+	if err != nil {
+		a.logger.Debug("get block failed", "height", blockNum, "error", err.Error())
+		return nil, nil, nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil, nil, fmt.Errorf("block %d not found", blockNum)
+	}
+
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("block result not found for height %d", resBlock.Block.Height)
+	}
+	msgs, _ := a.backend.EthMsgsFromTendermintBlock(resBlock, blockRes)
+	if len(msgs) == 0 {
+		return resBlock, nil, nil, nil
+	}
+
+	results, err := a.backend.TraceBlock(rpctypes.BlockNumber(resBlock.Block.Height), callTracerConfig(), resBlock)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(results) != len(msgs) {
+		return nil, nil, nil, fmt.Errorf(
+			"traced %d txs instead of %d in block %d",
+			len(results),
+			len(msgs),
+			resBlock.Block.Height,
+		)
+	}
+
+	frames := make([]*callFrame, 0, len(results))
+	for i, result := range results {
+		if result.Error != "" {
+			return nil, nil, nil, fmt.Errorf("failed to trace tx %s: %s", msgs[i].Hash, result.Error)
+		}
+		frame, err := parseCallFrame(result.Result)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		frames = append(frames, frame)
+	}
+	return resBlock, msgs, frames, nil
+}
+
+// callTracerConfig returns the trace config of the call tracer
+func callTracerConfig() *evmtypes.TraceConfig {
+	return &evmtypes.TraceConfig{Tracer: "callTracer"}
+}
+
+// setTxInfo sets the block and transaction of the traces
+func setTxInfo(traces []*FlatTrace, blockHash common.Hash, blockNumber uint64, txHash common.Hash, txIndex uint64) {
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &txIndex
+	}
+}
+
+// resolveBlockNumber returns the height of the block number, the latest height if not set or a tag
+func resolveBlockNumber(blockNum *rpctypes.BlockNumber, latest int64) int64 {
+	if blockNum == nil || *blockNum < 0 {
+		return latest
+	}
+	return blockNum.Int64()
+}
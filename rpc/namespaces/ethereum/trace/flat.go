@@ -0,0 +1,153 @@
+package trace
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// errReverted is the error of a reverted call in the flat trace format
+const errReverted = "Reverted"
+
+// parseCallFrame parses the result of the call tracer
+func parseCallFrame(result interface{}) (*callFrame, error) {
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	var frame callFrame
+	if err := json.Unmarshal(bz, &frame); err != nil {
+		return nil, err
+	}
+	return &frame, nil
+}
+
+// flattenCallFrame converts the call frame and its subcalls into flat traces, in the order of execution
+func flattenCallFrame(frame *callFrame) []*FlatTrace {
+	return appendFlatTraces(nil, frame, []int{})
+}
+
+func appendFlatTraces(traces []*FlatTrace, frame *callFrame, traceAddress []int) []*FlatTrace {
+	traces = append(traces, newFlatTrace(frame, traceAddress))
+	for i := range frame.Calls {
+		subAddress := make([]int, len(traceAddress), len(traceAddress)+1)
+		copy(subAddress, traceAddress)
+		traces = appendFlatTraces(traces, &frame.Calls[i], append(subAddress, i))
+	}
+	return traces
+}
+
+// newFlatTrace returns the flat trace of the call frame without its subcalls
+func newFlatTrace(frame *callFrame, traceAddress []int) *FlatTrace {
+	trace := &FlatTrace{
+		Subtraces:    len(frame.Calls),
+		TraceAddress: traceAddress,
+	}
+
+	var to common.Address
+	if frame.To != nil {
+		to = *frame.To
+	}
+	value := frame.Value
+	if value == nil {
+		value = new(hexutil.Big)
+	}
+
+	switch vm.StringToOp(frame.Type) {
+	case vm.CREATE, vm.CREATE2:
+		trace.Type = "create"
+		trace.Action = &CreateAction{
+			From:  frame.From,
+			Gas:   frame.Gas,
+			Init:  frame.Input,
+			Value: value,
+		}
+		trace.Result = &CreateResult{
+			Address: to,
+			Code:    frame.Output,
+			GasUsed: frame.GasUsed,
+		}
+	case vm.SELFDESTRUCT:
+		trace.Type = "suicide"
+		trace.Action = &SuicideAction{
+			Address:       frame.From,
+			Balance:       value,
+			RefundAddress: to,
+		}
+	default:
+		trace.Type = "call"
+		trace.Action = &CallAction{
+			CallType: strings.ToLower(frame.Type),
+			From:     frame.From,
+			Gas:      frame.Gas,
+			Input:    frame.Input,
+			To:       to,
+			Value:    value,
+		}
+		trace.Result = &CallResult{
+			GasUsed: frame.GasUsed,
+			Output:  frame.Output,
+		}
+	}
+
+	// the result of a failed call is empty
+	if frame.Error != "" {
+		trace.Error = frame.Error
+		if frame.Error == vm.ErrExecutionReverted.Error() {
+			trace.Error = errReverted
+		}
+		trace.Result = nil
+	}
+
+	return trace
+}
+
+// traceAddresses returns the sender and the recipient of the trace
+func traceAddresses(trace *FlatTrace) (from, to common.Address) {
+	switch action := trace.Action.(type) {
+	case *CallAction:
+		return action.From, action.To
+	case *CreateAction:
+		if result, ok := trace.Result.(*CreateResult); ok {
+			to = result.Address
+		}
+		return action.From, to
+	case *SuicideAction:
+		return action.Address, action.RefundAddress
+	}
+	return from, to
+}
+
+// matchTrace returns true if the trace matches the address filters of trace_filter.
+// In union mode, a trace matches if its sender or its recipient matches;
+// in intersection mode, both of them must match.
+func matchTrace(trace *FlatTrace, args FilterArgs) bool {
+	from, to := traceAddresses(trace)
+	fromMatch := containsAddress(args.FromAddress, from)
+	toMatch := containsAddress(args.ToAddress, to)
+
+	switch {
+	case len(args.FromAddress) == 0 && len(args.ToAddress) == 0:
+		return true
+	case len(args.FromAddress) == 0:
+		return toMatch
+	case len(args.ToAddress) == 0:
+		return fromMatch
+	case args.Mode == filterModeIntersection:
+		return fromMatch && toMatch
+	default:
+		return fromMatch || toMatch
+	}
+}
+
+func containsAddress(addresses []common.Address, address common.Address) bool {
+	for _, a := range addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package trace
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+// callTracerResult is the output of the call tracer for a call creating a contract
+// that self-destructs, followed by a reverted static call
+const callTracerResult = `{
+	"type": "CALL",
+	"from": "0x1000000000000000000000000000000000000001",
+	"to": "0x2000000000000000000000000000000000000002",
+	"value": "0xa",
+	"gas": "0x5208",
+	"gasUsed": "0x5000",
+	"input": "0x1234",
+	"output": "0x01",
+	"calls": [
+		{
+			"type": "CREATE2",
+			"from": "0x2000000000000000000000000000000000000002",
+			"to": "0x3000000000000000000000000000000000000003",
+			"value": "0x0",
+			"gas": "0x1000",
+			"gasUsed": "0x800",
+			"input": "0x6000",
+			"output": "0x00",
+			"calls": [
+				{
+					"type": "SELFDESTRUCT",
+					"from": "0x3000000000000000000000000000000000000003",
+					"to": "0x1000000000000000000000000000000000000001",
+					"value": "0x5",
+					"gas": "0x0",
+					"gasUsed": "0x0",
+					"input": "0x"
+				}
+			]
+		},
+		{
+			"type": "STATICCALL",
+			"from": "0x2000000000000000000000000000000000000002",
+			"to": "0x4000000000000000000000000000000000000004",
+			"gas": "0x100",
+			"gasUsed": "0x100",
+			"input": "0x",
+			"error": "execution reverted"
+		}
+	]
+}`
+
+func TestFlattenCallFrame(t *testing.T) {
+	var result interface{}
+	require.NoError(t, json.Unmarshal([]byte(callTracerResult), &result))
+
+	frame, err := parseCallFrame(result)
+	require.NoError(t, err)
+
+	traces := flattenCallFrame(frame)
+	require.Len(t, traces, 4)
+
+	// top-level call
+	require.Equal(t, "call", traces[0].Type)
+	require.Equal(t, []int{}, traces[0].TraceAddress)
+	require.Equal(t, 2, traces[0].Subtraces)
+	require.Equal(t, &CallAction{
+		CallType: "call",
+		From:     common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		Gas:      0x5208,
+		Input:    hexutil.MustDecode("0x1234"),
+		To:       common.HexToAddress("0x2000000000000000000000000000000000000002"),
+		Value:    (*hexutil.Big)(hexutil.MustDecodeBig("0xa")),
+	}, traces[0].Action)
+	require.Equal(t, &CallResult{GasUsed: 0x5000, Output: hexutil.MustDecode("0x01")}, traces[0].Result)
+
+	// contract creation
+	require.Equal(t, "create", traces[1].Type)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, 1, traces[1].Subtraces)
+	require.Equal(t, &CreateResult{
+		Address: common.HexToAddress("0x3000000000000000000000000000000000000003"),
+		Code:    hexutil.MustDecode("0x00"),
+		GasUsed: 0x800,
+	}, traces[1].Result)
+
+	// self-destruct of the created contract
+	require.Equal(t, "suicide", traces[2].Type)
+	require.Equal(t, []int{0, 0}, traces[2].TraceAddress)
+	require.Equal(t, &SuicideAction{
+		Address:       common.HexToAddress("0x3000000000000000000000000000000000000003"),
+		Balance:       (*hexutil.Big)(hexutil.MustDecodeBig("0x5")),
+		RefundAddress: common.HexToAddress("0x1000000000000000000000000000000000000001"),
+	}, traces[2].Action)
+	require.Nil(t, traces[2].Result)
+
+	// reverted static call
+	require.Equal(t, "call", traces[3].Type)
+	require.Equal(t, []int{1}, traces[3].TraceAddress)
+	require.Equal(t, "staticcall", traces[3].Action.(*CallAction).CallType)
+	require.Equal(t, errReverted, traces[3].Error)
+	require.Nil(t, traces[3].Result)
+}
+
+func TestMatchTrace(t *testing.T) {
+	alice := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	bob := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	carol := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	trace := &FlatTrace{Action: &CallAction{From: alice, To: bob}}
+
+	tests := []struct {
+		name  string
+		args  FilterArgs
+		match bool
+	}{
+		{"no filter", FilterArgs{}, true},
+		{"from address", FilterArgs{FromAddress: []common.Address{alice}}, true},
+		{"other from address", FilterArgs{FromAddress: []common.Address{carol}}, false},
+		{"to address", FilterArgs{ToAddress: []common.Address{carol, bob}}, true},
+		{"other to address", FilterArgs{ToAddress: []common.Address{alice}}, false},
+		{
+			"union of from and to addresses",
+			FilterArgs{FromAddress: []common.Address{alice}, ToAddress: []common.Address{carol}},
+			true,
+		},
+		{
+			"intersection of from and to addresses",
+			FilterArgs{
+				FromAddress: []common.Address{alice},
+				ToAddress:   []common.Address{carol},
+				Mode:        filterModeIntersection,
+			},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.match, matchTrace(trace, tt.args))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package trace
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+)
+
+const (
+	// filter modes of trace_filter
+	filterModeUnion        = "union"
+	filterModeIntersection = "intersection"
+
+	// trace types of trace_replayBlockTransactions
+	traceTypeTrace     = "trace"
+	traceTypeVMTrace   = "vmTrace"
+	traceTypeStateDiff = "stateDiff"
+)
+
+// FlatTrace is a call of a transaction in the flat trace format of OpenEthereum and Erigon
+type FlatTrace struct {
+	Action              interface{}  `json:"action"`
+	BlockHash           *common.Hash `json:"blockHash,omitempty"`
+	BlockNumber         *uint64      `json:"blockNumber,omitempty"`
+	Error               string       `json:"error,omitempty"`
+	Result              interface{}  `json:"result"`
+	Subtraces           int          `json:"subtraces"`
+	TraceAddress        []int        `json:"traceAddress"`
+	TransactionHash     *common.Hash `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64      `json:"transactionPosition,omitempty"`
+	Type                string       `json:"type"`
+}
+
+// CallAction is the action of a call trace
+type CallAction struct {
+	CallType string         `json:"callType"`
+	From     common.Address `json:"from"`
+	Gas      hexutil.Uint64 `json:"gas"`
+	Input    hexutil.Bytes  `json:"input"`
+	To       common.Address `json:"to"`
+	Value    *hexutil.Big   `json:"value"`
+}
+
+// CallResult is the result of a call trace
+type CallResult struct {
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+	Output  hexutil.Bytes  `json:"output"`
+}
+
+// CreateAction is the action of a create trace
+type CreateAction struct {
+	From  common.Address `json:"from"`
+	Gas   hexutil.Uint64 `json:"gas"`
+	Init  hexutil.Bytes  `json:"init"`
+	Value *hexutil.Big   `json:"value"`
+}
+
+// CreateResult is the result of a create trace
+type CreateResult struct {
+	Address common.Address `json:"address"`
+	Code    hexutil.Bytes  `json:"code"`
+	GasUsed hexutil.Uint64 `json:"gasUsed"`
+}
+
+// SuicideAction is the action of a selfdestruct trace
+type SuicideAction struct {
+	Address       common.Address `json:"address"`
+	Balance       *hexutil.Big   `json:"balance"`
+	RefundAddress common.Address `json:"refundAddress"`
+}
+
+// FilterArgs are the arguments of trace_filter
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	Mode        string                `json:"mode"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// TraceResults are the traces of a transaction replayed by trace_replayBlockTransactions.
+// Only the trace type is supported, the VM traces and state diffs are always empty.
+type TraceResults struct {
+	Output          hexutil.Bytes `json:"output"`
+	StateDiff       interface{}   `json:"stateDiff"`
+	Trace           []*FlatTrace  `json:"trace"`
+	VMTrace         interface{}   `json:"vmTrace"`
+	TransactionHash common.Hash   `json:"transactionHash"`
+}
+
+// callFrame is the output of the call tracer
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     hexutil.Uint64  `json:"gas"`
+	GasUsed hexutil.Uint64  `json:"gasUsed"`
+	Input   hexutil.Bytes   `json:"input"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Calls   []callFrame     `json:"calls,omitempty"`
+}
@@ -0,0 +1,254 @@
+package zeta
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/rpc/backend"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// cctxCreatedEvents are the events emitted when a CCTX is created
+var cctxCreatedEvents = map[string]bool{
+	proto.MessageName(&crosschaintypes.EventInboundFinalized{}):    true,
+	proto.MessageName(&crosschaintypes.EventZrcWithdrawCreated{}):  true,
+	proto.MessageName(&crosschaintypes.EventZetaWithdrawCreated{}): true,
+}
+
+const (
+	// crosschainEventPrefix is the prefix of the types of the crosschain events
+	crosschainEventPrefix = "zetachain.zetacore.crosschain."
+
+	// attributeKeyCctxIndex is the attribute of the crosschain events holding the CCTX index
+	attributeKeyCctxIndex = "cctx_index"
+)
+
+// PendingCctxsResult is the result of zeta_getPendingCctxs
+type PendingCctxsResult struct {
+	Cctxs        []json.RawMessage `json:"cctxs"`
+	TotalPending uint64            `json:"totalPending"`
+}
+
+// API exposes the cross-chain data of ZetaChain on the EVM JSON-RPC server,
+// so EVM tooling can relate zEVM transactions to CCTXs without a gRPC connection.
+// The CCTXs are returned in the JSON format of the gRPC gateway.
+type API struct {
+	ctx         *server.Context
+	logger      log.Logger
+	backend     backend.EVMBackend
+	queryClient crosschaintypes.QueryClient
+}
+
+// NewAPI creates a new API definition for the zeta methods
+func NewAPI(
+	ctx *server.Context,
+	clientCtx client.Context,
+	backend backend.EVMBackend,
+) *API {
+	return &API{
+		ctx:         ctx,
+		logger:      ctx.Logger.With("module", "zeta"),
+		backend:     backend,
+		queryClient: crosschaintypes.NewQueryClient(clientCtx),
+	}
+}
+
+// GetCctxByZevmTxHash returns the CCTXs created by the zEVM transaction, from a withdrawal or a gateway call.
+// For a synthetic deposit transaction, the CCTXs created by the call of the deposit are returned.
+func (a *API) GetCctxByZevmTxHash(hash common.Hash) ([]json.RawMessage, error) {
+	a.logger.Debug("zeta_getCctxByZevmTxHash", "hash", hash)
+	cctxs, err := a.cctxsByInboundHash(hash.Hex())
+	if err != nil || len(cctxs) > 0 {
+		return marshalCctxs(cctxs, err)
+	}
+
+	// the CCTXs created by a deposit are indexed by the index of the deposit CCTX
+	inbound, err := a.inboundForSyntheticTx(hash)
+	if err != nil || inbound == nil {
+		return nil, err
+	}
+	return marshalCctxs(a.cctxsByInboundHash(inbound.Index))
+}
+
+// GetCctxsByBlock returns the CCTXs created in the block, in the order of their creation
+func (a *API) GetCctxsByBlock(blockNrOrHash rpctypes.BlockNumberOrHash) ([]json.RawMessage, error) {
+	a.logger.Debug("zeta_getCctxsByBlock", "block number or hash", blockNrOrHash)
+	blockNum, err := a.backend.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	resBlock, err := a.backend.TendermintBlockByNumber(blockNum)
+	if err != nil || resBlock == nil || resBlock.Block == nil {
+		return nil, err
+	}
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&resBlock.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []abci.Event
+	events = append(events, blockRes.BeginBlockEvents...)
+	for _, txResult := range blockRes.TxsResults {
+		events = append(events, txResult.Events...)
+	}
+	events = append(events, blockRes.EndBlockEvents...)
+
+	indexes := cctxIndexesFromEvents(events, func(eventType string) bool {
+		return cctxCreatedEvents[eventType]
+	})
+	cctxs := make([]*crosschaintypes.CrossChainTx, 0, len(indexes))
+	for _, index := range indexes {
+		cctx, err := a.cctx(index)
+		if err != nil {
+			return nil, err
+		}
+		cctxs = append(cctxs, cctx)
+	}
+	return marshalCctxs(cctxs, nil)
+}
+
+// GetInboundForSyntheticTx returns the CCTX whose execution on zEVM produced the synthetic transaction,
+// a deposit or a revert. It returns null if the transaction isn't synthetic.
+func (a *API) GetInboundForSyntheticTx(hash common.Hash) (json.RawMessage, error) {
+	a.logger.Debug("zeta_getInboundForSyntheticTx", "hash", hash)
+	cctx, err := a.inboundForSyntheticTx(hash)
+	if err != nil || cctx == nil {
+		return nil, err
+	}
+	return codec.ProtoMarshalJSON(cctx, nil)
+}
+
+// GetPendingCctxs returns the pending CCTXs of the chain, up to the limit, and the total number of them
+func (a *API) GetPendingCctxs(chainID int64, limit uint32) (*PendingCctxsResult, error) {
+	a.logger.Debug("zeta_getPendingCctxs", "chain", chainID, "limit", limit)
+	res, err := a.queryClient.ListPendingCctx(context.Background(), &crosschaintypes.QueryListPendingCctxRequest{
+		ChainId: chainID,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cctxs, err := marshalCctxs(res.CrossChainTx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PendingCctxsResult{Cctxs: cctxs, TotalPending: res.TotalPending}, nil
+}
+
+// inboundForSyntheticTx returns the CCTX with an outbound executed by the zEVM transaction, nil if not found
+func (a *API) inboundForSyntheticTx(hash common.Hash) (*crosschaintypes.CrossChainTx, error) {
+	res, _, err := a.backend.GetTxByEthHash(hash)
+	if err != nil {
+		a.logger.Debug("tx not found", "hash", hash, "error", err.Error())
+		return nil, nil
+	}
+	blockRes, err := a.backend.TendermintBlockResultByNumber(&res.Height)
+	if err != nil {
+		return nil, err
+	}
+	// #nosec G115 always in range
+	if int(res.TxIndex) >= len(blockRes.TxsResults) {
+		return nil, nil
+	}
+
+	// the synthetic transaction is executed by the vote finalizing the CCTX
+	indexes := cctxIndexesFromEvents(blockRes.TxsResults[res.TxIndex].Events, func(eventType string) bool {
+		return strings.HasPrefix(eventType, crosschainEventPrefix)
+	})
+	for _, index := range indexes {
+		cctx, err := a.cctx(index)
+		if err != nil {
+			return nil, err
+		}
+		for _, outbound := range cctx.OutboundParams {
+			if strings.EqualFold(outbound.Hash, hash.Hex()) {
+				return cctx, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// cctxsByInboundHash returns the CCTXs of the inbound hash, none if not found
+func (a *API) cctxsByInboundHash(hash string) ([]*crosschaintypes.CrossChainTx, error) {
+	res, err := a.queryClient.InboundHashToCctxData(
+		context.Background(),
+		&crosschaintypes.QueryInboundHashToCctxDataRequest{InboundHash: hash},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cctxs := make([]*crosschaintypes.CrossChainTx, 0, len(res.CrossChainTxs))
+	for i := range res.CrossChainTxs {
+		cctxs = append(cctxs, &res.CrossChainTxs[i])
+	}
+	return cctxs, nil
+}
+
+// cctx returns the CCTX of the index
+func (a *API) cctx(index string) (*crosschaintypes.CrossChainTx, error) {
+	res, err := a.queryClient.Cctx(context.Background(), &crosschaintypes.QueryGetCctxRequest{Index: index})
+	if err != nil {
+		return nil, err
+	}
+	return res.CrossChainTx, nil
+}
+
+// cctxIndexesFromEvents returns the unique CCTX indexes of the selected crosschain events, in order
+func cctxIndexesFromEvents(events []abci.Event, selected func(eventType string) bool) []string {
+	var indexes []string
+	seen := make(map[string]bool)
+	for _, event := range events {
+		if !selected(event.Type) {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key != attributeKeyCctxIndex {
+				continue
+			}
+			// the attributes of typed events are JSON values
+			var index string
+			if err := json.Unmarshal([]byte(attr.Value), &index); err != nil {
+				index = attr.Value
+			}
+			if index != "" && !seen[index] {
+				seen[index] = true
+				indexes = append(indexes, index)
+			}
+		}
+	}
+	return indexes
+}
+
+// marshalCctxs returns the CCTXs in the JSON format of the gRPC gateway
+func marshalCctxs(cctxs []*crosschaintypes.CrossChainTx, err error) ([]json.RawMessage, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := make([]json.RawMessage, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		bz, err := codec.ProtoMarshalJSON(cctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, bz)
+	}
+	return result, nil
+}
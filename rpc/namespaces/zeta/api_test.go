@@ -0,0 +1,180 @@
+package zeta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/rpc/backend"
+	rpctypes "github.com/zeta-chain/node/rpc/types"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// fakeBackend returns the results of the txs of a single block
+type fakeBackend struct {
+	backend.EVMBackend
+	txs      map[common.Hash]*ethermint.TxResult
+	blockRes *tmrpctypes.ResultBlockResults
+}
+
+func (b fakeBackend) GetTxByEthHash(
+	hash common.Hash,
+) (*ethermint.TxResult, *rpctypes.TxResultAdditionalFields, error) {
+	res, ok := b.txs[hash]
+	if !ok {
+		return nil, nil, errors.New("not found")
+	}
+	return res, nil, nil
+}
+
+func (b fakeBackend) TendermintBlockResultByNumber(_ *int64) (*tmrpctypes.ResultBlockResults, error) {
+	return b.blockRes, nil
+}
+
+// fakeQueryClient returns the CCTXs from memory
+type fakeQueryClient struct {
+	crosschaintypes.QueryClient
+	cctxs     map[string]*crosschaintypes.CrossChainTx
+	byInbound map[string][]string
+}
+
+func (c fakeQueryClient) Cctx(
+	_ context.Context,
+	req *crosschaintypes.QueryGetCctxRequest,
+	_ ...grpc.CallOption,
+) (*crosschaintypes.QueryGetCctxResponse, error) {
+	cctx, ok := c.cctxs[req.Index]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &crosschaintypes.QueryGetCctxResponse{CrossChainTx: cctx}, nil
+}
+
+func (c fakeQueryClient) InboundHashToCctxData(
+	_ context.Context,
+	req *crosschaintypes.QueryInboundHashToCctxDataRequest,
+	_ ...grpc.CallOption,
+) (*crosschaintypes.QueryInboundHashToCctxDataResponse, error) {
+	indexes, ok := c.byInbound[req.InboundHash]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	res := &crosschaintypes.QueryInboundHashToCctxDataResponse{}
+	for _, index := range indexes {
+		res.CrossChainTxs = append(res.CrossChainTxs, *c.cctxs[index])
+	}
+	return res, nil
+}
+
+func cctxIndexEvent(eventType, index string) abci.Event {
+	return abci.Event{
+		Type:       eventType,
+		Attributes: []abci.EventAttribute{{Key: attributeKeyCctxIndex, Value: `"` + index + `"`}},
+	}
+}
+
+func TestAPI(t *testing.T) {
+	withdrawTx := sample.Hash()
+	depositTx := sample.Hash()
+
+	// a deposit executed by a synthetic tx, calling a contract that withdraws
+	deposit := sample.CrossChainTx(t, "deposit")
+	deposit.GetCurrentOutboundParam().Hash = depositTx.Hex()
+	withdraw := sample.CrossChainTx(t, "withdraw")
+	withdraw.InboundParams.ObservedHash = deposit.Index
+	// a withdrawal from a zEVM tx
+	other := sample.CrossChainTx(t, "other")
+	other.InboundParams.ObservedHash = withdrawTx.Hex()
+
+	api := &API{
+		logger: log.NewNopLogger(),
+		backend: fakeBackend{
+			txs: map[common.Hash]*ethermint.TxResult{depositTx: {Height: 10, TxIndex: 1}},
+			blockRes: &tmrpctypes.ResultBlockResults{
+				TxsResults: []*abci.ResponseDeliverTx{
+					{},
+					{Events: []abci.Event{
+						cctxIndexEvent("zetachain.zetacore.observer.EventBallotCreated", "ignored"),
+						cctxIndexEvent("zetachain.zetacore.crosschain.EventInboundFinalized", deposit.Index),
+						cctxIndexEvent("zetachain.zetacore.crosschain.EventZrcWithdrawCreated", withdraw.Index),
+					}},
+				},
+			},
+		},
+		queryClient: fakeQueryClient{
+			cctxs: map[string]*crosschaintypes.CrossChainTx{
+				deposit.Index:  deposit,
+				withdraw.Index: withdraw,
+				other.Index:    other,
+			},
+			byInbound: map[string][]string{
+				withdrawTx.Hex(): {other.Index},
+				deposit.Index:    {withdraw.Index},
+			},
+		},
+	}
+
+	requireCctxs := func(t *testing.T, expected []*crosschaintypes.CrossChainTx, actual []json.RawMessage) {
+		require.Len(t, actual, len(expected))
+		for i := range expected {
+			bz, err := codec.ProtoMarshalJSON(expected[i], nil)
+			require.NoError(t, err)
+			require.JSONEq(t, string(bz), string(actual[i]))
+		}
+	}
+
+	t.Run("should return the CCTXs created by a zEVM tx", func(t *testing.T) {
+		cctxs, err := api.GetCctxByZevmTxHash(withdrawTx)
+		require.NoError(t, err)
+		requireCctxs(t, []*crosschaintypes.CrossChainTx{other}, cctxs)
+	})
+
+	t.Run("should return the CCTXs created by the call of a deposit", func(t *testing.T) {
+		cctxs, err := api.GetCctxByZevmTxHash(depositTx)
+		require.NoError(t, err)
+		requireCctxs(t, []*crosschaintypes.CrossChainTx{withdraw}, cctxs)
+	})
+
+	t.Run("should return no CCTX for an unknown tx", func(t *testing.T) {
+		cctxs, err := api.GetCctxByZevmTxHash(sample.Hash())
+		require.NoError(t, err)
+		require.Empty(t, cctxs)
+	})
+
+	t.Run("should return the inbound of a synthetic tx", func(t *testing.T) {
+		inbound, err := api.GetInboundForSyntheticTx(depositTx)
+		require.NoError(t, err)
+		requireCctxs(t, []*crosschaintypes.CrossChainTx{deposit}, []json.RawMessage{inbound})
+
+		inbound, err = api.GetInboundForSyntheticTx(withdrawTx)
+		require.NoError(t, err)
+		require.Nil(t, inbound)
+	})
+}
+
+func TestCctxIndexesFromEvents(t *testing.T) {
+	events := []abci.Event{
+		cctxIndexEvent("zetachain.zetacore.crosschain.EventInboundFinalized", "0x1"),
+		cctxIndexEvent("zetachain.zetacore.crosschain.EventOutboundSuccess", "0x2"),
+		cctxIndexEvent("zetachain.zetacore.crosschain.EventZetaWithdrawCreated", "0x3"),
+		cctxIndexEvent("zetachain.zetacore.crosschain.EventZrcWithdrawCreated", "0x1"),
+	}
+
+	indexes := cctxIndexesFromEvents(events, func(eventType string) bool {
+		return cctxCreatedEvents[eventType]
+	})
+	require.Equal(t, []string{"0x1", "0x3"}, indexes)
+}
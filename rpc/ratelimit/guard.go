@@ -0,0 +1,232 @@
+package ratelimit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// maxRequestContentLength is the maximum size of a request read by the HTTP handler, as in the JSON-RPC server
+	maxRequestContentLength = 1024 * 1024 * 5
+
+	errCodeInvalidRequest = -32600
+	errCodeMethodNotFound = -32601
+	errCodeLimitExceeded  = -32005
+)
+
+var (
+	rejectedBatchCounter     = metrics.NewRegisteredCounter("rpc/rejected/batch", nil)
+	rejectedCostCounter      = metrics.NewRegisteredCounter("rpc/rejected/cost", nil)
+	rejectedMethodCounter    = metrics.NewRegisteredCounter("rpc/rejected/method", nil)
+	rejectedRateLimitCounter = metrics.NewRegisteredCounter("rpc/rejected/ratelimit", nil)
+)
+
+// Guard checks the JSON-RPC requests of a listener against its method allow and deny lists,
+// the batch size limit and the rate limits of the clients
+type Guard struct {
+	limiter    *Limiter
+	allowed    []string
+	denied     []string
+	batchLimit int
+}
+
+// NewGuard creates a new guard of a listener.
+// All the methods are allowed if the allow list is empty, the deny list takes precedence.
+// The batch size is not limited if the limit is 0.
+func NewGuard(limiter *Limiter, allowed, denied []string, batchLimit int) *Guard {
+	return &Guard{
+		limiter:    limiter,
+		allowed:    allowed,
+		denied:     denied,
+		batchLimit: batchLimit,
+	}
+}
+
+// Rejection is the rejection of a JSON-RPC request, all its calls are rejected with the same error
+type Rejection struct {
+	calls   []call
+	batch   bool
+	code    int
+	message string
+}
+
+// call is the part of a JSON-RPC call used by the guard
+type call struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+type errorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   errorMessage    `json:"error"`
+}
+
+type errorMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Response returns the JSON-RPC error response of the request
+func (r *Rejection) Response() json.RawMessage {
+	responses := make([]errorResponse, 0, len(r.calls))
+	for _, c := range r.calls {
+		id := c.ID
+		if len(id) == 0 {
+			id = json.RawMessage("null")
+		}
+		responses = append(responses, errorResponse{
+			Version: "2.0",
+			ID:      id,
+			Error:   errorMessage{Code: r.code, Message: r.message},
+		})
+	}
+
+	var res interface{} = responses
+	if !r.batch {
+		res = responses[0]
+	}
+	// #nosec G104 the responses can always be marshalled
+	bz, _ := json.Marshal(res)
+	return bz
+}
+
+// StatusCode returns the HTTP status of the rejection
+func (r *Rejection) StatusCode() int {
+	if r.code == errCodeLimitExceeded {
+		return http.StatusTooManyRequests
+	}
+	return http.StatusOK
+}
+
+// Enabled returns true if the guard checks the requests
+func (g *Guard) Enabled() bool {
+	return len(g.allowed) > 0 || len(g.denied) > 0 || g.batchLimit > 0 || g.limiter.Enabled()
+}
+
+// Check checks the JSON-RPC request of the client, it returns nil if the request is accepted.
+// Invalid requests are accepted, to be answered by the JSON-RPC server.
+func (g *Guard) Check(client Client, body []byte) *Rejection {
+	calls, batch, ok := parseCalls(body)
+	if !ok || len(calls) == 0 {
+		return nil
+	}
+	rejection := &Rejection{calls: calls, batch: batch}
+
+	if batch && g.batchLimit > 0 && len(calls) > g.batchLimit {
+		rejectedBatchCounter.Inc(int64(len(calls)))
+		rejection.code = errCodeInvalidRequest
+		rejection.message = fmt.Sprintf("batch too large, limit is %d calls", g.batchLimit)
+		return rejection
+	}
+
+	methods := make([]string, 0, len(calls))
+	for _, c := range calls {
+		if !g.allowedMethod(c.Method) {
+			rejectedMethodCounter.Inc(int64(len(calls)))
+			rejection.code = errCodeMethodNotFound
+			rejection.message = fmt.Sprintf("the method %s does not exist/is not available", c.Method)
+			return rejection
+		}
+		methods = append(methods, c.Method)
+	}
+
+	err := g.limiter.Allow(client, methods)
+	var costErr CostExceedsBurstError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &costErr):
+		// the request would never be allowed, it must not be retried like a rate limited one
+		rejectedCostCounter.Inc(int64(len(calls)))
+		rejection.code = errCodeInvalidRequest
+		rejection.message = err.Error()
+	default:
+		rejectedRateLimitCounter.Inc(int64(len(calls)))
+		rejection.code = errCodeLimitExceeded
+		rejection.message = err.Error()
+	}
+	return rejection
+}
+
+// Client returns the client of the request
+func (g *Guard) Client(r *http.Request) Client {
+	return g.limiter.Client(r)
+}
+
+// MarkInternal marks the request as already checked by the guard of another listener
+func (g *Guard) MarkInternal(r *http.Request) {
+	g.limiter.MarkInternal(r)
+}
+
+// Handler returns the HTTP handler checking the requests before serving them with the next handler
+func (g *Guard) Handler(next http.Handler) http.Handler {
+	if !g.Enabled() {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if g.limiter.isInternal(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		if rejection := g.Check(g.Client(r), body); rejection != nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(rejection.StatusCode())
+			// #nosec G104 the client is gone if the response can't be written
+			_, _ = w.Write(rejection.Response())
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allowedMethod returns true if the method is in the allow list, or the allow list is empty, and not in the deny list
+func (g *Guard) allowedMethod(method string) bool {
+	for _, pattern := range g.denied {
+		if matchMethod(pattern, method) {
+			return false
+		}
+	}
+	if len(g.allowed) == 0 {
+		return true
+	}
+	for _, pattern := range g.allowed {
+		if matchMethod(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseCalls parses the calls of a single or batch JSON-RPC request
+func parseCalls(body []byte) ([]call, bool, bool) {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var calls []call
+		if err := json.Unmarshal(body, &calls); err != nil {
+			return nil, true, false
+		}
+		return calls, true, true
+	}
+
+	var c call
+	if err := json.Unmarshal(body, &c); err != nil {
+		return nil, false, false
+	}
+	return []call{c}, false, true
+}
@@ -0,0 +1,215 @@
+package ratelimit
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(Config{
+		RequestsPerSecond: 0.001,
+		Burst:             10,
+		APIKeys:           map[string]float64{"secret": 1000},
+		MethodCosts:       map[string]int{"eth_*": 2, "eth_getLogs": 5, "debug_*": 10},
+	})
+
+	t.Run("should return the cost of the most specific method", func(t *testing.T) {
+		require.Equal(t, 5, limiter.Cost("eth_getLogs"))
+		require.Equal(t, 2, limiter.Cost("eth_call"))
+		require.Equal(t, 10, limiter.Cost("debug_traceTransaction"))
+		require.Equal(t, defaultMethodCost, limiter.Cost("net_version"))
+	})
+
+	t.Run("should identify the clients by API key or IP", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		require.Equal(t, Client{ID: "ip:10.0.0.1", RequestsPerSecond: 0.001}, limiter.Client(r))
+
+		r.Header.Set(APIKeyHeader, "unknown")
+		require.Equal(t, Client{ID: "ip:10.0.0.1", RequestsPerSecond: 0.001}, limiter.Client(r))
+
+		r.Header.Set(APIKeyHeader, "secret")
+		require.Equal(t, Client{ID: "key:secret", RequestsPerSecond: 1000}, limiter.Client(r))
+	})
+
+	t.Run("should limit the cost of the calls of each client", func(t *testing.T) {
+		alice := Client{ID: "ip:alice", RequestsPerSecond: 0.001}
+		bob := Client{ID: "ip:bob", RequestsPerSecond: 0.001}
+
+		require.NoError(t, limiter.Allow(alice, []string{"eth_getLogs", "eth_call"}))
+		require.ErrorIs(t, limiter.Allow(alice, []string{"eth_getLogs"}), ErrRateLimitExceeded)
+		require.NoError(t, limiter.Allow(alice, []string{"net_version", "eth_call"}))
+		require.ErrorIs(t, limiter.Allow(alice, []string{"net_version"}), ErrRateLimitExceeded)
+
+		require.NoError(t, limiter.Allow(bob, []string{"debug_traceCall"}))
+	})
+
+	t.Run("should reject the calls costing more than the burst", func(t *testing.T) {
+		carol := Client{ID: "ip:carol", RequestsPerSecond: 0.001}
+
+		err := limiter.Allow(carol, []string{"debug_traceCall", "net_version"})
+		require.ErrorAs(t, err, &CostExceedsBurstError{})
+		require.NotErrorIs(t, err, ErrRateLimitExceeded)
+
+		// the bucket is unchanged
+		require.NoError(t, limiter.Allow(carol, []string{"debug_traceCall"}))
+	})
+}
+
+func TestLimiterClientBehindProxy(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	limiter := NewLimiter(Config{RequestsPerSecond: 1, Burst: 1, TrustedProxies: []*net.IPNet{proxies}})
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		realIP       string
+		expected     string
+	}{
+		{
+			name:         "ignore the headers of an untrusted peer",
+			remoteAddr:   "1.2.3.4:1234",
+			forwardedFor: []string{"5.6.7.8"},
+			realIP:       "5.6.7.8",
+			expected:     "ip:1.2.3.4",
+		},
+		{
+			name:         "use the last IP forwarded by the trusted proxies",
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"9.9.9.9, 5.6.7.8", "10.0.0.2"},
+			expected:     "ip:5.6.7.8",
+		},
+		{
+			name:         "stop at an invalid forwarded IP",
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"5.6.7.8, unknown, 10.0.0.2"},
+			expected:     "ip:10.0.0.2",
+		},
+		{
+			name:       "use the real IP without forwarded IPs",
+			remoteAddr: "10.0.0.1:1234",
+			realIP:     "5.6.7.8",
+			expected:   "ip:5.6.7.8",
+		},
+		{
+			name:       "use the proxy IP without headers",
+			remoteAddr: "10.0.0.1:1234",
+			expected:   "ip:10.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("should "+tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, header := range tt.forwardedFor {
+				r.Header.Add("X-Forwarded-For", header)
+			}
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-IP", tt.realIP)
+			}
+			require.Equal(t, tt.expected, limiter.Client(r).ID)
+		})
+	}
+}
+
+func TestGuard(t *testing.T) {
+	limiter := NewLimiter(Config{RequestsPerSecond: 0.001, Burst: 3, MethodCosts: map[string]int{"eth_getLogs": 5}})
+	guard := NewGuard(limiter, []string{"eth_*", "net_version"}, []string{"eth_sign"}, 2)
+	client := Client{ID: "ip:alice", RequestsPerSecond: 0.001}
+
+	tests := []struct {
+		name     string
+		body     string
+		response string
+	}{
+		{
+			name: "invalid request",
+			body: `{"method":`,
+		},
+		{
+			name:     "batch too large",
+			body:     `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"eth_chainId"},{"id":3,"method":"eth_chainId"}]`,
+			response: `[{"jsonrpc":"2.0","id":1,"error":{"code":-32600,"message":"batch too large, limit is 2 calls"}},{"jsonrpc":"2.0","id":2,"error":{"code":-32600,"message":"batch too large, limit is 2 calls"}},{"jsonrpc":"2.0","id":3,"error":{"code":-32600,"message":"batch too large, limit is 2 calls"}}]`,
+		},
+		{
+			name:     "method not allowed",
+			body:     `{"id":"a","method":"debug_traceCall"}`,
+			response: `{"jsonrpc":"2.0","id":"a","error":{"code":-32601,"message":"the method debug_traceCall does not exist/is not available"}}`,
+		},
+		{
+			name:     "method denied",
+			body:     `{"method":"eth_sign"}`,
+			response: `{"jsonrpc":"2.0","id":null,"error":{"code":-32601,"message":"the method eth_sign does not exist/is not available"}}`,
+		},
+		{
+			name: "allowed batch",
+			body: `[{"id":1,"method":"eth_chainId"},{"id":2,"method":"net_version"}]`,
+		},
+		{
+			name: "allowed call",
+			body: `{"id":3,"method":"eth_blockNumber"}`,
+		},
+		{
+			name:     "cost exceeding the burst",
+			body:     `{"id":4,"method":"eth_getLogs"}`,
+			response: `{"jsonrpc":"2.0","id":4,"error":{"code":-32600,"message":"request cost 5 exceeds the rate limit burst 3"}}`,
+		},
+		{
+			name:     "rate limit exceeded",
+			body:     `{"id":5,"method":"eth_blockNumber"}`,
+			response: `{"jsonrpc":"2.0","id":5,"error":{"code":-32005,"message":"rate limit exceeded"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("should check "+tt.name, func(t *testing.T) {
+			rejection := guard.Check(client, []byte(tt.body))
+			if tt.response == "" {
+				require.Nil(t, rejection)
+				return
+			}
+			require.NotNil(t, rejection)
+			require.JSONEq(t, tt.response, string(rejection.Response()))
+		})
+	}
+}
+
+func TestGuardHandler(t *testing.T) {
+	limiter := NewLimiter(Config{RequestsPerSecond: 0.001, Burst: 1, MethodCosts: map[string]int{"eth_getLogs": 2}})
+	guard := NewGuard(limiter, nil, []string{"debug_*"}, 0)
+	handler := guard.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+
+	serve := func(body string, internal bool) int {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		if internal {
+			guard.MarkInternal(r)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve(`{"id":1,"method":"debug_traceCall"}`, false))
+	require.Equal(t, http.StatusOK, serve(`{"id":1,"method":"eth_getLogs"}`, false))
+	require.Equal(t, http.StatusAccepted, serve(`{"id":1,"method":"eth_chainId"}`, false))
+	require.Equal(t, http.StatusTooManyRequests, serve(`{"id":1,"method":"eth_chainId"}`, false))
+
+	// the internal calls were checked by the WebSocket server
+	require.Equal(t, http.StatusAccepted, serve(`{"id":1,"method":"debug_traceCall"}`, true))
+
+	t.Run("should not wrap the handler if disabled", func(t *testing.T) {
+		next := http.NewServeMux()
+		disabled := NewGuard(NewLimiter(Config{}), nil, nil, 0)
+		require.Same(t, next, disabled.Handler(next))
+	})
+}
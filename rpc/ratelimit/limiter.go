@@ -0,0 +1,261 @@
+package ratelimit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// APIKeyHeader is the HTTP header of the API key of a client
+	APIKeyHeader = "X-Api-Key"
+
+	// forwardedForHeader is the HTTP header of the IPs of the client and the proxies, set by the proxies
+	forwardedForHeader = "X-Forwarded-For"
+
+	// realIPHeader is the HTTP header of the IP of the client, set by a proxy
+	realIPHeader = "X-Real-Ip"
+
+	// internalHeader marks the calls forwarded by the WebSocket server to the HTTP server, already checked
+	internalHeader = "X-Zeta-Internal-Call"
+
+	// bucketTTL is the time after which the bucket of an idle client is removed, it is full again anyway
+	bucketTTL = 10 * time.Minute
+
+	// defaultMethodCost is the cost of a call to a method without a configured cost
+	defaultMethodCost = 1
+)
+
+// ErrRateLimitExceeded is returned when a client exceeded its rate limit
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+// CostExceedsBurstError is returned when the cost of a request is higher than the burst,
+// the request is never allowed whatever the rate limit of the client
+type CostExceedsBurstError struct {
+	Cost  int
+	Burst int
+}
+
+func (e CostExceedsBurstError) Error() string {
+	return fmt.Sprintf("request cost %d exceeds the rate limit burst %d", e.Cost, e.Burst)
+}
+
+// Config is the configuration of the rate limits of the clients
+type Config struct {
+	// RequestsPerSecond is the cost of the calls allowed per second for a client, 0 disables the rate limits
+	RequestsPerSecond float64
+	// Burst is the maximum cost of the calls of a client at once
+	Burst int
+	// APIKeys are the requests per second of the clients with an API key, instead of their IP
+	APIKeys map[string]float64
+	// MethodCosts are the costs of the methods, a pattern ending with * matches the methods with the prefix
+	MethodCosts map[string]int
+	// TrustedProxies are the networks of the proxies whose X-Forwarded-For and X-Real-IP headers identify the clients
+	TrustedProxies []*net.IPNet
+}
+
+// Client is a client of the JSON-RPC server identified by its API key or its IP
+type Client struct {
+	ID                string
+	RequestsPerSecond float64
+}
+
+// Limiter limits the cost of the JSON-RPC calls of each client with a token bucket.
+// It is shared by the listeners, the quota of a client covers HTTP and WebSocket.
+type Limiter struct {
+	cfg      Config
+	patterns []string // the method patterns with a cost, from the most specific
+	token    string   // the value of the header of the internal calls
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewLimiter creates a new limiter
+func NewLimiter(cfg Config) *Limiter {
+	patterns := make([]string, 0, len(cfg.MethodCosts))
+	for pattern := range cfg.MethodCosts {
+		patterns = append(patterns, pattern)
+	}
+	// exact methods first, then the longest prefixes
+	sort.Slice(patterns, func(i, j int) bool {
+		iWildcard, jWildcard := strings.HasSuffix(patterns[i], "*"), strings.HasSuffix(patterns[j], "*")
+		if iWildcard != jWildcard {
+			return jWildcard
+		}
+		if len(patterns[i]) != len(patterns[j]) {
+			return len(patterns[i]) > len(patterns[j])
+		}
+		return patterns[i] < patterns[j]
+	})
+
+	tokenBz := make([]byte, 32)
+	if _, err := rand.Read(tokenBz); err != nil {
+		panic(err)
+	}
+
+	return &Limiter{
+		cfg:       cfg,
+		patterns:  patterns,
+		token:     hex.EncodeToString(tokenBz),
+		buckets:   make(map[string]*bucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Enabled returns true if the rate limits are enabled
+func (l *Limiter) Enabled() bool {
+	return l.cfg.RequestsPerSecond > 0
+}
+
+// Client returns the client of the request, identified by its API key if registered, otherwise by its IP
+func (l *Limiter) Client(r *http.Request) Client {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		if rps, ok := l.cfg.APIKeys[key]; ok {
+			return Client{ID: "key:" + key, RequestsPerSecond: rps}
+		}
+	}
+
+	return Client{ID: "ip:" + l.clientIP(r), RequestsPerSecond: l.cfg.RequestsPerSecond}
+}
+
+// clientIP returns the IP of the client of the request.
+// Behind a trusted proxy, the client is the last IP of X-Forwarded-For that is not a trusted proxy,
+// otherwise the IP of X-Real-IP. The headers of the other peers are ignored, they can be forged.
+func (l *Limiter) clientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+	if !l.trustedProxy(ip) {
+		return ip
+	}
+
+	// the proxies append the IP of their peer, the rightmost IPs are the closest
+	var forwarded []string
+	for _, header := range r.Header.Values(forwardedForHeader) {
+		forwarded = append(forwarded, strings.Split(header, ",")...)
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(hop) == nil {
+			// the rest of the chain can't be trusted
+			break
+		}
+		ip = hop
+		if !l.trustedProxy(hop) {
+			return hop
+		}
+	}
+	if len(forwarded) > 0 {
+		return ip
+	}
+
+	if realIP := strings.TrimSpace(r.Header.Get(realIPHeader)); net.ParseIP(realIP) != nil {
+		return realIP
+	}
+	return ip
+}
+
+// trustedProxy returns true if the IP is a trusted proxy
+func (l *Limiter) trustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range l.cfg.TrustedProxies {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Cost returns the cost of a call to the method
+func (l *Limiter) Cost(method string) int {
+	for _, pattern := range l.patterns {
+		if matchMethod(pattern, method) {
+			return l.cfg.MethodCosts[pattern]
+		}
+	}
+	return defaultMethodCost
+}
+
+// Allow consumes the cost of the calls to the methods from the bucket of the client.
+// It returns ErrRateLimitExceeded if the client exceeded its rate limit, and CostExceedsBurstError if the cost
+// of the calls can never be allowed. The bucket is left unchanged on error.
+func (l *Limiter) Allow(client Client, methods []string) error {
+	if !l.Enabled() {
+		return nil
+	}
+
+	cost := 0
+	for _, method := range methods {
+		cost += l.Cost(method)
+	}
+	if cost > l.cfg.Burst {
+		return CostExceedsBurstError{Cost: cost, Burst: l.cfg.Burst}
+	}
+
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+	b, ok := l.buckets[client.ID]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(client.RequestsPerSecond), l.cfg.Burst)}
+		l.buckets[client.ID] = b
+	}
+	b.lastSeen = now
+	if !b.limiter.AllowN(now, cost) {
+		return ErrRateLimitExceeded
+	}
+	return nil
+}
+
+// MarkInternal marks the request as forwarded by a listener that already checked it
+func (l *Limiter) MarkInternal(r *http.Request) {
+	r.Header.Set(internalHeader, l.token)
+}
+
+// isInternal returns true if the request is marked as internal
+func (l *Limiter) isInternal(r *http.Request) bool {
+	return r.Header.Get(internalHeader) == l.token
+}
+
+// sweep removes the buckets of the idle clients, at most once per TTL
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < bucketTTL {
+		return
+	}
+	for id, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= bucketTTL {
+			delete(l.buckets, id)
+		}
+	}
+	l.lastSweep = now
+}
+
+// matchMethod returns true if the method matches the pattern, a pattern ending with * matches the methods with the prefix
+func matchMethod(pattern, method string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(method, prefix)
+	}
+	return pattern == method
+}
@@ -24,16 +24,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 	feemarkettypes "github.com/zeta-chain/ethermint/x/feemarket/types"
+
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
 )
 
 // QueryClient defines a gRPC Client used for:
 //   - Transaction simulation
 //   - EVM module queries
 //   - Fee market module queries
+//   - Fungible module queries
 type QueryClient struct {
 	tx.ServiceClient
 	evmtypes.QueryClient
 	FeeMarket feemarkettypes.QueryClient
+	Fungible  fungibletypes.QueryClient
 }
 
 // NewQueryClient creates a new gRPC query client
@@ -42,6 +46,7 @@ func NewQueryClient(clientCtx client.Context) *QueryClient {
 		ServiceClient: tx.NewServiceClient(clientCtx),
 		QueryClient:   evmtypes.NewQueryClient(clientCtx),
 		FeeMarket:     feemarkettypes.NewQueryClient(clientCtx),
+		Fungible:      fungibletypes.NewQueryClient(clientCtx),
 	}
 }
 
@@ -21,6 +21,9 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -81,20 +84,49 @@ type RPCTransaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = fungibletypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = fungibletypes.OverrideAccount
+
+// BlockOverrides is the set of header fields to override in a block during the execution of
+// a message call.
+type BlockOverrides = fungibletypes.BlockOverrides
+
+// TraceCallConfig is the config for traceCall API. It holds one more
+// field to override the state for tracing.
+type TraceCallConfig struct {
+	evmtypes.TraceConfig
+	StateOverrides *StateOverride  `json:"stateOverrides"`
+	BlockOverrides *BlockOverrides `json:"blockOverrides"`
+}
+
+// SimOpts are the inputs to eth_simulateV1.
+type SimOpts struct {
+	BlockStateCalls        []SimBlock `json:"blockStateCalls"`
+	TraceTransfers         bool       `json:"traceTransfers"`
+	Validation             bool       `json:"validation"`
+	ReturnFullTransactions bool       `json:"returnFullTransactions"`
+}
+
+// SimBlock is a batch of calls to be simulated sequentially in a block.
+type SimBlock = fungibletypes.SimulateBlock
+
+// SimBlockResult is the result of a simulated block.
+type SimBlockResult = fungibletypes.SimulateBlockResult
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult = fungibletypes.SimulateCallResult
+
+// SimCallError is the error of a failed simulated call.
+type SimCallError = fungibletypes.SimulateCallError
+
+// AccessListResult is the result of eth_createAccessList.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
 }
 
 type FeeHistoryResult struct {
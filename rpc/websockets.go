@@ -28,10 +28,13 @@ import (
 	"time"
 
 	"github.com/cometbft/cometbft/libs/log"
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/params"
@@ -39,10 +42,12 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
+	ethermint "github.com/zeta-chain/ethermint/types"
 	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
 
 	"github.com/zeta-chain/node/rpc/ethereum/pubsub"
 	rpcfilters "github.com/zeta-chain/node/rpc/namespaces/ethereum/eth/filters"
+	"github.com/zeta-chain/node/rpc/ratelimit"
 	"github.com/zeta-chain/node/rpc/types"
 	"github.com/zeta-chain/node/server/config"
 )
@@ -56,6 +61,8 @@ var (
 	readTimeout  = 15 * time.Second // Time to read the request
 	writeTimeout = 15 * time.Second // Time to write the response
 	idleTimeout  = 60 * time.Second // Max time for connections using TCP Keep-Alive
+
+	syncingPollInterval = 5 * time.Second // Interval of the sync status checks of the syncing subscription
 )
 
 type WebsocketsServer interface {
@@ -79,6 +86,18 @@ type SubscriptionResult struct {
 	Result       interface{} `json:"result"`
 }
 
+// syncingResult is the notification of the syncing subscription when the node starts catching up
+type syncingResult struct {
+	Syncing bool          `json:"syncing"`
+	Status  syncingStatus `json:"status"`
+}
+
+type syncingStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
 type ErrorResponseJSON struct {
 	Jsonrpc string            `json:"jsonrpc"`
 	Error   *ErrorMessageJSON `json:"error"`
@@ -96,6 +115,7 @@ type websocketsServer struct {
 	certFile string
 	keyFile  string
 	api      *pubSubAPI
+	guard    *ratelimit.Guard
 	logger   log.Logger
 }
 
@@ -104,6 +124,7 @@ func NewWebsocketsServer(
 	logger log.Logger,
 	tmWSClient *rpcclient.WSClient,
 	cfg *config.Config,
+	guard *ratelimit.Guard,
 ) WebsocketsServer {
 	logger = logger.With("api", "websocket-server")
 	_, port, err := net.SplitHostPort(cfg.JSONRPC.Address)
@@ -117,6 +138,7 @@ func NewWebsocketsServer(
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
 		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		guard:    guard,
 		logger:   logger,
 	}
 }
@@ -168,8 +190,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	conn.SetReadLimit(messageSizeLimit)
 
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:    new(sync.Mutex),
+		conn:   conn,
+		client: s.guard.Client(r),
 	})
 }
 
@@ -190,8 +213,9 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 }
 
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn   *websocket.Conn
+	mux    *sync.Mutex
+	client ratelimit.Client
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -235,6 +259,14 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			return
 		}
 
+		// the calls forwarded to the JSON-RPC server are checked here, with the method lists of the WebSocket server
+		if rejection := s.guard.Check(wsConn.client, mb); rejection != nil {
+			if err := wsConn.WriteJSON(rejection.Response()); err != nil {
+				s.logger.Debug("error writing error response", "error", err.Error())
+			}
+			continue
+		}
+
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -354,6 +386,7 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	s.guard.MarkInternal(req)
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -409,7 +442,11 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		fullTx, err := parseFullTxParam(params)
+		if err != nil {
+			return nil, err
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -417,6 +454,19 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 	}
 }
 
+// parseFullTxParam parses the flag of the newPendingTransactions subscription,
+// the full transactions are sent instead of the hashes with the true flag
+func parseFullTxParam(params []interface{}) (bool, error) {
+	if len(params) < 2 {
+		return false, nil
+	}
+	fullTx, ok := params[1].(bool)
+	if !ok {
+		return false, errors.New("invalid parameters")
+	}
+	return fullTx, nil
+}
+
 func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribeNewHeads()
 	if err != nil {
@@ -675,7 +725,16 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(
+	wsConn *wsConn,
+	subID rpc.ID,
+	fullTx bool,
+) (pubsub.UnsubscribeFunc, error) {
+	chainID, err := ethermint.ParseChainID(api.clientCtx.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -700,13 +759,19 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					result, err := pendingTxResult(ethTx, fullTx, chainID)
+					if err != nil {
+						api.logger.Debug("failed to build pending transaction", "hash", ethTx.Hash, "error", err.Error())
+						continue
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -736,8 +801,131 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// pendingTxResult returns the notification of a pending transaction: its hash, or the full transaction
+func pendingTxResult(ethTx *evmtypes.MsgEthereumTx, fullTx bool, chainID *big.Int) (interface{}, error) {
+	if !fullTx {
+		return ethTx.Hash, nil
+	}
+	// use zero block values since it's not included in a block yet
+	return types.NewTransactionFromMsg(ethTx, common.Hash{}, 0, 0, nil, chainID, nil)
+}
+
+// subscribeSyncing polls the sync status of CometBFT and notifies its changes:
+// the progress when the node starts catching up, false when it's done.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		syncing := false
+		var startingBlock int64
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			status, err := api.clientCtx.Client.Status(ctx)
+			if err != nil {
+				api.logger.Debug("failed to get sync status", "subscription-id", subID, "error", err.Error())
+				continue
+			}
+			if status.SyncInfo.CatchingUp == syncing {
+				continue
+			}
+			syncing = status.SyncInfo.CatchingUp
+
+			// the catch-up starts at the latest block of the node when it's first seen catching up
+			if syncing {
+				startingBlock = status.SyncInfo.LatestBlockHeight
+			}
+			result := api.syncingNotification(ctx, status, startingBlock)
+
+			// write to ws conn
+			res := &SubscriptionNotification{
+				Jsonrpc: "2.0",
+				Method:  "eth_subscription",
+				Params: &SubscriptionResult{
+					Subscription: subID,
+					Result:       result,
+				},
+			}
+
+			if err := wsConn.WriteJSON(res); err != nil {
+				api.logger.Debug("error writing sync status, will drop peer", "error", err.Error())
+
+				try(func() {
+					if !errors.Is(err, websocket.ErrCloseSent) {
+						err = wsConn.Close()
+						if err != nil {
+							api.logger.Debug("error closing websocket peer", "error", err.Error())
+						}
+					}
+				}, api.logger, "closing websocket peer sub")
+				return
+			}
+		}
+	}()
+
+	return pubsub.UnsubscribeFunc(cancel), nil
+}
+
+// syncingNotification returns the notification of the syncing subscription for the sync status of CometBFT:
+// the progress of the catch-up started at the starting block, false when the node is synced
+func (api *pubSubAPI) syncingNotification(
+	ctx context.Context,
+	status *coretypes.ResultStatus,
+	startingBlock int64,
+) interface{} {
+	if !status.SyncInfo.CatchingUp {
+		return false
+	}
+
+	currentBlock := status.SyncInfo.LatestBlockHeight
+	return &syncingResult{
+		Syncing: true,
+		Status: syncingStatus{
+			// #nosec G115 always positive
+			StartingBlock: hexutil.Uint64(startingBlock),
+			// #nosec G115 always positive
+			CurrentBlock: hexutil.Uint64(currentBlock),
+			// #nosec G115 always positive
+			HighestBlock: hexutil.Uint64(api.highestBlock(ctx, currentBlock)),
+		},
+	}
+}
+
+// highestBlock returns the highest block known from the peers, the current block if it's higher.
+// The height of a peer is the height it's voting on, its latest block is the previous one.
+func (api *pubSubAPI) highestBlock(ctx context.Context, currentBlock int64) int64 {
+	highest := currentBlock
+
+	networkClient, ok := api.clientCtx.Client.(tmrpcclient.NetworkClient)
+	if !ok {
+		return highest
+	}
+	state, err := networkClient.DumpConsensusState(ctx)
+	if err != nil {
+		api.logger.Debug("failed to get the peer states", "error", err.Error())
+		return highest
+	}
+
+	for _, peer := range state.Peers {
+		var peerState struct {
+			RoundState struct {
+				Height int64 `json:"height,string"`
+			} `json:"round_state"`
+		}
+		if err := json.Unmarshal(peer.PeerState, &peerState); err != nil {
+			continue
+		}
+		highest = max(highest, peerState.RoundState.Height-1)
+	}
+
+	return highest
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -0,0 +1,170 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	"github.com/zeta-chain/node/rpc/backend/mocks"
+	"github.com/zeta-chain/node/rpc/types"
+)
+
+func TestParseFullTxParam(t *testing.T) {
+	t.Run("should parse the true flag", func(t *testing.T) {
+		fullTx, err := parseFullTxParam([]interface{}{"newPendingTransactions", true})
+		require.NoError(t, err)
+		require.True(t, fullTx)
+	})
+
+	t.Run("should parse the false flag", func(t *testing.T) {
+		fullTx, err := parseFullTxParam([]interface{}{"newPendingTransactions", false})
+		require.NoError(t, err)
+		require.False(t, fullTx)
+	})
+
+	t.Run("should default to the hashes without the flag", func(t *testing.T) {
+		fullTx, err := parseFullTxParam([]interface{}{"newPendingTransactions"})
+		require.NoError(t, err)
+		require.False(t, fullTx)
+	})
+
+	t.Run("should fail if the flag is not a boolean", func(t *testing.T) {
+		_, err := parseFullTxParam([]interface{}{"newPendingTransactions", "true"})
+		require.Error(t, err)
+	})
+}
+
+func TestPendingTxResult(t *testing.T) {
+	chainID := big.NewInt(7001)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x5a4A1c2D1E6D3e0fF8fCf0E6aF1B2c3d4E5f6A7b")
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.LegacyTx{
+		Nonce:    3,
+		To:       &to,
+		Value:    big.NewInt(10),
+		Gas:      21000,
+		GasPrice: big.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	ethTx := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, ethTx.FromEthereumTx(tx))
+
+	t.Run("should return the hash of the transaction", func(t *testing.T) {
+		result, err := pendingTxResult(ethTx, false, chainID)
+		require.NoError(t, err)
+		require.Equal(t, tx.Hash().Hex(), result)
+	})
+
+	t.Run("should return the full transaction", func(t *testing.T) {
+		result, err := pendingTxResult(ethTx, true, chainID)
+		require.NoError(t, err)
+
+		rpcTx, ok := result.(*types.RPCTransaction)
+		require.True(t, ok)
+		require.Equal(t, tx.Hash(), rpcTx.Hash)
+		require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), rpcTx.From)
+		require.Equal(t, &to, rpcTx.To)
+		require.Equal(t, hexutil.Uint64(3), rpcTx.Nonce)
+		require.Nil(t, rpcTx.BlockHash)
+		require.Nil(t, rpcTx.BlockNumber)
+	})
+}
+
+func TestSyncingNotification(t *testing.T) {
+	ctx := context.Background()
+
+	newAPI := func(t *testing.T) (*pubSubAPI, *mocks.Client) {
+		tmClient := mocks.NewClient(t)
+		return &pubSubAPI{
+			logger:    log.NewNopLogger(),
+			clientCtx: client.Context{}.WithClient(tmClient),
+		}, tmClient
+	}
+
+	peerState := func(height string) json.RawMessage {
+		return json.RawMessage(`{"round_state":{"height":"` + height + `","round":0}}`)
+	}
+
+	status := func(catchingUp bool, latest int64) *coretypes.ResultStatus {
+		return &coretypes.ResultStatus{
+			SyncInfo: coretypes.SyncInfo{
+				CatchingUp:          catchingUp,
+				EarliestBlockHeight: 1,
+				LatestBlockHeight:   latest,
+			},
+		}
+	}
+
+	t.Run("should notify false when the node is synced", func(t *testing.T) {
+		api, _ := newAPI(t)
+
+		result := api.syncingNotification(ctx, status(false, 120), 100)
+		require.Equal(t, false, result)
+
+		bz, err := json.Marshal(result)
+		require.NoError(t, err)
+		require.JSONEq(t, `false`, string(bz))
+	})
+
+	t.Run("should notify the progress of the catch-up with the highest block of the peers", func(t *testing.T) {
+		api, tmClient := newAPI(t)
+		tmClient.On("DumpConsensusState", mock.Anything).Return(&coretypes.ResultDumpConsensusState{
+			Peers: []coretypes.PeerStateInfo{
+				{NodeAddress: "peer1", PeerState: peerState("150")},
+				{NodeAddress: "peer2", PeerState: peerState("201")},
+				{NodeAddress: "peer3", PeerState: json.RawMessage(`invalid`)},
+			},
+		}, nil)
+
+		result := api.syncingNotification(ctx, status(true, 120), 100)
+
+		bz, err := json.Marshal(result)
+		require.NoError(t, err)
+		require.JSONEq(t, `{
+			"syncing": true,
+			"status": {"startingBlock": "0x64", "currentBlock": "0x78", "highestBlock": "0xc8"}
+		}`, string(bz))
+	})
+
+	t.Run("should use the current block as highest block if the peers are behind", func(t *testing.T) {
+		api, tmClient := newAPI(t)
+		tmClient.On("DumpConsensusState", mock.Anything).Return(&coretypes.ResultDumpConsensusState{
+			Peers: []coretypes.PeerStateInfo{{NodeAddress: "peer1", PeerState: peerState("50")}},
+		}, nil)
+
+		result := api.syncingNotification(ctx, status(true, 120), 100)
+
+		syncing, ok := result.(*syncingResult)
+		require.True(t, ok)
+		require.Equal(t, hexutil.Uint64(120), syncing.Status.HighestBlock)
+	})
+
+	t.Run("should use the current block as highest block if the peers can't be fetched", func(t *testing.T) {
+		api, tmClient := newAPI(t)
+		tmClient.On("DumpConsensusState", mock.Anything).Return(nil, errors.New("not available"))
+
+		result := api.syncingNotification(ctx, status(true, 120), 100)
+
+		syncing, ok := result.(*syncingResult)
+		require.True(t, ok)
+		require.Equal(t, hexutil.Uint64(100), syncing.Status.StartingBlock)
+		require.Equal(t, hexutil.Uint64(120), syncing.Status.CurrentBlock)
+		require.Equal(t, hexutil.Uint64(120), syncing.Status.HighestBlock)
+	})
+}
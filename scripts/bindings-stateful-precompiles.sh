@@ -75,4 +75,8 @@ function bindings() {
 # List of bindings to generate
 bindings ./precompiles/prototype
 bindings ./precompiles/staking
+bindings ./precompiles/emissions
+bindings ./precompiles/crosschain
+bindings ./precompiles/bank
+bindings ./precompiles/distribution
 
@@ -18,7 +18,10 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"path"
+	"strconv"
+	gostrings "strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -73,6 +76,15 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultBatchRequestLimit is the maximum number of calls in a batch request
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultRateLimit disables the rate limits of the clients (disabled = 0)
+	DefaultRateLimit float64 = 0
+
+	// DefaultRateLimitBurst is the maximum cost of the calls of a client at once
+	DefaultRateLimitBurst = 100
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -132,6 +144,33 @@ type JSONRPCConfig struct {
 	MaxOpenConnections int `mapstructure:"max-open-connections"`
 	// EnableIndexer defines if enable the custom indexer service.
 	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// EnableLogIndexer defines if enable the log indexer, used by `eth_getLogs` over large block ranges.
+	EnableLogIndexer bool `mapstructure:"enable-log-indexer"`
+	// EnableAddressIndexer defines if enable the address indexer, used by the searches of the `ots` namespace.
+	EnableAddressIndexer bool `mapstructure:"enable-address-indexer"`
+	// BatchRequestLimit is the maximum number of calls in a batch request (unlimited = 0)
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// RateLimit is the cost of the calls allowed per second for a client identified by its IP (disabled = 0)
+	RateLimit float64 `mapstructure:"rate-limit"`
+	// RateLimitBurst is the maximum cost of the calls of a client at once
+	RateLimitBurst int `mapstructure:"rate-limit-burst"`
+	// RateLimitAPIKeys are the API keys identifying the clients instead of their IP, with their rate limit,
+	// in the format "key=rate"
+	RateLimitAPIKeys []string `mapstructure:"rate-limit-api-keys"`
+	// RateLimitMethodCosts are the costs of the calls to the methods, 1 by default, in the format "method=cost".
+	// A method ending with * matches the methods with the prefix.
+	RateLimitMethodCosts []string `mapstructure:"rate-limit-method-costs"`
+	// RateLimitTrustedProxies are the IPs or CIDR networks of the proxies trusted to identify the clients
+	// with the X-Forwarded-For and X-Real-IP headers
+	RateLimitTrustedProxies []string `mapstructure:"rate-limit-trusted-proxies"`
+	// HTTPAllowedMethods are the only methods allowed on the HTTP server if not empty, same format as the costs
+	HTTPAllowedMethods []string `mapstructure:"http-allowed-methods"`
+	// HTTPDeniedMethods are the methods denied on the HTTP server
+	HTTPDeniedMethods []string `mapstructure:"http-denied-methods"`
+	// WSAllowedMethods are the only methods allowed on the WebSocket server if not empty
+	WSAllowedMethods []string `mapstructure:"ws-allowed-methods"`
+	// WSDeniedMethods are the methods denied on the WebSocket server
+	WSDeniedMethods []string `mapstructure:"ws-denied-methods"`
 	// MetricsAddress defines the metrics server to listen on
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
@@ -215,7 +254,21 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "ots", "zeta"}
+}
+
+// GetDefaultRateLimitMethodCosts returns the default costs of the methods executing the EVM or reading many blocks
+func GetDefaultRateLimitMethodCosts() []string {
+	return []string{
+		"eth_call=5",
+		"eth_estimateGas=5",
+		"eth_getLogs=10",
+		"eth_getFilterLogs=10",
+		"eth_createAccessList=10",
+		"eth_simulateV1=10",
+		"debug_*=20",
+		"trace_*=20",
+	}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -237,6 +290,18 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		AllowUnprotectedTxs:      DefaultAllowUnprotectedTxs,
 		MaxOpenConnections:       DefaultMaxOpenConnections,
 		EnableIndexer:            false,
+		EnableLogIndexer:         false,
+		EnableAddressIndexer:     false,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		RateLimit:                DefaultRateLimit,
+		RateLimitBurst:           DefaultRateLimitBurst,
+		RateLimitAPIKeys:         []string{},
+		RateLimitMethodCosts:     GetDefaultRateLimitMethodCosts(),
+		RateLimitTrustedProxies:  []string{},
+		HTTPAllowedMethods:       []string{},
+		HTTPDeniedMethods:        []string{},
+		WSAllowedMethods:         []string{},
+		WSDeniedMethods:          []string{},
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
 	}
@@ -280,6 +345,44 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.RateLimit < 0 {
+		return errors.New("JSON-RPC rate limit cannot be negative")
+	}
+
+	if c.RateLimit > 0 && c.RateLimitBurst <= 0 {
+		return errors.New("JSON-RPC rate limit burst must be positive")
+	}
+
+	if _, err := c.ParseRateLimitAPIKeys(); err != nil {
+		return err
+	}
+
+	costs, err := c.ParseRateLimitMethodCosts()
+	if err != nil {
+		return err
+	}
+	if c.RateLimit > 0 {
+		// a call costing more than the burst would always be rejected
+		for method, cost := range costs {
+			if cost > c.RateLimitBurst {
+				return fmt.Errorf(
+					"JSON-RPC method cost %s=%d exceeds the rate limit burst %d",
+					method,
+					cost,
+					c.RateLimitBurst,
+				)
+			}
+		}
+	}
+
+	if _, err := c.ParseRateLimitTrustedProxies(); err != nil {
+		return err
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -293,6 +396,65 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// ParseRateLimitAPIKeys returns the rate limits of the API keys
+func (c JSONRPCConfig) ParseRateLimitAPIKeys() (map[string]float64, error) {
+	rates := make(map[string]float64, len(c.RateLimitAPIKeys))
+	for _, entry := range c.RateLimitAPIKeys {
+		key, value, ok := gostrings.Cut(entry, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC rate limit API key, expected key=rate")
+		}
+		rate, err := strconv.ParseFloat(value, 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC rate limit of API key: %s", value)
+		}
+		rates[key] = rate
+	}
+	return rates, nil
+}
+
+// ParseRateLimitMethodCosts returns the costs of the methods
+func (c JSONRPCConfig) ParseRateLimitMethodCosts() (map[string]int, error) {
+	costs := make(map[string]int, len(c.RateLimitMethodCosts))
+	for _, entry := range c.RateLimitMethodCosts {
+		method, value, ok := gostrings.Cut(entry, "=")
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid JSON-RPC method cost %s, expected method=cost", entry)
+		}
+		cost, err := strconv.Atoi(value)
+		if err != nil || cost < 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method cost %s", entry)
+		}
+		costs[method] = cost
+	}
+	return costs, nil
+}
+
+// ParseRateLimitTrustedProxies returns the networks of the trusted proxies, an IP is a network of a single IP
+func (c JSONRPCConfig) ParseRateLimitTrustedProxies() ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(c.RateLimitTrustedProxies))
+	for _, entry := range c.RateLimitTrustedProxies {
+		if !gostrings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid JSON-RPC trusted proxy %s, expected an IP or a CIDR", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON-RPC trusted proxy %s, expected an IP or a CIDR", entry)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -348,6 +510,18 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			AllowUnprotectedTxs:      v.GetBool("json-rpc.allow-unprotected-txs"),
 			MaxOpenConnections:       v.GetInt("json-rpc.max-open-connections"),
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
+			EnableLogIndexer:         v.GetBool("json-rpc.enable-log-indexer"),
+			EnableAddressIndexer:     v.GetBool("json-rpc.enable-address-indexer"),
+			BatchRequestLimit:        v.GetInt("json-rpc.batch-request-limit"),
+			RateLimit:                v.GetFloat64("json-rpc.rate-limit"),
+			RateLimitBurst:           v.GetInt("json-rpc.rate-limit-burst"),
+			RateLimitAPIKeys:         v.GetStringSlice("json-rpc.rate-limit-api-keys"),
+			RateLimitMethodCosts:     v.GetStringSlice("json-rpc.rate-limit-method-costs"),
+			RateLimitTrustedProxies:  v.GetStringSlice("json-rpc.rate-limit-trusted-proxies"),
+			HTTPAllowedMethods:       v.GetStringSlice("json-rpc.http-allowed-methods"),
+			HTTPDeniedMethods:        v.GetStringSlice("json-rpc.http-denied-methods"),
+			WSAllowedMethods:         v.GetStringSlice("json-rpc.ws-allowed-methods"),
+			WSDeniedMethods:          v.GetStringSlice("json-rpc.ws-denied-methods"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
 		},
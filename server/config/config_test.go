@@ -12,3 +12,38 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigValidate(t *testing.T) {
+	t.Run("should accept the default config", func(t *testing.T) {
+		cfg := DefaultJSONRPCConfig()
+		cfg.RateLimit = 10
+		require.NoError(t, cfg.Validate())
+	})
+
+	t.Run("should reject a method cost exceeding the burst", func(t *testing.T) {
+		cfg := DefaultJSONRPCConfig()
+		cfg.RateLimit = 10
+		cfg.RateLimitBurst = 5
+		require.ErrorContains(t, cfg.Validate(), "exceeds the rate limit burst 5")
+
+		// the costs are not checked without rate limit
+		cfg.RateLimit = 0
+		require.NoError(t, cfg.Validate())
+	})
+
+	t.Run("should parse the trusted proxies", func(t *testing.T) {
+		cfg := DefaultJSONRPCConfig()
+		cfg.RateLimitTrustedProxies = []string{"10.0.0.0/8", "192.168.1.10", "::1"}
+		require.NoError(t, cfg.Validate())
+
+		networks, err := cfg.ParseRateLimitTrustedProxies()
+		require.NoError(t, err)
+		require.Len(t, networks, 3)
+		require.Equal(t, "10.0.0.0/8", networks[0].String())
+		require.Equal(t, "192.168.1.10/32", networks[1].String())
+		require.Equal(t, "::1/128", networks[2].String())
+
+		cfg.RateLimitTrustedProxies = []string{"proxy"}
+		require.ErrorContains(t, cfg.Validate(), "invalid JSON-RPC trusted proxy")
+	})
+}
@@ -88,6 +88,50 @@ max-open-connections = {{ .JSONRPC.MaxOpenConnections }}
 # EnableIndexer enables the custom transaction indexer for the EVM (ethereum transactions).
 enable-indexer = {{ .JSONRPC.EnableIndexer }}
 
+# EnableLogIndexer enables the indexer of the EVM logs by address and topic.
+# The blocks it covers are not limited by the block range cap in eth_getLogs.
+enable-log-indexer = {{ .JSONRPC.EnableLogIndexer }}
+
+# EnableAddressIndexer enables the indexer of the EVM transactions by sender and recipient.
+# It is required by the searches of the transactions of an address in the ots namespace.
+enable-address-indexer = {{ .JSONRPC.EnableAddressIndexer }}
+
+# BatchRequestLimit is the maximum number of calls in a batch request (unlimited = 0).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# RateLimit is the cost of the calls allowed per second for a client, identified by its IP (disabled = 0).
+# The rejected calls are counted in the rpc/rejected metrics.
+rate-limit = {{ .JSONRPC.RateLimit }}
+
+# RateLimitBurst is the maximum cost of the calls of a client at once, a batch request costing more is rejected.
+rate-limit-burst = {{ .JSONRPC.RateLimitBurst }}
+
+# RateLimitAPIKeys are the API keys, sent in the X-Api-Key header, identifying the clients instead of their IP.
+# Example: ["key1=100", "key2=500"] for 100 and 500 per second.
+rate-limit-api-keys = [{{range $index, $elmt := .JSONRPC.RateLimitAPIKeys}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RateLimitMethodCosts are the costs of the calls to the methods, 1 by default, at most the rate limit burst.
+# A method ending with * matches all the methods with the prefix, the most specific method applies.
+rate-limit-method-costs = [{{range $index, $elmt := .JSONRPC.RateLimitMethodCosts}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# RateLimitTrustedProxies are the IPs or CIDR networks of the proxies trusted to identify the clients
+# with the X-Forwarded-For and X-Real-IP headers, the headers of the other peers are ignored.
+# Example: ["10.0.0.0/8", "192.168.1.10"]
+rate-limit-trusted-proxies = [{{range $index, $elmt := .JSONRPC.RateLimitTrustedProxies}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# HTTPAllowedMethods are the only methods allowed on the HTTP server if not empty.
+# Example: ["eth_*", "net_version"]
+http-allowed-methods = [{{range $index, $elmt := .JSONRPC.HTTPAllowedMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# HTTPDeniedMethods are the methods denied on the HTTP server, they take precedence over the allowed ones.
+http-denied-methods = [{{range $index, $elmt := .JSONRPC.HTTPDeniedMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# WSAllowedMethods are the only methods allowed on the WebSocket server if not empty.
+ws-allowed-methods = [{{range $index, $elmt := .JSONRPC.WSAllowedMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# WSDeniedMethods are the methods denied on the WebSocket server, they take precedence over the allowed ones.
+ws-denied-methods = [{{range $index, $elmt := .JSONRPC.WSDeniedMethods}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
 # MetricsAddress defines the EVM Metrics server address to bind to. Pass --metrics in CLI to enable
 # Prometheus metrics path: /debug/metrics/prometheus
 metrics-address = "{{ .JSONRPC.MetricsAddress }}"
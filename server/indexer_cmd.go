@@ -25,6 +25,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/spf13/cobra"
 	"github.com/zeta-chain/ethermint/indexer"
+
+	"github.com/zeta-chain/node/rpc/addressindexer"
+	"github.com/zeta-chain/node/rpc/logindexer"
 )
 
 func NewIndexTxCmd() *cobra.Command {
@@ -51,82 +54,164 @@ func NewIndexTxCmd() *cobra.Command {
 				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
-			cfg := serverCtx.Config
-			home := cfg.RootDir
 			logger := serverCtx.Logger
-			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(serverCtx.Viper))
+			idxDB, err := OpenIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
 			if err != nil {
 				logger.Error("failed to open evm indexer DB", "error", err.Error())
 				return err
 			}
 			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
-			// open local tendermint db, because the local rpc won't be available.
-			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+			return indexHistoricalBlocks(serverCtx, direction, idxer)
+		},
+	}
+	return cmd
+}
+
+func NewIndexLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-eth-logs [backward|forward]",
+		Short: "Index historical eth logs",
+		Long: `Index historical eth logs by address and topic, with the same traverse directions as index-eth-tx:
+		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
+		- forward: index the blocks from the latest indexed block to latest block in the chain.
+
+		eth_getLogs only uses the log indexer for the block ranges starting from an indexed block.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			direction := args[0]
+			if direction != "backward" && direction != "forward" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			}
+
+			logger := serverCtx.Logger
+			idxDB, err := OpenLogIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
 			if err != nil {
+				logger.Error("failed to open evm log indexer DB", "error", err.Error())
 				return err
 			}
-			blockStore := tmstore.NewBlockStore(tmdb)
+			idxer := logindexer.NewKVIndexer(idxDB, logger.With("module", "evmlogindex"))
+
+			return indexHistoricalBlocks(serverCtx, direction, idxer)
+		},
+	}
+	return cmd
+}
+
+func NewIndexAddressesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-eth-addresses [backward|forward]",
+		Short: "Index historical eth txs by address",
+		Long: `Index historical eth txs by sender and recipient, with the same traverse directions as index-eth-tx:
+		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
+		- forward: index the blocks from the latest indexed block to latest block in the chain.
 
-			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+		The searches of the transactions of an address in the ots namespace only return the indexed transactions.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
-			stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
-			})
-
-			indexBlock := func(height int64) error {
-				blk := blockStore.LoadBlock(height)
-				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
-				}
-				resBlk, err := stateStore.LoadABCIResponses(height)
-				if err != nil {
-					return err
-				}
-				if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
-					return err
-				}
-				fmt.Println(height)
-				return nil
+
+			direction := args[0]
+			if direction != "backward" && direction != "forward" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
 			}
 
-			switch args[0] {
-			case "backward":
-				first, err := idxer.FirstIndexedBlock()
-				if err != nil {
-					return err
-				}
-				if first == -1 {
-					// start from the latest block if indexer db is empty
-					first = blockStore.Height()
-				}
-				for i := first - 1; i > 0; i-- {
-					if err := indexBlock(i); err != nil {
-						return err
-					}
-				}
-			case "forward":
-				latest, err := idxer.LastIndexedBlock()
-				if err != nil {
-					return err
-				}
-				if latest == -1 {
-					// start from genesis if empty
-					latest = 0
-				}
-				for i := latest + 1; i <= blockStore.Height(); i++ {
-					if err := indexBlock(i); err != nil {
-						return err
-					}
-				}
-			default:
-				return fmt.Errorf("unknown direction %s", args[0])
+			logger := serverCtx.Logger
+			idxDB, err := OpenAddressIndexerDB(serverCtx.Config.RootDir, server.GetAppDBBackend(serverCtx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm address indexer DB", "error", err.Error())
+				return err
 			}
+			idxer := addressindexer.NewKVIndexer(idxDB, logger.With("module", "evmaddressindex"), clientCtx)
 
-			return nil
+			return indexHistoricalBlocks(serverCtx, direction, idxer)
 		},
 	}
 	return cmd
 }
+
+// historicalIndexer indexes the blocks of the local blockstore in both directions
+type historicalIndexer interface {
+	blockIndexer
+	// FirstIndexedBlock returns -1 if indexer db is empty
+	FirstIndexedBlock() (int64, error)
+}
+
+// indexHistoricalBlocks indexes the blocks from the local blockstore in the direction
+func indexHistoricalBlocks(serverCtx *server.Context, direction string, idxer historicalIndexer) error {
+	cfg := serverCtx.Config
+
+	// open local tendermint db, because the local rpc won't be available.
+	tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		return err
+	}
+	blockStore := tmstore.NewBlockStore(tmdb)
+
+	stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		return err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+
+	indexBlock := func(height int64) error {
+		blk := blockStore.LoadBlock(height)
+		if blk == nil {
+			return fmt.Errorf("block not found %d", height)
+		}
+		resBlk, err := stateStore.LoadABCIResponses(height)
+		if err != nil {
+			return err
+		}
+		if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
+			return err
+		}
+		fmt.Println(height)
+		return nil
+	}
+
+	switch direction {
+	case "backward":
+		first, err := idxer.FirstIndexedBlock()
+		if err != nil {
+			return err
+		}
+		if first == -1 {
+			// start from the latest block if indexer db is empty
+			first = blockStore.Height()
+		}
+		for i := first - 1; i > 0; i-- {
+			if err := indexBlock(i); err != nil {
+				return err
+			}
+		}
+	case "forward":
+		latest, err := idxer.LastIndexedBlock()
+		if err != nil {
+			return err
+		}
+		if latest == -1 {
+			// start from genesis if empty
+			latest = 0
+		}
+		for i := latest + 1; i <= blockStore.Height(); i++ {
+			if err := indexBlock(i); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("unknown direction %s", direction)
+	}
+
+	return nil
+}
@@ -19,36 +19,70 @@ import (
 	"context"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/service"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cometbft/cometbft/types"
 	ethermint "github.com/zeta-chain/ethermint/types"
+
+	"github.com/zeta-chain/node/rpc/addressindexer"
+	"github.com/zeta-chain/node/rpc/logindexer"
 )
 
 const (
 	ServiceName = "EVMIndexerService"
 
 	NewBlockWaitTimeout = 60 * time.Second
+
+	// indexRetryInterval is the wait before indexing again a block an indexer failed to index
+	indexRetryInterval = time.Second
 )
 
-// EVMIndexerService indexes transactions for json-rpc service.
+// blockIndexer indexes the blocks in order, implemented by the tx, log and address indexers
+type blockIndexer interface {
+	// LastIndexedBlock returns -1 if indexer db is empty
+	LastIndexedBlock() (int64, error)
+	IndexBlock(*types.Block, []*abci.ResponseDeliverTx) error
+}
+
+// EVMIndexerService indexes transactions, logs and addresses for json-rpc service.
 type EVMIndexerService struct {
 	service.BaseService
 
-	txIdxr ethermint.EVMTxIndexer
-	client rpcclient.Client
+	txIdxr   ethermint.EVMTxIndexer
+	logIdxr  logindexer.Indexer
+	addrIdxr addressindexer.Indexer
+	client   rpcclient.Client
 }
 
 // NewEVMIndexerService returns a new service instance.
+// The tx, log or address indexer can be nil if disabled.
 func NewEVMIndexerService(
 	txIdxr ethermint.EVMTxIndexer,
+	logIdxr logindexer.Indexer,
+	addrIdxr addressindexer.Indexer,
 	client rpcclient.Client,
 ) *EVMIndexerService {
-	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is := &EVMIndexerService{txIdxr: txIdxr, logIdxr: logIdxr, addrIdxr: addrIdxr, client: client}
 	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
 	return is
 }
 
+// indexers returns the enabled indexers
+func (eis *EVMIndexerService) indexers() []blockIndexer {
+	var indexers []blockIndexer
+	if eis.txIdxr != nil {
+		indexers = append(indexers, eis.txIdxr)
+	}
+	if eis.logIdxr != nil {
+		indexers = append(indexers, eis.logIdxr)
+	}
+	if eis.addrIdxr != nil {
+		indexers = append(indexers, eis.addrIdxr)
+	}
+	return indexers
+}
+
 // OnStart implements service.Service by subscribing for new blocks
 // and indexing them by events.
 func (eis *EVMIndexerService) OnStart() error {
@@ -87,12 +121,21 @@ func (eis *EVMIndexerService) OnStart() error {
 		}
 	}()
 
-	lastBlock, err := eis.txIdxr.LastIndexedBlock()
-	if err != nil {
-		return err
-	}
-	if lastBlock == -1 {
-		lastBlock = latestBlock
+	// each indexer resumes from its own last indexed block, to not create gaps
+	indexers := eis.indexers()
+	lastBlocks := make([]int64, len(indexers))
+	lastBlock := latestBlock
+	for i, idxr := range indexers {
+		lastBlocks[i], err = idxr.LastIndexedBlock()
+		if err != nil {
+			return err
+		}
+		if lastBlocks[i] == -1 {
+			lastBlocks[i] = latestBlock
+		}
+		if lastBlocks[i] < lastBlock {
+			lastBlock = lastBlocks[i]
+		}
 	}
 	for {
 		if latestBlock <= lastBlock {
@@ -114,8 +157,22 @@ func (eis *EVMIndexerService) OnStart() error {
 				eis.Logger.Error("failed to fetch block result", "height", i, "err", err)
 				break
 			}
-			if err := eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
-				eis.Logger.Error("failed to index block", "height", i, "err", err)
+			// an indexer failing to index the block retries it, to not leave a gap in its index
+			indexed := true
+			for j, idxr := range indexers {
+				if i <= lastBlocks[j] {
+					continue
+				}
+				if err := idxr.IndexBlock(block.Block, blockResult.TxsResults); err != nil {
+					eis.Logger.Error("failed to index block", "height", i, "err", err)
+					indexed = false
+					continue
+				}
+				lastBlocks[j] = blockResult.Height
+			}
+			if !indexed {
+				time.Sleep(indexRetryInterval)
+				break
 			}
 			lastBlock = blockResult.Height
 		}
@@ -29,6 +29,9 @@ import (
 	ethermint "github.com/zeta-chain/ethermint/types"
 
 	"github.com/zeta-chain/node/rpc"
+	"github.com/zeta-chain/node/rpc/addressindexer"
+	"github.com/zeta-chain/node/rpc/logindexer"
+	"github.com/zeta-chain/node/rpc/ratelimit"
 	"github.com/zeta-chain/node/server/config"
 )
 
@@ -39,6 +42,8 @@ func StartJSONRPC(ctx *server.Context,
 	tmEndpoint string,
 	config *config.Config,
 	indexer ethermint.EVMTxIndexer,
+	logIndexer logindexer.Indexer,
+	addressIndexer addressindexer.Indexer,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
@@ -60,7 +65,16 @@ func StartJSONRPC(ctx *server.Context,
 	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
 	rpcAPIArr := config.JSONRPC.API
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+	apis := rpc.GetRPCAPIs(
+		ctx,
+		clientCtx,
+		tmWsClient,
+		allowUnprotectedTxs,
+		indexer,
+		logIndexer,
+		addressIndexer,
+		rpcAPIArr,
+	)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -73,8 +87,25 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	limiter, err := newRateLimiter(config.JSONRPC)
+	if err != nil {
+		return nil, nil, err
+	}
+	httpGuard := ratelimit.NewGuard(
+		limiter,
+		config.JSONRPC.HTTPAllowedMethods,
+		config.JSONRPC.HTTPDeniedMethods,
+		config.JSONRPC.BatchRequestLimit,
+	)
+	wsGuard := ratelimit.NewGuard(
+		limiter,
+		config.JSONRPC.WSAllowedMethods,
+		config.JSONRPC.WSDeniedMethods,
+		config.JSONRPC.BatchRequestLimit,
+	)
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", httpGuard.Handler(rpcServer)).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
@@ -121,7 +152,31 @@ func StartJSONRPC(ctx *server.Context,
 
 	// allocate separate WS connection to Tendermint
 	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
+	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config, wsGuard)
 	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
+
+// newRateLimiter creates the rate limiter of the clients of the JSON-RPC server, shared by the HTTP and WebSocket servers
+func newRateLimiter(cfg config.JSONRPCConfig) (*ratelimit.Limiter, error) {
+	apiKeys, err := cfg.ParseRateLimitAPIKeys()
+	if err != nil {
+		return nil, err
+	}
+	methodCosts, err := cfg.ParseRateLimitMethodCosts()
+	if err != nil {
+		return nil, err
+	}
+	trustedProxies, err := cfg.ParseRateLimitTrustedProxies()
+	if err != nil {
+		return nil, err
+	}
+
+	return ratelimit.NewLimiter(ratelimit.Config{
+		RequestsPerSecond: cfg.RateLimit,
+		Burst:             cfg.RateLimitBurst,
+		APIKeys:           apiKeys,
+		MethodCosts:       methodCosts,
+		TrustedProxies:    trustedProxies,
+	}), nil
+}
@@ -59,6 +59,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	zetaos "github.com/zeta-chain/node/pkg/os"
+	"github.com/zeta-chain/node/rpc/addressindexer"
+	"github.com/zeta-chain/node/rpc/logindexer"
 	"github.com/zeta-chain/node/server/config"
 	srvflags "github.com/zeta-chain/node/server/flags"
 )
@@ -243,9 +245,17 @@ which accepts a path for the resulting pprof file.
 		Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().
 		Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener")
+	cmd.Flags().
+		Int(srvflags.JSONRPCBatchRequestLimit, config.DefaultBatchRequestLimit, "Sets the maximum number of calls in a batch request (0=unlimited)")
+	cmd.Flags().
+		Float64(srvflags.JSONRPCRateLimit, config.DefaultRateLimit, "Sets the cost of the calls allowed per second for a client (0=disabled)")
+	cmd.Flags().
+		Int(srvflags.JSONRPCRateLimitBurst, config.DefaultRateLimitBurst, "Sets the maximum cost of the calls of a client at once")
 
 		//nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableLogIndexer, false, "Enable the log indexer for eth_getLogs")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableAddressIndexer, false, "Enable the address indexer for the ots namespace")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().
@@ -411,6 +421,8 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		logger.Info("starting node in query only mode; Tendermint is disabled")
 		config.GRPC.Enable = true
 		config.JSONRPC.EnableIndexer = false
+		config.JSONRPC.EnableLogIndexer = false
+		config.JSONRPC.EnableAddressIndexer = false
 	} else {
 		logger.Info("starting node with ABCI Tendermint in-process")
 
@@ -444,7 +456,8 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	// Add the tx service to the gRPC router. We only need to register this
 	// service if API or gRPC or JSONRPC is enabled, and avoid doing so in the general
 	// case, because it spawns a new local tendermint RPC client.
-	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer) &&
+	if (config.API.Enable || config.GRPC.Enable || config.JSONRPC.Enable || config.JSONRPC.EnableIndexer ||
+		config.JSONRPC.EnableLogIndexer || config.JSONRPC.EnableAddressIndexer) &&
 		tmNode != nil {
 		clientCtx = clientCtx.WithClient(local.New(tmNode))
 
@@ -464,17 +477,39 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
 	}
 
-	var idxer ethermint.EVMTxIndexer
-	if config.JSONRPC.EnableIndexer {
-		idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
-		if err != nil {
-			logger.Error("failed to open evm indexer DB", "error", err.Error())
-			return err
+	var (
+		idxer     ethermint.EVMTxIndexer
+		logIdxer  logindexer.Indexer
+		addrIdxer addressindexer.Indexer
+	)
+	if config.JSONRPC.EnableIndexer || config.JSONRPC.EnableLogIndexer || config.JSONRPC.EnableAddressIndexer {
+		idxLogger := ctx.Logger.With("indexer", "evm")
+		if config.JSONRPC.EnableIndexer {
+			idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+			idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		}
+		if config.JSONRPC.EnableLogIndexer {
+			logIdxDB, err := OpenLogIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm log indexer DB", "error", err.Error())
+				return err
+			}
+			logIdxer = logindexer.NewKVIndexer(logIdxDB, idxLogger)
+		}
+		if config.JSONRPC.EnableAddressIndexer {
+			addrIdxDB, err := OpenAddressIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+			if err != nil {
+				logger.Error("failed to open evm address indexer DB", "error", err.Error())
+				return err
+			}
+			addrIdxer = addressindexer.NewKVIndexer(addrIdxDB, idxLogger, clientCtx)
 		}
 
-		idxLogger := ctx.Logger.With("indexer", "evm")
-		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
-		indexerService := NewEVMIndexerService(idxer, clientCtx.Client.(rpcclient.Client))
+		indexerService := NewEVMIndexerService(idxer, logIdxer, addrIdxer, clientCtx.Client.(rpcclient.Client))
 		indexerService.SetLogger(idxLogger)
 
 		errCh := make(chan error)
@@ -606,7 +641,16 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer)
+		httpSrv, httpSrvDone, err = StartJSONRPC(
+			ctx,
+			clientCtx,
+			tmRPCAddr,
+			tmEndpoint,
+			&config,
+			idxer,
+			logIdxer,
+			addrIdxer,
+		)
 		if err != nil {
 			return err
 		}
@@ -696,6 +740,18 @@ func OpenIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error)
 	return dbm.NewDB("evmindexer", backendType, dataDir)
 }
 
+// OpenLogIndexerDB opens the eth log indexer db, using the same db backend as the main app
+func OpenLogIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmlogindexer", backendType, dataDir)
+}
+
+// OpenAddressIndexerDB opens the eth address indexer db, using the same db backend as the main app
+func OpenAddressIndexerDB(rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return dbm.NewDB("evmaddressindexer", backendType, dataDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
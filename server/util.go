@@ -65,8 +65,10 @@ func AddCommands(
 		version.NewVersionCommand(),
 		sdkserver.NewRollbackCmd(opts.AppCreator, opts.DefaultNodeHome),
 
-		// custom tx indexer command
+		// custom tx and log indexer commands
 		NewIndexTxCmd(),
+		NewIndexLogsCmd(),
+		NewIndexAddressesCmd(),
 	)
 }
 
@@ -103,6 +103,7 @@ type SDKKeepers struct {
 	AuthKeeper           authkeeper.AccountKeeper
 	BankKeeper           bankkeeper.Keeper
 	StakingKeeper        stakingkeeper.Keeper
+	DistributionKeeper   distrkeeper.Keeper
 	SlashingKeeper       slashingkeeper.Keeper
 	FeeMarketKeeper      feemarketkeeper.Keeper
 	EvmKeeper            *evmkeeper.Keeper
@@ -458,6 +459,15 @@ func NewSDKKeepersWithKeys(
 		consensusKeeper,
 		allKeys,
 	)
+	distributionKeeper := distrkeeper.NewKeeper(
+		cdc,
+		keys[distrtypes.StoreKey],
+		authKeeper,
+		bankKeeper,
+		&stakingKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	slashingKeeper := slashingkeeper.NewKeeper(
 		cdc,
 		codec.NewLegacyAmino(),
@@ -472,14 +482,15 @@ func NewSDKKeepersWithKeys(
 	)
 
 	return SDKKeepers{
-		ParamsKeeper:     paramsKeeper,
-		AuthKeeper:       authKeeper,
-		BankKeeper:       bankKeeper,
-		StakingKeeper:    stakingKeeper,
-		FeeMarketKeeper:  feeMarketKeeper,
-		EvmKeeper:        evmKeeper,
-		SlashingKeeper:   slashingKeeper,
-		CapabilityKeeper: capabilityKeeper,
+		ParamsKeeper:       paramsKeeper,
+		AuthKeeper:         authKeeper,
+		BankKeeper:         bankKeeper,
+		StakingKeeper:      stakingKeeper,
+		DistributionKeeper: distributionKeeper,
+		FeeMarketKeeper:    feeMarketKeeper,
+		EvmKeeper:          evmKeeper,
+		SlashingKeeper:     slashingKeeper,
+		CapabilityKeeper:   capabilityKeeper,
 	}
 }
 
@@ -587,6 +598,7 @@ func NewSDKKeepers(
 		paramsKeeper,
 		consensusKeeper,
 	)
+	distributionKeeper := DistributionKeeper(cdc, db, ss, authKeeper, bankKeeper, &stakingKeeper)
 	slashingKeeper := SlashingKeeper(cdc, db, ss, stakingKeeper)
 
 	ibcKeeper := IBCKeeper(cdc, db, ss, paramsKeeper, stakingKeeper, UpgradeKeeper(cdc, db, ss), *capabilityKeeper)
@@ -603,17 +615,18 @@ func NewSDKKeepers(
 	)
 
 	return SDKKeepers{
-		CapabilityKeeper: capabilityKeeper,
-		ParamsKeeper:     paramsKeeper,
-		AuthKeeper:       authKeeper,
-		BankKeeper:       bankKeeper,
-		StakingKeeper:    stakingKeeper,
-		FeeMarketKeeper:  feeMarketKeeper,
-		EvmKeeper:        evmKeeper,
-		SlashingKeeper:   slashingKeeper,
-		IBCKeeper:        ibcKeeper,
-		TransferKeeper:   transferKeeper,
-		IBCRouter:        ibcRouter,
+		CapabilityKeeper:   capabilityKeeper,
+		ParamsKeeper:       paramsKeeper,
+		AuthKeeper:         authKeeper,
+		BankKeeper:         bankKeeper,
+		StakingKeeper:      stakingKeeper,
+		DistributionKeeper: distributionKeeper,
+		FeeMarketKeeper:    feeMarketKeeper,
+		EvmKeeper:          evmKeeper,
+		SlashingKeeper:     slashingKeeper,
+		IBCKeeper:          ibcKeeper,
+		TransferKeeper:     transferKeeper,
+		IBCRouter:          ibcRouter,
 	}
 }
 
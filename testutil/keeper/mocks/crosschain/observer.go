@@ -703,6 +703,11 @@ func (_m *CrosschainObserverKeeper) RemoveAllExistingMigrators(ctx types.Context
 	_m.Called(ctx)
 }
 
+// RemoveNonceToCctx provides a mock function with given fields: ctx, nonceToCctx
+func (_m *CrosschainObserverKeeper) RemoveNonceToCctx(ctx types.Context, nonceToCctx observertypes.NonceToCctx) {
+	_m.Called(ctx, nonceToCctx)
+}
+
 // RemoveFromPendingNonces provides a mock function with given fields: ctx, tss, chainID, nonce
 func (_m *CrosschainObserverKeeper) RemoveFromPendingNonces(ctx types.Context, tss string, chainID int64, nonce int64) {
 	_m.Called(ctx, tss, chainID, nonce)
@@ -56,6 +56,36 @@ func (_m *FungibleEVMKeeper) ApplyMessage(ctx types.Context, msg core.Message, t
 	return r0, r1
 }
 
+// ApplyMessageWithConfig provides a mock function with given fields: ctx, msg, tracer, commit, cfg, txConfig
+func (_m *FungibleEVMKeeper) ApplyMessageWithConfig(ctx types.Context, msg core.Message, tracer vm.EVMLogger, commit bool, cfg *statedb.EVMConfig, txConfig statedb.TxConfig) (*evmtypes.MsgEthereumTxResponse, error) {
+	ret := _m.Called(ctx, msg, tracer, commit, cfg, txConfig)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyMessageWithConfig")
+	}
+
+	var r0 *evmtypes.MsgEthereumTxResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, core.Message, vm.EVMLogger, bool, *statedb.EVMConfig, statedb.TxConfig) (*evmtypes.MsgEthereumTxResponse, error)); ok {
+		return rf(ctx, msg, tracer, commit, cfg, txConfig)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, core.Message, vm.EVMLogger, bool, *statedb.EVMConfig, statedb.TxConfig) *evmtypes.MsgEthereumTxResponse); ok {
+		r0 = rf(ctx, msg, tracer, commit, cfg, txConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*evmtypes.MsgEthereumTxResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, core.Message, vm.EVMLogger, bool, *statedb.EVMConfig, statedb.TxConfig) error); ok {
+		r1 = rf(ctx, msg, tracer, commit, cfg, txConfig)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ChainID provides a mock function with given fields:
 func (_m *FungibleEVMKeeper) ChainID() *big.Int {
 	ret := _m.Called()
@@ -106,6 +136,41 @@ func (_m *FungibleEVMKeeper) EstimateGas(c context.Context, req *evmtypes.EthCal
 	return r0, r1
 }
 
+// EVMConfig provides a mock function with given fields: ctx, proposerAddress, chainID
+func (_m *FungibleEVMKeeper) EVMConfig(ctx types.Context, proposerAddress types.ConsAddress, chainID *big.Int) (*statedb.EVMConfig, error) {
+	ret := _m.Called(ctx, proposerAddress, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EVMConfig")
+	}
+
+	var r0 *statedb.EVMConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.ConsAddress, *big.Int) (*statedb.EVMConfig, error)); ok {
+		return rf(ctx, proposerAddress, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, types.ConsAddress, *big.Int) *statedb.EVMConfig); ok {
+		r0 = rf(ctx, proposerAddress, chainID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*statedb.EVMConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, types.ConsAddress, *big.Int) error); ok {
+		r1 = rf(ctx, proposerAddress, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForEachStorage provides a mock function with given fields: ctx, addr, cb
+func (_m *FungibleEVMKeeper) ForEachStorage(ctx types.Context, addr common.Address, cb func(common.Hash, common.Hash) bool) {
+	_m.Called(ctx, addr, cb)
+}
+
 // GetAccount provides a mock function with given fields: ctx, addr
 func (_m *FungibleEVMKeeper) GetAccount(ctx types.Context, addr common.Address) *statedb.Account {
 	ret := _m.Called(ctx, addr)
@@ -207,11 +272,21 @@ func (_m *FungibleEVMKeeper) SetBlockBloomTransient(ctx types.Context, bloom *bi
 	_m.Called(ctx, bloom)
 }
 
+// SetCode provides a mock function with given fields: ctx, codeHash, code
+func (_m *FungibleEVMKeeper) SetCode(ctx types.Context, codeHash []byte, code []byte) {
+	_m.Called(ctx, codeHash, code)
+}
+
 // SetLogSizeTransient provides a mock function with given fields: ctx, logSize
 func (_m *FungibleEVMKeeper) SetLogSizeTransient(ctx types.Context, logSize uint64) {
 	_m.Called(ctx, logSize)
 }
 
+// SetState provides a mock function with given fields: ctx, addr, key, value
+func (_m *FungibleEVMKeeper) SetState(ctx types.Context, addr common.Address, key common.Hash, value []byte) {
+	_m.Called(ctx, addr, key, value)
+}
+
 // WithChainID provides a mock function with given fields: ctx
 func (_m *FungibleEVMKeeper) WithChainID(ctx types.Context) {
 	_m.Called(ctx)
@@ -53,6 +53,16 @@ func RateLimiterFlags() types.RateLimiterFlags {
 	}
 }
 
+func CctxPruningFlags() types.CctxPruningFlags {
+	r := Rand()
+
+	return types.CctxPruningFlags{
+		Enabled:           true,
+		RetentionPeriod:   r.Int63n(1000000) + 1,
+		MaxPrunedPerBlock: uint32(r.Int63n(types.MaxCctxPrunedPerBlock)) + 1,
+	}
+}
+
 // CustomAssetRate creates a custom asset rate with the given parameters
 func CustomAssetRate(
 	chainID int64,
@@ -1,8 +1,12 @@
 package sample
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/x/fungible/types"
@@ -58,3 +62,25 @@ func SystemContract() *types.SystemContract {
 		ConnectorZevm:  EthAddress().String(),
 	}
 }
+
+// PrecompileConfig returns a sample precompile config
+func PrecompileConfig(address string) types.PrecompileConfig {
+	return types.PrecompileConfig{
+		Address: address,
+		Enabled: true,
+		MethodGas: []types.PrecompileMethodGas{
+			{Method: "foo", Gas: 1000},
+			{Method: "bar", Gas: 2000},
+		},
+	}
+}
+
+// PrecompileABI returns the ABI of a precompile defining the methods of the sample precompile config
+func PrecompileABI(t *testing.T) abi.ABI {
+	contractABI, err := abi.JSON(strings.NewReader(`[
+		{"type":"function","name":"foo","inputs":[],"outputs":[],"stateMutability":"nonpayable"},
+		{"type":"function","name":"bar","inputs":[],"outputs":[],"stateMutability":"view"}
+	]`))
+	require.NoError(t, err)
+	return contractABI
+}
@@ -263,3 +263,21 @@ func GasPriceIncreaseFlags() types.GasPriceIncreaseFlags {
 		MaxPendingCctxs:         100,
 	}
 }
+
+func LivenessParams() types.LivenessParams {
+	return types.LivenessParams{
+		Enabled:            true,
+		WindowSize:         100,
+		MaxMissedRatio:     sdk.MustNewDecFromStr("0.3"),
+		GracePeriodBlocks:  10,
+		JailDurationBlocks: 1000,
+	}
+}
+
+func ObserverLiveness(observerAddress string) types.ObserverLiveness {
+	liveness := types.NewObserverLiveness(observerAddress)
+	for i := 0; i < 10; i++ {
+		liveness.RecordVote(100, i%3 == 0)
+	}
+	return liveness
+}
@@ -0,0 +1,49 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/crosschain/cctx_pruning_flags.proto (package zetachain.zetacore.crosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * CctxPruningFlags configures the removal of the finalized cctxs from the state
+ *
+ * @generated from message zetachain.zetacore.crosschain.CctxPruningFlags
+ */
+export declare class CctxPruningFlags extends Message<CctxPruningFlags> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * time in seconds after the last update of a finalized cctx before it is
+   * pruned
+   *
+   * @generated from field: int64 retention_period = 2;
+   */
+  retentionPeriod: bigint;
+
+  /**
+   * maximum number of cctxs pruned per block
+   *
+   * @generated from field: uint32 max_pruned_per_block = 3;
+   */
+  maxPrunedPerBlock: number;
+
+  constructor(data?: PartialMessage<CctxPruningFlags>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.CctxPruningFlags";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CctxPruningFlags;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CctxPruningFlags;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CctxPruningFlags;
+
+  static equals(a: CctxPruningFlags | PlainMessage<CctxPruningFlags> | undefined, b: CctxPruningFlags | PlainMessage<CctxPruningFlags> | undefined): boolean;
+}
+
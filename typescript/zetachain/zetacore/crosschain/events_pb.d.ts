@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { CrossChainTx } from "./cross_chain_tx_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.EventInboundFinalized
@@ -427,3 +428,34 @@ export declare class EventERC20CustodyPausing extends Message<EventERC20CustodyP
   static equals(a: EventERC20CustodyPausing | PlainMessage<EventERC20CustodyPausing> | undefined, b: EventERC20CustodyPausing | PlainMessage<EventERC20CustodyPausing> | undefined): boolean;
 }
 
+/**
+ * EventCctxPruned is the archival record of a cctx removed from the state
+ *
+ * @generated from message zetachain.zetacore.crosschain.EventCctxPruned
+ */
+export declare class EventCctxPruned extends Message<EventCctxPruned> {
+  /**
+   * @generated from field: string cctx_index = 1;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CrossChainTx cctx = 2;
+   */
+  cctx?: CrossChainTx;
+
+  constructor(data?: PartialMessage<EventCctxPruned>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.EventCctxPruned";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventCctxPruned;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventCctxPruned;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventCctxPruned;
+
+  static equals(a: EventCctxPruned | PlainMessage<EventCctxPruned> | undefined, b: EventCctxPruned | PlainMessage<EventCctxPruned> | undefined): boolean;
+}
+
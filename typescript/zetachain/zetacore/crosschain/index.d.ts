@@ -1,3 +1,4 @@
+export * from "./cctx_pruning_flags_pb";
 export * from "./cross_chain_tx_pb";
 export * from "./events_pb";
 export * from "./gas_price_pb";
@@ -13,6 +13,7 @@ import type { CrossChainTx } from "./cross_chain_tx_pb.js";
 import type { GasPrice } from "./gas_price_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
 import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { CctxPruningFlags } from "./cctx_pruning_flags_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryZetaAccountingRequest
@@ -813,6 +814,115 @@ export declare class QueryAllCctxResponse extends Message<QueryAllCctxResponse>
   static equals(a: QueryAllCctxResponse | PlainMessage<QueryAllCctxResponse> | undefined, b: QueryAllCctxResponse | PlainMessage<QueryAllCctxResponse> | undefined): boolean;
 }
 
+/**
+ * QueryCctxSearchRequest filters the cctxs, the filters are combined and the
+ * empty ones are ignored. Only the pagination key is supported, not the offset.
+ *
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxSearchRequest
+ */
+export declare class QueryCctxSearchRequest extends Message<QueryCctxSearchRequest> {
+  /**
+   * sender or tx origin of the inbound
+   *
+   * @generated from field: string sender = 1;
+   */
+  sender: string;
+
+  /**
+   * receiver of any of the outbounds
+   *
+   * @generated from field: string receiver = 2;
+   */
+  receiver: string;
+
+  /**
+   * name of the current status of the cctx, like Aborted
+   *
+   * @generated from field: string status = 3;
+   */
+  status: string;
+
+  /**
+   * @generated from field: int64 sender_chain_id = 4;
+   */
+  senderChainId: bigint;
+
+  /**
+   * receiver chain of any of the outbounds
+   *
+   * @generated from field: int64 receiver_chain_id = 5;
+   */
+  receiverChainId: bigint;
+
+  /**
+   * ZRC20 of the asset transferred by the cctx
+   *
+   * @generated from field: string asset = 6;
+   */
+  asset: string;
+
+  /**
+   * creation time of the cctx in unix seconds, after is inclusive and before
+   * exclusive
+   *
+   * @generated from field: int64 created_after = 7;
+   */
+  createdAfter: bigint;
+
+  /**
+   * @generated from field: int64 created_before = 8;
+   */
+  createdBefore: bigint;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 9;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryCctxSearchRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryCctxSearchRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCctxSearchRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCctxSearchRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCctxSearchRequest;
+
+  static equals(a: QueryCctxSearchRequest | PlainMessage<QueryCctxSearchRequest> | undefined, b: QueryCctxSearchRequest | PlainMessage<QueryCctxSearchRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxSearchResponse
+ */
+export declare class QueryCctxSearchResponse extends Message<QueryCctxSearchResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.CrossChainTx CrossChainTx = 1;
+   */
+  CrossChainTx: CrossChainTx[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryCctxSearchResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryCctxSearchResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCctxSearchResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCctxSearchResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCctxSearchResponse;
+
+  static equals(a: QueryCctxSearchResponse | PlainMessage<QueryCctxSearchResponse> | undefined, b: QueryCctxSearchResponse | PlainMessage<QueryCctxSearchResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryListPendingCctxRequest
  */
@@ -1214,6 +1324,49 @@ export declare class QueryRateLimiterFlagsResponse extends Message<QueryRateLimi
   static equals(a: QueryRateLimiterFlagsResponse | PlainMessage<QueryRateLimiterFlagsResponse> | undefined, b: QueryRateLimiterFlagsResponse | PlainMessage<QueryRateLimiterFlagsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxPruningFlagsRequest
+ */
+export declare class QueryCctxPruningFlagsRequest extends Message<QueryCctxPruningFlagsRequest> {
+  constructor(data?: PartialMessage<QueryCctxPruningFlagsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryCctxPruningFlagsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCctxPruningFlagsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCctxPruningFlagsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCctxPruningFlagsRequest;
+
+  static equals(a: QueryCctxPruningFlagsRequest | PlainMessage<QueryCctxPruningFlagsRequest> | undefined, b: QueryCctxPruningFlagsRequest | PlainMessage<QueryCctxPruningFlagsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryCctxPruningFlagsResponse
+ */
+export declare class QueryCctxPruningFlagsResponse extends Message<QueryCctxPruningFlagsResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxPruningFlags cctxPruningFlags = 1;
+   */
+  cctxPruningFlags?: CctxPruningFlags;
+
+  constructor(data?: PartialMessage<QueryCctxPruningFlagsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryCctxPruningFlagsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryCctxPruningFlagsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryCctxPruningFlagsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryCctxPruningFlagsResponse;
+
+  static equals(a: QueryCctxPruningFlagsResponse | PlainMessage<QueryCctxPruningFlagsResponse> | undefined, b: QueryCctxPruningFlagsResponse | PlainMessage<QueryCctxPruningFlagsResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryInboundTrackerRequest
  */
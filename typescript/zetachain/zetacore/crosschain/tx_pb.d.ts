@@ -10,6 +10,7 @@ import type { Proof } from "../pkg/proofs/proofs_pb.js";
 import type { ReceiveStatus } from "../pkg/chains/chains_pb.js";
 import type { ProtocolContractVersion, RevertOptions } from "./cross_chain_tx_pb.js";
 import type { RateLimiterFlags } from "./rate_limiter_flags_pb.js";
+import type { CctxPruningFlags } from "./cctx_pruning_flags_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgMigrateTssFunds
@@ -970,3 +971,51 @@ export declare class MsgUpdateERC20CustodyPauseStatusResponse extends Message<Ms
   static equals(a: MsgUpdateERC20CustodyPauseStatusResponse | PlainMessage<MsgUpdateERC20CustodyPauseStatusResponse> | undefined, b: MsgUpdateERC20CustodyPauseStatusResponse | PlainMessage<MsgUpdateERC20CustodyPauseStatusResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgUpdateCctxPruningFlags
+ */
+export declare class MsgUpdateCctxPruningFlags extends Message<MsgUpdateCctxPruningFlags> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxPruningFlags cctx_pruning_flags = 2;
+   */
+  cctxPruningFlags?: CctxPruningFlags;
+
+  constructor(data?: PartialMessage<MsgUpdateCctxPruningFlags>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgUpdateCctxPruningFlags";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateCctxPruningFlags;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateCctxPruningFlags;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateCctxPruningFlags;
+
+  static equals(a: MsgUpdateCctxPruningFlags | PlainMessage<MsgUpdateCctxPruningFlags> | undefined, b: MsgUpdateCctxPruningFlags | PlainMessage<MsgUpdateCctxPruningFlags> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgUpdateCctxPruningFlagsResponse
+ */
+export declare class MsgUpdateCctxPruningFlagsResponse extends Message<MsgUpdateCctxPruningFlagsResponse> {
+  constructor(data?: PartialMessage<MsgUpdateCctxPruningFlagsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgUpdateCctxPruningFlagsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateCctxPruningFlagsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateCctxPruningFlagsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateCctxPruningFlagsResponse;
+
+  static equals(a: MsgUpdateCctxPruningFlagsResponse | PlainMessage<MsgUpdateCctxPruningFlagsResponse> | undefined, b: MsgUpdateCctxPruningFlagsResponse | PlainMessage<MsgUpdateCctxPruningFlagsResponse> | undefined): boolean;
+}
+
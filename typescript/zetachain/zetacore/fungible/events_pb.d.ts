@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { CoinType } from "../pkg/coin/coin_pb.js";
+import type { PrecompileConfig } from "./precompile_config_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.fungible.EventSystemContractUpdated
@@ -379,3 +380,37 @@ export declare class EventGatewayContractUpdated extends Message<EventGatewayCon
   static equals(a: EventGatewayContractUpdated | PlainMessage<EventGatewayContractUpdated> | undefined, b: EventGatewayContractUpdated | PlainMessage<EventGatewayContractUpdated> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.fungible.EventPrecompileConfigUpdated
+ */
+export declare class EventPrecompileConfigUpdated extends Message<EventPrecompileConfigUpdated> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.fungible.PrecompileConfig config = 2;
+   */
+  config?: PrecompileConfig;
+
+  /**
+   * @generated from field: string signer = 3;
+   */
+  signer: string;
+
+  constructor(data?: PartialMessage<EventPrecompileConfigUpdated>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.EventPrecompileConfigUpdated";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventPrecompileConfigUpdated;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventPrecompileConfigUpdated;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventPrecompileConfigUpdated;
+
+  static equals(a: EventPrecompileConfigUpdated | PlainMessage<EventPrecompileConfigUpdated> | undefined, b: EventPrecompileConfigUpdated | PlainMessage<EventPrecompileConfigUpdated> | undefined): boolean;
+}
+
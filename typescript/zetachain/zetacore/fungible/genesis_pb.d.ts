@@ -7,6 +7,7 @@ import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialM
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { ForeignCoins } from "./foreign_coins_pb.js";
 import type { SystemContract } from "./system_contract_pb.js";
+import type { PrecompileConfig } from "./precompile_config_pb.js";
 
 /**
  * GenesisState defines the fungible module's genesis state.
@@ -24,6 +25,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   systemContract?: SystemContract;
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.fungible.PrecompileConfig precompileConfigList = 4;
+   */
+  precompileConfigList: PrecompileConfig[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -1,6 +1,7 @@
 export * from "./events_pb";
 export * from "./foreign_coins_pb";
 export * from "./genesis_pb";
+export * from "./precompile_config_pb";
 export * from "./query_pb";
 export * from "./system_contract_pb";
 export * from "./tx_pb";
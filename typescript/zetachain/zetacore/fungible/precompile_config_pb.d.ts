@@ -0,0 +1,80 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/fungible/precompile_config.proto (package zetachain.zetacore.fungible, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * PrecompileMethodGas defines the gas required by a method of a stateful
+ * precompiled contract
+ *
+ * @generated from message zetachain.zetacore.fungible.PrecompileMethodGas
+ */
+export declare class PrecompileMethodGas extends Message<PrecompileMethodGas> {
+  /**
+   * method is the name of the method in the contract ABI
+   *
+   * @generated from field: string method = 1;
+   */
+  method: string;
+
+  /**
+   * @generated from field: uint64 gas = 2;
+   */
+  gas: bigint;
+
+  constructor(data?: PartialMessage<PrecompileMethodGas>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.PrecompileMethodGas";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PrecompileMethodGas;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PrecompileMethodGas;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PrecompileMethodGas;
+
+  static equals(a: PrecompileMethodGas | PlainMessage<PrecompileMethodGas> | undefined, b: PrecompileMethodGas | PlainMessage<PrecompileMethodGas> | undefined): boolean;
+}
+
+/**
+ * PrecompileConfig defines the on-chain configuration of a stateful
+ * precompiled contract, it overrides the default enablement and gas
+ * requirements defined in the node binary
+ *
+ * @generated from message zetachain.zetacore.fungible.PrecompileConfig
+ */
+export declare class PrecompileConfig extends Message<PrecompileConfig> {
+  /**
+   * @generated from field: string address = 1;
+   */
+  address: string;
+
+  /**
+   * @generated from field: bool enabled = 2;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.fungible.PrecompileMethodGas method_gas = 3;
+   */
+  methodGas: PrecompileMethodGas[];
+
+  constructor(data?: PartialMessage<PrecompileConfig>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.PrecompileConfig";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PrecompileConfig;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PrecompileConfig;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PrecompileConfig;
+
+  static equals(a: PrecompileConfig | PlainMessage<PrecompileConfig> | undefined, b: PrecompileConfig | PlainMessage<PrecompileConfig> | undefined): boolean;
+}
+
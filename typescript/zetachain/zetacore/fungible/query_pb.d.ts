@@ -8,6 +8,7 @@ import { Message, proto3 } from "@bufbuild/protobuf";
 import type { ForeignCoins } from "./foreign_coins_pb.js";
 import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { SystemContract } from "./system_contract_pb.js";
+import type { PrecompileConfig } from "./precompile_config_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.fungible.QueryGetForeignCoinsRequest
@@ -369,3 +370,181 @@ export declare class QueryCodeHashResponse extends Message<QueryCodeHashResponse
   static equals(a: QueryCodeHashResponse | PlainMessage<QueryCodeHashResponse> | undefined, b: QueryCodeHashResponse | PlainMessage<QueryCodeHashResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryGetPrecompileConfigRequest
+ */
+export declare class QueryGetPrecompileConfigRequest extends Message<QueryGetPrecompileConfigRequest> {
+  /**
+   * @generated from field: string address = 1;
+   */
+  address: string;
+
+  constructor(data?: PartialMessage<QueryGetPrecompileConfigRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryGetPrecompileConfigRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetPrecompileConfigRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetPrecompileConfigRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetPrecompileConfigRequest;
+
+  static equals(a: QueryGetPrecompileConfigRequest | PlainMessage<QueryGetPrecompileConfigRequest> | undefined, b: QueryGetPrecompileConfigRequest | PlainMessage<QueryGetPrecompileConfigRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryGetPrecompileConfigResponse
+ */
+export declare class QueryGetPrecompileConfigResponse extends Message<QueryGetPrecompileConfigResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.fungible.PrecompileConfig config = 1;
+   */
+  config?: PrecompileConfig;
+
+  constructor(data?: PartialMessage<QueryGetPrecompileConfigResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryGetPrecompileConfigResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetPrecompileConfigResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetPrecompileConfigResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetPrecompileConfigResponse;
+
+  static equals(a: QueryGetPrecompileConfigResponse | PlainMessage<QueryGetPrecompileConfigResponse> | undefined, b: QueryGetPrecompileConfigResponse | PlainMessage<QueryGetPrecompileConfigResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryAllPrecompileConfigRequest
+ */
+export declare class QueryAllPrecompileConfigRequest extends Message<QueryAllPrecompileConfigRequest> {
+  constructor(data?: PartialMessage<QueryAllPrecompileConfigRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryAllPrecompileConfigRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllPrecompileConfigRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllPrecompileConfigRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllPrecompileConfigRequest;
+
+  static equals(a: QueryAllPrecompileConfigRequest | PlainMessage<QueryAllPrecompileConfigRequest> | undefined, b: QueryAllPrecompileConfigRequest | PlainMessage<QueryAllPrecompileConfigRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QueryAllPrecompileConfigResponse
+ */
+export declare class QueryAllPrecompileConfigResponse extends Message<QueryAllPrecompileConfigResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.fungible.PrecompileConfig configs = 1;
+   */
+  configs: PrecompileConfig[];
+
+  constructor(data?: PartialMessage<QueryAllPrecompileConfigResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QueryAllPrecompileConfigResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllPrecompileConfigResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllPrecompileConfigResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllPrecompileConfigResponse;
+
+  static equals(a: QueryAllPrecompileConfigResponse | PlainMessage<QueryAllPrecompileConfigResponse> | undefined, b: QueryAllPrecompileConfigResponse | PlainMessage<QueryAllPrecompileConfigResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QuerySimulateRequest
+ */
+export declare class QuerySimulateRequest extends Message<QuerySimulateRequest> {
+  /**
+   * args is the JSON encoded SimulateArgs
+   *
+   * @generated from field: bytes args = 1;
+   */
+  args: Uint8Array;
+
+  /**
+   * chain_id is the EIP-155 chain ID of the zEVM
+   *
+   * @generated from field: int64 chain_id = 3;
+   */
+  chainId: bigint;
+
+  /**
+   * block_number is the height of the block the simulation starts from
+   *
+   * @generated from field: int64 block_number = 4;
+   */
+  blockNumber: bigint;
+
+  /**
+   * block_time is the unix time of the block the simulation starts from
+   *
+   * @generated from field: int64 block_time = 5;
+   */
+  blockTime: bigint;
+
+  /**
+   * block_hash is the hex encoded hash of the block the simulation starts from
+   *
+   * @generated from field: string block_hash = 6;
+   */
+  blockHash: string;
+
+  /**
+   * proposer_address is the consensus address of the block proposer
+   *
+   * @generated from field: bytes proposer_address = 8;
+   */
+  proposerAddress: Uint8Array;
+
+  constructor(data?: PartialMessage<QuerySimulateRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QuerySimulateRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuerySimulateRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuerySimulateRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuerySimulateRequest;
+
+  static equals(a: QuerySimulateRequest | PlainMessage<QuerySimulateRequest> | undefined, b: QuerySimulateRequest | PlainMessage<QuerySimulateRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.QuerySimulateResponse
+ */
+export declare class QuerySimulateResponse extends Message<QuerySimulateResponse> {
+  /**
+   * data is the JSON encoded list of SimulateBlockResult
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  constructor(data?: PartialMessage<QuerySimulateResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.QuerySimulateResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuerySimulateResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuerySimulateResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuerySimulateResponse;
+
+  static equals(a: QuerySimulateResponse | PlainMessage<QuerySimulateResponse> | undefined, b: QuerySimulateResponse | PlainMessage<QuerySimulateResponse> | undefined): boolean;
+}
+
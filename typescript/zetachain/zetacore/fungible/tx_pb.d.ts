@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { CoinType } from "../pkg/coin/coin_pb.js";
+import type { PrecompileConfig } from "./precompile_config_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.fungible.MsgDeploySystemContracts
@@ -564,3 +565,51 @@ export declare class MsgUpdateGatewayContractResponse extends Message<MsgUpdateG
   static equals(a: MsgUpdateGatewayContractResponse | PlainMessage<MsgUpdateGatewayContractResponse> | undefined, b: MsgUpdateGatewayContractResponse | PlainMessage<MsgUpdateGatewayContractResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.fungible.MsgUpdatePrecompileConfig
+ */
+export declare class MsgUpdatePrecompileConfig extends Message<MsgUpdatePrecompileConfig> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.fungible.PrecompileConfig config = 2;
+   */
+  config?: PrecompileConfig;
+
+  constructor(data?: PartialMessage<MsgUpdatePrecompileConfig>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.MsgUpdatePrecompileConfig";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdatePrecompileConfig;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdatePrecompileConfig;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdatePrecompileConfig;
+
+  static equals(a: MsgUpdatePrecompileConfig | PlainMessage<MsgUpdatePrecompileConfig> | undefined, b: MsgUpdatePrecompileConfig | PlainMessage<MsgUpdatePrecompileConfig> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.fungible.MsgUpdatePrecompileConfigResponse
+ */
+export declare class MsgUpdatePrecompileConfigResponse extends Message<MsgUpdatePrecompileConfigResponse> {
+  constructor(data?: PartialMessage<MsgUpdatePrecompileConfigResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.fungible.MsgUpdatePrecompileConfigResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdatePrecompileConfigResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdatePrecompileConfigResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdatePrecompileConfigResponse;
+
+  static equals(a: MsgUpdatePrecompileConfigResponse | PlainMessage<MsgUpdatePrecompileConfigResponse> | undefined, b: MsgUpdatePrecompileConfigResponse | PlainMessage<MsgUpdatePrecompileConfigResponse> | undefined): boolean;
+}
+
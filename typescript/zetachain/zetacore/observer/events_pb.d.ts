@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { LivenessParams } from "./observer_liveness_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.EventBallotCreated
@@ -226,3 +227,100 @@ export declare class EventGasPriceIncreaseFlagsUpdated extends Message<EventGasP
   static equals(a: EventGasPriceIncreaseFlagsUpdated | PlainMessage<EventGasPriceIncreaseFlagsUpdated> | undefined, b: EventGasPriceIncreaseFlagsUpdated | PlainMessage<EventGasPriceIncreaseFlagsUpdated> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverJailed
+ */
+export declare class EventObserverJailed extends Message<EventObserverJailed> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 missed_votes_counter = 2;
+   */
+  missedVotesCounter: bigint;
+
+  /**
+   * @generated from field: int64 window_size = 3;
+   */
+  windowSize: bigint;
+
+  /**
+   * @generated from field: int64 jailed_until = 4;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<EventObserverJailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverJailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverJailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static equals(a: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined, b: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverUnjailed
+ */
+export declare class EventObserverUnjailed extends Message<EventObserverUnjailed> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string observer_address = 2;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<EventObserverUnjailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverUnjailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverUnjailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverUnjailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverUnjailed;
+
+  static equals(a: EventObserverUnjailed | PlainMessage<EventObserverUnjailed> | undefined, b: EventObserverUnjailed | PlainMessage<EventObserverUnjailed> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventLivenessParamsUpdated
+ */
+export declare class EventLivenessParamsUpdated extends Message<EventLivenessParamsUpdated> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 2;
+   */
+  livenessParams?: LivenessParams;
+
+  constructor(data?: PartialMessage<EventLivenessParamsUpdated>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventLivenessParamsUpdated";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventLivenessParamsUpdated;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventLivenessParamsUpdated;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventLivenessParamsUpdated;
+
+  static equals(a: EventLivenessParamsUpdated | PlainMessage<EventLivenessParamsUpdated> | undefined, b: EventLivenessParamsUpdated | PlainMessage<EventLivenessParamsUpdated> | undefined): boolean;
+}
+
@@ -7,6 +7,7 @@ export * from "./genesis_pb";
 export * from "./keygen_pb";
 export * from "./node_account_pb";
 export * from "./nonce_to_cctx_pb";
+export * from "./observer_liveness_pb";
 export * from "./observer_pb";
 export * from "./params_pb";
 export * from "./pending_nonces_pb";
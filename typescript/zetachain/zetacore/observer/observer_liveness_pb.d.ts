@@ -0,0 +1,125 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file zetachain/zetacore/observer/observer_liveness.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * LivenessParams defines how observers voting activity is tracked and when
+ * observers that miss too many votes are jailed
+ *
+ * @generated from message zetachain.zetacore.observer.LivenessParams
+ */
+export declare class LivenessParams extends Message<LivenessParams> {
+  /**
+   * enable the tracking of missed votes and the jailing of observers
+   *
+   * @generated from field: bool enabled = 1;
+   */
+  enabled: boolean;
+
+  /**
+   * number of finalized ballots tracked in the sliding window of each observer
+   *
+   * @generated from field: int64 window_size = 2;
+   */
+  windowSize: bigint;
+
+  /**
+   * ratio of missed votes in the window above which an observer is jailed
+   *
+   * @generated from field: string max_missed_ratio = 3;
+   */
+  maxMissedRatio: string;
+
+  /**
+   * number of blocks after the creation of a ballot before the votes are
+   * accounted, observers that haven't voted after this period missed the vote
+   *
+   * @generated from field: int64 grace_period_blocks = 4;
+   */
+  gracePeriodBlocks: bigint;
+
+  /**
+   * number of blocks an observer remains jailed before it can be unjailed
+   *
+   * @generated from field: int64 jail_duration_blocks = 5;
+   */
+  jailDurationBlocks: bigint;
+
+  constructor(data?: PartialMessage<LivenessParams>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.LivenessParams";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LivenessParams;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LivenessParams;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LivenessParams;
+
+  static equals(a: LivenessParams | PlainMessage<LivenessParams> | undefined, b: LivenessParams | PlainMessage<LivenessParams> | undefined): boolean;
+}
+
+/**
+ * ObserverLiveness tracks the votes missed by an observer in the sliding window
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverLiveness
+ */
+export declare class ObserverLiveness extends Message<ObserverLiveness> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * total number of ballots accounted since the window was last reset
+   *
+   * @generated from field: int64 index_offset = 2;
+   */
+  indexOffset: bigint;
+
+  /**
+   * number of votes missed in the current window
+   *
+   * @generated from field: int64 missed_votes_counter = 3;
+   */
+  missedVotesCounter: bigint;
+
+  /**
+   * bit array of the votes missed in the current window
+   *
+   * @generated from field: bytes missed_votes = 4;
+   */
+  missedVotes: Uint8Array;
+
+  /**
+   * @generated from field: bool jailed = 5;
+   */
+  jailed: boolean;
+
+  /**
+   * height from which the observer can be unjailed
+   *
+   * @generated from field: int64 jailed_until = 6;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<ObserverLiveness>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverLiveness";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverLiveness;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static equals(a: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined, b: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined): boolean;
+}
+
@@ -5,6 +5,66 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { CoinType } from "../pkg/coin/coin_pb.js";
+
+/**
+ * ConfirmationMode defines how observers determine that a block of a chain is
+ * confirmed
+ *
+ * @generated from enum zetachain.zetacore.observer.ConfirmationMode
+ */
+export declare enum ConfirmationMode {
+  /**
+   * a block is confirmed once followed by confirmation_count blocks
+   *
+   * @generated from enum value: FixedCount = 0;
+   */
+  FixedCount = 0,
+
+  /**
+   * a block is confirmed once the safe head of the chain reaches it
+   *
+   * @generated from enum value: SafeTag = 1;
+   */
+  SafeTag = 1,
+
+  /**
+   * a block is confirmed once the finalized head of the chain reaches it
+   *
+   * @generated from enum value: FinalizedTag = 2;
+   */
+  FinalizedTag = 2,
+}
+
+/**
+ * TraceMode defines how observers find the native token deposits to TSS and
+ * the TSS outbounds of an EVM chain
+ *
+ * @generated from enum zetachain.zetacore.observer.TraceMode
+ */
+export declare enum TraceMode {
+  /**
+   * every block of the chain is scanned with its full transactions
+   *
+   * @generated from enum value: BlockScan = 0;
+   */
+  BlockScan = 0,
+
+  /**
+   * the calls from and to TSS are found with the trace_filter method
+   *
+   * @generated from enum value: TraceFilter = 1;
+   */
+  TraceFilter = 1,
+
+  /**
+   * the calls from and to TSS are found with the debug_traceBlockByNumber
+   * method and the call tracer
+   *
+   * @generated from enum value: DebugTrace = 2;
+   */
+  DebugTrace = 2,
+}
 
 /**
  * @generated from message zetachain.zetacore.observer.ChainParamsList
@@ -30,6 +90,52 @@ export declare class ChainParamsList extends Message<ChainParamsList> {
   static equals(a: ChainParamsList | PlainMessage<ChainParamsList> | undefined, b: ChainParamsList | PlainMessage<ChainParamsList> | undefined): boolean;
 }
 
+/**
+ * ConfirmationTier defines the number of confirmations required by the
+ * inbounds of an asset with an amount greater than or equal to min_amount
+ *
+ * @generated from message zetachain.zetacore.observer.ConfirmationTier
+ */
+export declare class ConfirmationTier extends Message<ConfirmationTier> {
+  /**
+   * @generated from field: zetachain.zetacore.pkg.coin.CoinType coin_type = 1;
+   */
+  coinType: CoinType;
+
+  /**
+   * asset is the address of the asset, empty for the gas and zeta tokens
+   *
+   * @generated from field: string asset = 2;
+   */
+  asset: string;
+
+  /**
+   * min_amount is denominated in the smallest unit of the asset
+   *
+   * @generated from field: string min_amount = 3;
+   */
+  minAmount: string;
+
+  /**
+   * @generated from field: uint64 confirmation_count = 4;
+   */
+  confirmationCount: bigint;
+
+  constructor(data?: PartialMessage<ConfirmationTier>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ConfirmationTier";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfirmationTier;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfirmationTier;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfirmationTier;
+
+  static equals(a: ConfirmationTier | PlainMessage<ConfirmationTier> | undefined, b: ConfirmationTier | PlainMessage<ConfirmationTier> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.ChainParams
  */
@@ -109,6 +215,32 @@ export declare class ChainParams extends Message<ChainParams> {
    */
   gatewayAddress: string;
 
+  /**
+   * confirmation_mode defines how blocks are considered confirmed, block tags
+   * are only supported on EVM chains and fall back to confirmation_count if not
+   * supported by the RPC
+   *
+   * @generated from field: zetachain.zetacore.observer.ConfirmationMode confirmation_mode = 18;
+   */
+  confirmationMode: ConfirmationMode;
+
+  /**
+   * confirmation_tiers defines the confirmations required by inbounds
+   * depending on their amount, inbounds are held until their tier is met
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.ConfirmationTier confirmation_tiers = 19;
+   */
+  confirmationTiers: ConfirmationTier[];
+
+  /**
+   * trace_mode defines how the TSS deposits and outbounds are found, call
+   * traces are only supported on EVM chains and require the RPC to support
+   * the trace method
+   *
+   * @generated from field: zetachain.zetacore.observer.TraceMode trace_mode = 20;
+   */
+  traceMode: TraceMode;
+
   constructor(data?: PartialMessage<ChainParams>);
 
   static readonly runtime: typeof proto3;
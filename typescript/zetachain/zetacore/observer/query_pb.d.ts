@@ -5,6 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
+import type { LivenessParams } from "./observer_liveness_pb.js";
 import type { TssFundMigratorInfo } from "./tss_funds_migrator_pb.js";
 import type { ChainNonces } from "./chain_nonces_pb.js";
 import type { PageRequest, PageResponse } from "../../../cosmos/base/query/v1beta1/pagination_pb.js";
@@ -19,6 +20,195 @@ import type { CrosschainFlags } from "./crosschain_flags_pb.js";
 import type { Keygen } from "./keygen_pb.js";
 import type { Blame } from "./blame_pb.js";
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetLivenessParamsRequest
+ */
+export declare class QueryGetLivenessParamsRequest extends Message<QueryGetLivenessParamsRequest> {
+  constructor(data?: PartialMessage<QueryGetLivenessParamsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetLivenessParamsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetLivenessParamsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetLivenessParamsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetLivenessParamsRequest;
+
+  static equals(a: QueryGetLivenessParamsRequest | PlainMessage<QueryGetLivenessParamsRequest> | undefined, b: QueryGetLivenessParamsRequest | PlainMessage<QueryGetLivenessParamsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetLivenessParamsResponse
+ */
+export declare class QueryGetLivenessParamsResponse extends Message<QueryGetLivenessParamsResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 1;
+   */
+  livenessParams?: LivenessParams;
+
+  constructor(data?: PartialMessage<QueryGetLivenessParamsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetLivenessParamsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetLivenessParamsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetLivenessParamsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetLivenessParamsResponse;
+
+  static equals(a: QueryGetLivenessParamsResponse | PlainMessage<QueryGetLivenessParamsResponse> | undefined, b: QueryGetLivenessParamsResponse | PlainMessage<QueryGetLivenessParamsResponse> | undefined): boolean;
+}
+
+/**
+ * ObserverLivenessInfo summarizes the voting activity of an observer
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverLivenessInfo
+ */
+export declare class ObserverLivenessInfo extends Message<ObserverLivenessInfo> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * number of votes missed in the current window
+   *
+   * @generated from field: int64 missed_votes = 2;
+   */
+  missedVotes: bigint;
+
+  /**
+   * number of ballots accounted in the current window
+   *
+   * @generated from field: int64 total_votes = 3;
+   */
+  totalVotes: bigint;
+
+  /**
+   * @generated from field: string missed_ratio = 4;
+   */
+  missedRatio: string;
+
+  /**
+   * @generated from field: bool jailed = 5;
+   */
+  jailed: boolean;
+
+  /**
+   * @generated from field: int64 jailed_until = 6;
+   */
+  jailedUntil: bigint;
+
+  constructor(data?: PartialMessage<ObserverLivenessInfo>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverLivenessInfo";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverLivenessInfo;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverLivenessInfo;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverLivenessInfo;
+
+  static equals(a: ObserverLivenessInfo | PlainMessage<ObserverLivenessInfo> | undefined, b: ObserverLivenessInfo | PlainMessage<ObserverLivenessInfo> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetObserverLivenessRequest
+ */
+export declare class QueryGetObserverLivenessRequest extends Message<QueryGetObserverLivenessRequest> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<QueryGetObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessRequest;
+
+  static equals(a: QueryGetObserverLivenessRequest | PlainMessage<QueryGetObserverLivenessRequest> | undefined, b: QueryGetObserverLivenessRequest | PlainMessage<QueryGetObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryGetObserverLivenessResponse
+ */
+export declare class QueryGetObserverLivenessResponse extends Message<QueryGetObserverLivenessResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverLivenessInfo observer_liveness = 1;
+   */
+  observerLiveness?: ObserverLivenessInfo;
+
+  constructor(data?: PartialMessage<QueryGetObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryGetObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetObserverLivenessResponse;
+
+  static equals(a: QueryGetObserverLivenessResponse | PlainMessage<QueryGetObserverLivenessResponse> | undefined, b: QueryGetObserverLivenessResponse | PlainMessage<QueryGetObserverLivenessResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllObserverLivenessRequest
+ */
+export declare class QueryAllObserverLivenessRequest extends Message<QueryAllObserverLivenessRequest> {
+  constructor(data?: PartialMessage<QueryAllObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessRequest;
+
+  static equals(a: QueryAllObserverLivenessRequest | PlainMessage<QueryAllObserverLivenessRequest> | undefined, b: QueryAllObserverLivenessRequest | PlainMessage<QueryAllObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryAllObserverLivenessResponse
+ */
+export declare class QueryAllObserverLivenessResponse extends Message<QueryAllObserverLivenessResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLivenessInfo observer_liveness = 1;
+   */
+  observerLiveness: ObserverLivenessInfo[];
+
+  constructor(data?: PartialMessage<QueryAllObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryAllObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllObserverLivenessResponse;
+
+  static equals(a: QueryAllObserverLivenessResponse | PlainMessage<QueryAllObserverLivenessResponse> | undefined, b: QueryAllObserverLivenessResponse | PlainMessage<QueryAllObserverLivenessResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllRequest
  */
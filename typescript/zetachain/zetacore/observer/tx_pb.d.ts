@@ -11,6 +11,7 @@ import type { ChainParams } from "./params_pb.js";
 import type { Blame } from "./blame_pb.js";
 import type { ReceiveStatus } from "../pkg/chains/chains_pb.js";
 import type { GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { LivenessParams } from "./observer_liveness_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.MsgUpdateObserver
@@ -683,3 +684,94 @@ export declare class MsgUpdateGasPriceIncreaseFlagsResponse extends Message<MsgU
   static equals(a: MsgUpdateGasPriceIncreaseFlagsResponse | PlainMessage<MsgUpdateGasPriceIncreaseFlagsResponse> | undefined, b: MsgUpdateGasPriceIncreaseFlagsResponse | PlainMessage<MsgUpdateGasPriceIncreaseFlagsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserver
+ */
+export declare class MsgUnjailObserver extends Message<MsgUnjailObserver> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  constructor(data?: PartialMessage<MsgUnjailObserver>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnjailObserver";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnjailObserver;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnjailObserver;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnjailObserver;
+
+  static equals(a: MsgUnjailObserver | PlainMessage<MsgUnjailObserver> | undefined, b: MsgUnjailObserver | PlainMessage<MsgUnjailObserver> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserverResponse
+ */
+export declare class MsgUnjailObserverResponse extends Message<MsgUnjailObserverResponse> {
+  constructor(data?: PartialMessage<MsgUnjailObserverResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnjailObserverResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnjailObserverResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnjailObserverResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnjailObserverResponse;
+
+  static equals(a: MsgUnjailObserverResponse | PlainMessage<MsgUnjailObserverResponse> | undefined, b: MsgUnjailObserverResponse | PlainMessage<MsgUnjailObserverResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateLivenessParams
+ */
+export declare class MsgUpdateLivenessParams extends Message<MsgUpdateLivenessParams> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.LivenessParams liveness_params = 2;
+   */
+  livenessParams?: LivenessParams;
+
+  constructor(data?: PartialMessage<MsgUpdateLivenessParams>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateLivenessParams";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateLivenessParams;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParams;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParams;
+
+  static equals(a: MsgUpdateLivenessParams | PlainMessage<MsgUpdateLivenessParams> | undefined, b: MsgUpdateLivenessParams | PlainMessage<MsgUpdateLivenessParams> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse
+ */
+export declare class MsgUpdateLivenessParamsResponse extends Message<MsgUpdateLivenessParamsResponse> {
+  constructor(data?: PartialMessage<MsgUpdateLivenessParamsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateLivenessParamsResponse;
+
+  static equals(a: MsgUpdateLivenessParamsResponse | PlainMessage<MsgUpdateLivenessParamsResponse> | undefined, b: MsgUpdateLivenessParamsResponse | PlainMessage<MsgUpdateLivenessParamsResponse> | undefined): boolean;
+}
+
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/zeta-chain/node/x/authority/migrations/v2"
+	v3 "github.com/zeta-chain/node/x/authority/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -22,3 +23,8 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.authorityKeeper)
 }
+
+// Migrate2to3 migrates the authority store from consensus version 2 to 3
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.authorityKeeper)
+}
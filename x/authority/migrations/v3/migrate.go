@@ -0,0 +1,51 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/authority/types"
+)
+
+type authorityKeeper interface {
+	GetAuthorizationList(ctx sdk.Context) (val types.AuthorizationList, found bool)
+	SetAuthorizationList(ctx sdk.Context, list types.AuthorizationList)
+}
+
+// NewAuthorizations are the authorizations of the messages added since the consensus version 2
+var NewAuthorizations = []types.Authorization{
+	{
+		MsgUrl:           "/zetachain.zetacore.crosschain.MsgUpdateCctxPruningFlags",
+		AuthorizedPolicy: types.PolicyType_groupOperational,
+	},
+	{
+		MsgUrl:           "/zetachain.zetacore.fungible.MsgUpdatePrecompileConfig",
+		AuthorizedPolicy: types.PolicyType_groupEmergency,
+	},
+	{
+		MsgUrl:           "/zetachain.zetacore.observer.MsgUpdateLivenessParams",
+		AuthorizedPolicy: types.PolicyType_groupOperational,
+	},
+}
+
+// MigrateStore migrates the authority module state from the consensus version 2 to 3
+// It adds the authorizations of the new messages to the authorization list of the store
+func MigrateStore(
+	ctx sdk.Context,
+	keeper authorityKeeper,
+) error {
+	list, found := keeper.GetAuthorizationList(ctx)
+	if !found {
+		list = types.DefaultAuthorizationsList()
+	}
+
+	for _, authorization := range NewAuthorizations {
+		list.SetAuthorization(authorization)
+	}
+
+	if err := list.Validate(); err != nil {
+		return err
+	}
+
+	keeper.SetAuthorizationList(ctx, list)
+	return nil
+}
@@ -0,0 +1,53 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	v3 "github.com/zeta-chain/node/x/authority/migrations/v3"
+	"github.com/zeta-chain/node/x/authority/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("add the new authorizations to the authorization list", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		// the authorization list of the consensus version 2
+		list := types.DefaultAuthorizationsList()
+		for _, authorization := range v3.NewAuthorizations {
+			list.RemoveAuthorization(authorization.MsgUrl)
+		}
+		list.SetAuthorization(types.Authorization{MsgUrl: "ABC", AuthorizedPolicy: types.PolicyType_groupAdmin})
+		k.SetAuthorizationList(ctx, list)
+
+		err := v3.MigrateStore(ctx, *k)
+		require.NoError(t, err)
+
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.Len(t, list.Authorizations, len(types.DefaultAuthorizationsList().Authorizations)+1)
+		for _, authorization := range v3.NewAuthorizations {
+			policy, err := list.GetAuthorizedPolicy(authorization.MsgUrl)
+			require.NoError(t, err)
+			require.Equal(t, authorization.AuthorizedPolicy, policy)
+		}
+
+		// the existing authorizations are kept
+		policy, err := list.GetAuthorizedPolicy("ABC")
+		require.NoError(t, err)
+		require.Equal(t, types.PolicyType_groupAdmin, policy)
+	})
+
+	t.Run("set the default authorization list if not found", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		err := v3.MigrateStore(ctx, *k)
+		require.NoError(t, err)
+
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.ElementsMatch(t, types.DefaultAuthorizationsList().Authorizations, list.Authorizations)
+	})
+}
@@ -12,6 +12,7 @@ var (
 		"/zetachain.zetacore.crosschain.MsgRefundAbortedCCTX",
 		"/zetachain.zetacore.crosschain.MsgAbortStuckCCTX",
 		"/zetachain.zetacore.crosschain.MsgUpdateRateLimiterFlags",
+		"/zetachain.zetacore.crosschain.MsgUpdateCctxPruningFlags",
 		"/zetachain.zetacore.fungible.MsgDeploySystemContracts",
 		"/zetachain.zetacore.fungible.MsgUpdateZRC20LiquidityCap",
 		"/zetachain.zetacore.fungible.MsgUpdateZRC20WithdrawFee",
@@ -20,6 +21,7 @@ var (
 		"/zetachain.zetacore.observer.MsgUpdateChainParams",
 		"/zetachain.zetacore.observer.MsgEnableCCTX",
 		"/zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlags",
+		"/zetachain.zetacore.observer.MsgUpdateLivenessParams",
 	}
 	// AdminPolicyMessages keeps track of the message URLs that can, by default, only be executed by admin policy address
 	AdminPolicyMessages = []string{
@@ -47,6 +49,7 @@ var (
 		"/zetachain.zetacore.crosschain.MsgAddOutboundTracker",
 		"/zetachain.zetacore.crosschain.MsgRemoveOutboundTracker",
 		"/zetachain.zetacore.fungible.MsgPauseZRC20",
+		"/zetachain.zetacore.fungible.MsgUpdatePrecompileConfig",
 		"/zetachain.zetacore.observer.MsgUpdateKeygen",
 		"/zetachain.zetacore.observer.MsgDisableCCTX",
 		"/zetachain.zetacore.lightclient.MsgDisableHeaderVerification",
@@ -391,6 +391,7 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&crosschaintypes.MsgRefundAbortedCCTX{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgAbortStuckCCTX{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgUpdateRateLimiterFlags{}),
+			sdk.MsgTypeURL(&crosschaintypes.MsgUpdateCctxPruningFlags{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgDeploySystemContracts{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgUpdateZRC20LiquidityCap{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgUpdateZRC20WithdrawFee{}),
@@ -399,6 +400,7 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&observertypes.MsgUpdateChainParams{}),
 			sdk.MsgTypeURL(&observertypes.MsgEnableCCTX{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateGasPriceIncreaseFlags{}),
+			sdk.MsgTypeURL(&observertypes.MsgUpdateLivenessParams{}),
 		}
 
 		// EmergencyPolicyMessageList is a list of messages that can be authorized by the emergency policy
@@ -407,6 +409,7 @@ func TestDefaultAuthorizationsList(t *testing.T) {
 			sdk.MsgTypeURL(&crosschaintypes.MsgAddOutboundTracker{}),
 			sdk.MsgTypeURL(&crosschaintypes.MsgRemoveOutboundTracker{}),
 			sdk.MsgTypeURL(&fungibletypes.MsgPauseZRC20{}),
+			sdk.MsgTypeURL(&fungibletypes.MsgUpdatePrecompileConfig{}),
 			sdk.MsgTypeURL(&observertypes.MsgUpdateKeygen{}),
 			sdk.MsgTypeURL(&observertypes.MsgDisableCCTX{}),
 			sdk.MsgTypeURL(&lightclienttypes.MsgDisableHeaderVerification{}),
@@ -28,6 +28,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 		CmdListSend(),
 		CmdShowSend(),
+		CmdSearchCctx(),
 		CmdLastZetaHeight(),
 		CmdInboundHashToCctxData(),
 		CmdListInboundHashToCctx(),
@@ -41,6 +42,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListPendingCCTXWithinRateLimit(),
 
 		CmdShowUpdateRateLimiterFlags(),
+		CmdShowCctxPruningFlags(),
 	)
 
 	return cmd
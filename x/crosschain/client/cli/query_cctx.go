@@ -11,6 +11,18 @@ import (
 	"github.com/zeta-chain/node/x/crosschain/types"
 )
 
+// flags of the search-cctx command
+const (
+	flagSender        = "sender"
+	flagReceiver      = "receiver"
+	flagStatus        = "status"
+	flagSenderChain   = "sender-chain"
+	flagReceiverChain = "receiver-chain"
+	flagAsset         = "asset"
+	flagCreatedAfter  = "created-after"
+	flagCreatedBefore = "created-before"
+)
+
 func CmdListSend() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-cctx",
@@ -110,3 +122,68 @@ func CmdShowSend() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSearchCctx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-cctx",
+		Short: "search CCTX with combined filters, in the order of creation",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCctxSearchRequest{
+				Pagination: pageReq,
+			}
+			if params.Sender, err = cmd.Flags().GetString(flagSender); err != nil {
+				return err
+			}
+			if params.Receiver, err = cmd.Flags().GetString(flagReceiver); err != nil {
+				return err
+			}
+			if params.Status, err = cmd.Flags().GetString(flagStatus); err != nil {
+				return err
+			}
+			if params.SenderChainId, err = cmd.Flags().GetInt64(flagSenderChain); err != nil {
+				return err
+			}
+			if params.ReceiverChainId, err = cmd.Flags().GetInt64(flagReceiverChain); err != nil {
+				return err
+			}
+			if params.Asset, err = cmd.Flags().GetString(flagAsset); err != nil {
+				return err
+			}
+			if params.CreatedAfter, err = cmd.Flags().GetInt64(flagCreatedAfter); err != nil {
+				return err
+			}
+			if params.CreatedBefore, err = cmd.Flags().GetInt64(flagCreatedBefore); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CctxSearch(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagSender, "", "sender or tx origin of the inbound")
+	cmd.Flags().String(flagReceiver, "", "receiver of an outbound")
+	cmd.Flags().String(flagStatus, "", "status of the CCTX, like PendingOutbound")
+	cmd.Flags().Int64(flagSenderChain, 0, "sender chain id")
+	cmd.Flags().Int64(flagReceiverChain, 0, "receiver chain id of an outbound")
+	cmd.Flags().String(flagAsset, "", "ZRC20 address of the asset")
+	cmd.Flags().Int64(flagCreatedAfter, 0, "created at or after this unix time")
+	cmd.Flags().Int64(flagCreatedBefore, 0, "created before this unix time")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -56,6 +56,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	k.SetRateLimiterFlags(ctx, genState.RateLimiterFlags)
+	k.SetCctxPruningFlags(ctx, genState.CctxPruningFlags)
 }
 
 // ExportGenesis returns the crosschain module's exported genesis.
@@ -98,5 +99,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.RateLimiterFlags = rateLimiterFlags
 	}
 
+	cctxPruningFlags, found := k.GetCctxPruningFlags(ctx)
+	if found {
+		genesis.CctxPruningFlags = cctxPruningFlags
+	}
+
 	return &genesis
 }
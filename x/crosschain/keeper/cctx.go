@@ -63,6 +63,15 @@ func (k Keeper) SetCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx) {
 	}
 	p := types.KeyPrefix(fmt.Sprintf("%s", types.CCTXKey))
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
+
+	// update the search indexes from the previous version of the cctx
+	var previous *types.CrossChainTx
+	if b := store.Get(types.KeyPrefix(cctx.Index)); b != nil {
+		previous = &types.CrossChainTx{}
+		k.cdc.MustUnmarshal(b, previous)
+	}
+	k.updateCctxIndexes(ctx, previous, &cctx)
+
 	b := k.cdc.MustMarshal(&cctx)
 	store.Set(types.KeyPrefix(cctx.Index), b)
 }
@@ -103,5 +112,11 @@ func (k Keeper) GetAllCrossChainTx(ctx sdk.Context) (list []types.CrossChainTx)
 func (k Keeper) RemoveCrossChainTx(ctx sdk.Context, index string) {
 	p := types.KeyPrefix(fmt.Sprintf("%s", types.CCTXKey))
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
+
+	if b := store.Get(types.KeyPrefix(index)); b != nil {
+		var cctx types.CrossChainTx
+		k.cdc.MustUnmarshal(b, &cctx)
+		k.updateCctxIndexes(ctx, &cctx, nil)
+	}
 	store.Delete(types.KeyPrefix(index))
 }
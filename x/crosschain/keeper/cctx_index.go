@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// IndexCrossChainTx sets the index entries of a cctx already in the store, used to backfill the indexes
+func (k Keeper) IndexCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx) {
+	k.updateCctxIndexes(ctx, nil, &cctx)
+}
+
+// updateCctxIndexes replaces the index entries of the previous version of a cctx by the entries of the new version
+// previous or cctx can be nil when the cctx is created or removed
+func (k Keeper) updateCctxIndexes(ctx sdk.Context, previous, cctx *types.CrossChainTx) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxIndexKeyPrefix))
+
+	var keys [][]byte
+	newKeys := make(map[string]bool)
+	if cctx != nil {
+		keys = cctx.CctxIndexKeys()
+		for _, key := range keys {
+			newKeys[string(key)] = true
+		}
+	}
+
+	oldKeys := make(map[string]bool)
+	if previous != nil {
+		for _, key := range previous.CctxIndexKeys() {
+			oldKeys[string(key)] = true
+			if !newKeys[string(key)] {
+				store.Delete(key)
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if !oldKeys[string(key)] {
+			store.Set(key, []byte{})
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// cctxPruningScanFactor bounds the number of index entries scanned per block to a multiple of the cctxs pruned
+const cctxPruningScanFactor = 10
+
+// prunableStatuses are the statuses of the cctxs that can be pruned once final
+var prunableStatuses = []types.CctxStatus{
+	types.CctxStatus_OutboundMined,
+	types.CctxStatus_Reverted,
+	types.CctxStatus_Aborted,
+}
+
+// PruneCctxs removes from the state the final cctxs not updated during the retention period, from the oldest.
+// At most MaxPrunedPerBlock cctxs are removed, an archival event is emitted for each of them.
+// The scan of each status resumes where it stopped in the previous block, so the cctxs that can't be pruned yet
+// don't prevent the pruning of the following ones, and restarts from the oldest once the cutoff is reached.
+// The finalized inbounds are kept to reject the inbounds of the pruned cctxs observed again.
+// The latest finalized cctx of each chain is kept for the observers to read its outbound.
+// It returns the number of cctxs pruned.
+func (k Keeper) PruneCctxs(ctx sdk.Context) int {
+	flags, found := k.GetCctxPruningFlags(ctx)
+	if !found || !flags.Enabled || flags.RetentionPeriod <= 0 || flags.MaxPrunedPerBlock == 0 {
+		return 0
+	}
+	maxPruned := int(flags.MaxPrunedPerBlock)
+	maxScanned := maxPruned * cctxPruningScanFactor
+	cutoff := ctx.BlockTime().Unix() - flags.RetentionPeriod
+	if cutoff < 0 {
+		return 0
+	}
+
+	// collect the cctxs before removing them to not write the store while iterating it
+	var cctxs []types.CrossChainTx
+	scanned := 0
+	latestFinalized := k.latestFinalizedNonces(ctx)
+	for _, status := range prunableStatuses {
+		if len(cctxs) >= maxPruned || scanned >= maxScanned {
+			break
+		}
+		store := prefix.NewStore(
+			ctx.KVStore(k.storeKey),
+			append(
+				types.KeyPrefix(types.CctxIndexKeyPrefix),
+				types.CctxIndexPrefix(types.CctxIndexFieldStatus, fmt.Sprintf("%d", status))...,
+			),
+		)
+
+		// the cctxs updated before the cutoff are created before it
+		start, end := k.getCctxPruningCursor(ctx, status), types.CctxIndexTimestamp(cutoff+1)
+		if start != nil && bytes.Compare(start, end) >= 0 {
+			start = nil
+		}
+		iterator := store.Iterator(start, end)
+		var lastKey []byte
+		for ; iterator.Valid() && len(cctxs) < maxPruned && scanned < maxScanned; iterator.Next() {
+			scanned++
+			key := iterator.Key()
+			lastKey = key
+			if len(key) <= 8 {
+				continue
+			}
+			cctx, found := k.GetCrossChainTx(ctx, string(key[8:]))
+			if !found || !cctx.IsPrunable() || cctx.CctxStatus.LastUpdateTimestamp > cutoff ||
+				latestFinalized.contains(cctx) {
+				continue
+			}
+			cctxs = append(cctxs, cctx)
+		}
+
+		// resume after the last scanned entry, or from the oldest once all the entries before the cutoff are scanned
+		if iterator.Valid() && lastKey != nil {
+			k.setCctxPruningCursor(ctx, status, append(bytes.Clone(lastKey), 0))
+		} else {
+			k.removeCctxPruningCursor(ctx, status)
+		}
+		if err := iterator.Close(); err != nil {
+			ctx.Logger().Error("PruneCctxs: error closing iterator", "error", err)
+		}
+	}
+
+	for _, cctx := range cctxs {
+		k.pruneCrossChainTx(ctx, cctx)
+	}
+	return len(cctxs)
+}
+
+// finalizedNonces are the nonces of the latest finalized outbounds of the current TSS by chain,
+// read from the pending nonces of the chains when first needed
+type finalizedNonces struct {
+	ctx    sdk.Context
+	k      Keeper
+	tss    string
+	nonces map[int64]int64
+}
+
+// latestFinalizedNonces returns the nonces of the latest finalized outbounds of the current TSS
+func (k Keeper) latestFinalizedNonces(ctx sdk.Context) *finalizedNonces {
+	tss, found := k.GetObserverKeeper().GetTSS(ctx)
+	if !found {
+		return &finalizedNonces{}
+	}
+	return &finalizedNonces{
+		ctx:    ctx,
+		k:      k,
+		tss:    tss.TssPubkey,
+		nonces: make(map[int64]int64),
+	}
+}
+
+// contains returns true if an outbound of the cctx is the latest finalized outbound of its chain,
+// the nonce before the lowest pending nonce of the chain
+func (f *finalizedNonces) contains(cctx types.CrossChainTx) bool {
+	if f.tss == "" {
+		return false
+	}
+	for _, outbound := range cctx.OutboundParams {
+		if outbound == nil || outbound.TssPubkey != f.tss {
+			continue
+		}
+		chainID := outbound.ReceiverChainId
+		nonce, found := f.nonces[chainID]
+		if !found {
+			nonce = -1
+			if pendingNonces, found := f.k.GetObserverKeeper().GetPendingNonces(f.ctx, f.tss, chainID); found {
+				nonce = pendingNonces.NonceLow - 1
+			}
+			f.nonces[chainID] = nonce
+		}
+		// #nosec G115 always in range
+		if nonce == int64(outbound.TssNonce) {
+			return true
+		}
+	}
+	return false
+}
+
+// getCctxPruningCursor returns the index key where the pruning of the status resumes, nil to start from the oldest
+func (k Keeper) getCctxPruningCursor(ctx sdk.Context, status types.CctxStatus) []byte {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruningCursorKeyPrefix))
+	return store.Get(cctxPruningCursorKey(status))
+}
+
+// setCctxPruningCursor sets the index key where the pruning of the status resumes
+func (k Keeper) setCctxPruningCursor(ctx sdk.Context, status types.CctxStatus, key []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruningCursorKeyPrefix))
+	store.Set(cctxPruningCursorKey(status), key)
+}
+
+// removeCctxPruningCursor restarts the pruning of the status from the oldest cctx
+func (k Keeper) removeCctxPruningCursor(ctx sdk.Context, status types.CctxStatus) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruningCursorKeyPrefix))
+	store.Delete(cctxPruningCursorKey(status))
+}
+
+// cctxPruningCursorKey returns the key of the pruning cursor of the status
+func cctxPruningCursorKey(status types.CctxStatus) []byte {
+	return []byte(fmt.Sprintf("%d", status))
+}
+
+// pruneCrossChainTx emits the archival event of a cctx and removes it with its inbound hash and nonce mappings
+func (k Keeper) pruneCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx) {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCctxPruned{
+		CctxIndex: cctx.Index,
+		Cctx:      &cctx,
+	}); err != nil {
+		ctx.Logger().Error("PruneCctxs: error emitting EventCctxPruned", "error", err, "cctx", cctx.Index)
+	}
+
+	if cctx.InboundParams != nil {
+		inboundHash := cctx.InboundParams.ObservedHash
+		if in, found := k.GetInboundHashToCctx(ctx, inboundHash); found {
+			indexes := make([]string, 0, len(in.CctxIndex))
+			for _, index := range in.CctxIndex {
+				if index != cctx.Index {
+					indexes = append(indexes, index)
+				}
+			}
+			if len(indexes) == 0 {
+				k.RemoveInboundHashToCctx(ctx, inboundHash)
+			} else {
+				in.CctxIndex = indexes
+				k.SetInboundHashToCctx(ctx, in)
+			}
+		}
+	}
+
+	for _, outbound := range cctx.OutboundParams {
+		if outbound == nil || outbound.TssPubkey == "" {
+			continue
+		}
+		// #nosec G115 always in range
+		nonce := int64(outbound.TssNonce)
+		nonceToCctx, found := k.GetObserverKeeper().GetNonceToCctx(ctx, outbound.TssPubkey, outbound.ReceiverChainId, nonce)
+		if found && nonceToCctx.CctxIndex == cctx.Index {
+			k.GetObserverKeeper().RemoveNonceToCctx(ctx, observertypes.NonceToCctx{
+				ChainId: outbound.ReceiverChainId,
+				Nonce:   nonce,
+				Tss:     outbound.TssPubkey,
+			})
+		}
+	}
+
+	k.RemoveCrossChainTx(ctx, cctx.Index)
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// SetCctxPruningFlags set the cctx pruning flags in the store
+func (k Keeper) SetCctxPruningFlags(ctx sdk.Context, cctxPruningFlags types.CctxPruningFlags) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruningFlagsKey))
+	b := k.cdc.MustMarshal(&cctxPruningFlags)
+	store.Set([]byte{0}, b)
+}
+
+// GetCctxPruningFlags returns the cctx pruning flags
+func (k Keeper) GetCctxPruningFlags(ctx sdk.Context) (val types.CctxPruningFlags, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruningFlagsKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
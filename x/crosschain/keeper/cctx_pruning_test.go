@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/keeper"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+)
+
+// setPrunedCctx sets in the store a cctx with the status, updated at the timestamp, with its nonce and inbound hash
+func setPrunedCctx(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	zk keepertest.ZetaKeepers,
+	i int,
+	status types.CctxStatus,
+	timestamp int64,
+) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, fmt.Sprintf("prune-%d", i))
+	cctx.CctxStatus.Status = status
+	cctx.CctxStatus.CreatedTimestamp = timestamp
+	cctx.CctxStatus.LastUpdateTimestamp = timestamp
+	cctx.OutboundParams = cctx.OutboundParams[:1]
+	cctx.OutboundParams[0].TssNonce = uint64(i)
+	cctx.OutboundParams[0].TssPubkey = "tss"
+
+	// set at genesis to keep the update timestamp
+	k.SetCrossChainTx(ctx.WithBlockHeight(0), *cctx)
+	k.SetInboundHashToCctx(ctx, types.InboundHashToCctx{
+		InboundHash: cctx.InboundParams.ObservedHash,
+		CctxIndex:   []string{cctx.Index},
+	})
+	zk.ObserverKeeper.SetNonceToCctx(ctx, observertypes.NonceToCctx{
+		ChainId:   cctx.OutboundParams[0].ReceiverChainId,
+		Nonce:     int64(i),
+		CctxIndex: cctx.Index,
+		Tss:       cctx.OutboundParams[0].TssPubkey,
+	})
+	return *cctx
+}
+
+func isPruned(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	zk keepertest.ZetaKeepers,
+	cctx types.CrossChainTx,
+) bool {
+	_, found := k.GetCrossChainTx(ctx, cctx.Index)
+	_, foundInbound := k.GetInboundHashToCctx(ctx, cctx.InboundParams.ObservedHash)
+	_, foundNonce := zk.ObserverKeeper.GetNonceToCctx(
+		ctx,
+		cctx.OutboundParams[0].TssPubkey,
+		cctx.OutboundParams[0].ReceiverChainId,
+		int64(cctx.OutboundParams[0].TssNonce),
+	)
+	require.Equal(t, found, foundInbound)
+	require.Equal(t, found, foundNonce)
+	return !found
+}
+
+func TestKeeper_PruneCctxs(t *testing.T) {
+	t.Run("should not prune if the pruning is disabled", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockTime(time.Unix(10000, 0))
+		cctx := setPrunedCctx(t, ctx, k, zk, 0, types.CctxStatus_OutboundMined, 100)
+
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+
+		flags := sample.CctxPruningFlags()
+		flags.Enabled = false
+		k.SetCctxPruningFlags(ctx, flags)
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		require.False(t, isPruned(t, ctx, k, zk, cctx))
+	})
+
+	t.Run("should prune the final cctxs older than the retention period", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockTime(time.Unix(10000, 0)).WithEventManager(sdk.NewEventManager())
+		k.SetCctxPruningFlags(ctx, types.CctxPruningFlags{
+			Enabled:           true,
+			RetentionPeriod:   1000,
+			MaxPrunedPerBlock: 10,
+		})
+
+		mined := setPrunedCctx(t, ctx, k, zk, 0, types.CctxStatus_OutboundMined, 100)
+		reverted := setPrunedCctx(t, ctx, k, zk, 1, types.CctxStatus_Reverted, 9000)
+		aborted := setPrunedCctx(t, ctx, k, zk, 2, types.CctxStatus_Aborted, 100)
+		pending := setPrunedCctx(t, ctx, k, zk, 3, types.CctxStatus_PendingOutbound, 100)
+		recent := setPrunedCctx(t, ctx, k, zk, 4, types.CctxStatus_OutboundMined, 9001)
+		k.AddFinalizedInbound(ctx, mined.InboundParams.ObservedHash, mined.InboundParams.SenderChainId, 0)
+
+		require.Equal(t, 2, k.PruneCctxs(ctx))
+		require.True(t, isPruned(t, ctx, k, zk, mined))
+		require.True(t, isPruned(t, ctx, k, zk, reverted))
+		require.False(t, isPruned(t, ctx, k, zk, aborted))
+		require.False(t, isPruned(t, ctx, k, zk, pending))
+		require.False(t, isPruned(t, ctx, k, zk, recent))
+
+		// the inbound of the pruned cctx is still finalized
+		require.True(t, k.IsFinalizedInbound(
+			ctx,
+			mined.InboundParams.ObservedHash,
+			mined.InboundParams.SenderChainId,
+			0,
+		))
+
+		// the pruned cctxs are archived in events
+		var archived int
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "zetachain.zetacore.crosschain.EventCctxPruned" {
+				archived++
+			}
+		}
+		require.Equal(t, 2, archived)
+
+		// the refunded aborted cctx is pruned
+		aborted.CctxStatus.IsAbortRefunded = true
+		k.SetCrossChainTx(ctx.WithBlockHeight(0), aborted)
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.True(t, isPruned(t, ctx, k, zk, aborted))
+	})
+
+	t.Run("should prune at most the max pruned per block", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockTime(time.Unix(10000, 0))
+		k.SetCctxPruningFlags(ctx, types.CctxPruningFlags{
+			Enabled:           true,
+			RetentionPeriod:   1000,
+			MaxPrunedPerBlock: 2,
+		})
+
+		var cctxs []types.CrossChainTx
+		for i := 0; i < 3; i++ {
+			cctxs = append(cctxs, setPrunedCctx(t, ctx, k, zk, i, types.CctxStatus_OutboundMined, int64(100+i)))
+		}
+
+		require.Equal(t, 2, k.PruneCctxs(ctx))
+		require.True(t, isPruned(t, ctx, k, zk, cctxs[0]))
+		require.True(t, isPruned(t, ctx, k, zk, cctxs[1]))
+		require.False(t, isPruned(t, ctx, k, zk, cctxs[2]))
+
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.True(t, isPruned(t, ctx, k, zk, cctxs[2]))
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+	})
+	t.Run("should keep the latest finalized cctx of each chain for the current TSS", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockTime(time.Unix(10000, 0))
+		k.SetCctxPruningFlags(ctx, types.CctxPruningFlags{
+			Enabled:           true,
+			RetentionPeriod:   1000,
+			MaxPrunedPerBlock: 10,
+		})
+
+		older := setPrunedCctx(t, ctx, k, zk, 0, types.CctxStatus_OutboundMined, 100)
+		latest := setPrunedCctx(t, ctx, k, zk, 1, types.CctxStatus_OutboundMined, 100)
+		latest.OutboundParams[0].ReceiverChainId = older.OutboundParams[0].ReceiverChainId
+		k.SetCrossChainTx(ctx.WithBlockHeight(0), latest)
+		zk.ObserverKeeper.SetNonceToCctx(ctx, observertypes.NonceToCctx{
+			ChainId:   latest.OutboundParams[0].ReceiverChainId,
+			Nonce:     1,
+			CctxIndex: latest.Index,
+			Tss:       "tss",
+		})
+
+		zk.ObserverKeeper.SetTSS(ctx, observertypes.TSS{TssPubkey: "tss"})
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			NonceLow:  2,
+			NonceHigh: 2,
+			ChainId:   latest.OutboundParams[0].ReceiverChainId,
+			Tss:       "tss",
+		})
+
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.True(t, isPruned(t, ctx, k, zk, older))
+		require.False(t, isPruned(t, ctx, k, zk, latest))
+
+		// the cctx is pruned once a following outbound is finalized
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			NonceLow:  3,
+			NonceHigh: 3,
+			ChainId:   latest.OutboundParams[0].ReceiverChainId,
+			Tss:       "tss",
+		})
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.True(t, isPruned(t, ctx, k, zk, latest))
+	})
+
+	t.Run("should resume the scan after the cctxs that can't be pruned yet", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockTime(time.Unix(10000, 0))
+		k.SetCctxPruningFlags(ctx, types.CctxPruningFlags{
+			Enabled:           true,
+			RetentionPeriod:   1000,
+			MaxPrunedPerBlock: 1,
+		})
+
+		// the oldest cctxs fill the scan of a block, they were updated during the retention period
+		var updated []types.CrossChainTx
+		for i := 0; i < 10; i++ {
+			cctx := setPrunedCctx(t, ctx, k, zk, i, types.CctxStatus_OutboundMined, int64(100+i))
+			cctx.CctxStatus.LastUpdateTimestamp = 9500
+			k.SetCrossChainTx(ctx.WithBlockHeight(0), cctx)
+			updated = append(updated, cctx)
+		}
+		prunable := setPrunedCctx(t, ctx, k, zk, 10, types.CctxStatus_OutboundMined, 200)
+
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.True(t, isPruned(t, ctx, k, zk, prunable))
+
+		// the scan restarts from the oldest cctxs once they can be pruned
+		ctx = ctx.WithBlockTime(time.Unix(10600, 0))
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.True(t, isPruned(t, ctx, k, zk, updated[0]))
+		require.False(t, isPruned(t, ctx, k, zk, updated[1]))
+	})
+}
@@ -149,10 +149,14 @@ func (k Keeper) ListPendingCctx(
 		startNonce = 0
 	}
 	for i := startNonce; i < pendingNonces.NonceLow; i++ {
-		cctx, err := getCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, req.ChainId, i)
+		// the finalized cctxs may have been pruned
+		cctx, found, err := findCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, req.ChainId, i)
 		if err != nil {
 			return nil, err
 		}
+		if !found {
+			continue
+		}
 
 		// only take a `limit` number of pending cctxs as result but still count the total pending cctxs
 		if IsPending(cctx) {
@@ -190,16 +194,38 @@ func getCctxByChainIDAndNonce(
 	chainID int64,
 	nonce int64,
 ) (*types.CrossChainTx, error) {
-	nonceToCctx, found := k.GetObserverKeeper().GetNonceToCctx(ctx, tssPubkey, chainID, nonce)
+	cctx, found, err := findCctxByChainIDAndNonce(k, ctx, tssPubkey, chainID, nonce)
+	if err != nil {
+		return nil, err
+	}
 	if !found {
 		return nil, status.Error(
 			codes.Internal,
 			fmt.Sprintf("nonceToCctx not found: chainid %d, nonce %d", chainID, nonce),
 		)
 	}
+	return cctx, nil
+}
+
+// findCctxByChainIDAndNonce returns the cctx by chainID and nonce, found is false if the nonce has no cctx
+// this is the case of the nonces of the pruned cctxs
+func findCctxByChainIDAndNonce(
+	k Keeper,
+	ctx sdk.Context,
+	tssPubkey string,
+	chainID int64,
+	nonce int64,
+) (*types.CrossChainTx, bool, error) {
+	nonceToCctx, found := k.GetObserverKeeper().GetNonceToCctx(ctx, tssPubkey, chainID, nonce)
+	if !found {
+		return nil, false, nil
+	}
 	cctx, found := k.GetCrossChainTx(ctx, nonceToCctx.CctxIndex)
 	if !found {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("cctx not found: index %s", nonceToCctx.CctxIndex))
+		return nil, false, status.Error(
+			codes.Internal,
+			fmt.Sprintf("cctx not found: index %s", nonceToCctx.CctxIndex),
+		)
 	}
-	return &cctx, nil
+	return &cctx, true, nil
 }
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// CctxPruningFlags queries the cctx pruning flags
+func (k Keeper) CctxPruningFlags(
+	c context.Context,
+	req *types.QueryCctxPruningFlagsRequest,
+) (*types.QueryCctxPruningFlagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	cctxPruningFlags, found := k.GetCctxPruningFlags(ctx)
+	if !found {
+		return nil, status.Error(codes.Internal, "not found")
+	}
+
+	return &types.QueryCctxPruningFlagsResponse{CctxPruningFlags: cctxPruningFlags}, nil
+}
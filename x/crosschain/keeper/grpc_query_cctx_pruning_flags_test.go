@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestKeeper_CctxPruningFlags(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.CctxPruningFlags(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if cctx pruning flags not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.CctxPruningFlags(wctx, &types.QueryCctxPruningFlagsRequest{})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return if cctx pruning flags found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		flags := sample.CctxPruningFlags()
+		k.SetCctxPruningFlags(ctx, flags)
+
+		res, err := k.CctxPruningFlags(wctx, &types.QueryCctxPruningFlagsRequest{})
+
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryCctxPruningFlagsResponse{
+			CctxPruningFlags: flags,
+		}, res)
+	})
+}
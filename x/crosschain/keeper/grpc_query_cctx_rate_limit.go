@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	sdkmath "cosmossdk.io/math"
@@ -130,10 +131,20 @@ func (k Keeper) RateLimiterInput(
 
 		// go all the way back to the left window boundary or `NonceLow - 1000`, depending on which on arrives first
 		for nonce := startNonce; nonce >= 0; nonce-- {
-			cctx, err := getCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
+			cctx, found, err := findCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
 			if err != nil {
 				return nil, err
 			}
+			if !found {
+				// the cctxs before a pruned cctx are finalized and out of the window
+				if nonce < pendingNonces.NonceLow {
+					break
+				}
+				return nil, status.Error(
+					codes.Internal,
+					fmt.Sprintf("nonceToCctx not found: chainid %d, nonce %d", chain.ChainId, nonce),
+				)
+			}
 			inWindow := isCCTXInWindow(cctx)
 			isOutgoing := isCCTXOutgoing(cctx)
 			isPast := isPastCctx(cctx, pendingNonces.NonceLow)
@@ -331,10 +342,14 @@ func (k Keeper) ListPendingCctxWithinRateLimit(
 
 		// query cctx by nonce backwards to the left boundary of the rate limit sliding window
 		for nonce := startNonce; nonce >= 0; nonce-- {
-			cctx, err := getCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
+			cctx, found, err := findCctxByChainIDAndNonce(k, ctx, tss.TssPubkey, chain.ChainId, nonce)
 			if err != nil {
 				return nil, err
 			}
+			if !found {
+				// the cctxs before a pruned cctx are finalized and out of the window
+				break
+			}
 			inWindow := isCCTXInWindow(cctx)
 			isOutgoing := isCCTXOutgoing(cctx)
 
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// MaxCctxSearchScan is the maximum number of index entries scanned by a search query,
+// a next key is returned to continue the search if it is reached
+const MaxCctxSearchScan = 10000
+
+// cctxSearchFilter is a field value a cctx must be indexed with
+type cctxSearchFilter struct {
+	field string
+	value string
+}
+
+// CctxSearch returns the cctxs matching all the filters of the request, sorted by creation time.
+// The most selective filter drives the iteration over its index, the other filters are checked on each cctx.
+func (k Keeper) CctxSearch(
+	c context.Context,
+	req *types.QueryCctxSearchRequest,
+) (*types.QueryCctxSearchResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pagination := req.Pagination
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+	if pagination.Offset > 0 || pagination.CountTotal {
+		return nil, status.Error(codes.InvalidArgument, "only the pagination key is supported")
+	}
+	limit := pagination.Limit
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+	if req.CreatedBefore > 0 && req.CreatedBefore <= req.CreatedAfter {
+		return nil, status.Error(codes.InvalidArgument, "created before must be after created after")
+	}
+
+	filters, err := k.cctxSearchFilters(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the first filter is the most selective one
+	driving := cctxSearchFilter{field: types.CctxIndexFieldTime}
+	if len(filters) > 0 {
+		driving, filters = filters[0], filters[1:]
+	}
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		append(types.KeyPrefix(types.CctxIndexKeyPrefix), types.CctxIndexPrefix(driving.field, driving.value)...),
+	)
+
+	// the keys of the index store are the creation time followed by the cctx index
+	var start, end []byte
+	if req.CreatedAfter > 0 {
+		start = types.CctxIndexTimestamp(req.CreatedAfter)
+	}
+	if req.CreatedBefore > 0 {
+		end = types.CctxIndexTimestamp(req.CreatedBefore)
+	}
+	if len(pagination.Key) > 0 {
+		if pagination.Reverse {
+			// the next key is included
+			end = append(bytes.Clone(pagination.Key), 0)
+		} else if bytes.Compare(pagination.Key, start) > 0 {
+			start = pagination.Key
+		}
+	}
+
+	var iterator storetypes.Iterator
+	if pagination.Reverse {
+		iterator = store.ReverseIterator(start, end)
+	} else {
+		iterator = store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	cctxs := make([]*types.CrossChainTx, 0)
+	var nextKey []byte
+	for scanned := 0; iterator.Valid(); iterator.Next() {
+		// #nosec G115 len always positive
+		if uint64(len(cctxs)) >= limit || scanned >= MaxCctxSearchScan {
+			nextKey = bytes.Clone(iterator.Key())
+			break
+		}
+		scanned++
+
+		key := iterator.Key()
+		if len(key) <= 8 {
+			continue
+		}
+		cctx, found := k.GetCrossChainTx(ctx, string(key[8:]))
+		if !found || !matchCctxSearchFilters(cctx, filters) {
+			continue
+		}
+		cctxs = append(cctxs, &cctx)
+	}
+
+	return &types.QueryCctxSearchResponse{
+		CrossChainTx: cctxs,
+		Pagination:   &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// cctxSearchFilters returns the filters of the request, from the most to the least selective
+func (k Keeper) cctxSearchFilters(ctx sdk.Context, req *types.QueryCctxSearchRequest) ([]cctxSearchFilter, error) {
+	var filters []cctxSearchFilter
+	if req.Sender != "" {
+		filters = append(filters, cctxSearchFilter{
+			field: types.CctxIndexFieldSender,
+			value: types.NormalizeCctxIndexAddress(req.Sender),
+		})
+	}
+	if req.Receiver != "" {
+		filters = append(filters, cctxSearchFilter{
+			field: types.CctxIndexFieldReceiver,
+			value: types.NormalizeCctxIndexAddress(req.Receiver),
+		})
+	}
+	if req.Asset != "" {
+		foreignCoin, found := k.fungibleKeeper.GetForeignCoins(ctx, req.Asset)
+		if !found && ethcommon.IsHexAddress(req.Asset) {
+			foreignCoin, found = k.fungibleKeeper.GetForeignCoins(ctx, ethcommon.HexToAddress(req.Asset).Hex())
+		}
+		if !found {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("zrc20 %s not found", req.Asset))
+		}
+		filters = append(filters, cctxSearchFilter{
+			field: types.CctxIndexFieldAsset,
+			value: types.CctxIndexAsset(foreignCoin.ForeignChainId, foreignCoin.CoinType, foreignCoin.Asset),
+		})
+	}
+	if req.ReceiverChainId != 0 {
+		filters = append(filters, cctxSearchFilter{
+			field: types.CctxIndexFieldReceiverChain,
+			value: fmt.Sprintf("%d", req.ReceiverChainId),
+		})
+	}
+	if req.SenderChainId != 0 {
+		filters = append(filters, cctxSearchFilter{
+			field: types.CctxIndexFieldSenderChain,
+			value: fmt.Sprintf("%d", req.SenderChainId),
+		})
+	}
+	if req.Status != "" {
+		cctxStatus, ok := types.CctxStatus_value[req.Status]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid status %s", req.Status))
+		}
+		filters = append(filters, cctxSearchFilter{
+			field: types.CctxIndexFieldStatus,
+			value: fmt.Sprintf("%d", cctxStatus),
+		})
+	}
+	return filters, nil
+}
+
+// matchCctxSearchFilters returns true if the cctx is indexed with all the filters
+func matchCctxSearchFilters(cctx types.CrossChainTx, filters []cctxSearchFilter) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	keys := make(map[string]bool)
+	for _, key := range cctx.CctxIndexKeys() {
+		keys[string(key)] = true
+	}
+	var createdTimestamp int64
+	if cctx.CctxStatus != nil {
+		createdTimestamp = cctx.CctxStatus.CreatedTimestamp
+	}
+	for _, filter := range filters {
+		if !keys[string(types.CctxIndexKey(filter.field, filter.value, createdTimestamp, cctx.Index))] {
+			return false
+		}
+	}
+	return true
+}
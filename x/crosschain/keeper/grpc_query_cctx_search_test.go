@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/node/x/fungible/types"
+)
+
+// searchCctx returns a cctx created at the timestamp, sent by the sender from the chain
+func searchCctx(t *testing.T, i int, sender string, senderChainID int64) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, fmt.Sprintf("search-%d", i))
+	cctx.CctxStatus.CreatedTimestamp = int64(1000 + i)
+	cctx.CctxStatus.Status = types.CctxStatus_PendingOutbound
+	cctx.InboundParams.Sender = sender
+	cctx.InboundParams.TxOrigin = sender
+	cctx.InboundParams.SenderChainId = senderChainID
+	cctx.InboundParams.CoinType = coin.CoinType_Gas
+	cctx.InboundParams.Asset = ""
+	return *cctx
+}
+
+func cctxIndexes(cctxs []*types.CrossChainTx) []string {
+	indexes := make([]string, 0, len(cctxs))
+	for _, cctx := range cctxs {
+		indexes = append(indexes, cctx.Index)
+	}
+	return indexes
+}
+
+func TestKeeper_CctxSearch(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxSearch(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if the pagination has an offset", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Pagination: &query.PageRequest{Offset: 1}})
+		require.Error(t, err)
+	})
+
+	t.Run("should error if the status is invalid", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Status: "Unknown"})
+		require.Error(t, err)
+	})
+
+	t.Run("should error if the asset is not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Asset: sample.EthAddress().Hex()})
+		require.Error(t, err)
+	})
+
+	t.Run("should search the cctxs with the combined filters", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		alice, bob := sample.EthAddress().Hex(), sample.EthAddress().Hex()
+
+		var all []string
+		for i := 0; i < 10; i++ {
+			sender, chainID := alice, int64(1)
+			if i%2 == 1 {
+				sender = bob
+			}
+			if i%3 == 0 {
+				chainID = 2
+			}
+			cctx := searchCctx(t, i, sender, chainID)
+			k.SetCrossChainTx(ctx, cctx)
+			all = append(all, cctx.Index)
+		}
+
+		// no filter
+		res, err := k.CctxSearch(ctx, &types.QueryCctxSearchRequest{})
+		require.NoError(t, err)
+		require.Equal(t, all, cctxIndexes(res.CrossChainTx))
+
+		// sender, case-insensitive
+		res, err = k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Sender: bob})
+		require.NoError(t, err)
+		require.Equal(t, []string{all[1], all[3], all[5], all[7], all[9]}, cctxIndexes(res.CrossChainTx))
+
+		// sender and chain
+		res, err = k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Sender: bob, SenderChainId: 2})
+		require.NoError(t, err)
+		require.Equal(t, []string{all[3], all[9]}, cctxIndexes(res.CrossChainTx))
+
+		// chain and time range
+		res, err = k.CctxSearch(ctx, &types.QueryCctxSearchRequest{
+			SenderChainId: 2,
+			CreatedAfter:  1003,
+			CreatedBefore: 1009,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{all[3], all[6]}, cctxIndexes(res.CrossChainTx))
+
+		// asset
+		zrc20 := sample.EthAddress().Hex()
+		zk.FungibleKeeper.SetForeignCoins(ctx, fungibletypes.ForeignCoins{
+			Zrc20ContractAddress: zrc20,
+			ForeignChainId:       2,
+			CoinType:             coin.CoinType_Gas,
+		})
+		res, err = k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Asset: zrc20, Sender: alice})
+		require.NoError(t, err)
+		require.Equal(t, []string{all[0], all[6]}, cctxIndexes(res.CrossChainTx))
+	})
+
+	t.Run("should update the indexes when the status changes", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := searchCctx(t, 0, sample.EthAddress().Hex(), 1)
+		k.SetCrossChainTx(ctx, cctx)
+
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCrossChainTx(ctx, cctx)
+
+		res, err := k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Status: "PendingOutbound"})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+
+		res, err = k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Status: "OutboundMined"})
+		require.NoError(t, err)
+		require.Equal(t, []string{cctx.Index}, cctxIndexes(res.CrossChainTx))
+
+		// removed cctxs are no longer indexed
+		k.RemoveCrossChainTx(ctx, cctx.Index)
+		res, err = k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Status: "OutboundMined"})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+	})
+
+	t.Run("should paginate with the next key", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		var all []string
+		for i := 0; i < 5; i++ {
+			cctx := searchCctx(t, i, sample.EthAddress().Hex(), 1)
+			k.SetCrossChainTx(ctx, cctx)
+			all = append(all, cctx.Index)
+		}
+
+		for _, reverse := range []bool{false, true} {
+			var indexes []string
+			var key []byte
+			for {
+				res, err := k.CctxSearch(ctx, &types.QueryCctxSearchRequest{
+					SenderChainId: 1,
+					Pagination:    &query.PageRequest{Key: key, Limit: 2, Reverse: reverse},
+				})
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(res.CrossChainTx), 2)
+				indexes = append(indexes, cctxIndexes(res.CrossChainTx)...)
+				key = res.Pagination.NextKey
+				if key == nil {
+					break
+				}
+			}
+			if reverse {
+				require.Equal(t, []string{all[4], all[3], all[2], all[1], all[0]}, indexes)
+			} else {
+				require.Equal(t, all, indexes)
+			}
+		}
+	})
+}
@@ -185,6 +185,28 @@ func TestKeeper_CctxListPending(t *testing.T) {
 		// pending nonce + 2
 		require.EqualValues(t, uint64(1002), res.TotalPending)
 	})
+
+	t.Run("can retrieve pending cctx if the cctxs below nonce low are pruned", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID()
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		cctxs := createCctxWithNonceRange(t, ctx, *k, 1000, 1100, chainID, tss, zk)
+
+		// prune the cctxs below nonce 950
+		for i := int64(0); i < 950; i++ {
+			zk.ObserverKeeper.RemoveNonceToCctx(ctx, observertypes.NonceToCctx{
+				ChainId: chainID,
+				Nonce:   i,
+				Tss:     tss.TssPubkey,
+			})
+		}
+
+		res, err := k.ListPendingCctx(ctx, &types.QueryListPendingCctxRequest{ChainId: chainID})
+		require.NoError(t, err)
+		require.EqualValues(t, cctxs, res.CrossChainTx)
+		require.EqualValues(t, uint64(100), res.TotalPending)
+	})
 }
 
 func TestKeeper_ZetaAccounting(t *testing.T) {
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// UpdateCctxPruningFlags updates the cctx pruning flags.
+// Authorized: admin policy operational.
+func (k msgServer) UpdateCctxPruningFlags(
+	goCtx context.Context,
+	msg *types.MsgUpdateCctxPruningFlags,
+) (*types.MsgUpdateCctxPruningFlagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errorsmod.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+	k.SetCctxPruningFlags(ctx, msg.CctxPruningFlags)
+
+	return &types.MsgUpdateCctxPruningFlagsResponse{}, nil
+}
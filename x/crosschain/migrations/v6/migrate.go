@@ -0,0 +1,44 @@
+package v6
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// crosschainKeeper is an interface to prevent cyclic dependency
+type crosschainKeeper interface {
+	GetStoreKey() storetypes.StoreKey
+	GetCodec() codec.Codec
+	IndexCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx)
+}
+
+// MigrateStore migrates the x/crosschain module state from the consensus version 5 to 6
+// It backfills the search indexes of the existing cctxs, the cctxs are indexed while walking the store
+// so they're never all loaded in memory
+func MigrateStore(ctx sdk.Context, crosschainKeeper crosschainKeeper) error {
+	p := types.KeyPrefix(fmt.Sprintf("%s", types.CCTXKey))
+	store := prefix.NewStore(ctx.KVStore(crosschainKeeper.GetStoreKey()), p)
+	cdc := crosschainKeeper.GetCodec()
+
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		var cctx types.CrossChainTx
+		if err := cdc.Unmarshal(iterator.Value(), &cctx); err != nil {
+			return fmt.Errorf("unable to unmarshal cctx %x: %w", iterator.Key(), err)
+		}
+		crosschainKeeper.IndexCrossChainTx(ctx, cctx)
+		count++
+	}
+
+	ctx.Logger().Info("MigrateStore: indexed cctxs", "count", count)
+	return nil
+}
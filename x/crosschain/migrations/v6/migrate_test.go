@@ -0,0 +1,49 @@
+package v6_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	v6 "github.com/zeta-chain/node/x/crosschain/migrations/v6"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+	for i := 0; i < 10; i++ {
+		k.SetCrossChainTx(ctx, *sample.CrossChainTx(t, fmt.Sprintf("%d", i)))
+	}
+
+	// remove the indexes to get the state before the migration
+	store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), types.KeyPrefix(types.CctxIndexKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	require.NoError(t, iterator.Close())
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	res, err := k.CctxSearch(ctx, &types.QueryCctxSearchRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.CrossChainTx)
+
+	require.NoError(t, v6.MigrateStore(ctx, k))
+
+	res, err = k.CctxSearch(ctx, &types.QueryCctxSearchRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.CrossChainTx, 10)
+	for _, cctx := range res.CrossChainTx {
+		sender, err := k.CctxSearch(ctx, &types.QueryCctxSearchRequest{Sender: cctx.InboundParams.Sender})
+		require.NoError(t, err)
+		require.Len(t, sender.CrossChainTx, 1)
+		require.Equal(t, cctx.Index, sender.CrossChainTx[0].Index)
+	}
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/node/pkg/coin"
+)
+
+// fields of the secondary indexes of the cctxs
+const (
+	CctxIndexFieldSender        = "sender"
+	CctxIndexFieldReceiver      = "receiver"
+	CctxIndexFieldStatus        = "status"
+	CctxIndexFieldSenderChain   = "senderChain"
+	CctxIndexFieldReceiverChain = "receiverChain"
+	CctxIndexFieldAsset         = "asset"
+
+	// CctxIndexFieldTime indexes all the cctxs by creation time only
+	CctxIndexFieldTime = "time"
+)
+
+// CctxIndexPrefix returns the prefix of the index entries of a field value, relative to CctxIndexKeyPrefix
+func CctxIndexPrefix(field, value string) []byte {
+	return []byte(field + "/" + value + "/")
+}
+
+// CctxIndexKey returns the key of the index entry of a cctx for a field value, relative to CctxIndexKeyPrefix.
+// The entries of a field value are sorted by creation time of the cctx.
+func CctxIndexKey(field, value string, createdTimestamp int64, cctxIndex string) []byte {
+	key := CctxIndexPrefix(field, value)
+	key = append(key, CctxIndexTimestamp(createdTimestamp)...)
+	return append(key, cctxIndex...)
+}
+
+// CctxIndexTimestamp returns the sortable encoding of a creation time in the index keys
+func CctxIndexTimestamp(timestamp int64) []byte {
+	if timestamp < 0 {
+		timestamp = 0
+	}
+	// #nosec G115 positive
+	return binary.BigEndian.AppendUint64(nil, uint64(timestamp))
+}
+
+// NormalizeCctxIndexAddress returns the address as indexed, the hex addresses are case-insensitive
+func NormalizeCctxIndexAddress(address string) string {
+	if strings.HasPrefix(address, "0x") && ethcommon.IsHexAddress(address) {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// CctxIndexAsset returns the indexed value of an asset of a chain
+func CctxIndexAsset(chainID int64, coinType coin.CoinType, asset string) string {
+	return fmt.Sprintf("%d/%d/%s", chainID, coinType, strings.ToLower(asset))
+}
+
+// CctxIndexKeys returns the keys of the index entries of the cctx, relative to CctxIndexKeyPrefix
+func (m CrossChainTx) CctxIndexKeys() [][]byte {
+	var createdTimestamp int64
+	if m.CctxStatus != nil {
+		createdTimestamp = m.CctxStatus.CreatedTimestamp
+	}
+
+	seen := make(map[string]bool)
+	var keys [][]byte
+	add := func(field, value string) {
+		key := CctxIndexKey(field, value, createdTimestamp, m.Index)
+		if seen[string(key)] {
+			return
+		}
+		seen[string(key)] = true
+		keys = append(keys, key)
+	}
+
+	add(CctxIndexFieldTime, "")
+	if m.CctxStatus != nil {
+		add(CctxIndexFieldStatus, fmt.Sprintf("%d", m.CctxStatus.Status))
+	}
+
+	if m.InboundParams != nil {
+		if m.InboundParams.Sender != "" {
+			add(CctxIndexFieldSender, NormalizeCctxIndexAddress(m.InboundParams.Sender))
+		}
+		if m.InboundParams.TxOrigin != "" {
+			add(CctxIndexFieldSender, NormalizeCctxIndexAddress(m.InboundParams.TxOrigin))
+		}
+		add(CctxIndexFieldSenderChain, fmt.Sprintf("%d", m.InboundParams.SenderChainId))
+	}
+
+	for _, outbound := range m.OutboundParams {
+		if outbound == nil {
+			continue
+		}
+		if outbound.Receiver != "" {
+			add(CctxIndexFieldReceiver, NormalizeCctxIndexAddress(outbound.Receiver))
+		}
+		add(CctxIndexFieldReceiverChain, fmt.Sprintf("%d", outbound.ReceiverChainId))
+	}
+
+	// the asset is indexed for the foreign chains of the cctx, only the gas and ERC20 coins have a ZRC20
+	if m.InboundParams != nil &&
+		(m.InboundParams.CoinType == coin.CoinType_Gas || m.InboundParams.CoinType == coin.CoinType_ERC20) {
+		coinType, asset := m.InboundParams.CoinType, m.InboundParams.Asset
+		add(CctxIndexFieldAsset, CctxIndexAsset(m.InboundParams.SenderChainId, coinType, asset))
+		for _, outbound := range m.OutboundParams {
+			if outbound != nil {
+				add(CctxIndexFieldAsset, CctxIndexAsset(outbound.ReceiverChainId, coinType, asset))
+			}
+		}
+	}
+
+	return keys
+}
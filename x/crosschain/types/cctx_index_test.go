@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestCctxIndexKey(t *testing.T) {
+	// the keys of a value are sorted by creation time
+	require.Equal(t, -1, bytes.Compare(
+		types.CctxIndexKey(types.CctxIndexFieldStatus, "1", 255, "0xff"),
+		types.CctxIndexKey(types.CctxIndexFieldStatus, "1", 256, "0x00"),
+	))
+	require.True(t, bytes.HasPrefix(
+		types.CctxIndexKey(types.CctxIndexFieldStatus, "1", 10, "0x00"),
+		types.CctxIndexPrefix(types.CctxIndexFieldStatus, "1"),
+	))
+	require.Equal(t, types.CctxIndexTimestamp(0), types.CctxIndexTimestamp(-10))
+}
+
+func TestNormalizeCctxIndexAddress(t *testing.T) {
+	require.Equal(t,
+		"0x8d1e2f2bf2e0e1ac8bbab9a4e3fdfcde73dc6a94",
+		types.NormalizeCctxIndexAddress("0x8D1E2F2bF2E0e1ac8BbAB9A4E3FdfcDE73dc6a94"),
+	)
+	require.Equal(t,
+		"bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu",
+		types.NormalizeCctxIndexAddress("bc1qysd4sp9q8my59ul9wsf5rvs9p387hf8vfwatzu"),
+	)
+}
+
+func TestCrossChainTx_CctxIndexKeys(t *testing.T) {
+	t.Run("should index the fields of the cctx", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "index")
+		cctx.InboundParams.Sender = "0xAA00000000000000000000000000000000000000"
+		cctx.InboundParams.TxOrigin = "0xaa00000000000000000000000000000000000000"
+		cctx.InboundParams.CoinType = coin.CoinType_ERC20
+		cctx.InboundParams.Asset = "0xBB00000000000000000000000000000000000000"
+		cctx.InboundParams.SenderChainId = 1
+		cctx.OutboundParams[0].ReceiverChainId = 2
+		cctx.OutboundParams[1].ReceiverChainId = 1
+		cctx.CctxStatus.Status = types.CctxStatus_PendingInbound
+
+		keys := make(map[string]bool)
+		for _, key := range cctx.CctxIndexKeys() {
+			keys[string(key)] = true
+		}
+		has := func(field, value string) bool {
+			return keys[string(types.CctxIndexKey(field, value, cctx.CctxStatus.CreatedTimestamp, cctx.Index))]
+		}
+
+		// the sender and tx origin are deduplicated
+		require.Len(t, keys, 1+1+1+1+2+2+2)
+		require.True(t, has(types.CctxIndexFieldTime, ""))
+		require.True(t, has(types.CctxIndexFieldStatus, "0"))
+		require.True(t, has(types.CctxIndexFieldSender, "0xaa00000000000000000000000000000000000000"))
+		require.True(t, has(types.CctxIndexFieldSenderChain, "1"))
+		require.True(t, has(types.CctxIndexFieldReceiverChain, "2"))
+		require.True(t, has(types.CctxIndexFieldAsset, "1/2/0xbb00000000000000000000000000000000000000"))
+		require.True(t, has(types.CctxIndexFieldAsset, "2/2/0xbb00000000000000000000000000000000000000"))
+	})
+
+	t.Run("should not index the asset of the zeta coins", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "index")
+		cctx.InboundParams.CoinType = coin.CoinType_Zeta
+		for _, key := range cctx.CctxIndexKeys() {
+			require.False(t, bytes.HasPrefix(key, []byte(types.CctxIndexFieldAsset+"/")))
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package types
+
+import "fmt"
+
+// MaxCctxPrunedPerBlock is the upper bound of the number of cctxs pruned per block
+const MaxCctxPrunedPerBlock = 1000
+
+// Validate checks that the CctxPruningFlags is valid
+func (f CctxPruningFlags) Validate() error {
+	if f.RetentionPeriod < 0 {
+		return fmt.Errorf("retention period must not be negative: %d", f.RetentionPeriod)
+	}
+	if f.MaxPrunedPerBlock > MaxCctxPrunedPerBlock {
+		return fmt.Errorf("max pruned per block must not exceed %d: %d", MaxCctxPrunedPerBlock, f.MaxPrunedPerBlock)
+	}
+	if f.Enabled && (f.RetentionPeriod == 0 || f.MaxPrunedPerBlock == 0) {
+		return fmt.Errorf("retention period and max pruned per block must be set when enabled")
+	}
+	return nil
+}
+
+// IsPrunable returns true if the cctx is final and can be removed from the state.
+// The aborted cctxs are prunable only once refunded.
+func (m CrossChainTx) IsPrunable() bool {
+	if m.CctxStatus == nil {
+		return false
+	}
+	switch m.CctxStatus.Status {
+	case CctxStatus_OutboundMined, CctxStatus_Reverted:
+		return true
+	case CctxStatus_Aborted:
+		return m.CctxStatus.IsAbortRefunded
+	default:
+		return false
+	}
+}
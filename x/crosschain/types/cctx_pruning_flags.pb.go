@@ -0,0 +1,386 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zetachain/zetacore/crosschain/cctx_pruning_flags.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CctxPruningFlags configures the removal of the finalized cctxs from the state
+type CctxPruningFlags struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// time in seconds after the last update of a finalized cctx before it is
+	// pruned
+	RetentionPeriod int64 `protobuf:"varint,2,opt,name=retention_period,json=retentionPeriod,proto3" json:"retention_period,omitempty"`
+	// maximum number of cctxs pruned per block
+	MaxPrunedPerBlock uint32 `protobuf:"varint,3,opt,name=max_pruned_per_block,json=maxPrunedPerBlock,proto3" json:"max_pruned_per_block,omitempty"`
+}
+
+func (m *CctxPruningFlags) Reset()         { *m = CctxPruningFlags{} }
+func (m *CctxPruningFlags) String() string { return proto.CompactTextString(m) }
+func (*CctxPruningFlags) ProtoMessage()    {}
+func (*CctxPruningFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c64832b621cfec94, []int{0}
+}
+func (m *CctxPruningFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CctxPruningFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CctxPruningFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CctxPruningFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CctxPruningFlags.Merge(m, src)
+}
+func (m *CctxPruningFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *CctxPruningFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_CctxPruningFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CctxPruningFlags proto.InternalMessageInfo
+
+func (m *CctxPruningFlags) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *CctxPruningFlags) GetRetentionPeriod() int64 {
+	if m != nil {
+		return m.RetentionPeriod
+	}
+	return 0
+}
+
+func (m *CctxPruningFlags) GetMaxPrunedPerBlock() uint32 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CctxPruningFlags)(nil), "zetachain.zetacore.crosschain.CctxPruningFlags")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/crosschain/cctx_pruning_flags.proto", fileDescriptor_c64832b621cfec94)
+}
+
+var fileDescriptor_c64832b621cfec94 = []byte{
+	// 249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xab, 0x4a, 0x2d, 0x49,
+	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x93, 0x8b, 0xf2, 0x8b,
+	0x8b, 0x21, 0x62, 0xc9, 0xc9, 0x25, 0x15, 0xf1, 0x05, 0x45, 0xa5, 0x79, 0x99, 0x79, 0xe9, 0xf1,
+	0x69, 0x39, 0x89, 0xe9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xb2, 0x70, 0x7d, 0x7a,
+	0x30, 0x7d, 0x7a, 0x08, 0x7d, 0x4a, 0x1d, 0x8c, 0x5c, 0x02, 0xce, 0xc9, 0x25, 0x15, 0x01, 0x10,
+	0xad, 0x6e, 0x20, 0x9d, 0x42, 0x12, 0x5c, 0xec, 0xa9, 0x79, 0x89, 0x49, 0x39, 0xa9, 0x29, 0x12,
+	0x8c, 0x0a, 0x8c, 0x1a, 0x1c, 0x41, 0x30, 0xae, 0x90, 0x26, 0x97, 0x40, 0x51, 0x6a, 0x49, 0x6a,
+	0x5e, 0x49, 0x66, 0x7e, 0x5e, 0x7c, 0x41, 0x6a, 0x51, 0x66, 0x7e, 0x8a, 0x04, 0x93, 0x02, 0xa3,
+	0x06, 0x73, 0x10, 0x3f, 0x5c, 0x3c, 0x00, 0x2c, 0x2c, 0xa4, 0xcf, 0x25, 0x92, 0x9b, 0x08, 0x71,
+	0x53, 0x6a, 0x0a, 0x48, 0x6d, 0x7c, 0x52, 0x4e, 0x7e, 0x72, 0xb6, 0x04, 0xb3, 0x02, 0xa3, 0x06,
+	0x6f, 0x90, 0x60, 0x6e, 0x22, 0xd8, 0xce, 0xd4, 0x94, 0x80, 0xd4, 0x22, 0x27, 0x90, 0x84, 0x93,
+	0xfb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
+	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64,
+	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x82, 0x3d, 0xaf, 0x0b, 0xf1, 0x73, 0x5e, 0x7e, 0x4a, 0xaa,
+	0x7e, 0x05, 0x72, 0x28, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x7d, 0x6e, 0x0c, 0x18,
+	0x00, 0x67, 0x5d, 0xf1, 0x9f, 0x33, 0x01, 0x00, 0x00,
+}
+
+func (m *CctxPruningFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CctxPruningFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CctxPruningFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintCctxPruningFlags(dAtA, i, uint64(m.MaxPrunedPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RetentionPeriod != 0 {
+		i = encodeVarintCctxPruningFlags(dAtA, i, uint64(m.RetentionPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCctxPruningFlags(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCctxPruningFlags(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CctxPruningFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.RetentionPeriod != 0 {
+		n += 1 + sovCctxPruningFlags(uint64(m.RetentionPeriod))
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovCctxPruningFlags(uint64(m.MaxPrunedPerBlock))
+	}
+	return n
+}
+
+func sovCctxPruningFlags(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCctxPruningFlags(x uint64) (n int) {
+	return sovCctxPruningFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CctxPruningFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCctxPruningFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CctxPruningFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CctxPruningFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCctxPruningFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionPeriod", wireType)
+			}
+			m.RetentionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCctxPruningFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetentionPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
+			}
+			m.MaxPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCctxPruningFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCctxPruningFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCctxPruningFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCctxPruningFlags(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCctxPruningFlags
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCctxPruningFlags
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCctxPruningFlags
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCctxPruningFlags
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCctxPruningFlags
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCctxPruningFlags
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCctxPruningFlags        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCctxPruningFlags          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCctxPruningFlags = fmt.Errorf("proto: unexpected end of group")
+)
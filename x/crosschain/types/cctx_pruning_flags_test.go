@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestCctxPruningFlags_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags types.CctxPruningFlags
+		valid bool
+	}{
+		{
+			name:  "valid flags",
+			flags: sample.CctxPruningFlags(),
+			valid: true,
+		},
+		{
+			name:  "disabled flags",
+			flags: types.CctxPruningFlags{},
+			valid: true,
+		},
+		{
+			name:  "negative retention period",
+			flags: types.CctxPruningFlags{RetentionPeriod: -1},
+		},
+		{
+			name:  "max pruned per block too high",
+			flags: types.CctxPruningFlags{MaxPrunedPerBlock: types.MaxCctxPrunedPerBlock + 1},
+		},
+		{
+			name:  "enabled without retention period",
+			flags: types.CctxPruningFlags{Enabled: true, MaxPrunedPerBlock: 10},
+		},
+		{
+			name:  "enabled without max pruned per block",
+			flags: types.CctxPruningFlags{Enabled: true, RetentionPeriod: 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.flags.Validate()
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestCrossChainTx_IsPrunable(t *testing.T) {
+	tests := []struct {
+		status   types.CctxStatus
+		refunded bool
+		prunable bool
+	}{
+		{status: types.CctxStatus_PendingInbound},
+		{status: types.CctxStatus_PendingOutbound},
+		{status: types.CctxStatus_PendingRevert},
+		{status: types.CctxStatus_OutboundMined, prunable: true},
+		{status: types.CctxStatus_Reverted, prunable: true},
+		{status: types.CctxStatus_Aborted},
+		{status: types.CctxStatus_Aborted, refunded: true, prunable: true},
+	}
+	for _, tt := range tests {
+		cctx := sample.CrossChainTx(t, "prunable")
+		cctx.CctxStatus.Status = tt.status
+		cctx.CctxStatus.IsAbortRefunded = tt.refunded
+		require.Equal(t, tt.prunable, cctx.IsPrunable(), tt.status.String())
+	}
+}
@@ -747,6 +747,59 @@ func (m *EventERC20CustodyPausing) GetCctxIndex() string {
 	return ""
 }
 
+// EventCctxPruned is the archival record of a cctx removed from the state
+type EventCctxPruned struct {
+	CctxIndex string        `protobuf:"bytes,1,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	Cctx      *CrossChainTx `protobuf:"bytes,2,opt,name=cctx,proto3" json:"cctx,omitempty"`
+}
+
+func (m *EventCctxPruned) Reset()         { *m = EventCctxPruned{} }
+func (m *EventCctxPruned) String() string { return proto.CompactTextString(m) }
+func (*EventCctxPruned) ProtoMessage()    {}
+func (*EventCctxPruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd08b628129fa2e1, []int{9}
+}
+func (m *EventCctxPruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCctxPruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCctxPruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCctxPruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCctxPruned.Merge(m, src)
+}
+func (m *EventCctxPruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCctxPruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCctxPruned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCctxPruned proto.InternalMessageInfo
+
+func (m *EventCctxPruned) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *EventCctxPruned) GetCctx() *CrossChainTx {
+	if m != nil {
+		return m.Cctx
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventInboundFinalized)(nil), "zetachain.zetacore.crosschain.EventInboundFinalized")
 	proto.RegisterType((*EventZrcWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZrcWithdrawCreated")
@@ -757,6 +810,7 @@ func init() {
 	proto.RegisterType((*EventERC20Whitelist)(nil), "zetachain.zetacore.crosschain.EventERC20Whitelist")
 	proto.RegisterType((*EventERC20CustodyFundsMigration)(nil), "zetachain.zetacore.crosschain.EventERC20CustodyFundsMigration")
 	proto.RegisterType((*EventERC20CustodyPausing)(nil), "zetachain.zetacore.crosschain.EventERC20CustodyPausing")
+	proto.RegisterType((*EventCctxPruned)(nil), "zetachain.zetacore.crosschain.EventCctxPruned")
 }
 
 func init() {
@@ -764,57 +818,60 @@ func init() {
 }
 
 var fileDescriptor_dd08b628129fa2e1 = []byte{
-	// 793 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xdd, 0x8e, 0x1b, 0x35,
-	0x14, 0xc7, 0x77, 0x9a, 0x6f, 0x37, 0x09, 0x30, 0x0d, 0x30, 0xac, 0xb4, 0xa1, 0x0d, 0x42, 0x20,
-	0x44, 0x93, 0x55, 0x79, 0x02, 0x1a, 0x75, 0xdb, 0x5c, 0x54, 0xad, 0xd2, 0xa2, 0xa2, 0xbd, 0xb1,
-	0x9c, 0xf1, 0x61, 0xc6, 0x30, 0xb1, 0x23, 0xdb, 0xb3, 0x49, 0xf6, 0x29, 0x10, 0xef, 0xc1, 0x0d,
-	0x12, 0x77, 0x3c, 0x00, 0x97, 0x7b, 0xc9, 0x25, 0xda, 0xbc, 0x08, 0xf2, 0xc7, 0x64, 0x93, 0xc9,
-	0x0a, 0x2e, 0x10, 0x48, 0xbd, 0x9b, 0xf3, 0x3f, 0x67, 0x7c, 0x7e, 0xfe, 0x9f, 0xf1, 0x18, 0x7d,
-	0x71, 0x09, 0x9a, 0xc4, 0x29, 0x61, 0x7c, 0x64, 0x9f, 0x84, 0x84, 0x51, 0x2c, 0x85, 0x52, 0x4e,
-	0x83, 0x0b, 0xe0, 0x5a, 0x0d, 0x17, 0x52, 0x68, 0x11, 0x9e, 0x6c, 0x6b, 0x87, 0x45, 0xed, 0xf0,
-	0xa6, 0xf6, 0xb8, 0x97, 0x88, 0x44, 0xd8, 0xca, 0x91, 0x79, 0x72, 0x2f, 0x0d, 0x36, 0x15, 0xf4,
-	0xfe, 0x13, 0xb3, 0xca, 0x84, 0xcf, 0x44, 0xce, 0xe9, 0x19, 0xe3, 0x24, 0x63, 0x97, 0x40, 0xc3,
-	0xfb, 0xa8, 0x3d, 0x57, 0x09, 0xd6, 0xeb, 0x05, 0xe0, 0x5c, 0x66, 0x51, 0x70, 0x3f, 0xf8, 0xbc,
-	0x35, 0x45, 0x73, 0x95, 0xbc, 0x5e, 0x2f, 0xe0, 0x1b, 0x99, 0x85, 0x27, 0x08, 0xc5, 0xb1, 0x5e,
-	0x61, 0xc6, 0x29, 0xac, 0xa2, 0x3b, 0x36, 0xdf, 0x32, 0xca, 0xc4, 0x08, 0xe1, 0x07, 0xa8, 0xae,
-	0x80, 0x53, 0x90, 0x51, 0xc5, 0xa6, 0x7c, 0x14, 0x7e, 0x84, 0x9a, 0x7a, 0x85, 0x85, 0x4c, 0x18,
-	0x8f, 0xaa, 0x36, 0xd3, 0xd0, 0xab, 0x17, 0x26, 0x0c, 0x7b, 0xa8, 0x46, 0x94, 0x02, 0x1d, 0xd5,
-	0xac, 0xee, 0x82, 0xf0, 0x01, 0x6a, 0x33, 0x47, 0x87, 0x53, 0xa2, 0xd2, 0xa8, 0x6e, 0x93, 0x77,
-	0xbd, 0xf6, 0x8c, 0xa8, 0x34, 0x3c, 0x45, 0xbd, 0xa2, 0x64, 0x96, 0x89, 0xf8, 0x07, 0x9c, 0x02,
-	0x4b, 0x52, 0x1d, 0x35, 0x6c, 0x69, 0xe8, 0x73, 0x8f, 0x4d, 0xea, 0x99, 0xcd, 0x84, 0xc7, 0xa8,
-	0x29, 0x21, 0x06, 0x76, 0x01, 0x32, 0x6a, 0xda, 0xaa, 0x6d, 0x1c, 0x7e, 0x8a, 0xba, 0xc5, 0x33,
-	0xb6, 0xe6, 0x45, 0x2d, 0x5b, 0xd1, 0x29, 0xd4, 0xb1, 0x11, 0xcd, 0x06, 0xc9, 0x5c, 0xe4, 0x5c,
-	0x47, 0xc8, 0x6d, 0xd0, 0x45, 0xe1, 0x67, 0xe8, 0x1d, 0x09, 0x19, 0x59, 0x03, 0xc5, 0x73, 0x50,
-	0x8a, 0x24, 0x10, 0xdd, 0xb5, 0x05, 0x5d, 0x2f, 0x3f, 0x77, 0xaa, 0x31, 0x90, 0xc3, 0x12, 0x2b,
-	0x4d, 0x74, 0xae, 0xa2, 0xb6, 0x33, 0x90, 0xc3, 0xf2, 0x95, 0x15, 0x0c, 0x86, 0x4b, 0x6d, 0x97,
-	0xe9, 0x38, 0x0c, 0xa7, 0x16, 0xab, 0x3c, 0x40, 0x6d, 0xe7, 0xac, 0x67, 0xed, 0x3a, 0x7b, 0x9c,
-	0x66, 0x49, 0x07, 0xbf, 0xdc, 0x41, 0x1f, 0xda, 0x29, 0x9f, 0xcb, 0xf8, 0x0d, 0xd3, 0x29, 0x95,
-	0x64, 0x39, 0x96, 0x40, 0xf4, 0x7f, 0x39, 0xe7, 0x32, 0x57, 0xf5, 0x80, 0xeb, 0x60, 0xb2, 0xb5,
-	0xc3, 0xc9, 0xee, 0xce, 0xa9, 0xfe, 0x8f, 0x73, 0x6a, 0xfc, 0xfd, 0x9c, 0x9a, 0x7b, 0x73, 0xda,
-	0xb7, 0xbf, 0x55, 0xb2, 0x7f, 0xf0, 0x6b, 0x80, 0x22, 0x67, 0x1a, 0x68, 0xf2, 0x7f, 0xba, 0xb6,
-	0x67, 0x49, 0xf5, 0xd0, 0x92, 0x7d, 0xee, 0x5a, 0x99, 0xfb, 0xb7, 0x00, 0xf5, 0x2c, 0xf7, 0x8b,
-	0x5c, 0xbb, 0x33, 0x4d, 0x58, 0x96, 0x4b, 0xf8, 0xf7, 0xcc, 0x27, 0x08, 0x89, 0x8c, 0x16, 0x8d,
-	0x1d, 0x77, 0x4b, 0x64, 0xd4, 0x7f, 0xaf, 0xfb, 0x5c, 0xd5, 0x5b, 0x3e, 0xe7, 0x0b, 0x92, 0xe5,
-	0x80, 0xfd, 0x74, 0xa8, 0x47, 0xef, 0x58, 0x75, 0xea, 0xc5, 0x43, 0xfc, 0x57, 0x79, 0x1c, 0x83,
-	0x52, 0x6f, 0x09, 0xfe, 0x4f, 0x01, 0x3a, 0xb6, 0xf8, 0xe3, 0xf1, 0xeb, 0x6f, 0x9f, 0x12, 0xf5,
-	0x52, 0xb2, 0x18, 0x26, 0x3c, 0x96, 0x40, 0x14, 0xd0, 0x12, 0x62, 0x50, 0x46, 0xfc, 0x12, 0x85,
-	0x09, 0x51, 0x78, 0x61, 0x5e, 0xc2, 0xcc, 0xbf, 0xe5, 0x77, 0xf2, 0x6e, 0x52, 0x5a, 0xcd, 0xfc,
-	0x68, 0x08, 0xa5, 0x4c, 0x33, 0xc1, 0x49, 0x86, 0xbf, 0x03, 0x28, 0x76, 0xd5, 0xbd, 0x91, 0xcf,
-	0x00, 0xd4, 0x20, 0x43, 0xf7, 0x2c, 0xd3, 0x93, 0xe9, 0xf8, 0xd1, 0xe9, 0x9b, 0x94, 0x69, 0xc8,
-	0x98, 0xd2, 0xe6, 0xaf, 0xb9, 0x2c, 0x02, 0x7c, 0x80, 0x15, 0x6e, 0x73, 0xe3, 0x2d, 0xdf, 0x27,
-	0xa8, 0x73, 0x29, 0xe3, 0x47, 0xa7, 0x98, 0x50, 0x2a, 0x41, 0x29, 0x8f, 0xd6, 0xb6, 0xe2, 0xd7,
-	0x4e, 0x1b, 0xfc, 0x1c, 0xa0, 0x8f, 0x6f, 0xda, 0x8d, 0x73, 0xa5, 0x05, 0x5d, 0x9f, 0xe5, 0x9c,
-	0xaa, 0xe7, 0x2c, 0x91, 0xc4, 0x70, 0x85, 0x43, 0x74, 0xcf, 0x98, 0x1d, 0xbb, 0xe4, 0x76, 0x39,
-	0xd7, 0xf9, 0x3d, 0x0e, 0x4b, 0xff, 0x9a, 0x5f, 0xd3, 0x34, 0x86, 0xdb, 0x1a, 0xc3, 0x4e, 0xe3,
-	0x9d, 0x83, 0x5e, 0x29, 0x1f, 0xf4, 0x9d, 0xdd, 0x55, 0x4b, 0xa6, 0x0f, 0xbe, 0xf7, 0xe7, 0x7c,
-	0x17, 0xf7, 0x25, 0xc9, 0x15, 0xe3, 0x89, 0xb9, 0xac, 0xec, 0x9f, 0x05, 0x33, 0x6a, 0xe1, 0x2a,
-	0xd3, 0x86, 0x8d, 0x27, 0xd4, 0x5c, 0x56, 0x0b, 0x92, 0xfb, 0xf1, 0x34, 0xa7, 0x2e, 0x28, 0xf5,
-	0xaa, 0x94, 0x7a, 0x3d, 0x7e, 0xfa, 0xfb, 0x75, 0x3f, 0xb8, 0xba, 0xee, 0x07, 0x7f, 0x5e, 0xf7,
-	0x83, 0x1f, 0x37, 0xfd, 0xa3, 0xab, 0x4d, 0xff, 0xe8, 0x8f, 0x4d, 0xff, 0xe8, 0xfc, 0x61, 0xc2,
-	0x74, 0x9a, 0xcf, 0x86, 0xb1, 0x98, 0xdb, 0xbb, 0xfe, 0xa1, 0xbb, 0xe2, 0xb9, 0xa0, 0x30, 0x5a,
-	0xed, 0x5e, 0xfa, 0xe6, 0x00, 0xa8, 0x59, 0xdd, 0xde, 0xdf, 0x5f, 0xfd, 0x15, 0x00, 0x00, 0xff,
-	0xff, 0xde, 0x67, 0x9e, 0x8b, 0x22, 0x08, 0x00, 0x00,
+	// 841 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x96, 0x41, 0x8f, 0x1b, 0x35,
+	0x14, 0xc7, 0x77, 0x9a, 0xec, 0x6e, 0xe2, 0xcd, 0x6e, 0x61, 0x1a, 0x60, 0x88, 0xb4, 0xa1, 0x0d,
+	0x42, 0x20, 0xa0, 0xc9, 0x2a, 0x7c, 0x00, 0x44, 0x47, 0xdd, 0x36, 0x87, 0xaa, 0xab, 0x74, 0x51,
+	0x51, 0x2f, 0x96, 0x33, 0x7e, 0xcc, 0x18, 0x26, 0x76, 0xb0, 0x3d, 0x9b, 0xc9, 0x7e, 0x0a, 0xc4,
+	0xf7, 0xe0, 0x82, 0xc4, 0x8d, 0x0f, 0xc0, 0xb1, 0x47, 0x8e, 0x68, 0xf3, 0x45, 0x90, 0xed, 0x99,
+	0x6c, 0x32, 0xa9, 0x76, 0x0f, 0x08, 0xa4, 0xde, 0xfc, 0xfe, 0xef, 0xd9, 0xef, 0xe7, 0xf7, 0xec,
+	0x19, 0xa3, 0xcf, 0x2f, 0x41, 0x93, 0x28, 0x21, 0x8c, 0x0f, 0xec, 0x48, 0x48, 0x18, 0x44, 0x52,
+	0x28, 0xe5, 0x34, 0xb8, 0x00, 0xae, 0x55, 0x7f, 0x26, 0x85, 0x16, 0xfe, 0xf1, 0x2a, 0xb6, 0x5f,
+	0xc6, 0xf6, 0xaf, 0x63, 0x3b, 0xed, 0x58, 0xc4, 0xc2, 0x46, 0x0e, 0xcc, 0xc8, 0x4d, 0xea, 0x0c,
+	0x6f, 0x4e, 0x60, 0x87, 0xd8, 0x8e, 0xb1, 0xce, 0xdd, 0x9c, 0xde, 0xb2, 0x86, 0xde, 0x7b, 0x6c,
+	0x32, 0x8f, 0xf8, 0x44, 0x64, 0x9c, 0x9e, 0x32, 0x4e, 0x52, 0x76, 0x09, 0xd4, 0xbf, 0x8f, 0x5a,
+	0x53, 0x15, 0x63, 0xbd, 0x98, 0x01, 0xce, 0x64, 0x1a, 0x78, 0xf7, 0xbd, 0xcf, 0x9a, 0x63, 0x34,
+	0x55, 0xf1, 0xf9, 0x62, 0x06, 0xdf, 0xca, 0xd4, 0x3f, 0x46, 0x28, 0x8a, 0x74, 0x8e, 0x19, 0xa7,
+	0x90, 0x07, 0x77, 0xac, 0xbf, 0x69, 0x94, 0x91, 0x11, 0xfc, 0xf7, 0xd1, 0x9e, 0x02, 0x4e, 0x41,
+	0x06, 0x35, 0xeb, 0x2a, 0x2c, 0xff, 0x43, 0xd4, 0xd0, 0x39, 0x16, 0x32, 0x66, 0x3c, 0xa8, 0x5b,
+	0xcf, 0xbe, 0xce, 0x9f, 0x1b, 0xd3, 0x6f, 0xa3, 0x5d, 0xa2, 0x14, 0xe8, 0x60, 0xd7, 0xea, 0xce,
+	0xf0, 0x1f, 0xa0, 0x16, 0x73, 0x74, 0x38, 0x21, 0x2a, 0x09, 0xf6, 0xac, 0xf3, 0xa0, 0xd0, 0x9e,
+	0x12, 0x95, 0xf8, 0x27, 0xa8, 0x5d, 0x86, 0x4c, 0x52, 0x11, 0xfd, 0x88, 0x13, 0x60, 0x71, 0xa2,
+	0x83, 0x7d, 0x1b, 0xea, 0x17, 0xbe, 0x47, 0xc6, 0xf5, 0xd4, 0x7a, 0xfc, 0x0e, 0x6a, 0x48, 0x88,
+	0x80, 0x5d, 0x80, 0x0c, 0x1a, 0x36, 0x6a, 0x65, 0xfb, 0x9f, 0xa0, 0xa3, 0x72, 0xec, 0xea, 0x15,
+	0x34, 0x6d, 0xc4, 0x61, 0xa9, 0x86, 0x46, 0x34, 0x1b, 0x24, 0x53, 0x91, 0x71, 0x1d, 0x20, 0xb7,
+	0x41, 0x67, 0xf9, 0x9f, 0xa2, 0xbb, 0x12, 0x52, 0xb2, 0x00, 0x8a, 0xa7, 0xa0, 0x14, 0x89, 0x21,
+	0x38, 0xb0, 0x01, 0x47, 0x85, 0xfc, 0xcc, 0xa9, 0xa6, 0x80, 0x1c, 0xe6, 0x58, 0x69, 0xa2, 0x33,
+	0x15, 0xb4, 0x5c, 0x01, 0x39, 0xcc, 0x5f, 0x58, 0xc1, 0x60, 0x38, 0xd7, 0x6a, 0x99, 0x43, 0x87,
+	0xe1, 0xd4, 0x72, 0x95, 0x07, 0xa8, 0xe5, 0x2a, 0x5b, 0xb0, 0x1e, 0xb9, 0xf2, 0x38, 0xcd, 0x92,
+	0xf6, 0x7e, 0xbb, 0x83, 0x3e, 0xb0, 0x5d, 0x7e, 0x25, 0xa3, 0x97, 0x4c, 0x27, 0x54, 0x92, 0x79,
+	0x28, 0x81, 0xe8, 0xff, 0xb2, 0xcf, 0x55, 0xae, 0xfa, 0x16, 0xd7, 0x56, 0x67, 0x77, 0xb7, 0x3b,
+	0xbb, 0xde, 0xa7, 0xbd, 0x5b, 0xfb, 0xb4, 0x7f, 0x73, 0x9f, 0x1a, 0x1b, 0x7d, 0xda, 0x2c, 0x7f,
+	0xb3, 0x52, 0xfe, 0xde, 0xef, 0x1e, 0x0a, 0x5c, 0xd1, 0x40, 0x93, 0xff, 0xb3, 0x6a, 0x1b, 0x25,
+	0xa9, 0x6f, 0x97, 0x64, 0x93, 0x7b, 0xb7, 0xca, 0xfd, 0x87, 0x87, 0xda, 0x96, 0xfb, 0x79, 0xa6,
+	0xdd, 0x9d, 0x26, 0x2c, 0xcd, 0x24, 0xfc, 0x7b, 0xe6, 0x63, 0x84, 0x44, 0x4a, 0xcb, 0xc4, 0x8e,
+	0xbb, 0x29, 0x52, 0x5a, 0x9c, 0xd7, 0x4d, 0xae, 0xfa, 0x1b, 0x8e, 0xf3, 0x05, 0x49, 0x33, 0xc0,
+	0x45, 0x77, 0x68, 0x81, 0x7e, 0x68, 0xd5, 0x71, 0x21, 0x6e, 0xe3, 0xbf, 0xc8, 0xa2, 0x08, 0x94,
+	0x7a, 0x4b, 0xf0, 0x7f, 0xf1, 0x50, 0xc7, 0xe2, 0x87, 0xe1, 0xf9, 0x77, 0x4f, 0x88, 0x3a, 0x93,
+	0x2c, 0x82, 0x11, 0x8f, 0x24, 0x10, 0x05, 0xb4, 0x82, 0xe8, 0x55, 0x11, 0xbf, 0x44, 0x7e, 0x4c,
+	0x14, 0x9e, 0x99, 0x49, 0x98, 0x15, 0xb3, 0x8a, 0x9d, 0xbc, 0x13, 0x57, 0x56, 0x33, 0x1f, 0x1a,
+	0x42, 0x29, 0xd3, 0x4c, 0x70, 0x92, 0xe2, 0xef, 0x01, 0xca, 0x5d, 0x1d, 0x5d, 0xcb, 0xa7, 0x00,
+	0xaa, 0x97, 0xa2, 0x7b, 0x96, 0xe9, 0xf1, 0x38, 0x1c, 0x9e, 0xbc, 0x4c, 0x98, 0x86, 0x94, 0x29,
+	0x6d, 0xbe, 0x9a, 0xf3, 0xd2, 0xc0, 0x5b, 0x58, 0xfe, 0xca, 0x17, 0xae, 0xf8, 0x3e, 0x46, 0x87,
+	0x97, 0x32, 0x1a, 0x9e, 0x60, 0x42, 0xa9, 0x04, 0xa5, 0x0a, 0xb4, 0x96, 0x15, 0xbf, 0x71, 0x5a,
+	0xef, 0x57, 0x0f, 0x7d, 0x74, 0x9d, 0x2e, 0xcc, 0x94, 0x16, 0x74, 0x71, 0x9a, 0x71, 0xaa, 0x9e,
+	0xb1, 0x58, 0x12, 0xc3, 0xe5, 0xf7, 0xd1, 0x3d, 0x53, 0xec, 0xc8, 0x39, 0x57, 0xcb, 0xb9, 0xcc,
+	0xef, 0x72, 0x98, 0x17, 0xd3, 0x8a, 0x35, 0x4d, 0x62, 0x78, 0x53, 0x62, 0x58, 0x4b, 0xbc, 0x76,
+	0xd1, 0x6b, 0xd5, 0x8b, 0xbe, 0xb6, 0xbb, 0x7a, 0xa5, 0xe8, 0xbd, 0x1f, 0x50, 0xb0, 0x85, 0x7b,
+	0x46, 0x32, 0xc5, 0x78, 0x6c, 0x7e, 0x56, 0xee, 0x8f, 0xc9, 0xa8, 0x85, 0xab, 0x8d, 0xf7, 0xad,
+	0x3d, 0xa2, 0xe6, 0x67, 0x35, 0x23, 0x59, 0xd1, 0x9e, 0xc6, 0xd8, 0x19, 0x95, 0x5c, 0xb5, 0x6a,
+	0xae, 0x9f, 0xd0, 0x5d, 0x77, 0x3a, 0x22, 0x9d, 0x9f, 0xc9, 0x8c, 0xdf, 0x7e, 0x24, 0xbe, 0x46,
+	0x75, 0x63, 0xd8, 0x2c, 0x07, 0xc3, 0x2f, 0xfa, 0x37, 0xbe, 0x0c, 0xfa, 0xa1, 0x19, 0xda, 0xcf,
+	0xde, 0x79, 0x3e, 0xb6, 0x13, 0x1f, 0x3d, 0xf9, 0xf3, 0xaa, 0xeb, 0xbd, 0xbe, 0xea, 0x7a, 0x7f,
+	0x5f, 0x75, 0xbd, 0x9f, 0x97, 0xdd, 0x9d, 0xd7, 0xcb, 0xee, 0xce, 0x5f, 0xcb, 0xee, 0xce, 0xab,
+	0x87, 0x31, 0xd3, 0x49, 0x36, 0xe9, 0x47, 0x62, 0x6a, 0x5f, 0x0c, 0x0f, 0xdd, 0x43, 0x81, 0x0b,
+	0x0a, 0x83, 0x7c, 0xfd, 0xe9, 0x60, 0xee, 0x9c, 0x9a, 0xec, 0xd9, 0x27, 0xc3, 0x57, 0xff, 0x0c,
+	0x00, 0x47, 0x1d, 0xfd, 0xf2, 0xc9, 0x08, 0x00, 0x00,
 }
 
 func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
@@ -1375,6 +1432,48 @@ func (m *EventERC20CustodyPausing) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCctxPruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCctxPruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCctxPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cctx != nil {
+		{
+			size, err := m.Cctx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1665,6 +1764,23 @@ func (m *EventERC20CustodyPausing) Size() (n int) {
 	return n
 }
 
+func (m *EventCctxPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Cctx != nil {
+		l = m.Cctx.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3696,6 +3812,124 @@ func (m *EventERC20CustodyPausing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCctxPruned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCctxPruned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCctxPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cctx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cctx == nil {
+				m.Cctx = &CrossChainTx{}
+			}
+			if err := m.Cctx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
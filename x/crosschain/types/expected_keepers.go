@@ -78,6 +78,7 @@ type ObserverKeeper interface {
 	GetChainNonces(ctx sdk.Context, chainID int64) (val observertypes.ChainNonces, found bool)
 	GetAllChainNonces(ctx sdk.Context) (list []observertypes.ChainNonces)
 	SetNonceToCctx(ctx sdk.Context, nonceToCctx observertypes.NonceToCctx)
+	RemoveNonceToCctx(ctx sdk.Context, nonceToCctx observertypes.NonceToCctx)
 	GetNonceToCctx(ctx sdk.Context, tss string, chainID int64, nonce int64) (val observertypes.NonceToCctx, found bool)
 	GetAllPendingNonces(ctx sdk.Context) (list []observertypes.PendingNonces, err error)
 	GetPendingNonces(ctx sdk.Context, tss string, chainID int64) (val observertypes.PendingNonces, found bool)
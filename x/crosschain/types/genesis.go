@@ -50,7 +50,10 @@ func (gs GenesisState) Validate() error {
 		gasPriceIndexMap[elem.Index] = true
 	}
 
-	return gs.RateLimiterFlags.Validate()
+	if err := gs.RateLimiterFlags.Validate(); err != nil {
+		return err
+	}
+	return gs.CctxPruningFlags.Validate()
 }
 
 func GetGenesisStateFromAppState(marshaler codec.JSONCodec, appState map[string]json.RawMessage) GenesisState {
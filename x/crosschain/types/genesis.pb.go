@@ -34,6 +34,7 @@ type GenesisState struct {
 	ZetaAccounting        ZetaAccounting      `protobuf:"bytes,12,opt,name=zeta_accounting,json=zetaAccounting,proto3" json:"zeta_accounting"`
 	FinalizedInbounds     []string            `protobuf:"bytes,16,rep,name=FinalizedInbounds,proto3" json:"FinalizedInbounds,omitempty"`
 	RateLimiterFlags      RateLimiterFlags    `protobuf:"bytes,17,opt,name=rate_limiter_flags,json=rateLimiterFlags,proto3" json:"rate_limiter_flags"`
+	CctxPruningFlags      CctxPruningFlags    `protobuf:"bytes,18,opt,name=cctx_pruning_flags,json=cctxPruningFlags,proto3" json:"cctx_pruning_flags"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +133,13 @@ func (m *GenesisState) GetRateLimiterFlags() RateLimiterFlags {
 	return RateLimiterFlags{}
 }
 
+func (m *GenesisState) GetCctxPruningFlags() CctxPruningFlags {
+	if m != nil {
+		return m.CctxPruningFlags
+	}
+	return CctxPruningFlags{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.crosschain.GenesisState")
 }
@@ -141,40 +149,41 @@ func init() {
 }
 
 var fileDescriptor_547615497292ea23 = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x5b, 0x06, 0x83, 0x79, 0x05, 0x36, 0x6f, 0x48, 0x51, 0x25, 0x42, 0xc5, 0x85, 0x49,
-	0xa3, 0x09, 0xda, 0x00, 0x71, 0x65, 0x95, 0xd6, 0x21, 0x2a, 0x01, 0xa1, 0xa7, 0x09, 0xc9, 0xb8,
-	0xae, 0x97, 0x58, 0xcb, 0xe2, 0x2a, 0x7e, 0x95, 0x4a, 0x3f, 0x05, 0x9f, 0x88, 0xf3, 0x8e, 0x3b,
-	0x72, 0x42, 0xa8, 0xfd, 0x22, 0xc8, 0x8e, 0x57, 0x9a, 0xb6, 0x4a, 0x7a, 0x7b, 0x7a, 0x79, 0xff,
-	0xff, 0xef, 0x29, 0x7f, 0x3f, 0x74, 0x38, 0xe6, 0x40, 0x59, 0x44, 0x45, 0xe2, 0x9b, 0x4a, 0xa6,
-	0xdc, 0x67, 0xa9, 0x54, 0x2a, 0xeb, 0x85, 0x3c, 0xe1, 0x4a, 0x28, 0x6f, 0x90, 0x4a, 0x90, 0xf8,
-	0xe9, 0x6c, 0xd8, 0xbb, 0x1d, 0xf6, 0xfe, 0x0f, 0xd7, 0x8f, 0x8a, 0xbd, 0x4c, 0x49, 0x4c, 0x4d,
-	0x60, 0x94, 0x59, 0xd6, 0x9b, 0x25, 0x7c, 0xaa, 0xc8, 0x20, 0x15, 0x8c, 0xdb, 0xf1, 0x77, 0xc5,
-	0xe3, 0x22, 0xe9, 0xc9, 0x61, 0xd2, 0x27, 0x11, 0x55, 0x11, 0x01, 0x49, 0x18, 0x9b, 0x81, 0x8e,
-	0xd7, 0x53, 0x42, 0x4a, 0xd9, 0x25, 0x4f, 0xad, 0xe8, 0x4d, 0xb1, 0x28, 0xa6, 0x0a, 0x48, 0x2f,
-	0x96, 0xec, 0x92, 0x44, 0x5c, 0x84, 0x11, 0x58, 0xd9, 0xeb, 0x62, 0x99, 0x1c, 0xc2, 0x2a, 0xd8,
-	0xdb, 0x62, 0x55, 0x4a, 0x81, 0x93, 0x58, 0x5c, 0x09, 0xe0, 0x29, 0xb9, 0x88, 0x69, 0x68, 0x53,
-	0xa9, 0xef, 0x87, 0x32, 0x94, 0xa6, 0xf4, 0x75, 0x95, 0x75, 0x9f, 0xff, 0xda, 0x44, 0xb5, 0x76,
-	0x96, 0xde, 0x57, 0xa0, 0xc0, 0xf1, 0x05, 0xda, 0xbb, 0x05, 0x77, 0x33, 0x6e, 0x47, 0x28, 0x70,
-	0xee, 0x34, 0x36, 0x0e, 0xb6, 0x8f, 0x3c, 0xaf, 0x30, 0x5a, 0xef, 0x53, 0x5e, 0x79, 0x72, 0xf7,
-	0xfa, 0xcf, 0xb3, 0x4a, 0xb0, 0xca, 0x10, 0x7f, 0x44, 0xb5, 0x90, 0xaa, 0xcf, 0x3a, 0x34, 0x03,
-	0xb8, 0x67, 0x00, 0x2f, 0x4a, 0x00, 0x6d, 0x2b, 0x09, 0x72, 0x62, 0xfc, 0x05, 0x3d, 0x6c, 0xe9,
-	0xa1, 0x96, 0x1e, 0xea, 0x8e, 0x94, 0x73, 0xdf, 0xb8, 0x1d, 0x96, 0xb8, 0xcd, 0x6b, 0x82, 0xbc,
-	0x03, 0xfe, 0x8e, 0xf6, 0x74, 0x6e, 0x27, 0x3a, 0xb6, 0x33, 0x93, 0x9a, 0x59, 0xf3, 0xc1, 0x5a,
-	0xff, 0xa1, 0x93, 0x57, 0x06, 0xab, 0xac, 0x70, 0x8c, 0x9e, 0xd8, 0xe7, 0x74, 0x46, 0x55, 0xd4,
-	0x95, 0x2d, 0x06, 0x23, 0xc3, 0xd8, 0x32, 0x8c, 0x57, 0x25, 0x8c, 0x0f, 0x8b, 0x5a, 0xfb, 0xb7,
-	0x57, 0x9b, 0x62, 0x8e, 0xf6, 0x17, 0x1e, 0x2f, 0x89, 0x35, 0x6c, 0xdb, 0xc0, 0x9a, 0xeb, 0xc1,
-	0xf2, 0xb9, 0x62, 0x91, 0x2c, 0xc5, 0xfa, 0x0d, 0x3d, 0xd6, 0x7a, 0x42, 0x19, 0x93, 0xc3, 0x04,
-	0x44, 0x12, 0x3a, 0xb5, 0x46, 0x75, 0x0d, 0xc2, 0x39, 0x07, 0xfa, 0x7e, 0x26, 0xb2, 0x84, 0x47,
-	0xe3, 0x5c, 0x17, 0xbf, 0x44, 0xbb, 0xa7, 0x22, 0xa1, 0xb1, 0x18, 0xf3, 0xbe, 0x5d, 0x49, 0x39,
-	0x3b, 0x8d, 0x8d, 0x83, 0xad, 0x60, 0xf9, 0x03, 0x66, 0x08, 0x2f, 0x5f, 0x83, 0xb3, 0x6b, 0xd6,
-	0xf1, 0x4b, 0xd6, 0x09, 0x28, 0xf0, 0x4e, 0xa6, 0x3b, 0xd5, 0x32, 0xbb, 0xd0, 0x4e, 0xba, 0xd8,
-	0x6f, 0x5f, 0x4f, 0xdc, 0xea, 0xcd, 0xc4, 0xad, 0xfe, 0x9d, 0xb8, 0xd5, 0x9f, 0x53, 0xb7, 0x72,
-	0x33, 0x75, 0x2b, 0xbf, 0xa7, 0x6e, 0xe5, 0xbc, 0x19, 0x0a, 0x88, 0x86, 0x3d, 0x8f, 0xc9, 0x2b,
-	0x73, 0xa9, 0xcd, 0xec, 0x40, 0x13, 0xd9, 0xe7, 0xfe, 0x68, 0xfe, 0x64, 0xe1, 0xc7, 0x80, 0xab,
-	0xde, 0xa6, 0x39, 0xc8, 0xe3, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xde, 0x67, 0x86, 0x4a, 0x6b,
-	0x05, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xc0, 0x5b, 0x06, 0x8c, 0x79, 0x05, 0x36, 0x6f, 0x48, 0xd1, 0x24, 0x42, 0xc5, 0x85, 0x49,
+	0xa3, 0x29, 0xda, 0x00, 0x71, 0x65, 0x95, 0xd6, 0x21, 0x2a, 0x31, 0x42, 0x4f, 0x13, 0x92, 0x71,
+	0x5d, 0x2f, 0xb1, 0x96, 0xd9, 0x55, 0xfc, 0x2a, 0x95, 0x7e, 0x0a, 0xbe, 0x03, 0x5f, 0x66, 0xc7,
+	0x1d, 0x39, 0x21, 0xd4, 0x7e, 0x11, 0x64, 0xc7, 0xfb, 0x93, 0xb6, 0x6a, 0x72, 0x7b, 0x7a, 0x79,
+	0xbf, 0xf7, 0x7b, 0xf2, 0x73, 0x8c, 0xf6, 0xc6, 0x1c, 0x28, 0x8b, 0xa9, 0x90, 0x4d, 0x1b, 0xa9,
+	0x94, 0x37, 0x59, 0xaa, 0xb4, 0xce, 0x72, 0x11, 0x97, 0x5c, 0x0b, 0x1d, 0x0c, 0x52, 0x05, 0x0a,
+	0x3f, 0xbf, 0x29, 0x0e, 0xae, 0x8b, 0x83, 0xdb, 0xe2, 0x9d, 0xf7, 0xcb, 0x7b, 0x31, 0x06, 0x23,
+	0x32, 0x48, 0x87, 0x52, 0xc8, 0x88, 0x9c, 0x25, 0x34, 0x72, 0x6d, 0x77, 0xf6, 0x0b, 0x38, 0x13,
+	0x12, 0x1b, 0x13, 0x18, 0x39, 0xa6, 0x51, 0x30, 0x37, 0xd5, 0x64, 0x90, 0x0a, 0xc6, 0x5d, 0xf9,
+	0x87, 0xe5, 0xe5, 0x42, 0xf6, 0xd4, 0x50, 0xf6, 0x49, 0x4c, 0x75, 0x4c, 0x40, 0x11, 0x33, 0xaa,
+	0x23, 0x0f, 0xca, 0x91, 0x90, 0x52, 0x76, 0xce, 0x53, 0x07, 0xbd, 0x5b, 0x0e, 0x25, 0x54, 0x03,
+	0xe9, 0x25, 0x8a, 0x9d, 0x93, 0x98, 0x8b, 0x28, 0x06, 0x87, 0xbd, 0x5d, 0x8e, 0xa9, 0x21, 0x2c,
+	0x92, 0x15, 0x1c, 0x7b, 0x4a, 0x81, 0x93, 0x44, 0x5c, 0x08, 0xe0, 0x69, 0xee, 0xd8, 0xb7, 0x23,
+	0x15, 0x29, 0x1b, 0x36, 0x4d, 0x94, 0x65, 0x5f, 0xfe, 0x5e, 0x45, 0xb5, 0x76, 0xb6, 0xf5, 0x6f,
+	0x40, 0x81, 0xe3, 0x33, 0xb4, 0x75, 0x2d, 0xee, 0x66, 0xde, 0x8e, 0xd0, 0xe0, 0xdd, 0xab, 0xaf,
+	0xec, 0xae, 0xef, 0x07, 0xc1, 0xd2, 0x2b, 0x11, 0x7c, 0xc9, 0x93, 0x87, 0xf7, 0x2f, 0xff, 0xbe,
+	0xa8, 0x84, 0x8b, 0x1a, 0xe2, 0xcf, 0xa8, 0x16, 0x51, 0x7d, 0x62, 0x96, 0x66, 0x05, 0x0f, 0xac,
+	0xe0, 0x55, 0x81, 0xa0, 0xed, 0x90, 0x30, 0x07, 0xe3, 0xaf, 0xe8, 0x71, 0xcb, 0x14, 0xb5, 0x4c,
+	0x51, 0x77, 0xa4, 0xbd, 0x55, 0xdb, 0x6d, 0xaf, 0xa0, 0xdb, 0x5d, 0x26, 0xcc, 0x77, 0xc0, 0x3f,
+	0xd0, 0x96, 0xd9, 0xdb, 0xa1, 0x59, 0xdb, 0xb1, 0xdd, 0x9a, 0x1d, 0xf3, 0x51, 0xa9, 0x73, 0xe8,
+	0xe4, 0xc9, 0x70, 0x51, 0x2b, 0x9c, 0xa0, 0x67, 0xee, 0x3a, 0x1d, 0x53, 0x1d, 0x77, 0x55, 0x8b,
+	0xc1, 0xc8, 0x3a, 0xd6, 0xac, 0xe3, 0x4d, 0x81, 0xe3, 0xd3, 0x2c, 0xeb, 0x4e, 0x7b, 0x71, 0x53,
+	0xcc, 0xd1, 0xf6, 0xcc, 0xe5, 0x25, 0x89, 0x91, 0xad, 0x5b, 0x59, 0xa3, 0x9c, 0x2c, 0xbf, 0x57,
+	0x2c, 0xe4, 0xdc, 0x5a, 0xbf, 0xa3, 0xa7, 0x86, 0x27, 0x94, 0x31, 0x35, 0x94, 0x20, 0x64, 0xe4,
+	0xd5, 0xea, 0xd5, 0x12, 0x86, 0x53, 0x0e, 0xf4, 0xe3, 0x0d, 0xe4, 0x0c, 0x4f, 0xc6, 0xb9, 0x2c,
+	0x7e, 0x8d, 0x36, 0x8f, 0x84, 0xa4, 0x89, 0x18, 0xf3, 0xbe, 0x1b, 0x49, 0x7b, 0x1b, 0xf5, 0x95,
+	0xdd, 0xb5, 0x70, 0xfe, 0x03, 0x66, 0x08, 0xcf, 0xff, 0x0d, 0xde, 0xa6, 0x1d, 0xa7, 0x59, 0x30,
+	0x4e, 0x48, 0x81, 0x77, 0x32, 0xee, 0xc8, 0x60, 0x6e, 0xa0, 0x8d, 0x74, 0x26, 0x6f, 0x24, 0xf3,
+	0x2f, 0x9d, 0x87, 0x4b, 0x49, 0xcc, 0x72, 0x4e, 0x32, 0x2e, 0x27, 0x61, 0xb3, 0xf9, 0xf6, 0xe5,
+	0xc4, 0xaf, 0x5e, 0x4d, 0xfc, 0xea, 0xbf, 0x89, 0x5f, 0xfd, 0x35, 0xf5, 0x2b, 0x57, 0x53, 0xbf,
+	0xf2, 0x67, 0xea, 0x57, 0x4e, 0x1b, 0x91, 0x80, 0x78, 0xd8, 0x0b, 0x98, 0xba, 0xb0, 0xcf, 0x41,
+	0x23, 0x7b, 0x05, 0xa4, 0xea, 0xf3, 0xe6, 0xe8, 0xee, 0xbb, 0x00, 0x3f, 0x07, 0x5c, 0xf7, 0x1e,
+	0xda, 0xbf, 0xfe, 0xe0, 0xff, 0x00, 0xac, 0x98, 0x1e, 0x19, 0x08, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -197,6 +206,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CctxPruningFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	{
 		size, err := m.RateLimiterFlags.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -380,6 +401,8 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.RateLimiterFlags.Size()
 	n += 2 + l + sovGenesis(uint64(l))
+	l = m.CctxPruningFlags.Size()
+	n += 2 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -720,6 +743,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxPruningFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CctxPruningFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
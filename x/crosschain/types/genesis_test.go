@@ -45,6 +45,7 @@ func TestGenesisState_Validate(t *testing.T) {
 					sample.GasPrice(t, "2"),
 				},
 				RateLimiterFlags: sample.RateLimiterFlags(),
+				CctxPruningFlags: sample.CctxPruningFlags(),
 			},
 			valid: true,
 		},
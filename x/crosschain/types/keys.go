@@ -60,6 +60,14 @@ const (
 	ZetaAccountingKey = "ZetaAccounting-value-"
 
 	RateLimiterFlagsKey = "RateLimiterFlags-value-"
+
+	CctxPruningFlagsKey = "CctxPruningFlags-value-"
+
+	// CctxPruningCursorKeyPrefix is the prefix of the index keys where the cctx pruning resumes for each status
+	CctxPruningCursorKeyPrefix = "CctxPruningCursor-value-"
+
+	// CctxIndexKeyPrefix is the prefix of the secondary indexes of the cctxs used to search them
+	CctxIndexKeyPrefix = "CctxIndex-value-"
 )
 
 // OutboundTrackerKey returns the store key to retrieve a OutboundTracker from the index fields
@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateCctxPruningFlags = "UpdateCctxPruningFlags"
+
+var _ sdk.Msg = &MsgUpdateCctxPruningFlags{}
+
+func NewMsgUpdateCctxPruningFlags(creator string, flags CctxPruningFlags) *MsgUpdateCctxPruningFlags {
+	return &MsgUpdateCctxPruningFlags{
+		Creator:          creator,
+		CctxPruningFlags: flags,
+	}
+}
+
+func (msg *MsgUpdateCctxPruningFlags) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateCctxPruningFlags) Type() string {
+	return TypeMsgUpdateCctxPruningFlags
+}
+
+func (msg *MsgUpdateCctxPruningFlags) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateCctxPruningFlags) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateCctxPruningFlags) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.CctxPruningFlags.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidCctxPruningFlags, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/crosschain/types"
+)
+
+func TestMsgUpdateCctxPruningFlags_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateCctxPruningFlags
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateCctxPruningFlags(sample.AccAddress(), sample.CctxPruningFlags()),
+		},
+		{
+			name: "invalid creator address",
+			msg:  types.NewMsgUpdateCctxPruningFlags("invalid", sample.CctxPruningFlags()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid cctx pruning flags",
+			msg: types.NewMsgUpdateCctxPruningFlags(sample.AccAddress(), types.CctxPruningFlags{
+				RetentionPeriod: -1,
+			}),
+			err: types.ErrInvalidCctxPruningFlags,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateCctxPruningFlags_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    *types.MsgUpdateCctxPruningFlags
+		panics bool
+	}{
+		{
+			name:   "valid signer",
+			msg:    types.NewMsgUpdateCctxPruningFlags(signer, sample.CctxPruningFlags()),
+			panics: false,
+		},
+		{
+			name:   "invalid signer",
+			msg:    types.NewMsgUpdateCctxPruningFlags("invalid", sample.CctxPruningFlags()),
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdateCctxPruningFlags_Type(t *testing.T) {
+	msg := types.NewMsgUpdateCctxPruningFlags(sample.AccAddress(), sample.CctxPruningFlags())
+	require.Equal(t, types.TypeMsgUpdateCctxPruningFlags, msg.Type())
+}
+
+func TestMsgUpdateCctxPruningFlags_Route(t *testing.T) {
+	msg := types.NewMsgUpdateCctxPruningFlags(sample.AccAddress(), sample.CctxPruningFlags())
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdateCctxPruningFlags_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgUpdateCctxPruningFlags(sample.AccAddress(), sample.CctxPruningFlags())
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
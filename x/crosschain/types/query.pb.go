@@ -1484,6 +1484,175 @@ func (m *QueryAllCctxResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryCctxSearchRequest filters the cctxs, the filters are combined and the
+// empty ones are ignored. Only the pagination key is supported, not the offset.
+type QueryCctxSearchRequest struct {
+	// sender or tx origin of the inbound
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver of any of the outbounds
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// name of the current status of the cctx, like Aborted
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	SenderChainId int64  `protobuf:"varint,4,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	// receiver chain of any of the outbounds
+	ReceiverChainId int64 `protobuf:"varint,5,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	// ZRC20 of the asset transferred by the cctx
+	Asset string `protobuf:"bytes,6,opt,name=asset,proto3" json:"asset,omitempty"`
+	// creation time of the cctx in unix seconds, after is inclusive and before
+	// exclusive
+	CreatedAfter  int64              `protobuf:"varint,7,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore int64              `protobuf:"varint,8,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,9,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCctxSearchRequest) Reset()         { *m = QueryCctxSearchRequest{} }
+func (m *QueryCctxSearchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxSearchRequest) ProtoMessage()    {}
+func (*QueryCctxSearchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{31}
+}
+func (m *QueryCctxSearchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxSearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxSearchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxSearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxSearchRequest.Merge(m, src)
+}
+func (m *QueryCctxSearchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxSearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxSearchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxSearchRequest proto.InternalMessageInfo
+
+func (m *QueryCctxSearchRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryCctxSearchRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryCctxSearchRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryCctxSearchRequest) GetSenderChainId() int64 {
+	if m != nil {
+		return m.SenderChainId
+	}
+	return 0
+}
+
+func (m *QueryCctxSearchRequest) GetReceiverChainId() int64 {
+	if m != nil {
+		return m.ReceiverChainId
+	}
+	return 0
+}
+
+func (m *QueryCctxSearchRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *QueryCctxSearchRequest) GetCreatedAfter() int64 {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return 0
+}
+
+func (m *QueryCctxSearchRequest) GetCreatedBefore() int64 {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return 0
+}
+
+func (m *QueryCctxSearchRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCctxSearchResponse struct {
+	CrossChainTx []*CrossChainTx     `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCctxSearchResponse) Reset()         { *m = QueryCctxSearchResponse{} }
+func (m *QueryCctxSearchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxSearchResponse) ProtoMessage()    {}
+func (*QueryCctxSearchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{32}
+}
+func (m *QueryCctxSearchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxSearchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxSearchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxSearchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxSearchResponse.Merge(m, src)
+}
+func (m *QueryCctxSearchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxSearchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxSearchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxSearchResponse proto.InternalMessageInfo
+
+func (m *QueryCctxSearchResponse) GetCrossChainTx() []*CrossChainTx {
+	if m != nil {
+		return m.CrossChainTx
+	}
+	return nil
+}
+
+func (m *QueryCctxSearchResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryListPendingCctxRequest struct {
 	ChainId int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	Limit   uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -1493,7 +1662,7 @@ func (m *QueryListPendingCctxRequest) Reset()         { *m = QueryListPendingCct
 func (m *QueryListPendingCctxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListPendingCctxRequest) ProtoMessage()    {}
 func (*QueryListPendingCctxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{31}
+	return fileDescriptor_d00cb546ea76908b, []int{33}
 }
 func (m *QueryListPendingCctxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +1714,7 @@ func (m *QueryListPendingCctxResponse) Reset()         { *m = QueryListPendingCc
 func (m *QueryListPendingCctxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListPendingCctxResponse) ProtoMessage()    {}
 func (*QueryListPendingCctxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{32}
+	return fileDescriptor_d00cb546ea76908b, []int{34}
 }
 func (m *QueryListPendingCctxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,7 +1766,7 @@ func (m *QueryRateLimiterInputRequest) Reset()         { *m = QueryRateLimiterIn
 func (m *QueryRateLimiterInputRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterInputRequest) ProtoMessage()    {}
 func (*QueryRateLimiterInputRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{33}
+	return fileDescriptor_d00cb546ea76908b, []int{35}
 }
 func (m *QueryRateLimiterInputRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1654,7 +1823,7 @@ func (m *QueryRateLimiterInputResponse) Reset()         { *m = QueryRateLimiterI
 func (m *QueryRateLimiterInputResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterInputResponse) ProtoMessage()    {}
 func (*QueryRateLimiterInputResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{34}
+	return fileDescriptor_d00cb546ea76908b, []int{36}
 }
 func (m *QueryRateLimiterInputResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1744,7 +1913,7 @@ func (m *QueryListPendingCctxWithinRateLimitRequest) String() string {
 }
 func (*QueryListPendingCctxWithinRateLimitRequest) ProtoMessage() {}
 func (*QueryListPendingCctxWithinRateLimitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{35}
+	return fileDescriptor_d00cb546ea76908b, []int{37}
 }
 func (m *QueryListPendingCctxWithinRateLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1796,7 +1965,7 @@ func (m *QueryListPendingCctxWithinRateLimitResponse) String() string {
 }
 func (*QueryListPendingCctxWithinRateLimitResponse) ProtoMessage() {}
 func (*QueryListPendingCctxWithinRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{36}
+	return fileDescriptor_d00cb546ea76908b, []int{38}
 }
 func (m *QueryListPendingCctxWithinRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,7 +2036,7 @@ func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightR
 func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
 func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{37}
+	return fileDescriptor_d00cb546ea76908b, []int{39}
 }
 func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,7 +2073,7 @@ func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeight
 func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
 func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{38}
+	return fileDescriptor_d00cb546ea76908b, []int{40}
 }
 func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1949,7 +2118,7 @@ func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZ
 func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
 func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{39}
+	return fileDescriptor_d00cb546ea76908b, []int{41}
 }
 func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2002,7 +2171,7 @@ func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasTo
 func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
 func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{40}
+	return fileDescriptor_d00cb546ea76908b, []int{42}
 }
 func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2059,7 +2228,7 @@ func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMess
 func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{41}
+	return fileDescriptor_d00cb546ea76908b, []int{43}
 }
 func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2098,7 +2267,7 @@ func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
 func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
 func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{42}
+	return fileDescriptor_d00cb546ea76908b, []int{44}
 }
 func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2141,7 +2310,7 @@ func (m *QueryRateLimiterFlagsRequest) Reset()         { *m = QueryRateLimiterFl
 func (m *QueryRateLimiterFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsRequest) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{43}
+	return fileDescriptor_d00cb546ea76908b, []int{45}
 }
 func (m *QueryRateLimiterFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2178,7 +2347,7 @@ func (m *QueryRateLimiterFlagsResponse) Reset()         { *m = QueryRateLimiterF
 func (m *QueryRateLimiterFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRateLimiterFlagsResponse) ProtoMessage()    {}
 func (*QueryRateLimiterFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{44}
+	return fileDescriptor_d00cb546ea76908b, []int{46}
 }
 func (m *QueryRateLimiterFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2214,6 +2383,86 @@ func (m *QueryRateLimiterFlagsResponse) GetRateLimiterFlags() RateLimiterFlags {
 	return RateLimiterFlags{}
 }
 
+type QueryCctxPruningFlagsRequest struct {
+}
+
+func (m *QueryCctxPruningFlagsRequest) Reset()         { *m = QueryCctxPruningFlagsRequest{} }
+func (m *QueryCctxPruningFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxPruningFlagsRequest) ProtoMessage()    {}
+func (*QueryCctxPruningFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{47}
+}
+func (m *QueryCctxPruningFlagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxPruningFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxPruningFlagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxPruningFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxPruningFlagsRequest.Merge(m, src)
+}
+func (m *QueryCctxPruningFlagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxPruningFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxPruningFlagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxPruningFlagsRequest proto.InternalMessageInfo
+
+type QueryCctxPruningFlagsResponse struct {
+	CctxPruningFlags CctxPruningFlags `protobuf:"bytes,1,opt,name=cctxPruningFlags,proto3" json:"cctxPruningFlags"`
+}
+
+func (m *QueryCctxPruningFlagsResponse) Reset()         { *m = QueryCctxPruningFlagsResponse{} }
+func (m *QueryCctxPruningFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCctxPruningFlagsResponse) ProtoMessage()    {}
+func (*QueryCctxPruningFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d00cb546ea76908b, []int{48}
+}
+func (m *QueryCctxPruningFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCctxPruningFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCctxPruningFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCctxPruningFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCctxPruningFlagsResponse.Merge(m, src)
+}
+func (m *QueryCctxPruningFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCctxPruningFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCctxPruningFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCctxPruningFlagsResponse proto.InternalMessageInfo
+
+func (m *QueryCctxPruningFlagsResponse) GetCctxPruningFlags() CctxPruningFlags {
+	if m != nil {
+		return m.CctxPruningFlags
+	}
+	return CctxPruningFlags{}
+}
+
 type QueryInboundTrackerRequest struct {
 	ChainId int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	TxHash  string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
@@ -2223,7 +2472,7 @@ func (m *QueryInboundTrackerRequest) Reset()         { *m = QueryInboundTrackerR
 func (m *QueryInboundTrackerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryInboundTrackerRequest) ProtoMessage()    {}
 func (*QueryInboundTrackerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{45}
+	return fileDescriptor_d00cb546ea76908b, []int{49}
 }
 func (m *QueryInboundTrackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2274,7 +2523,7 @@ func (m *QueryInboundTrackerResponse) Reset()         { *m = QueryInboundTracker
 func (m *QueryInboundTrackerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryInboundTrackerResponse) ProtoMessage()    {}
 func (*QueryInboundTrackerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d00cb546ea76908b, []int{46}
+	return fileDescriptor_d00cb546ea76908b, []int{50}
 }
 func (m *QueryInboundTrackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2342,6 +2591,8 @@ func init() {
 	proto.RegisterType((*QueryGetCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetCctxResponse")
 	proto.RegisterType((*QueryAllCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxRequest")
 	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
+	proto.RegisterType((*QueryCctxSearchRequest)(nil), "zetachain.zetacore.crosschain.QueryCctxSearchRequest")
+	proto.RegisterType((*QueryCctxSearchResponse)(nil), "zetachain.zetacore.crosschain.QueryCctxSearchResponse")
 	proto.RegisterType((*QueryListPendingCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxRequest")
 	proto.RegisterType((*QueryListPendingCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryListPendingCctxResponse")
 	proto.RegisterType((*QueryRateLimiterInputRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterInputRequest")
@@ -2356,6 +2607,8 @@ func init() {
 	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
 	proto.RegisterType((*QueryRateLimiterFlagsRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterFlagsRequest")
 	proto.RegisterType((*QueryRateLimiterFlagsResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimiterFlagsResponse")
+	proto.RegisterType((*QueryCctxPruningFlagsRequest)(nil), "zetachain.zetacore.crosschain.QueryCctxPruningFlagsRequest")
+	proto.RegisterType((*QueryCctxPruningFlagsResponse)(nil), "zetachain.zetacore.crosschain.QueryCctxPruningFlagsResponse")
 	proto.RegisterType((*QueryInboundTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryInboundTrackerRequest")
 	proto.RegisterType((*QueryInboundTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryInboundTrackerResponse")
 }
@@ -2365,154 +2618,168 @@ func init() {
 }
 
 var fileDescriptor_d00cb546ea76908b = []byte{
-	// 2350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
-	0x19, 0x4f, 0x79, 0xf2, 0x2c, 0x27, 0xf6, 0xba, 0xe2, 0x24, 0xde, 0xd9, 0xc4, 0xc9, 0x76, 0x36,
-	0xb1, 0x37, 0xc1, 0x33, 0x89, 0x1d, 0x3b, 0xcf, 0xdd, 0xc4, 0x8f, 0xc4, 0x31, 0x72, 0x12, 0xef,
-	0xc8, 0x22, 0x28, 0x3c, 0x5a, 0xe5, 0x9e, 0xda, 0x99, 0x66, 0xdb, 0xdd, 0xb3, 0xd3, 0x35, 0xf1,
-	0x64, 0x2d, 0x1f, 0x58, 0x89, 0x03, 0x37, 0xa4, 0x15, 0xe2, 0xc2, 0x11, 0x04, 0x07, 0x0e, 0x1c,
-	0xd0, 0x5e, 0x10, 0x48, 0xbc, 0x23, 0x16, 0xa4, 0xb0, 0x48, 0x08, 0x71, 0x40, 0x4b, 0x82, 0xe0,
-	0xce, 0x5f, 0x80, 0xba, 0xfa, 0xeb, 0x99, 0x7e, 0x77, 0xcd, 0x78, 0x22, 0x79, 0x4f, 0xee, 0xee,
-	0xaa, 0xef, 0xab, 0xdf, 0xef, 0xfb, 0xea, 0xf1, 0xd5, 0x6f, 0x8c, 0xdf, 0xfc, 0x80, 0x71, 0xaa,
-	0x55, 0xa9, 0x6e, 0x16, 0xc5, 0x93, 0x55, 0x67, 0x45, 0xad, 0x6e, 0xd9, 0xb6, 0xfb, 0xed, 0xfd,
-	0x06, 0xab, 0x3f, 0x29, 0xd4, 0xea, 0x16, 0xb7, 0xc8, 0x89, 0x56, 0xd7, 0x82, 0xd7, 0xb5, 0xd0,
-	0xee, 0x9a, 0x3f, 0xa7, 0x59, 0xf6, 0xba, 0x65, 0x17, 0xd7, 0xa8, 0xcd, 0x5c, 0xbb, 0xe2, 0xe3,
-	0x8b, 0x6b, 0x8c, 0xd3, 0x8b, 0xc5, 0x1a, 0xad, 0xe8, 0x26, 0xe5, 0xba, 0x65, 0xba, 0xae, 0xf2,
-	0x93, 0xe9, 0xa3, 0x8a, 0x47, 0x55, 0x3c, 0xab, 0xbc, 0x09, 0x36, 0x13, 0xe9, 0x36, 0x15, 0x6a,
-	0xab, 0xb5, 0xba, 0xae, 0x31, 0xe8, 0x7e, 0x25, 0xbd, 0xbb, 0x6e, 0xae, 0x59, 0x0d, 0xb3, 0xac,
-	0x56, 0xa9, 0x5d, 0x55, 0xb9, 0xa5, 0x6a, 0x5a, 0x6b, 0xa0, 0x29, 0x39, 0x4b, 0x5e, 0xa7, 0xda,
-	0x7b, 0xac, 0x0e, 0x46, 0xd3, 0xe9, 0x46, 0x06, 0xb5, 0xb9, 0xba, 0x66, 0x58, 0xda, 0x7b, 0x6a,
-	0x95, 0xe9, 0x95, 0x2a, 0x07, 0xb3, 0x4b, 0xe9, 0x66, 0x56, 0x83, 0xc7, 0x0d, 0x36, 0x93, 0x6e,
-	0x55, 0xa7, 0x9c, 0xa9, 0x86, 0xbe, 0xae, 0x73, 0x56, 0x57, 0xdf, 0x35, 0x68, 0xc5, 0x06, 0xbb,
-	0xe1, 0x8a, 0x55, 0xb1, 0xc4, 0x63, 0xd1, 0x79, 0x82, 0xaf, 0xc7, 0x2b, 0x96, 0x55, 0x31, 0x58,
-	0x91, 0xd6, 0xf4, 0x22, 0x35, 0x4d, 0x8b, 0x8b, 0x4c, 0x81, 0x8d, 0x72, 0x1c, 0xe7, 0xdf, 0x71,
-	0x92, 0xf9, 0x88, 0x71, 0x3a, 0xab, 0x69, 0x56, 0xc3, 0xe4, 0xba, 0x59, 0x29, 0xb1, 0xf7, 0x1b,
-	0xcc, 0xe6, 0xca, 0x3d, 0xfc, 0x5a, 0x6c, 0xab, 0x5d, 0xb3, 0x4c, 0x9b, 0x91, 0x02, 0x3e, 0x4c,
-	0xd7, 0xac, 0x3a, 0x67, 0x65, 0xd5, 0x01, 0xaa, 0xd2, 0x75, 0xa7, 0xc7, 0x08, 0x3a, 0x85, 0xc6,
-	0x0f, 0x94, 0x86, 0xa0, 0x49, 0xd8, 0x8a, 0x06, 0x65, 0x05, 0x8f, 0x0a, 0x77, 0x8b, 0x8c, 0x3f,
-	0x00, 0xea, 0xab, 0x2e, 0x73, 0x18, 0x90, 0x8c, 0xe0, 0x7d, 0x82, 0xe4, 0xd2, 0x82, 0xf0, 0x92,
-	0x2b, 0x79, 0xaf, 0x64, 0x18, 0xef, 0x31, 0x2d, 0x53, 0x63, 0x23, 0x7d, 0xa7, 0xd0, 0xf8, 0xee,
-	0x92, 0xfb, 0xa2, 0x7c, 0x13, 0xe1, 0x93, 0x89, 0x2e, 0x01, 0xe5, 0xd7, 0xf1, 0xa0, 0x15, 0x6c,
-	0x12, 0xbe, 0xfb, 0x27, 0x0b, 0x85, 0xd4, 0x29, 0x5f, 0x08, 0x39, 0x9c, 0xdb, 0xfd, 0xf4, 0x9f,
-	0x27, 0x77, 0x95, 0xc2, 0xce, 0x94, 0x2a, 0xb0, 0x9a, 0x35, 0x8c, 0x04, 0x56, 0x77, 0x30, 0x6e,
-	0xaf, 0x11, 0x18, 0xfc, 0x6c, 0xc1, 0x5d, 0x50, 0x05, 0x67, 0x41, 0x15, 0xdc, 0x85, 0x08, 0x0b,
-	0xaa, 0xb0, 0x42, 0x2b, 0x0c, 0x6c, 0x4b, 0x3e, 0x4b, 0xe5, 0x8f, 0x1e, 0xdb, 0xb8, 0xa1, 0xd2,
-	0xd8, 0xe6, 0x7a, 0xc6, 0x96, 0x2c, 0x06, 0xb8, 0xf4, 0x09, 0x2e, 0x63, 0x99, 0x5c, 0x5c, 0x70,
-	0x01, 0x32, 0xdf, 0x42, 0xf8, 0x4c, 0x02, 0x99, 0xb9, 0x27, 0xf3, 0x0e, 0x24, 0x2f, 0x7c, 0xc3,
-	0x78, 0x8f, 0x80, 0x08, 0x53, 0xc2, 0x7d, 0x09, 0x05, 0xb5, 0xaf, 0xeb, 0xa0, 0xfe, 0x05, 0xe1,
-	0xb3, 0x59, 0x38, 0x3e, 0x6f, 0xb1, 0xfd, 0x36, 0xc2, 0x6f, 0x78, 0x9c, 0x96, 0xcc, 0x94, 0xd0,
-	0xbe, 0x8a, 0xf7, 0xbb, 0xfb, 0xb0, 0x5e, 0x0e, 0x2e, 0xb8, 0x72, 0xcf, 0xe2, 0xfb, 0x67, 0x5f,
-	0x9e, 0x13, 0xb0, 0x40, 0x78, 0xbf, 0x82, 0x07, 0x74, 0x33, 0x26, 0xba, 0x13, 0x19, 0xd1, 0x0d,
-	0x79, 0x75, 0x83, 0x1b, 0x72, 0xd5, 0xbb, 0xd8, 0xfa, 0x96, 0x7b, 0x70, 0x60, 0xbb, 0xd7, 0xcb,
-	0xfd, 0x0f, 0xbe, 0xe5, 0x1e, 0x19, 0xea, 0x73, 0x15, 0xb3, 0x05, 0x7c, 0xca, 0xdb, 0xa5, 0x61,
-	0xe0, 0xbb, 0xd4, 0xae, 0xae, 0x5a, 0xf3, 0x1a, 0x6f, 0x7a, 0x51, 0x3b, 0x85, 0xfb, 0xf5, 0x76,
-	0x1b, 0x1c, 0x22, 0xfe, 0x4f, 0xce, 0xac, 0x7e, 0x3d, 0xc5, 0x0d, 0x44, 0xa4, 0x8c, 0x87, 0xf4,
-	0x70, 0x23, 0x24, 0xe1, 0x82, 0x5c, 0x50, 0xda, 0x76, 0x10, 0x97, 0xa8, 0x43, 0xe5, 0x36, 0x40,
-	0x89, 0x98, 0x2c, 0x50, 0x4e, 0xe5, 0x29, 0x6d, 0x61, 0x25, 0xcd, 0x0d, 0x50, 0x7a, 0x88, 0x0f,
-	0xcd, 0x3b, 0x28, 0xc5, 0x72, 0x59, 0x6d, 0xda, 0x90, 0xe3, 0xf3, 0x19, 0x74, 0xfc, 0x36, 0xc0,
-	0x24, 0xe8, 0x47, 0xf9, 0x06, 0xe4, 0xa5, 0x3d, 0xc1, 0xa2, 0x79, 0xe9, 0xd5, 0x6c, 0xfe, 0xd4,
-	0xcb, 0x5e, 0xfc, 0x60, 0xe9, 0xd9, 0xcb, 0xf5, 0x34, 0x7b, 0xbd, 0x9b, 0xd8, 0x45, 0x7c, 0xcc,
-	0x9b, 0x91, 0x8b, 0xd4, 0x5e, 0x71, 0x0a, 0x54, 0xdf, 0xa9, 0xa5, 0x9b, 0x65, 0xd6, 0x84, 0xb4,
-	0xbb, 0x2f, 0x8a, 0x8a, 0x47, 0xa2, 0x06, 0xc0, 0x7d, 0x1e, 0xef, 0xf7, 0xbe, 0x41, 0x9c, 0xc7,
-	0x32, 0x28, 0xb7, 0x5c, 0xb4, 0x0c, 0x15, 0x0a, 0x88, 0x66, 0x0d, 0x23, 0x8c, 0xa8, 0x57, 0x99,
-	0xfc, 0x31, 0x02, 0x12, 0x81, 0x31, 0x62, 0x49, 0xe4, 0xba, 0x22, 0xd1, 0xbb, 0xfc, 0xcc, 0xb4,
-	0x2b, 0xce, 0x65, 0x6a, 0xf3, 0x39, 0xa7, 0x44, 0xbf, 0x2b, 0x2a, 0xf4, 0xf4, 0x34, 0x6d, 0xb6,
-	0xcb, 0xca, 0x88, 0x1d, 0x10, 0xfd, 0x32, 0x1e, 0x0c, 0x35, 0x49, 0x96, 0x95, 0x61, 0x87, 0x61,
-	0x37, 0xfe, 0x13, 0x26, 0x01, 0x74, 0xaf, 0x32, 0xf9, 0x5b, 0xdf, 0x09, 0xd3, 0x11, 0xcf, 0x5c,
-	0x0f, 0x78, 0xf6, 0x2e, 0xcb, 0xe7, 0xf1, 0x61, 0x2f, 0x5b, 0xfe, 0x9d, 0x2b, 0x3e, 0xb5, 0xcb,
-	0x70, 0xe3, 0x81, 0xce, 0x73, 0x4f, 0xee, 0x3b, 0x37, 0x89, 0x6e, 0x2f, 0x20, 0x15, 0x3c, 0x1c,
-	0x1c, 0x1a, 0xa2, 0xf6, 0x00, 0x1f, 0xf4, 0x6f, 0xb5, 0x90, 0xa3, 0x4e, 0x76, 0xec, 0x52, 0xc0,
-	0x81, 0xf2, 0x35, 0xe0, 0x38, 0x6b, 0x18, 0x2f, 0x63, 0x77, 0xfe, 0x29, 0x02, 0x22, 0x2d, 0xff,
-	0x89, 0x44, 0x72, 0xdb, 0x22, 0xd2, 0xbb, 0xac, 0xdf, 0x87, 0xcb, 0xe9, 0xb2, 0x6e, 0xf3, 0x15,
-	0x66, 0x96, 0x75, 0xb3, 0xe2, 0x8f, 0x4c, 0x4a, 0x69, 0x3b, 0x8c, 0xf7, 0x88, 0xfb, 0xb3, 0x18,
-	0xfd, 0x50, 0xc9, 0x7d, 0x51, 0x3e, 0x42, 0xf8, 0x78, 0xbc, 0xc3, 0x97, 0x15, 0x0a, 0x05, 0x1f,
-	0xe4, 0x16, 0xa7, 0x06, 0x0c, 0x06, 0x33, 0x2b, 0xf0, 0x4d, 0x59, 0x06, 0x50, 0x25, 0xca, 0xd9,
-	0xb2, 0x7b, 0xe9, 0x5f, 0x32, 0x6b, 0x0d, 0xff, 0xfe, 0xe5, 0x72, 0x41, 0x3e, 0x2e, 0xe4, 0x28,
-	0xde, 0xbb, 0xa1, 0x9b, 0x65, 0x6b, 0x43, 0xf8, 0xcc, 0x95, 0xe0, 0x4d, 0xf9, 0x6e, 0x0e, 0x9f,
-	0x48, 0x70, 0x07, 0x24, 0x8f, 0xe2, 0xbd, 0xd5, 0xf6, 0x6e, 0x96, 0x2b, 0xc1, 0x1b, 0xb9, 0x8f,
-	0x0f, 0x6a, 0x1a, 0x6f, 0xda, 0xea, 0xba, 0x6e, 0xdb, 0xac, 0x3c, 0xd2, 0xd7, 0x39, 0xf9, 0x7e,
-	0xe1, 0xe0, 0x9e, 0xb0, 0x27, 0x2b, 0xf8, 0x90, 0xeb, 0xaf, 0x06, 0xe4, 0x73, 0x5d, 0x44, 0x53,
-	0x78, 0x80, 0x48, 0x91, 0xd3, 0xf8, 0x90, 0x88, 0x5c, 0xcb, 0xe3, 0xee, 0x68, 0x38, 0xc9, 0x38,
-	0x7e, 0xa5, 0x46, 0x6d, 0xae, 0xba, 0x63, 0x3f, 0xa6, 0x46, 0x83, 0x8d, 0xec, 0x11, 0xdb, 0xc3,
-	0x80, 0xf3, 0xdd, 0xc9, 0xb7, 0xfd, 0x25, 0xe7, 0x2b, 0x29, 0xe0, 0xc3, 0xe0, 0x28, 0xd0, 0x79,
-	0xaf, 0x2b, 0x6e, 0xd4, 0xda, 0xf3, 0x03, 0xfa, 0x5f, 0xc7, 0x79, 0xc3, 0xda, 0x60, 0x36, 0x57,
-	0xfd, 0x66, 0xa0, 0x07, 0x8d, 0xec, 0x13, 0xc1, 0x3c, 0xe6, 0xf6, 0xf0, 0x4d, 0x2e, 0xd8, 0xf2,
-	0xe7, 0xf0, 0xb9, 0xb8, 0xa9, 0xf7, 0x50, 0xe7, 0x55, 0xdd, 0x6c, 0xe5, 0x2a, 0x35, 0xe7, 0xca,
-	0xaf, 0xfa, 0xf0, 0x79, 0x29, 0x27, 0x90, 0xe9, 0x77, 0xf0, 0x40, 0x50, 0x89, 0xeb, 0x6a, 0x42,
-	0x6b, 0xfe, 0x09, 0x1d, 0x49, 0x41, 0xcc, 0x8c, 0x26, 0x33, 0xf8, 0x98, 0xd6, 0xa8, 0xd7, 0x99,
-	0xc9, 0xd5, 0x0d, 0x9d, 0x57, 0xcb, 0x75, 0xba, 0xa1, 0xc2, 0x64, 0xcd, 0x89, 0x28, 0x1d, 0x81,
-	0xe6, 0x87, 0xd0, 0xfa, 0x50, 0x34, 0x92, 0x49, 0x7c, 0x24, 0x62, 0x57, 0xa7, 0x9c, 0x89, 0x3c,
-	0x1f, 0x28, 0x1d, 0x0e, 0x59, 0x39, 0x84, 0x9d, 0x24, 0xb6, 0xe5, 0x32, 0x95, 0x35, 0x35, 0xc6,
-	0xca, 0xac, 0x2c, 0x32, 0xbe, 0xbf, 0x34, 0x54, 0xf7, 0x62, 0x72, 0x1b, 0x1a, 0x5a, 0x72, 0x98,
-	0x73, 0x54, 0x3d, 0x62, 0x9c, 0x06, 0x8e, 0x5d, 0x65, 0xda, 0xdb, 0x71, 0x42, 0xad, 0xed, 0xa5,
-	0x73, 0x37, 0xb0, 0x74, 0x20, 0xb9, 0xab, 0xb0, 0x84, 0xe7, 0x2d, 0xf3, 0x31, 0xab, 0x3b, 0x75,
-	0xdf, 0xaa, 0xe5, 0x98, 0x47, 0xce, 0x9c, 0xc8, 0x46, 0x95, 0xc7, 0xfb, 0x2b, 0xd4, 0x5e, 0x6e,
-	0xed, 0x55, 0x07, 0x4a, 0xad, 0x77, 0xe5, 0x87, 0x08, 0x96, 0x72, 0xd4, 0x2d, 0xe0, 0xf9, 0x02,
-	0x1e, 0xf2, 0x14, 0x86, 0x45, 0x6a, 0x2f, 0x99, 0x4e, 0xa3, 0x27, 0xce, 0x45, 0x1a, 0x9c, 0xde,
-	0x42, 0x12, 0xd4, 0x2c, 0xe3, 0x0e, 0x63, 0xd0, 0xbb, 0x0f, 0x66, 0x7b, 0xb8, 0x81, 0x8c, 0xe3,
-	0x41, 0xe7, 0xaf, 0xbf, 0x2a, 0xc8, 0x89, 0x5c, 0x87, 0x3f, 0x2b, 0x63, 0x70, 0xfd, 0xbf, 0xc7,
-	0x6c, 0x9b, 0x56, 0xd8, 0x0a, 0xb5, 0x6d, 0xdd, 0xac, 0xac, 0xb4, 0x3d, 0x7a, 0xd1, 0xbd, 0x03,
-	0x3a, 0x4c, 0x4a, 0x47, 0x20, 0x76, 0x1c, 0x1f, 0x78, 0xb7, 0x05, 0xd1, 0x25, 0xd4, 0xfe, 0xa0,
-	0x8c, 0x46, 0x77, 0xcc, 0x3b, 0x06, 0xad, 0x78, 0xd7, 0x73, 0xe5, 0x43, 0x14, 0xdd, 0x03, 0xa1,
-	0x03, 0xf8, 0xa7, 0xf8, 0x95, 0x7a, 0xa8, 0x0d, 0x8e, 0xd6, 0x62, 0xc6, 0xda, 0x08, 0xbb, 0x84,
-	0x2b, 0x48, 0xc4, 0x9d, 0xb2, 0x02, 0x13, 0x2d, 0x78, 0x0f, 0x97, 0x38, 0xbb, 0x8e, 0xe1, 0x7d,
-	0xce, 0xae, 0xe2, 0xdc, 0x27, 0xdd, 0xe4, 0xec, 0xe5, 0x4d, 0x71, 0x95, 0xdc, 0x84, 0xc9, 0x19,
-	0xf6, 0x08, 0x9c, 0xbe, 0x8a, 0x07, 0x43, 0xd2, 0x36, 0x50, 0xea, 0x85, 0x52, 0x30, 0xf9, 0x83,
-	0x49, 0xbc, 0x47, 0x8c, 0x4e, 0x3e, 0x45, 0x78, 0x30, 0x24, 0x77, 0x91, 0xb7, 0x32, 0x86, 0x48,
-	0x17, 0x85, 0xf3, 0x6f, 0x77, 0x6b, 0xee, 0x52, 0x57, 0x6e, 0x7d, 0xf8, 0xd7, 0x7f, 0x7f, 0xd4,
-	0x77, 0x8d, 0x5c, 0x11, 0x72, 0xfa, 0x84, 0xef, 0x47, 0x88, 0xa0, 0x0c, 0x0f, 0x76, 0xc5, 0x4d,
-	0x28, 0xf9, 0xb6, 0x8a, 0x9b, 0xa2, 0xc8, 0xdb, 0x22, 0xbf, 0x41, 0x98, 0x84, 0xbc, 0xcf, 0x1a,
-	0x86, 0x1c, 0xaf, 0x44, 0x59, 0x58, 0x8e, 0x57, 0xb2, 0xd4, 0xab, 0x14, 0x04, 0xaf, 0x71, 0x72,
-	0x56, 0x8e, 0x17, 0xf9, 0x2f, 0xc2, 0xaf, 0x46, 0x59, 0x80, 0x0a, 0x47, 0x16, 0xba, 0x43, 0x13,
-	0x14, 0x14, 0xf3, 0xb7, 0xb7, 0xe9, 0x05, 0xa8, 0xbd, 0x25, 0xa8, 0x5d, 0x26, 0xd3, 0x72, 0xd4,
-	0xc0, 0x1c, 0x32, 0xb7, 0x45, 0xfe, 0x83, 0xf0, 0x48, 0x70, 0xde, 0xfa, 0x88, 0xce, 0x4b, 0x42,
-	0x4c, 0x13, 0x4e, 0xf3, 0x0b, 0xdb, 0x73, 0x02, 0x34, 0x6f, 0x0a, 0x9a, 0x57, 0xc9, 0xe5, 0x04,
-	0x9a, 0xba, 0x99, 0xcc, 0x52, 0xd5, 0xcb, 0x5b, 0xe4, 0xd7, 0x08, 0x0f, 0x45, 0x88, 0x4a, 0xcf,
-	0xcb, 0x78, 0xfd, 0x52, 0x7a, 0x5e, 0x26, 0x68, 0x92, 0x99, 0xf3, 0x32, 0xc8, 0xca, 0x26, 0x9f,
-	0x20, 0x3c, 0x10, 0xf4, 0x45, 0xae, 0xca, 0x40, 0x88, 0xdd, 0x3b, 0xf3, 0xd7, 0xba, 0x31, 0x05,
-	0xe4, 0x73, 0x02, 0xf9, 0x0d, 0x72, 0x4d, 0x0a, 0xb9, 0x2f, 0x11, 0xc5, 0x4d, 0xd8, 0x94, 0xb7,
-	0xc8, 0xdf, 0xda, 0x29, 0xf1, 0x29, 0x4e, 0x37, 0x25, 0xf7, 0xb0, 0x24, 0x19, 0x2e, 0x7f, 0xab,
-	0x7b, 0x07, 0x40, 0xee, 0x6d, 0x41, 0xee, 0x0a, 0x99, 0x49, 0x27, 0xd7, 0xb6, 0x2c, 0x6e, 0xfa,
-	0x3e, 0x6d, 0x91, 0xcf, 0x10, 0x3e, 0x12, 0xab, 0x53, 0x92, 0x5b, 0x1d, 0x84, 0x3c, 0x56, 0x29,
-	0xcd, 0xcf, 0x6e, 0xc3, 0x43, 0x67, 0xb9, 0x0b, 0x5a, 0x87, 0x28, 0x7e, 0x82, 0xf0, 0x70, 0x64,
-	0x14, 0x67, 0x45, 0xdd, 0xec, 0x6c, 0x49, 0x74, 0x99, 0xbe, 0x34, 0x65, 0x54, 0xb9, 0x20, 0xf8,
-	0x9d, 0x23, 0xe3, 0xb2, 0xfc, 0xc8, 0x4f, 0x50, 0x5b, 0x8b, 0x23, 0x33, 0x92, 0xf3, 0x27, 0x24,
-	0x1a, 0xe6, 0x2f, 0x77, 0x6c, 0x07, 0x78, 0x8b, 0x02, 0xef, 0x9b, 0x64, 0x2c, 0x01, 0x6f, 0x05,
-	0x0c, 0x9c, 0x14, 0x94, 0x59, 0x73, 0x8b, 0xfc, 0x08, 0xe1, 0x7e, 0xcf, 0x8b, 0x13, 0xf3, 0x19,
-	0xc9, 0x90, 0x75, 0x85, 0x38, 0x46, 0xba, 0x54, 0xc6, 0x04, 0xe2, 0xd7, 0xc9, 0xc9, 0x0c, 0xc4,
-	0xe4, 0x97, 0x08, 0xbf, 0x12, 0xae, 0xba, 0xc9, 0x75, 0x99, 0x61, 0x13, 0xae, 0x00, 0xf9, 0x1b,
-	0xdd, 0x19, 0x4b, 0x86, 0x5a, 0x0b, 0x63, 0xfd, 0x3d, 0xc2, 0xfd, 0xbe, 0xc2, 0x5a, 0xee, 0xec,
-	0xcf, 0x2a, 0xe0, 0xe5, 0xce, 0xfe, 0xcc, 0xea, 0x5e, 0x39, 0x27, 0xd8, 0xbc, 0x41, 0x94, 0x04,
-	0x36, 0xbe, 0xcb, 0x08, 0x79, 0x8a, 0x22, 0xea, 0xa4, 0x74, 0xb5, 0x19, 0xaf, 0xad, 0x4a, 0x57,
-	0x9b, 0x09, 0x7a, 0xa9, 0x32, 0x23, 0xe0, 0x5f, 0x20, 0x85, 0x04, 0xf8, 0x46, 0xd0, 0xae, 0x35,
-	0xfd, 0x9d, 0x1a, 0x33, 0xe4, 0xb3, 0x93, 0xb3, 0x7c, 0x3b, 0x6c, 0x92, 0xd5, 0xdf, 0xcc, 0xb3,
-	0x3c, 0xc4, 0x86, 0x7c, 0x1f, 0xe1, 0xdd, 0x62, 0xf3, 0x99, 0x94, 0x0c, 0xa3, 0x7f, 0x93, 0x9c,
-	0xea, 0xc8, 0x06, 0x10, 0x9e, 0x17, 0x08, 0xcf, 0x90, 0xd3, 0x49, 0x93, 0x1f, 0x4e, 0x32, 0x11,
-	0xe4, 0x9f, 0x21, 0xdc, 0xef, 0x53, 0x7d, 0xe5, 0xea, 0x8c, 0x58, 0xa5, 0xb8, 0x3b, 0xb0, 0xd3,
-	0x02, 0x6c, 0x91, 0x4c, 0xa4, 0x82, 0x8d, 0xdc, 0x3f, 0xbe, 0x87, 0xf0, 0x3e, 0xef, 0x28, 0x9a,
-	0x94, 0xcc, 0x68, 0xc7, 0x81, 0x0d, 0x29, 0xbf, 0xca, 0x69, 0x81, 0xf5, 0x04, 0x79, 0x2d, 0x05,
-	0x2b, 0xf9, 0xd8, 0x59, 0x80, 0x41, 0xbd, 0x89, 0x48, 0x55, 0x60, 0xf1, 0xaa, 0x6d, 0xfe, 0x7a,
-	0x57, 0xb6, 0xb2, 0x3b, 0x87, 0x0f, 0xe4, 0xff, 0x10, 0x1e, 0x4d, 0x17, 0xca, 0xc8, 0x52, 0x17,
-	0x58, 0xe2, 0x15, 0xbb, 0xfc, 0x17, 0x7b, 0xe1, 0x0a, 0x58, 0x5e, 0x15, 0x2c, 0xa7, 0xc8, 0xc5,
-	0x6c, 0x96, 0x61, 0x46, 0x1f, 0x23, 0x3c, 0x10, 0xfc, 0x5f, 0x2e, 0xb9, 0x15, 0x10, 0xfb, 0xdf,
-	0x61, 0x72, 0x95, 0x76, 0xfc, 0xbf, 0x8e, 0x29, 0x13, 0x82, 0xc4, 0x18, 0x39, 0x93, 0x40, 0xe2,
-	0x83, 0x20, 0x4a, 0x07, 0x78, 0x50, 0x75, 0x93, 0x03, 0x1e, 0xab, 0xe3, 0xc9, 0x01, 0x8f, 0x17,
-	0xf9, 0x32, 0x81, 0x1b, 0x41, 0x94, 0x4e, 0xa9, 0x10, 0x16, 0x85, 0xe4, 0x4a, 0x85, 0x04, 0xf9,
-	0x4a, 0xae, 0x54, 0x48, 0x92, 0xb6, 0x32, 0x4b, 0x85, 0xb0, 0x50, 0x15, 0x26, 0x20, 0x7e, 0x2c,
-	0xe8, 0x98, 0x80, 0xff, 0x17, 0x8b, 0x8e, 0x09, 0x04, 0x7e, 0x9f, 0xe8, 0x84, 0x80, 0x8b, 0xf5,
-	0x4f, 0x08, 0x1f, 0x7c, 0xd0, 0xe0, 0xab, 0xcd, 0x1d, 0xa2, 0x46, 0x49, 0x48, 0x1b, 0x2d, 0xac,
-	0x31, 0x47, 0xc1, 0x2f, 0x5c, 0x7d, 0xad, 0xd5, 0x65, 0x07, 0xe8, 0x50, 0x59, 0x27, 0xb0, 0x9f,
-	0x11, 0xf9, 0x17, 0xc2, 0x47, 0x43, 0xf8, 0x77, 0xa4, 0x02, 0x75, 0x4d, 0x90, 0xba, 0x44, 0x26,
-	0x25, 0x48, 0x85, 0xe5, 0x27, 0xf7, 0xa6, 0x1c, 0x47, 0x71, 0x07, 0x69, 0x4f, 0x37, 0x04, 0xc1,
-	0x19, 0x72, 0x29, 0xf1, 0x3e, 0x99, 0xc0, 0x4f, 0x08, 0x4f, 0x3f, 0x17, 0x9a, 0x4d, 0x57, 0xb3,
-	0xf0, 0x25, 0xa9, 0x4e, 0x59, 0x87, 0xbf, 0x8f, 0x0f, 0x79, 0x06, 0xe8, 0x77, 0x96, 0x40, 0x73,
-	0x5d, 0x30, 0x98, 0x26, 0x53, 0x29, 0x0c, 0x12, 0xd5, 0x99, 0x7f, 0x20, 0x4c, 0x82, 0x94, 0x76,
-	0x8e, 0x34, 0x93, 0x2d, 0x73, 0x86, 0x71, 0x87, 0xc8, 0xfd, 0x4e, 0x68, 0x6a, 0xfe, 0x4e, 0x3b,
-	0x44, 0x94, 0xc9, 0xaa, 0x06, 0x82, 0xcc, 0xe6, 0x16, 0x9f, 0x3e, 0x1f, 0x45, 0xcf, 0x9e, 0x8f,
-	0xa2, 0xcf, 0x9e, 0x8f, 0xa2, 0xef, 0xbc, 0x18, 0xdd, 0xf5, 0xec, 0xc5, 0xe8, 0xae, 0xbf, 0xbf,
-	0x18, 0xdd, 0xf5, 0x68, 0xa2, 0xa2, 0xf3, 0x6a, 0x63, 0xad, 0xa0, 0x59, 0xeb, 0x7e, 0x57, 0xa6,
-	0x55, 0x66, 0xc5, 0xa6, 0xdf, 0x23, 0x7f, 0x52, 0x63, 0xf6, 0xda, 0x5e, 0x71, 0x09, 0x9e, 0xfa,
-	0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xf0, 0x93, 0x1b, 0xe0, 0x31, 0x00, 0x00,
+	// 2574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdf, 0x6f, 0xd4, 0xd8,
+	0xf5, 0xc7, 0x19, 0x02, 0xc9, 0x09, 0x24, 0xe4, 0x12, 0x60, 0xd6, 0x40, 0x00, 0xb3, 0x90, 0x00,
+	0xdf, 0xcc, 0x40, 0x20, 0x01, 0x42, 0x16, 0x48, 0x02, 0x84, 0x7c, 0x15, 0x20, 0x3b, 0x8d, 0x4a,
+	0xb5, 0xfd, 0x61, 0x39, 0x9e, 0xcb, 0x8c, 0xbb, 0x13, 0x7b, 0xd6, 0xf6, 0x90, 0x61, 0xa3, 0x3c,
+	0x74, 0xa5, 0x3e, 0xf4, 0xa5, 0xaa, 0xb4, 0xaa, 0xfa, 0xd2, 0xd7, 0xfe, 0x78, 0xe8, 0x43, 0xa5,
+	0x56, 0xfb, 0x52, 0xb5, 0x52, 0x7f, 0xa3, 0x6e, 0x2b, 0xd1, 0xad, 0x54, 0x55, 0x7d, 0xa8, 0xb6,
+	0x50, 0xb5, 0xef, 0xfd, 0x0b, 0xaa, 0x7b, 0x7d, 0x3c, 0x63, 0x7b, 0x6c, 0x8f, 0xc7, 0x19, 0xa4,
+	0xec, 0x53, 0xc6, 0xf7, 0xde, 0x73, 0xee, 0xe7, 0x73, 0xce, 0xb9, 0xd7, 0xd7, 0x9f, 0x1b, 0x38,
+	0xf7, 0x3e, 0xb5, 0x15, 0xb5, 0xac, 0x68, 0x7a, 0x9e, 0xff, 0x32, 0x4c, 0x9a, 0x57, 0x4d, 0xc3,
+	0xb2, 0x9c, 0xb6, 0xf7, 0x6a, 0xd4, 0x7c, 0x96, 0xab, 0x9a, 0x86, 0x6d, 0x90, 0xe3, 0x8d, 0xa1,
+	0x39, 0x77, 0x68, 0xae, 0x39, 0x54, 0x3c, 0xaf, 0x1a, 0xd6, 0xba, 0x61, 0xe5, 0xd7, 0x14, 0x8b,
+	0x3a, 0x76, 0xf9, 0xa7, 0x97, 0xd6, 0xa8, 0xad, 0x5c, 0xca, 0x57, 0x95, 0x92, 0xa6, 0x2b, 0xb6,
+	0x66, 0xe8, 0x8e, 0x2b, 0x71, 0x3a, 0x7e, 0x56, 0x55, 0xb5, 0xeb, 0x72, 0xd5, 0xac, 0xe9, 0x9a,
+	0x5e, 0x92, 0x9f, 0x54, 0x94, 0x92, 0x85, 0x76, 0x93, 0x6d, 0xec, 0xd8, 0x4f, 0x99, 0xff, 0x96,
+	0xed, 0x3a, 0xda, 0x4c, 0xc4, 0xdb, 0x94, 0x14, 0x4b, 0xae, 0x9a, 0x9a, 0x4a, 0x71, 0xf8, 0xb5,
+	0xf8, 0xe1, 0x9a, 0xbe, 0x66, 0xd4, 0xf4, 0xa2, 0x5c, 0x56, 0xac, 0xb2, 0x6c, 0x1b, 0x32, 0x83,
+	0x8a, 0x96, 0x97, 0x93, 0x59, 0xda, 0xa6, 0xa2, 0xbe, 0x4b, 0x4d, 0x34, 0x9a, 0x8a, 0x37, 0xaa,
+	0x28, 0x96, 0x2d, 0xaf, 0x55, 0x0c, 0xf5, 0x5d, 0xb9, 0x4c, 0xb5, 0x52, 0xd9, 0x46, 0xb3, 0x2b,
+	0xf1, 0x66, 0x46, 0xcd, 0x0e, 0x9b, 0xac, 0x4d, 0xd8, 0x4d, 0xc5, 0xa6, 0x72, 0x45, 0x5b, 0xd7,
+	0x6c, 0x6a, 0xfa, 0xc2, 0x3e, 0x52, 0x32, 0x4a, 0x06, 0xff, 0x99, 0x67, 0xbf, 0xb0, 0xf5, 0x58,
+	0xc9, 0x30, 0x4a, 0x15, 0x9a, 0x57, 0xaa, 0x5a, 0x5e, 0xd1, 0x75, 0xc3, 0xe6, 0x19, 0x46, 0x1b,
+	0xe9, 0x18, 0x88, 0x6f, 0xb3, 0x22, 0x78, 0x87, 0xda, 0xca, 0x9c, 0xaa, 0x1a, 0x35, 0xdd, 0xd6,
+	0xf4, 0x52, 0x81, 0xbe, 0x57, 0xa3, 0x96, 0x2d, 0x3d, 0x80, 0xa3, 0xa1, 0xbd, 0x56, 0xd5, 0xd0,
+	0x2d, 0x4a, 0x72, 0x70, 0x50, 0x59, 0x33, 0x4c, 0x9b, 0x16, 0x65, 0x06, 0x54, 0x56, 0xd6, 0xd9,
+	0x88, 0xac, 0x70, 0x52, 0x18, 0xef, 0x2f, 0x0c, 0x63, 0x17, 0xb7, 0xe5, 0x1d, 0xd2, 0x0a, 0x8c,
+	0x72, 0x77, 0x8b, 0xd4, 0x7e, 0x84, 0xd4, 0x57, 0x1d, 0xe6, 0x38, 0x21, 0xc9, 0xc2, 0x5e, 0x4e,
+	0x72, 0xe9, 0x0e, 0xf7, 0x92, 0x29, 0xb8, 0x8f, 0x64, 0x04, 0x7a, 0x75, 0x43, 0x57, 0x69, 0xb6,
+	0xe7, 0xa4, 0x30, 0xbe, 0xbb, 0xe0, 0x3c, 0x48, 0x5f, 0x13, 0xe0, 0x44, 0xa4, 0x4b, 0x44, 0xf9,
+	0x15, 0x18, 0x32, 0xfc, 0x5d, 0xdc, 0xf7, 0xc0, 0x64, 0x2e, 0x17, 0xbb, 0x54, 0x72, 0x01, 0x87,
+	0xf3, 0xbb, 0x9f, 0xff, 0xe3, 0xc4, 0xae, 0x42, 0xd0, 0x99, 0x54, 0x46, 0x56, 0x73, 0x95, 0x4a,
+	0x04, 0xab, 0x7b, 0x00, 0xcd, 0xb5, 0x85, 0x93, 0x9f, 0xcd, 0x39, 0x0b, 0x31, 0xc7, 0x16, 0x62,
+	0xce, 0x59, 0xc0, 0xb8, 0x10, 0x73, 0x2b, 0x4a, 0x89, 0xa2, 0x6d, 0xc1, 0x63, 0x29, 0xfd, 0xc1,
+	0x65, 0x1b, 0x36, 0x55, 0x1c, 0xdb, 0x4c, 0xd7, 0xd8, 0x92, 0x45, 0x1f, 0x97, 0x1e, 0xce, 0x65,
+	0xac, 0x2d, 0x17, 0x07, 0x9c, 0x8f, 0xcc, 0xd7, 0x05, 0x38, 0x13, 0x41, 0x66, 0xfe, 0xd9, 0x02,
+	0x83, 0xe4, 0x86, 0x6f, 0x04, 0x7a, 0x39, 0x44, 0x2c, 0x09, 0xe7, 0x81, 0xdc, 0x0b, 0x01, 0x92,
+	0x26, 0xa8, 0x7f, 0x16, 0xe0, 0x6c, 0x3b, 0x1c, 0x9f, 0xb5, 0xd8, 0x7e, 0x43, 0x80, 0x37, 0x5d,
+	0x4e, 0x4b, 0x7a, 0x4c, 0x68, 0xdf, 0x80, 0x3e, 0x67, 0x1f, 0xd6, 0x8a, 0xfe, 0x05, 0x57, 0xec,
+	0x5a, 0x7c, 0xff, 0xe4, 0xc9, 0x73, 0x04, 0x16, 0x0c, 0xef, 0x17, 0x61, 0x50, 0xd3, 0x43, 0xa2,
+	0x3b, 0xd1, 0x26, 0xba, 0x4b, 0x7a, 0x48, 0x70, 0x03, 0xae, 0xba, 0x17, 0x5b, 0xcf, 0x72, 0xf7,
+	0x4f, 0x6c, 0x75, 0x7b, 0xb9, 0xff, 0xde, 0xb3, 0xdc, 0x5b, 0xa6, 0xfa, 0x4c, 0xc5, 0xec, 0x0e,
+	0x9c, 0x74, 0x77, 0x69, 0x9c, 0xf8, 0xbe, 0x62, 0x95, 0x57, 0x8d, 0x05, 0xd5, 0xae, 0xbb, 0x51,
+	0x3b, 0x09, 0x03, 0x5a, 0xb3, 0x0f, 0x5f, 0x22, 0xde, 0x26, 0x56, 0xd5, 0xa7, 0x62, 0xdc, 0x60,
+	0x44, 0x8a, 0x30, 0xac, 0x05, 0x3b, 0x31, 0x09, 0x17, 0x93, 0x05, 0xa5, 0x69, 0x87, 0x71, 0x69,
+	0x75, 0x28, 0xdd, 0x45, 0x28, 0x2d, 0x26, 0x77, 0x14, 0x5b, 0x49, 0x4e, 0x69, 0x0b, 0xa4, 0x38,
+	0x37, 0x48, 0xe9, 0x31, 0xec, 0x5f, 0x60, 0x28, 0xf9, 0x72, 0x59, 0xad, 0x5b, 0x98, 0xe3, 0x0b,
+	0x6d, 0xe8, 0x78, 0x6d, 0x90, 0x89, 0xdf, 0x8f, 0xf4, 0x55, 0x38, 0x19, 0x28, 0xb0, 0xd6, 0xbc,
+	0x74, 0xab, 0x9a, 0x3f, 0x71, 0xb3, 0x17, 0x3e, 0x59, 0x7c, 0xf6, 0x32, 0x5d, 0xcd, 0x5e, 0xf7,
+	0x0a, 0x3b, 0x0f, 0x47, 0xdc, 0x8a, 0x5c, 0x54, 0xac, 0x15, 0x53, 0x53, 0xa9, 0xe7, 0xad, 0xa5,
+	0xe9, 0x45, 0x5a, 0xc7, 0xb4, 0x3b, 0x0f, 0x92, 0x0c, 0xd9, 0x56, 0x03, 0xe4, 0xbe, 0x00, 0x7d,
+	0x6e, 0x1b, 0xc6, 0x79, 0xac, 0x0d, 0xe5, 0x86, 0x8b, 0x86, 0xa1, 0xa4, 0x20, 0xa2, 0xb9, 0x4a,
+	0x25, 0x88, 0xa8, 0x5b, 0x99, 0xfc, 0xa1, 0x00, 0xd9, 0xd6, 0x39, 0x42, 0x49, 0x64, 0x52, 0x91,
+	0xe8, 0x5e, 0x7e, 0xa6, 0x9b, 0x27, 0xce, 0x65, 0xc5, 0xb2, 0xe7, 0xd9, 0x11, 0xfd, 0x3e, 0x3f,
+	0xa1, 0xc7, 0xa7, 0x69, 0x13, 0x4e, 0x44, 0xda, 0x21, 0xd1, 0x2f, 0xc0, 0x50, 0xa0, 0x2b, 0xe1,
+	0xb1, 0x32, 0xe8, 0x30, 0xe8, 0xc6, 0xfb, 0x86, 0x89, 0x00, 0xdd, 0xad, 0x4c, 0xfe, 0xc6, 0xf3,
+	0x86, 0xe9, 0x88, 0x67, 0xa6, 0x0b, 0x3c, 0xbb, 0x97, 0xe5, 0x0b, 0x70, 0xd0, 0xcd, 0x96, 0x77,
+	0xe7, 0x0a, 0x4f, 0xed, 0x32, 0x88, 0xde, 0xc1, 0xf3, 0xcf, 0x1e, 0x1a, 0xba, 0x4a, 0xd3, 0x7e,
+	0x80, 0x94, 0x60, 0xc4, 0x3f, 0x35, 0x46, 0xed, 0x11, 0xec, 0xf3, 0x6e, 0xb5, 0x98, 0xa3, 0x4e,
+	0x76, 0xec, 0x82, 0xcf, 0x81, 0xf4, 0x65, 0xe4, 0x38, 0x57, 0xa9, 0xbc, 0x8e, 0xdd, 0xf9, 0xc7,
+	0x02, 0x8c, 0xf8, 0xfd, 0x47, 0x12, 0xc9, 0x6c, 0x8b, 0x48, 0xf7, 0xb2, 0xfe, 0xb2, 0x07, 0x0e,
+	0x73, 0xc8, 0x0c, 0xef, 0xe7, 0xa8, 0x62, 0xaa, 0x65, 0x37, 0x2a, 0x87, 0x61, 0x8f, 0x45, 0xf5,
+	0x22, 0x7e, 0xe9, 0xf5, 0x17, 0xf0, 0x89, 0x88, 0xd0, 0x67, 0x52, 0x95, 0x6a, 0x4f, 0xa9, 0xc9,
+	0x67, 0xee, 0x2f, 0x34, 0x9e, 0xb9, 0x8d, 0xad, 0xd8, 0x35, 0x2b, 0x9b, 0x41, 0x1b, 0xfe, 0x44,
+	0xce, 0xc2, 0x90, 0x63, 0x2d, 0x37, 0x4e, 0xca, 0xbb, 0x79, 0x65, 0xec, 0x77, 0x9a, 0x17, 0xf0,
+	0xbc, 0x7c, 0x1e, 0x86, 0x5d, 0x5f, 0xcd, 0x91, 0xbd, 0x7c, 0xe4, 0x90, 0xdb, 0xe1, 0x8e, 0x1d,
+	0x81, 0x5e, 0xc5, 0xb2, 0xa8, 0x9d, 0xdd, 0xe3, 0x54, 0x26, 0x7f, 0x20, 0xa7, 0x61, 0xbf, 0x6a,
+	0x52, 0x85, 0x7d, 0x4e, 0x2b, 0x4f, 0x6c, 0x6a, 0x66, 0xf7, 0x72, 0xeb, 0x7d, 0xd8, 0x38, 0xc7,
+	0xda, 0xc8, 0x19, 0x18, 0x74, 0x07, 0xad, 0xd1, 0x27, 0x86, 0x49, 0xb3, 0x7d, 0x0e, 0x1a, 0x6c,
+	0x9d, 0xe7, 0x8d, 0x81, 0xba, 0xe8, 0x4f, 0x5d, 0x17, 0x3f, 0x11, 0xe0, 0x48, 0x4b, 0x90, 0x77,
+	0x7c, 0x69, 0x3c, 0x44, 0xdd, 0x62, 0x59, 0xb3, 0xec, 0x15, 0xaa, 0x17, 0x35, 0xbd, 0xe4, 0x5d,
+	0x34, 0x31, 0x5f, 0x3d, 0x23, 0xd0, 0xcb, 0xa5, 0x15, 0x3e, 0xfb, 0xfe, 0x82, 0xf3, 0x20, 0x7d,
+	0x28, 0xc0, 0xb1, 0x70, 0x87, 0xaf, 0x2b, 0x14, 0x12, 0xec, 0xb3, 0x0d, 0x5b, 0xa9, 0xe0, 0x64,
+	0xb8, 0xe9, 0xf8, 0xda, 0xa4, 0x65, 0x04, 0x55, 0x50, 0x6c, 0xba, 0xec, 0xe8, 0x41, 0x4b, 0x7a,
+	0xb5, 0xe6, 0x7d, 0xb5, 0x39, 0x5c, 0x04, 0x0f, 0x17, 0x56, 0xe7, 0x1b, 0x9a, 0x5e, 0x34, 0x36,
+	0xb8, 0xcf, 0x4c, 0x01, 0x9f, 0xa4, 0x6f, 0x67, 0xe0, 0x78, 0x84, 0x3b, 0x24, 0x79, 0x18, 0xf6,
+	0x94, 0x9b, 0x2f, 0xba, 0x4c, 0x01, 0x9f, 0xc8, 0x43, 0xd8, 0xc7, 0xf4, 0x35, 0x4b, 0x5e, 0xd7,
+	0x2c, 0x8b, 0x16, 0xb3, 0x3d, 0x9d, 0x93, 0x1f, 0xe0, 0x0e, 0x1e, 0x70, 0x7b, 0xb2, 0x02, 0xfb,
+	0x1d, 0x7f, 0x55, 0x24, 0x9f, 0x49, 0x11, 0x4d, 0xee, 0x01, 0x23, 0xc5, 0x56, 0x16, 0x8f, 0x5c,
+	0xc3, 0xe3, 0xee, 0xd6, 0x70, 0x92, 0x71, 0x38, 0x50, 0x65, 0x3a, 0x9e, 0x33, 0xf7, 0x53, 0xa5,
+	0x52, 0xa3, 0x7c, 0xfd, 0xf6, 0x17, 0x06, 0x59, 0x3b, 0xcb, 0xb7, 0xf5, 0x79, 0xd6, 0xca, 0x74,
+	0x2f, 0x74, 0xe4, 0x1b, 0xec, 0x2c, 0xe6, 0xe1, 0x6a, 0xb3, 0x3e, 0x70, 0xfc, 0x0d, 0x10, 0x2b,
+	0xc6, 0x06, 0xb5, 0x6c, 0xd9, 0x6b, 0x86, 0x52, 0x21, 0xae, 0xf2, 0x23, 0xce, 0x08, 0x4f, 0x71,
+	0xe1, 0x69, 0x60, 0x1e, 0xce, 0x87, 0x95, 0xde, 0x63, 0xcd, 0x2e, 0x6b, 0x7a, 0x23, 0x57, 0xb1,
+	0x39, 0x97, 0x7e, 0xd9, 0x03, 0x17, 0x12, 0x39, 0xc1, 0x4c, 0xbf, 0x0d, 0x83, 0x7e, 0x91, 0x36,
+	0x55, 0x41, 0xab, 0x9e, 0xa7, 0xd6, 0x14, 0x84, 0x54, 0x34, 0x99, 0x86, 0x23, 0x6a, 0xcd, 0x34,
+	0xa9, 0x6e, 0xcb, 0x1b, 0x9a, 0x5d, 0x2e, 0x9a, 0xca, 0x86, 0x8c, 0xc5, 0x9a, 0xe1, 0x51, 0x3a,
+	0x84, 0xdd, 0x8f, 0xb1, 0xf7, 0x31, 0xef, 0x24, 0x93, 0x70, 0xa8, 0xc5, 0xce, 0x54, 0x6c, 0xca,
+	0xf3, 0xdc, 0x5f, 0x38, 0x18, 0xb0, 0x62, 0x84, 0x59, 0x12, 0x9b, 0x4a, 0xaa, 0x4c, 0xeb, 0x2a,
+	0xa5, 0x45, 0xea, 0xec, 0xd8, 0x7d, 0x85, 0x61, 0xd3, 0x8d, 0xc9, 0x5d, 0xec, 0x68, 0x28, 0xa5,
+	0xec, 0x14, 0xc3, 0x34, 0x4d, 0xdf, 0x89, 0x4c, 0x9a, 0x82, 0xa3, 0xa1, 0xbd, 0xcd, 0xa5, 0x73,
+	0xdf, 0xb7, 0x74, 0x30, 0xb9, 0xab, 0xb8, 0x84, 0x17, 0x0c, 0xfd, 0x29, 0x35, 0xd9, 0x27, 0xc1,
+	0xaa, 0xc1, 0xcc, 0x5b, 0x8e, 0x23, 0x2d, 0x1b, 0x95, 0x08, 0x7d, 0x25, 0xc5, 0x5a, 0x6e, 0xec,
+	0x55, 0xfd, 0x85, 0xc6, 0xb3, 0xf4, 0x3d, 0x01, 0x8e, 0x47, 0xb8, 0x45, 0x3c, 0xff, 0x07, 0xc3,
+	0xae, 0xf8, 0xb4, 0xa8, 0x58, 0x4b, 0x3a, 0xeb, 0x74, 0x75, 0xdb, 0x96, 0x0e, 0x36, 0x9a, 0xab,
+	0xc5, 0xaa, 0x51, 0xb9, 0x47, 0x29, 0x8e, 0xee, 0xc1, 0x6a, 0x0f, 0x76, 0x90, 0x71, 0x18, 0x62,
+	0x7f, 0xbd, 0x07, 0xc6, 0x0c, 0xcf, 0x75, 0xb0, 0x59, 0x1a, 0x43, 0x65, 0xe8, 0x01, 0xb5, 0x2c,
+	0xa5, 0x44, 0x57, 0x14, 0xcb, 0xd2, 0xf4, 0xd2, 0x4a, 0xd3, 0xa3, 0x1b, 0xdd, 0x7b, 0x70, 0xb6,
+	0xdd, 0x40, 0x24, 0x76, 0x0c, 0xfa, 0x9f, 0x50, 0xea, 0x23, 0xd4, 0x6c, 0x90, 0x46, 0x5b, 0x77,
+	0xcc, 0x7b, 0x4c, 0x40, 0x77, 0xe7, 0xf9, 0x40, 0x80, 0xe3, 0x11, 0x03, 0xd0, 0xbf, 0x02, 0x07,
+	0xcc, 0x40, 0x1f, 0x9e, 0xba, 0xf2, 0x6d, 0xd6, 0x46, 0xd0, 0x25, 0x7e, 0x9d, 0xb6, 0xb8, 0x6b,
+	0x80, 0x64, 0x0b, 0x74, 0xc5, 0xb9, 0x5d, 0x09, 0x07, 0xd9, 0x3a, 0xa0, 0x09, 0x52, 0x0d, 0xf4,
+	0x25, 0x04, 0x19, 0x74, 0xe9, 0x82, 0x0c, 0xba, 0x93, 0x56, 0x70, 0x35, 0xf8, 0x75, 0xa4, 0x04,
+	0x2f, 0xd8, 0x23, 0xb0, 0x97, 0x6d, 0x7d, 0x4c, 0x0f, 0x71, 0x2a, 0x68, 0x8f, 0x5d, 0xe7, 0x52,
+	0xc8, 0x26, 0x1c, 0x0d, 0xf5, 0x88, 0x9c, 0xbe, 0x04, 0x43, 0x81, 0xab, 0x19, 0xa4, 0xd4, 0x0d,
+	0xa5, 0x6b, 0xf2, 0x9b, 0x53, 0xd0, 0xcb, 0x67, 0x27, 0x9f, 0x08, 0x30, 0x14, 0x90, 0x6b, 0xc9,
+	0x5b, 0x6d, 0xa6, 0x88, 0xbf, 0xd4, 0x10, 0x6f, 0xa6, 0x35, 0x77, 0xa8, 0x4b, 0xb7, 0x3f, 0xf8,
+	0xcb, 0xbf, 0x3e, 0xec, 0x99, 0x21, 0xd7, 0xf8, 0x75, 0xd0, 0x84, 0xe7, 0x12, 0xcd, 0x7f, 0x8d,
+	0x84, 0x76, 0xf9, 0x4d, 0xfc, 0x64, 0xd9, 0xca, 0x6f, 0xf2, 0x8f, 0x94, 0x2d, 0xf2, 0x6b, 0x01,
+	0x48, 0xc0, 0xfb, 0x5c, 0xa5, 0x92, 0x8c, 0x57, 0xe4, 0xb5, 0x86, 0x78, 0x33, 0xad, 0x39, 0xf2,
+	0xca, 0x71, 0x5e, 0xe3, 0xe4, 0x6c, 0x32, 0x5e, 0xe4, 0x3f, 0x02, 0xbc, 0xd1, 0xca, 0x02, 0x55,
+	0x64, 0x72, 0x27, 0x1d, 0x1a, 0xbf, 0x20, 0x2e, 0xde, 0xdd, 0xa6, 0x17, 0xa4, 0xf6, 0x16, 0xa7,
+	0x76, 0x95, 0x4c, 0x25, 0xa3, 0x86, 0xe6, 0x98, 0xb9, 0x2d, 0xf2, 0x6f, 0x01, 0xb2, 0x4b, 0x7a,
+	0x04, 0xd1, 0x85, 0x84, 0x10, 0xe3, 0x84, 0x7f, 0xf1, 0xce, 0xf6, 0x9c, 0x20, 0xcd, 0x5b, 0x9c,
+	0xe6, 0x75, 0x72, 0x35, 0x82, 0xa6, 0xa6, 0x47, 0xb3, 0x94, 0xb5, 0xe2, 0x16, 0xf9, 0x95, 0x00,
+	0xc3, 0x4b, 0x7a, 0xda, 0xba, 0x0c, 0xd7, 0xdf, 0xc5, 0x9b, 0x69, 0xcd, 0x13, 0xd6, 0xa5, 0x9f,
+	0x95, 0x45, 0x3e, 0x16, 0x60, 0xd0, 0xef, 0x8b, 0x5c, 0x4f, 0x02, 0x21, 0x74, 0xef, 0x14, 0x67,
+	0xd2, 0x98, 0x22, 0xf2, 0x79, 0x8e, 0x7c, 0x96, 0xcc, 0x24, 0x42, 0xee, 0x49, 0x44, 0x7e, 0x13,
+	0x37, 0xe5, 0x2d, 0xf2, 0xd7, 0x66, 0x4a, 0x3c, 0x8a, 0xe9, 0xad, 0x84, 0x7b, 0x58, 0x94, 0x8c,
+	0x2c, 0xde, 0x4e, 0xef, 0x00, 0xc9, 0xdd, 0xe4, 0xe4, 0xae, 0x91, 0xe9, 0x78, 0x72, 0x4d, 0xcb,
+	0xfc, 0xa6, 0xa7, 0x69, 0x8b, 0x7c, 0x2a, 0xc0, 0xa1, 0x50, 0x9d, 0x9d, 0xdc, 0xee, 0x20, 0xe4,
+	0xa1, 0x4a, 0xbf, 0x38, 0xb7, 0x0d, 0x0f, 0x9d, 0xe5, 0xce, 0x6f, 0x1d, 0xa0, 0xf8, 0xb1, 0x00,
+	0x23, 0x2d, 0xb3, 0xb0, 0x15, 0x75, 0xab, 0xb3, 0x25, 0x91, 0x32, 0x7d, 0x71, 0xca, 0xbe, 0x74,
+	0x91, 0xf3, 0x3b, 0x4f, 0xc6, 0x93, 0xf2, 0x23, 0x3f, 0x12, 0x9a, 0x5a, 0x32, 0x99, 0x4e, 0x58,
+	0x3f, 0x01, 0xd1, 0x5b, 0xbc, 0xda, 0xb1, 0x1d, 0xe2, 0xcd, 0x73, 0xbc, 0xe7, 0xc8, 0x58, 0x04,
+	0xde, 0x12, 0x1a, 0xb0, 0x14, 0x14, 0x69, 0x7d, 0x8b, 0xfc, 0x40, 0x80, 0x01, 0xd7, 0x0b, 0x8b,
+	0xf9, 0x74, 0xc2, 0x90, 0xa5, 0x42, 0x1c, 0x22, 0xbd, 0x4b, 0x63, 0x1c, 0xf1, 0x29, 0x72, 0xa2,
+	0x0d, 0x62, 0xf2, 0x0b, 0x01, 0x0e, 0x04, 0x3f, 0x0d, 0xc8, 0x8d, 0x24, 0xd3, 0x46, 0x7c, 0xa7,
+	0x88, 0xb3, 0xe9, 0x8c, 0x13, 0x86, 0x5a, 0x0d, 0x62, 0xfd, 0x9d, 0x00, 0x03, 0x9e, 0xd3, 0x7f,
+	0xb2, 0x77, 0x7f, 0xbb, 0xaf, 0x0c, 0xf1, 0xee, 0x36, 0xbd, 0x20, 0x9b, 0xf3, 0x9c, 0xcd, 0x9b,
+	0x44, 0x8a, 0x60, 0xe3, 0xf9, 0x62, 0x22, 0xcf, 0x85, 0x16, 0x75, 0x3d, 0xf1, 0x69, 0x33, 0xfc,
+	0x6e, 0x40, 0xbc, 0x99, 0xd6, 0x1c, 0xe1, 0x4f, 0x73, 0xf8, 0x17, 0x49, 0x2e, 0x02, 0x7e, 0xc5,
+	0x6f, 0xd7, 0x28, 0x7f, 0x76, 0xc6, 0x0c, 0xf8, 0xec, 0xe4, 0x5d, 0xbe, 0x1d, 0x36, 0xd1, 0xb7,
+	0x17, 0x6d, 0xdf, 0xe5, 0x01, 0x36, 0xe4, 0xbb, 0x02, 0xec, 0xe6, 0x9b, 0xcf, 0x64, 0xc2, 0x30,
+	0x7a, 0x37, 0xc9, 0xcb, 0x1d, 0xd9, 0x20, 0xc2, 0x0b, 0x1c, 0xe1, 0x19, 0x72, 0x3a, 0xaa, 0xf8,
+	0xf1, 0x4d, 0xc6, 0x83, 0xfc, 0x53, 0x01, 0x06, 0x3c, 0xb7, 0x16, 0xe4, 0x7a, 0x07, 0x33, 0xfa,
+	0x6f, 0x3a, 0xd2, 0x81, 0x9d, 0xe2, 0x60, 0xf3, 0x64, 0x22, 0x16, 0x6c, 0xcb, 0xf7, 0xc7, 0x77,
+	0x04, 0xd8, 0xeb, 0xbe, 0x8a, 0x26, 0x13, 0x66, 0xb4, 0xe3, 0xc0, 0x06, 0x6e, 0x2e, 0xa4, 0xd3,
+	0x1c, 0xeb, 0x71, 0x72, 0x34, 0x06, 0x2b, 0xf9, 0xbe, 0x00, 0xd0, 0x94, 0xb6, 0xc9, 0x54, 0xa2,
+	0x7d, 0x2c, 0x78, 0xdf, 0x20, 0x4e, 0x77, 0x6a, 0x86, 0x10, 0xcf, 0x71, 0x88, 0xa7, 0xc9, 0xa9,
+	0x18, 0x88, 0x88, 0xec, 0x23, 0xb6, 0x53, 0xf8, 0xd5, 0x3b, 0x92, 0xe8, 0xa8, 0x18, 0xae, 0x81,
+	0x8b, 0x37, 0x52, 0xd9, 0x26, 0xdd, 0xe2, 0x3c, 0x20, 0xff, 0x2b, 0xc0, 0x68, 0xbc, 0xec, 0x48,
+	0x96, 0x52, 0x60, 0x09, 0xd7, 0x3f, 0xc5, 0xff, 0xef, 0x86, 0x2b, 0x64, 0x79, 0x9d, 0xb3, 0xbc,
+	0x4c, 0x2e, 0xb5, 0x67, 0x19, 0x64, 0xf4, 0x91, 0x00, 0x83, 0xfe, 0x7f, 0x9a, 0x4c, 0xb6, 0x54,
+	0x43, 0xff, 0x0d, 0x53, 0x9c, 0x49, 0x63, 0x8a, 0x24, 0x26, 0x38, 0x89, 0x31, 0x72, 0x26, 0x82,
+	0xc4, 0xfb, 0x7e, 0x94, 0x0c, 0xb8, 0x5f, 0xc3, 0x4c, 0x06, 0x3c, 0x54, 0x15, 0x15, 0x67, 0xd2,
+	0x98, 0x26, 0x04, 0x5e, 0xf1, 0xa3, 0x64, 0x67, 0x9a, 0xa0, 0xc4, 0x96, 0xec, 0x4c, 0x13, 0x21,
+	0x06, 0x8a, 0xb3, 0xe9, 0x8c, 0x13, 0x9e, 0x69, 0x82, 0xb2, 0x9f, 0x73, 0x28, 0x0b, 0xc8, 0x6c,
+	0x09, 0x0f, 0x65, 0xe1, 0x42, 0xa1, 0x38, 0x9b, 0xce, 0x38, 0x21, 0x81, 0xa0, 0x24, 0x18, 0xcc,
+	0x00, 0xbf, 0x3b, 0xea, 0x38, 0x03, 0xde, 0x0b, 0x2c, 0x71, 0x36, 0x9d, 0x71, 0xe7, 0x19, 0x70,
+	0xb0, 0xfe, 0x51, 0x80, 0x7d, 0x8f, 0x6a, 0xf6, 0x6a, 0x7d, 0x87, 0xe8, 0x7e, 0x09, 0x44, 0xa4,
+	0x06, 0xd6, 0x90, 0x97, 0xee, 0xcf, 0x1d, 0x25, 0xb3, 0x31, 0x64, 0x07, 0x28, 0x7e, 0xed, 0xce,
+	0x3a, 0x5e, 0x46, 0xe4, 0x9f, 0x02, 0x1c, 0x0e, 0xe0, 0xdf, 0x91, 0x5a, 0xdf, 0x0c, 0x27, 0x75,
+	0x85, 0x4c, 0x26, 0x20, 0x15, 0x14, 0xfa, 0x1c, 0x4d, 0x62, 0xb5, 0xbe, 0xa3, 0x55, 0xbe, 0x59,
+	0x4e, 0x70, 0x9a, 0x5c, 0x89, 0xfc, 0x72, 0x8f, 0xe0, 0xc7, 0x25, 0xbe, 0x9f, 0x71, 0x75, 0x2c,
+	0x55, 0x15, 0xbe, 0x26, 0x7d, 0xaf, 0xdd, 0xe9, 0xc5, 0xc3, 0x87, 0xbc, 0x40, 0xf4, 0x3b, 0x4b,
+	0x0a, 0xbb, 0xc1, 0x19, 0x4c, 0x91, 0xcb, 0x31, 0x0c, 0x22, 0x75, 0xb0, 0xbf, 0x0b, 0x40, 0xfc,
+	0x94, 0x76, 0x8e, 0x08, 0xd6, 0x5e, 0x50, 0x0e, 0xe2, 0x0e, 0x90, 0xfb, 0x2d, 0x57, 0x2f, 0xbd,
+	0x83, 0x76, 0x88, 0xfc, 0xd5, 0xee, 0x38, 0xe3, 0x67, 0x36, 0xbf, 0xf8, 0xfc, 0xe5, 0xa8, 0xf0,
+	0xe2, 0xe5, 0xa8, 0xf0, 0xe9, 0xcb, 0x51, 0xe1, 0x5b, 0xaf, 0x46, 0x77, 0xbd, 0x78, 0x35, 0xba,
+	0xeb, 0x6f, 0xaf, 0x46, 0x77, 0xbd, 0x33, 0x51, 0xd2, 0xec, 0x72, 0x6d, 0x2d, 0xa7, 0x1a, 0xeb,
+	0x5e, 0x57, 0xba, 0x51, 0xa4, 0xf9, 0xba, 0xd7, 0xa3, 0xfd, 0xac, 0x4a, 0xad, 0xb5, 0x3d, 0x5c,
+	0x6e, 0xb8, 0xfc, 0xbf, 0x01, 0x00, 0xda, 0x81, 0x8f, 0xce, 0x42, 0x36, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2557,6 +2824,8 @@ type QueryClient interface {
 	CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
 	// Queries a list of cctx items.
 	CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries the cctxs matching the filters, in the order of creation.
+	CctxSearch(ctx context.Context, in *QueryCctxSearchRequest, opts ...grpc.CallOption) (*QueryCctxSearchResponse, error)
 	// Queries a list of pending cctxs.
 	ListPendingCctx(ctx context.Context, in *QueryListPendingCctxRequest, opts ...grpc.CallOption) (*QueryListPendingCctxResponse, error)
 	// Queries a list of pending cctxs within rate limit.
@@ -2566,6 +2835,8 @@ type QueryClient interface {
 	LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error)
 	// Queries the rate limiter flags
 	RateLimiterFlags(ctx context.Context, in *QueryRateLimiterFlagsRequest, opts ...grpc.CallOption) (*QueryRateLimiterFlagsResponse, error)
+	// Queries the cctx pruning flags
+	CctxPruningFlags(ctx context.Context, in *QueryCctxPruningFlagsRequest, opts ...grpc.CallOption) (*QueryCctxPruningFlagsResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(ctx context.Context, in *QueryRateLimiterInputRequest, opts ...grpc.CallOption) (*QueryRateLimiterInputResponse, error)
 	// Deprecated(v17): use OutboundTracker
@@ -2756,6 +3027,15 @@ func (c *queryClient) CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CctxSearch(ctx context.Context, in *QueryCctxSearchRequest, opts ...grpc.CallOption) (*QueryCctxSearchResponse, error) {
+	out := new(QueryCctxSearchResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ListPendingCctx(ctx context.Context, in *QueryListPendingCctxRequest, opts ...grpc.CallOption) (*QueryListPendingCctxResponse, error) {
 	out := new(QueryListPendingCctxResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ListPendingCctx", in, out, opts...)
@@ -2801,6 +3081,15 @@ func (c *queryClient) RateLimiterFlags(ctx context.Context, in *QueryRateLimiter
 	return out, nil
 }
 
+func (c *queryClient) CctxPruningFlags(ctx context.Context, in *QueryCctxPruningFlagsRequest, opts ...grpc.CallOption) (*QueryCctxPruningFlagsResponse, error) {
+	out := new(QueryCctxPruningFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxPruningFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RateLimiterInput(ctx context.Context, in *QueryRateLimiterInputRequest, opts ...grpc.CallOption) (*QueryRateLimiterInputResponse, error) {
 	out := new(QueryRateLimiterInputResponse)
 	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/RateLimiterInput", in, out, opts...)
@@ -2914,6 +3203,8 @@ type QueryServer interface {
 	CctxByNonce(context.Context, *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error)
 	// Queries a list of cctx items.
 	CctxAll(context.Context, *QueryAllCctxRequest) (*QueryAllCctxResponse, error)
+	// Queries the cctxs matching the filters, in the order of creation.
+	CctxSearch(context.Context, *QueryCctxSearchRequest) (*QueryCctxSearchResponse, error)
 	// Queries a list of pending cctxs.
 	ListPendingCctx(context.Context, *QueryListPendingCctxRequest) (*QueryListPendingCctxResponse, error)
 	// Queries a list of pending cctxs within rate limit.
@@ -2923,6 +3214,8 @@ type QueryServer interface {
 	LastZetaHeight(context.Context, *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error)
 	// Queries the rate limiter flags
 	RateLimiterFlags(context.Context, *QueryRateLimiterFlagsRequest) (*QueryRateLimiterFlagsResponse, error)
+	// Queries the cctx pruning flags
+	CctxPruningFlags(context.Context, *QueryCctxPruningFlagsRequest) (*QueryCctxPruningFlagsResponse, error)
 	// Queries the input data of rate limiter.
 	RateLimiterInput(context.Context, *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error)
 	// Deprecated(v17): use OutboundTracker
@@ -3001,6 +3294,9 @@ func (*UnimplementedQueryServer) CctxByNonce(ctx context.Context, req *QueryGetC
 func (*UnimplementedQueryServer) CctxAll(ctx context.Context, req *QueryAllCctxRequest) (*QueryAllCctxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CctxAll not implemented")
 }
+func (*UnimplementedQueryServer) CctxSearch(ctx context.Context, req *QueryCctxSearchRequest) (*QueryCctxSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxSearch not implemented")
+}
 func (*UnimplementedQueryServer) ListPendingCctx(ctx context.Context, req *QueryListPendingCctxRequest) (*QueryListPendingCctxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPendingCctx not implemented")
 }
@@ -3016,6 +3312,9 @@ func (*UnimplementedQueryServer) LastZetaHeight(ctx context.Context, req *QueryL
 func (*UnimplementedQueryServer) RateLimiterFlags(ctx context.Context, req *QueryRateLimiterFlagsRequest) (*QueryRateLimiterFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterFlags not implemented")
 }
+func (*UnimplementedQueryServer) CctxPruningFlags(ctx context.Context, req *QueryCctxPruningFlagsRequest) (*QueryCctxPruningFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxPruningFlags not implemented")
+}
 func (*UnimplementedQueryServer) RateLimiterInput(ctx context.Context, req *QueryRateLimiterInputRequest) (*QueryRateLimiterInputResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RateLimiterInput not implemented")
 }
@@ -3372,6 +3671,24 @@ func _Query_CctxAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CctxSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCctxSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxSearch(ctx, req.(*QueryCctxSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ListPendingCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryListPendingCctxRequest)
 	if err := dec(in); err != nil {
@@ -3462,6 +3779,24 @@ func _Query_RateLimiterFlags_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CctxPruningFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCctxPruningFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxPruningFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxPruningFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxPruningFlags(ctx, req.(*QueryCctxPruningFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RateLimiterInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRateLimiterInputRequest)
 	if err := dec(in); err != nil {
@@ -3700,6 +4035,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CctxAll",
 			Handler:    _Query_CctxAll_Handler,
 		},
+		{
+			MethodName: "CctxSearch",
+			Handler:    _Query_CctxSearch_Handler,
+		},
 		{
 			MethodName: "ListPendingCctx",
 			Handler:    _Query_ListPendingCctx_Handler,
@@ -3720,6 +4059,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RateLimiterFlags",
 			Handler:    _Query_RateLimiterFlags_Handler,
 		},
+		{
+			MethodName: "CctxPruningFlags",
+			Handler:    _Query_CctxPruningFlags_Handler,
+		},
 		{
 			MethodName: "RateLimiterInput",
 			Handler:    _Query_RateLimiterInput_Handler,
@@ -4920,7 +5263,7 @@ func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListPendingCctxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCctxSearchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4930,41 +5273,173 @@ func (m *QueryListPendingCctxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListPendingCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCctxSearchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListPendingCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCctxSearchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x4a
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.CreatedBefore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedBefore))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x40
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryListPendingCctxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.CreatedAfter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CreatedAfter))
+		i--
+		dAtA[i] = 0x38
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryListPendingCctxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ReceiverChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiverChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SenderChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCctxSearchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxSearchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxSearchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPendingCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPendingCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPendingCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
@@ -5437,6 +5912,62 @@ func (m *QueryRateLimiterFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryCctxPruningFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxPruningFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxPruningFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCctxPruningFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCctxPruningFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCctxPruningFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CctxPruningFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryInboundTrackerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5971,6 +6502,66 @@ func (m *QueryAllCctxResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCctxSearchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SenderChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SenderChainId))
+	}
+	if m.ReceiverChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiverChainId))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedAfter != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedAfter))
+	}
+	if m.CreatedBefore != 0 {
+		n += 1 + sovQuery(uint64(m.CreatedBefore))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCctxSearchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryListPendingCctxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6196,6 +6787,26 @@ func (m *QueryRateLimiterFlagsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryCctxPruningFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCctxPruningFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CctxPruningFlags.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryInboundTrackerRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9141,7 +9752,7 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPendingCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCctxSearchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9164,17 +9775,17 @@ func (m *QueryListPendingCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPendingCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCctxSearchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPendingCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCctxSearchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.ChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9184,16 +9795,29 @@ func (m *QueryListPendingCctxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9203,15 +9827,412 @@ func (m *QueryListPendingCctxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChainId", wireType)
+			}
+			m.SenderChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChainId", wireType)
+			}
+			m.ReceiverChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAfter", wireType)
+			}
+			m.CreatedAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBefore", wireType)
+			}
+			m.CreatedBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBefore |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCctxSearchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCctxSearchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCctxSearchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPendingCctxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPendingCctxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPendingCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -10520,6 +11541,139 @@ func (m *QueryRateLimiterFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryCctxPruningFlagsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCctxPruningFlagsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCctxPruningFlagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCctxPruningFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCctxPruningFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCctxPruningFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxPruningFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CctxPruningFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryInboundTrackerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -985,9 +985,9 @@ type MsgVoteInbound struct {
 	SenderChainId int64  `protobuf:"varint,3,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
 	Receiver      string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	ReceiverChain int64  `protobuf:"varint,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
-	// string zeta_burnt = 6;
+	//  string zeta_burnt = 6;
 	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
-	// string mMint = 7;
+	//  string mMint = 7;
 	Message            string        `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
 	InboundHash        string        `protobuf:"bytes,9,opt,name=inbound_hash,json=inboundHash,proto3" json:"inbound_hash,omitempty"`
 	InboundBlockHeight uint64        `protobuf:"varint,10,opt,name=inbound_block_height,json=inboundBlockHeight,proto3" json:"inbound_block_height,omitempty"`
@@ -1670,6 +1670,94 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) GetCctxIndex() string {
 	return ""
 }
 
+type MsgUpdateCctxPruningFlags struct {
+	Creator          string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxPruningFlags CctxPruningFlags `protobuf:"bytes,2,opt,name=cctx_pruning_flags,json=cctxPruningFlags,proto3" json:"cctx_pruning_flags"`
+}
+
+func (m *MsgUpdateCctxPruningFlags) Reset()         { *m = MsgUpdateCctxPruningFlags{} }
+func (m *MsgUpdateCctxPruningFlags) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCctxPruningFlags) ProtoMessage()    {}
+func (*MsgUpdateCctxPruningFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{28}
+}
+func (m *MsgUpdateCctxPruningFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCctxPruningFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCctxPruningFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCctxPruningFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCctxPruningFlags.Merge(m, src)
+}
+func (m *MsgUpdateCctxPruningFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCctxPruningFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCctxPruningFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCctxPruningFlags proto.InternalMessageInfo
+
+func (m *MsgUpdateCctxPruningFlags) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateCctxPruningFlags) GetCctxPruningFlags() CctxPruningFlags {
+	if m != nil {
+		return m.CctxPruningFlags
+	}
+	return CctxPruningFlags{}
+}
+
+type MsgUpdateCctxPruningFlagsResponse struct {
+}
+
+func (m *MsgUpdateCctxPruningFlagsResponse) Reset()         { *m = MsgUpdateCctxPruningFlagsResponse{} }
+func (m *MsgUpdateCctxPruningFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCctxPruningFlagsResponse) ProtoMessage()    {}
+func (*MsgUpdateCctxPruningFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f0860550897740, []int{29}
+}
+func (m *MsgUpdateCctxPruningFlagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCctxPruningFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCctxPruningFlagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCctxPruningFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCctxPruningFlagsResponse.Merge(m, src)
+}
+func (m *MsgUpdateCctxPruningFlagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCctxPruningFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCctxPruningFlagsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCctxPruningFlagsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMigrateTssFunds)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFunds")
 	proto.RegisterType((*MsgMigrateTssFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateTssFundsResponse")
@@ -1699,6 +1787,8 @@ func init() {
 	proto.RegisterType((*MsgMigrateERC20CustodyFundsResponse)(nil), "zetachain.zetacore.crosschain.MsgMigrateERC20CustodyFundsResponse")
 	proto.RegisterType((*MsgUpdateERC20CustodyPauseStatus)(nil), "zetachain.zetacore.crosschain.MsgUpdateERC20CustodyPauseStatus")
 	proto.RegisterType((*MsgUpdateERC20CustodyPauseStatusResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateERC20CustodyPauseStatusResponse")
+	proto.RegisterType((*MsgUpdateCctxPruningFlags)(nil), "zetachain.zetacore.crosschain.MsgUpdateCctxPruningFlags")
+	proto.RegisterType((*MsgUpdateCctxPruningFlagsResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateCctxPruningFlagsResponse")
 }
 
 func init() {
@@ -1706,119 +1796,122 @@ func init() {
 }
 
 var fileDescriptor_15f0860550897740 = []byte{
-	// 1782 bytes of a gzipped FileDescriptorProto
+	// 1828 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0xd7, 0xb2, 0x2c, 0x3d, 0xd9, 0xb2, 0xcd, 0x75, 0x6c, 0x99, 0x5e, 0xcb, 0x8e, 0xd2,
-	0xb8, 0x46, 0x11, 0x4b, 0xae, 0xb2, 0x4d, 0xb7, 0xde, 0xa2, 0xdb, 0x58, 0xbb, 0xf1, 0xba, 0x88,
-	0x12, 0x83, 0xeb, 0x6c, 0x3f, 0x2e, 0x04, 0x45, 0x8e, 0x69, 0xc2, 0x12, 0x47, 0xe0, 0x8c, 0xb4,
-	0x72, 0x50, 0xa0, 0x45, 0x81, 0x02, 0x3d, 0xb6, 0x45, 0x4f, 0x39, 0xf4, 0x56, 0xa0, 0xfd, 0x4f,
-	0x72, 0x0c, 0x7a, 0x2a, 0x7a, 0x08, 0x8a, 0xe4, 0xd4, 0x5b, 0xdb, 0x6b, 0x2f, 0x05, 0xdf, 0x0c,
-	0x19, 0x89, 0xfa, 0xb4, 0x8c, 0x62, 0x2f, 0x16, 0xe7, 0xf1, 0xfd, 0xde, 0xbc, 0xaf, 0x99, 0xf7,
-	0x1e, 0x0d, 0xbb, 0xcf, 0x09, 0x37, 0xad, 0x0b, 0xd3, 0xf5, 0x4a, 0xf8, 0x44, 0x7d, 0x52, 0xb2,
-	0x7c, 0xca, 0x98, 0xa0, 0xf1, 0x4e, 0xb1, 0xe9, 0x53, 0x4e, 0xd5, 0xad, 0x88, 0xaf, 0x18, 0xf2,
-	0x15, 0xdf, 0xf1, 0x69, 0xab, 0x0e, 0x75, 0x28, 0x72, 0x96, 0x82, 0x27, 0x01, 0xd2, 0xbe, 0x35,
-	0x40, 0x78, 0xf3, 0xd2, 0x29, 0x21, 0x89, 0xc9, 0x1f, 0xc9, 0xbb, 0x3b, 0x8c, 0x97, 0xba, 0x1e,
-	0xfe, 0x19, 0x23, 0xb3, 0xe9, 0x53, 0x7a, 0xce, 0xe4, 0x8f, 0xe4, 0x7d, 0x30, 0xda, 0x38, 0xdf,
-	0xe4, 0xc4, 0xa8, 0xbb, 0x0d, 0x97, 0x13, 0xdf, 0x38, 0xaf, 0x9b, 0x4e, 0x88, 0x2b, 0x8f, 0xc6,
-	0xe1, 0xa3, 0x81, 0xcf, 0x46, 0xe8, 0xa0, 0xc2, 0xef, 0x15, 0x50, 0xab, 0xcc, 0xa9, 0xba, 0x4e,
-	0x20, 0xf6, 0x8c, 0xb1, 0x47, 0x2d, 0xcf, 0x66, 0x6a, 0x0e, 0xe6, 0x2d, 0x9f, 0x98, 0x9c, 0xfa,
-	0x39, 0x65, 0x47, 0xd9, 0x4b, 0xeb, 0xe1, 0x52, 0xdd, 0x80, 0x94, 0x10, 0xe1, 0xda, 0xb9, 0xf7,
-	0x76, 0x94, 0xbd, 0x59, 0x7d, 0x1e, 0xd7, 0x27, 0xb6, 0x7a, 0x0c, 0x49, 0xb3, 0x41, 0x5b, 0x1e,
-	0xcf, 0xcd, 0x06, 0x98, 0xa3, 0xd2, 0xcb, 0xd7, 0xdb, 0x33, 0x7f, 0x7f, 0xbd, 0xfd, 0x4d, 0xc7,
-	0xe5, 0x17, 0xad, 0x5a, 0xd1, 0xa2, 0x8d, 0x92, 0x45, 0x59, 0x83, 0x32, 0xf9, 0xb3, 0xcf, 0xec,
-	0xcb, 0x12, 0xbf, 0x6a, 0x12, 0x56, 0x7c, 0xe6, 0x7a, 0x5c, 0x97, 0xf0, 0xc2, 0x07, 0xa0, 0xf5,
-	0xeb, 0xa4, 0x13, 0xd6, 0xa4, 0x1e, 0x23, 0x85, 0x27, 0xf0, 0x7e, 0x95, 0x39, 0xcf, 0x9a, 0xb6,
-	0x78, 0xf9, 0xd0, 0xb6, 0x7d, 0xc2, 0x46, 0xa9, 0xbc, 0x05, 0xc0, 0x19, 0x33, 0x9a, 0xad, 0xda,
-	0x25, 0xb9, 0x42, 0xa5, 0xd3, 0x7a, 0x9a, 0x33, 0x76, 0x8a, 0x84, 0xc2, 0x16, 0x6c, 0x0e, 0x90,
-	0x17, 0x6d, 0xf7, 0xc7, 0xf7, 0x60, 0xb5, 0xca, 0x9c, 0x87, 0xb6, 0x7d, 0xe2, 0xd5, 0x68, 0xcb,
-	0xb3, 0xcf, 0x7c, 0xd3, 0xba, 0x24, 0xfe, 0x74, 0x3e, 0x5a, 0x87, 0x79, 0xde, 0x31, 0x2e, 0x4c,
-	0x76, 0x21, 0x9c, 0xa4, 0x27, 0x79, 0xe7, 0x73, 0x93, 0x5d, 0xa8, 0x47, 0x90, 0x0e, 0xd2, 0xc5,
-	0x08, 0xdc, 0x91, 0x4b, 0xec, 0x28, 0x7b, 0xd9, 0xf2, 0xdd, 0xe2, 0x80, 0xec, 0x6d, 0x5e, 0x3a,
-	0x45, 0xcc, 0xab, 0x0a, 0x75, 0xbd, 0xb3, 0xab, 0x26, 0xd1, 0x53, 0x96, 0x7c, 0x52, 0x0f, 0x61,
-	0x0e, 0x13, 0x29, 0x37, 0xb7, 0xa3, 0xec, 0x65, 0xca, 0xdf, 0x18, 0x86, 0x97, 0xd9, 0x76, 0x1a,
-	0xfc, 0xe8, 0x02, 0x12, 0x38, 0xa9, 0x56, 0xa7, 0xd6, 0xa5, 0xd0, 0x2d, 0x29, 0x9c, 0x84, 0x14,
-	0x54, 0x6f, 0x03, 0x52, 0xbc, 0x63, 0xb8, 0x9e, 0x4d, 0x3a, 0xb9, 0x79, 0x61, 0x12, 0xef, 0x9c,
-	0x04, 0xcb, 0x42, 0x1e, 0x3e, 0x18, 0xe4, 0x9f, 0xc8, 0x81, 0x7f, 0x55, 0x60, 0xa5, 0xca, 0x9c,
-	0x1f, 0x5f, 0xb8, 0x9c, 0xd4, 0x5d, 0xc6, 0x3f, 0xd3, 0x2b, 0xe5, 0x83, 0x11, 0xde, 0xbb, 0x03,
-	0x8b, 0xc4, 0xb7, 0xca, 0x07, 0x86, 0x29, 0x22, 0x21, 0x23, 0xb6, 0x80, 0xc4, 0x30, 0xda, 0xdd,
-	0x2e, 0x9e, 0xed, 0x75, 0xb1, 0x0a, 0x09, 0xcf, 0x6c, 0x08, 0x27, 0xa6, 0x75, 0x7c, 0x56, 0xd7,
-	0x20, 0xc9, 0xae, 0x1a, 0x35, 0x5a, 0x47, 0xd7, 0xa4, 0x75, 0xb9, 0x52, 0x35, 0x48, 0xd9, 0xc4,
-	0x72, 0x1b, 0x66, 0x9d, 0xa1, 0xcd, 0x8b, 0x7a, 0xb4, 0x56, 0x37, 0x21, 0xed, 0x98, 0x4c, 0x9c,
-	0x34, 0x69, 0x73, 0xca, 0x31, 0xd9, 0xe3, 0x60, 0x5d, 0x30, 0x60, 0xa3, 0xcf, 0xa6, 0xd0, 0xe2,
-	0xc0, 0x82, 0xe7, 0x3d, 0x16, 0x08, 0x0b, 0x17, 0x9e, 0x77, 0x5b, 0xb0, 0x05, 0x60, 0x59, 0x91,
-	0x4f, 0x65, 0x56, 0x06, 0x14, 0xe1, 0xd5, 0x7f, 0x2b, 0x70, 0x4b, 0xb8, 0xf5, 0x69, 0x8b, 0xdf,
-	0x3c, 0xef, 0x56, 0x61, 0xce, 0xa3, 0x9e, 0x45, 0xd0, 0x59, 0x09, 0x5d, 0x2c, 0xba, 0xb3, 0x31,
-	0xd1, 0x93, 0x8d, 0x5f, 0x4f, 0x26, 0xfd, 0x00, 0xb6, 0x06, 0x9a, 0x1c, 0x39, 0x76, 0x0b, 0xc0,
-	0x65, 0x86, 0x4f, 0x1a, 0xb4, 0x4d, 0x6c, 0xb4, 0x3e, 0xa5, 0xa7, 0x5d, 0xa6, 0x0b, 0x42, 0x81,
-	0x40, 0xae, 0xca, 0x1c, 0xb1, 0xfa, 0xff, 0x79, 0xad, 0x50, 0x80, 0x9d, 0x61, 0xdb, 0x44, 0x49,
-	0xff, 0x67, 0x05, 0x96, 0xaa, 0xcc, 0xf9, 0x92, 0x72, 0x72, 0x6c, 0xb2, 0x53, 0xdf, 0xb5, 0xc8,
-	0xd4, 0x2a, 0x34, 0x03, 0x74, 0xa8, 0x02, 0x2e, 0xd4, 0xdb, 0xb0, 0xd0, 0xf4, 0x5d, 0xea, 0xbb,
-	0xfc, 0xca, 0x38, 0x27, 0x04, 0xbd, 0x9c, 0xd0, 0x33, 0x21, 0xed, 0x11, 0x41, 0x16, 0x11, 0x06,
-	0xaf, 0xd5, 0xa8, 0x11, 0x1f, 0x03, 0x9c, 0xd0, 0x33, 0x48, 0x7b, 0x82, 0xa4, 0x1f, 0x25, 0x52,
-	0x73, 0xcb, 0xc9, 0xc2, 0x06, 0xac, 0xc7, 0x34, 0x8d, 0xac, 0xf8, 0x53, 0x32, 0xb2, 0x22, 0x34,
-	0x74, 0x84, 0x15, 0x9b, 0x80, 0xf9, 0x2b, 0xe2, 0x2e, 0x12, 0x3a, 0x15, 0x10, 0x30, 0xec, 0x1f,
-	0xc2, 0x1a, 0xad, 0x31, 0xe2, 0xb7, 0x89, 0x6d, 0x50, 0x29, 0xab, 0xfb, 0x1e, 0x5c, 0x0d, 0xdf,
-	0x86, 0x1b, 0x21, 0xaa, 0x02, 0xf9, 0x7e, 0x94, 0xcc, 0x2e, 0xe2, 0x3a, 0x17, 0x5c, 0x9a, 0xb5,
-	0x19, 0x47, 0x1f, 0x61, 0xbe, 0x21, 0x8b, 0xfa, 0x31, 0x68, 0xfd, 0x42, 0x82, 0xa3, 0xdd, 0x62,
-	0xc4, 0xce, 0x01, 0x0a, 0x58, 0x8f, 0x0b, 0x38, 0x36, 0xd9, 0x33, 0x46, 0x6c, 0xf5, 0x97, 0x0a,
-	0xdc, 0xed, 0x47, 0x93, 0xf3, 0x73, 0x62, 0x71, 0xb7, 0x4d, 0x50, 0x8e, 0x08, 0x50, 0x06, 0x8b,
-	0x5e, 0x51, 0x16, 0xbd, 0xdd, 0x09, 0x8a, 0xde, 0x89, 0xc7, 0xf5, 0xdb, 0xf1, 0x8d, 0x3f, 0x0b,
-	0x45, 0x47, 0x79, 0x73, 0x3a, 0x5e, 0x03, 0x71, 0x49, 0x2d, 0xa0, 0x29, 0x23, 0x25, 0xe2, 0xed,
-	0xa5, 0x52, 0xc8, 0xb6, 0xcd, 0x7a, 0x8b, 0x18, 0x3e, 0xb1, 0x88, 0x1b, 0x9c, 0x25, 0xbc, 0x16,
-	0x8f, 0x3e, 0xbf, 0x66, 0xc5, 0xfe, 0xcf, 0xeb, 0xed, 0x5b, 0x57, 0x66, 0xa3, 0x7e, 0x58, 0xe8,
-	0x15, 0x57, 0xd0, 0x17, 0x91, 0xa0, 0xcb, 0xb5, 0xfa, 0x29, 0x24, 0x19, 0x37, 0x79, 0x4b, 0xdc,
-	0xb2, 0xd9, 0xf2, 0xbd, 0xa1, 0xa5, 0x4d, 0x34, 0x57, 0x12, 0xf8, 0x05, 0x62, 0x74, 0x89, 0x55,
-	0xef, 0x42, 0x36, 0xb2, 0x1f, 0x19, 0xe5, 0x05, 0xb2, 0x18, 0x52, 0x2b, 0x01, 0x51, 0xbd, 0x07,
-	0x6a, 0xc4, 0x16, 0x14, 0x7e, 0x71, 0x84, 0x53, 0xe8, 0x9c, 0xe5, 0xf0, 0xcd, 0x19, 0x63, 0x4f,
-	0xf0, 0x0e, 0xec, 0x29, 0xbc, 0xe9, 0xa9, 0x0a, 0x6f, 0xd7, 0x11, 0x0a, 0x7d, 0x1e, 0x1d, 0xa1,
-	0x7f, 0xce, 0x41, 0x56, 0xbe, 0x93, 0xf5, 0x71, 0xc4, 0x09, 0x0a, 0xca, 0x14, 0xf1, 0x6c, 0xe2,
-	0xcb, 0xe3, 0x23, 0x57, 0xea, 0x2e, 0x2c, 0x89, 0x27, 0x23, 0x56, 0xf4, 0x16, 0x05, 0xb9, 0x22,
-	0x2f, 0x0b, 0x0d, 0x52, 0x32, 0x04, 0xbe, 0xbc, 0xd0, 0xa3, 0x75, 0xe0, 0xbc, 0xf0, 0x59, 0x3a,
-	0x6f, 0x4e, 0x88, 0x08, 0xa9, 0xc2, 0x79, 0xef, 0x9a, 0xb8, 0xe4, 0x8d, 0x9a, 0xb8, 0xc0, 0xca,
-	0x06, 0x61, 0xcc, 0x74, 0x84, 0xeb, 0xd3, 0x7a, 0xb8, 0x0c, 0x6e, 0x26, 0xd7, 0xeb, 0xba, 0x00,
-	0xd2, 0xf8, 0x3a, 0x23, 0x69, 0x78, 0xee, 0x0f, 0x60, 0x35, 0x64, 0xe9, 0x39, 0xed, 0xe2, 0xb0,
-	0xaa, 0xf2, 0x5d, 0xf7, 0x21, 0xef, 0xa9, 0xd6, 0x19, 0x64, 0x8b, 0xaa, 0x75, 0x6f, 0x8c, 0x17,
-	0xa6, 0x6b, 0xae, 0x36, 0x21, 0xcd, 0x3b, 0x06, 0xf5, 0x5d, 0xc7, 0xf5, 0x72, 0x8b, 0xc2, 0xb9,
-	0xbc, 0xf3, 0x14, 0xd7, 0xc1, 0x2d, 0x6d, 0x32, 0x46, 0x78, 0x2e, 0x8b, 0x2f, 0xc4, 0x42, 0xdd,
-	0x86, 0x0c, 0x69, 0x13, 0x8f, 0xcb, 0x6a, 0xb7, 0x84, 0x5a, 0x01, 0x92, 0xb0, 0xe0, 0xa9, 0x3e,
-	0x6c, 0x60, 0x1b, 0x6e, 0xd1, 0xba, 0x61, 0x51, 0x8f, 0xfb, 0xa6, 0xc5, 0x8d, 0x36, 0xf1, 0x99,
-	0x4b, 0xbd, 0xdc, 0x32, 0xea, 0xf9, 0xa0, 0x38, 0x72, 0x84, 0x09, 0x4a, 0x2f, 0xe2, 0x2b, 0x12,
-	0xfe, 0xa5, 0x40, 0xeb, 0xeb, 0xcd, 0xc1, 0x2f, 0xd4, 0x9f, 0x06, 0x79, 0xd0, 0x26, 0x3e, 0x37,
-	0x68, 0x93, 0xbb, 0xd4, 0x63, 0xb9, 0x15, 0xac, 0xf1, 0xf7, 0xc6, 0x6c, 0xa4, 0x23, 0xe8, 0xa9,
-	0xc0, 0x1c, 0x25, 0x82, 0xb4, 0x08, 0x72, 0xa7, 0x8b, 0x58, 0xc8, 0xc1, 0x5a, 0x6f, 0xaa, 0x47,
-	0xa7, 0xe0, 0x31, 0xb6, 0x80, 0x0f, 0x6b, 0xd4, 0xe7, 0x5f, 0xf0, 0x96, 0x75, 0x59, 0xa9, 0x9c,
-	0xfd, 0x64, 0x74, 0xc7, 0x3e, 0xaa, 0x37, 0xda, 0xc4, 0xe6, 0xab, 0x57, 0x5a, 0xb4, 0x55, 0x1b,
-	0xdb, 0x75, 0x9d, 0x9c, 0xb7, 0x3c, 0x1b, 0x59, 0x88, 0x7d, 0xa3, 0xdd, 0xc4, 0xc1, 0x09, 0xa4,
-	0x45, 0xed, 0x9c, 0xa8, 0x58, 0x8b, 0x82, 0x2a, 0xfb, 0x39, 0xd9, 0x06, 0xf7, 0xed, 0x1b, 0xe9,
-	0xf5, 0x42, 0x41, 0xad, 0xc5, 0x9c, 0xa1, 0x9b, 0x9c, 0x3c, 0x16, 0x23, 0xdc, 0xa3, 0x60, 0x82,
-	0x1b, 0xa1, 0x9d, 0x05, 0x6a, 0xff, 0xc4, 0x87, 0x5a, 0x66, 0xca, 0xa5, 0x71, 0x31, 0x8b, 0x6d,
-	0x23, 0xc3, 0xb6, 0xec, 0xc7, 0xe8, 0x85, 0x3b, 0x70, 0x7b, 0xa8, 0x6e, 0x91, 0x05, 0xff, 0x52,
-	0x70, 0x52, 0x92, 0x73, 0x19, 0xb6, 0xbc, 0x95, 0x16, 0xe3, 0xd4, 0xbe, 0xba, 0xc1, 0xd0, 0x58,
-	0x84, 0xf7, 0x3d, 0xf2, 0x95, 0x61, 0x09, 0x41, 0x31, 0x17, 0xaf, 0x78, 0xe4, 0x2b, 0xb9, 0x45,
-	0xd8, 0x36, 0xf7, 0x4d, 0x07, 0x89, 0x01, 0xd3, 0xc1, 0xbb, 0x4b, 0x6c, 0xee, 0x66, 0x93, 0xe8,
-	0xa7, 0x70, 0x67, 0x84, 0xc5, 0xdd, 0x7d, 0x69, 0x57, 0x06, 0x29, 0xf1, 0x7c, 0x6d, 0x60, 0xc3,
-	0x28, 0xbc, 0xdb, 0x2d, 0xe4, 0xd4, 0x6c, 0x31, 0x59, 0xe3, 0xa6, 0x6f, 0x0e, 0x03, 0x19, 0xe8,
-	0xae, 0x94, 0x2e, 0x16, 0x85, 0x13, 0xd8, 0x1b, 0xb7, 0xdd, 0x84, 0x9a, 0x97, 0xff, 0x9b, 0x85,
-	0xd9, 0x2a, 0x73, 0xd4, 0xdf, 0x28, 0xa0, 0x0e, 0x18, 0x45, 0x3e, 0x1c, 0x93, 0x7f, 0x03, 0xbb,
-	0x79, 0xed, 0xfb, 0xd3, 0xa0, 0x22, 0x8d, 0x7f, 0xad, 0xc0, 0x4a, 0xff, 0x30, 0x7e, 0x7f, 0x22,
-	0x99, 0xbd, 0x20, 0xed, 0xe3, 0x29, 0x40, 0x91, 0x1e, 0xbf, 0x53, 0xe0, 0xd6, 0xe0, 0x51, 0xe3,
-	0xbb, 0xe3, 0xc5, 0x0e, 0x04, 0x6a, 0x9f, 0x4c, 0x09, 0x8c, 0x74, 0x6a, 0xc3, 0x42, 0xcf, 0xc4,
-	0x51, 0x1c, 0x2f, 0xb0, 0x9b, 0x5f, 0x7b, 0x70, 0x3d, 0xfe, 0xf8, 0xbe, 0xd1, 0x8c, 0x30, 0xe1,
-	0xbe, 0x21, 0xff, 0xa4, 0xfb, 0xc6, 0x9b, 0x2b, 0x95, 0x41, 0xa6, 0xbb, 0xb1, 0xda, 0x9f, 0x4c,
-	0x8c, 0x64, 0xd7, 0xbe, 0x73, 0x2d, 0xf6, 0x68, 0xd3, 0x9f, 0x43, 0x36, 0xf6, 0x2d, 0xe3, 0x60,
-	0xbc, 0xa0, 0x5e, 0x84, 0xf6, 0xd1, 0x75, 0x11, 0xd1, 0xee, 0xbf, 0x52, 0x60, 0xb9, 0xef, 0xdb,
-	0x57, 0x79, 0xbc, 0xb8, 0x38, 0x46, 0x3b, 0xbc, 0x3e, 0x26, 0x52, 0xe2, 0x17, 0xb0, 0x14, 0xff,
-	0x62, 0xf8, 0xed, 0xf1, 0xe2, 0x62, 0x10, 0xed, 0x7b, 0xd7, 0x86, 0x74, 0xc7, 0x20, 0xd6, 0x4c,
-	0x4c, 0x10, 0x83, 0x5e, 0xc4, 0x24, 0x31, 0x18, 0xdc, 0x62, 0xe0, 0x15, 0xd4, 0xdf, 0x60, 0xdc,
-	0x9f, 0xe4, 0xf4, 0xc6, 0x40, 0x93, 0x5c, 0x41, 0x43, 0x5b, 0x0a, 0xf5, 0x0f, 0x0a, 0xac, 0x0d,
-	0xe9, 0x27, 0x3e, 0x9a, 0x34, 0xba, 0x71, 0xa4, 0xf6, 0xc3, 0x69, 0x91, 0x91, 0x5a, 0x2f, 0x14,
-	0xc8, 0x0d, 0x6d, 0x12, 0x0e, 0x27, 0x0e, 0x7a, 0x1f, 0x56, 0x3b, 0x9a, 0x1e, 0x1b, 0x29, 0xf7,
-	0x17, 0x05, 0xb6, 0x46, 0x57, 0xe2, 0x4f, 0x26, 0x75, 0xc0, 0x10, 0x01, 0xda, 0xf1, 0x0d, 0x05,
-	0x84, 0xba, 0x1e, 0x1d, 0xbf, 0x7c, 0x93, 0x57, 0x5e, 0xbd, 0xc9, 0x2b, 0xff, 0x78, 0x93, 0x57,
-	0x7e, 0xfb, 0x36, 0x3f, 0xf3, 0xea, 0x6d, 0x7e, 0xe6, 0x6f, 0x6f, 0xf3, 0x33, 0x3f, 0xdb, 0xef,
-	0x6a, 0x64, 0x82, 0x2d, 0xf6, 0xc5, 0x27, 0x7e, 0x8f, 0xda, 0xa4, 0xd4, 0xe9, 0xf9, 0x4f, 0x48,
-	0xd0, 0xd3, 0xd4, 0x92, 0x38, 0x0c, 0xdc, 0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x52,
-	0x23, 0x18, 0x37, 0x19, 0x00, 0x00,
+	0x15, 0x0f, 0x37, 0xb2, 0x22, 0x3d, 0xd9, 0x8e, 0xc3, 0x75, 0x12, 0x99, 0x5e, 0x2b, 0x8e, 0xd2,
+	0xa4, 0x46, 0x91, 0x48, 0xae, 0xb2, 0x75, 0x53, 0xa7, 0xe8, 0x6e, 0xac, 0xdd, 0x78, 0x5d, 0x44,
+	0x89, 0xc1, 0x75, 0xb6, 0x1f, 0x17, 0x82, 0x22, 0xc7, 0x34, 0x61, 0x69, 0x46, 0xe0, 0x8c, 0xb4,
+	0x72, 0x50, 0xa0, 0x45, 0x81, 0x02, 0x3d, 0xb6, 0x8b, 0x9e, 0xf6, 0xd0, 0x5b, 0x81, 0xf6, 0x3f,
+	0xd9, 0x63, 0xd0, 0x53, 0xd1, 0x43, 0x50, 0x24, 0xa7, 0xde, 0xda, 0xfe, 0x05, 0x05, 0x67, 0x86,
+	0x63, 0x91, 0xd4, 0xb7, 0x51, 0xf4, 0x62, 0x71, 0x1e, 0xdf, 0xef, 0x7d, 0xcf, 0xbc, 0x37, 0x34,
+	0xdc, 0x7b, 0x85, 0x98, 0xed, 0x9c, 0xd8, 0x3e, 0xae, 0xf2, 0x27, 0x12, 0xa0, 0xaa, 0x13, 0x10,
+	0x4a, 0x05, 0x8d, 0xf5, 0x2b, 0x9d, 0x80, 0x30, 0xa2, 0x6f, 0x28, 0xbe, 0x4a, 0xc4, 0x57, 0x39,
+	0xe7, 0x33, 0x56, 0x3d, 0xe2, 0x11, 0xce, 0x59, 0x0d, 0x9f, 0x04, 0xc8, 0xf8, 0xce, 0x10, 0xe1,
+	0x9d, 0x53, 0xaf, 0xca, 0x49, 0x54, 0xfe, 0x48, 0xde, 0x7b, 0xa3, 0x78, 0x89, 0x8f, 0xf9, 0x9f,
+	0x09, 0x32, 0x3b, 0x01, 0x21, 0xc7, 0x54, 0xfe, 0x48, 0xde, 0x9d, 0xf1, 0xce, 0x05, 0x36, 0x43,
+	0x56, 0xcb, 0x6f, 0xfb, 0x0c, 0x05, 0xd6, 0x71, 0xcb, 0xf6, 0xa6, 0xc4, 0x39, 0x0e, 0xeb, 0x5b,
+	0x9d, 0xa0, 0x8b, 0x7d, 0xec, 0xc5, 0x70, 0xb5, 0x09, 0xb8, 0xf0, 0xd1, 0xe2, 0xcf, 0x56, 0x14,
+	0xd8, 0xf2, 0x57, 0x1a, 0xe8, 0x0d, 0xea, 0x35, 0x7c, 0x2f, 0x34, 0xe7, 0x88, 0xd2, 0xa7, 0x5d,
+	0xec, 0x52, 0xbd, 0x08, 0x57, 0x9c, 0x00, 0xd9, 0x8c, 0x04, 0x45, 0x6d, 0x53, 0xdb, 0xca, 0x9b,
+	0xd1, 0x52, 0x5f, 0x83, 0x9c, 0x10, 0xe1, 0xbb, 0xc5, 0xf7, 0x36, 0xb5, 0xad, 0xcb, 0xe6, 0x15,
+	0xbe, 0x3e, 0x70, 0xf5, 0x7d, 0xc8, 0xda, 0x6d, 0xd2, 0xc5, 0xac, 0x78, 0x39, 0xc4, 0xec, 0x55,
+	0xbf, 0x79, 0x73, 0xeb, 0xd2, 0xdf, 0xdf, 0xdc, 0xfa, 0xb6, 0xe7, 0xb3, 0x93, 0x6e, 0xb3, 0xe2,
+	0x90, 0x76, 0xd5, 0x21, 0xb4, 0x4d, 0xa8, 0xfc, 0x79, 0x40, 0xdd, 0xd3, 0x2a, 0x3b, 0xeb, 0x20,
+	0x5a, 0x79, 0xe9, 0x63, 0x66, 0x4a, 0x78, 0xf9, 0x03, 0x30, 0xd2, 0x36, 0x99, 0x88, 0x76, 0x08,
+	0xa6, 0xa8, 0xfc, 0x1c, 0xde, 0x6f, 0x50, 0xef, 0x65, 0xc7, 0x15, 0x2f, 0x9f, 0xb8, 0x6e, 0x80,
+	0xe8, 0x38, 0x93, 0x37, 0x00, 0x18, 0xa5, 0x56, 0xa7, 0xdb, 0x3c, 0x45, 0x67, 0xdc, 0xe8, 0xbc,
+	0x99, 0x67, 0x94, 0x1e, 0x72, 0x42, 0x79, 0x03, 0xd6, 0x87, 0xc8, 0x53, 0xea, 0xfe, 0xf8, 0x1e,
+	0xac, 0x36, 0xa8, 0xf7, 0xc4, 0x75, 0x0f, 0x70, 0x93, 0x74, 0xb1, 0x7b, 0x14, 0xd8, 0xce, 0x29,
+	0x0a, 0xe6, 0x8b, 0xd1, 0x4d, 0xb8, 0xc2, 0xfa, 0xd6, 0x89, 0x4d, 0x4f, 0x44, 0x90, 0xcc, 0x2c,
+	0xeb, 0x7f, 0x66, 0xd3, 0x13, 0x7d, 0x0f, 0xf2, 0x61, 0x99, 0x59, 0x61, 0x38, 0x8a, 0x99, 0x4d,
+	0x6d, 0x6b, 0xb9, 0x76, 0xb7, 0x32, 0xa4, 0xea, 0x3b, 0xa7, 0x5e, 0x85, 0xd7, 0x63, 0x9d, 0xf8,
+	0xf8, 0xe8, 0xac, 0x83, 0xcc, 0x9c, 0x23, 0x9f, 0xf4, 0x5d, 0x58, 0xe0, 0x05, 0x58, 0x5c, 0xd8,
+	0xd4, 0xb6, 0x0a, 0xb5, 0x6f, 0x8d, 0xc2, 0xcb, 0x2a, 0x3d, 0x0c, 0x7f, 0x4c, 0x01, 0x09, 0x83,
+	0xd4, 0x6c, 0x11, 0xe7, 0x54, 0xd8, 0x96, 0x15, 0x41, 0xe2, 0x14, 0x6e, 0xde, 0x1a, 0xe4, 0x58,
+	0xdf, 0xf2, 0xb1, 0x8b, 0xfa, 0xc5, 0x2b, 0xc2, 0x25, 0xd6, 0x3f, 0x08, 0x97, 0xe5, 0x12, 0x7c,
+	0x30, 0x2c, 0x3e, 0x2a, 0x80, 0x7f, 0xd5, 0xe0, 0x5a, 0x83, 0x7a, 0x3f, 0x39, 0xf1, 0x19, 0x6a,
+	0xf9, 0x94, 0x7d, 0x6a, 0xd6, 0x6b, 0xdb, 0x63, 0xa2, 0x77, 0x07, 0x96, 0x50, 0xe0, 0xd4, 0xb6,
+	0x2d, 0x5b, 0x64, 0x42, 0x66, 0x6c, 0x91, 0x13, 0xa3, 0x6c, 0x0f, 0x86, 0xf8, 0x72, 0x3c, 0xc4,
+	0x3a, 0x64, 0xb0, 0xdd, 0x16, 0x41, 0xcc, 0x9b, 0xfc, 0x59, 0xbf, 0x01, 0x59, 0x7a, 0xd6, 0x6e,
+	0x92, 0x16, 0x0f, 0x4d, 0xde, 0x94, 0x2b, 0xdd, 0x80, 0x9c, 0x8b, 0x1c, 0xbf, 0x6d, 0xb7, 0x28,
+	0xf7, 0x79, 0xc9, 0x54, 0x6b, 0x7d, 0x1d, 0xf2, 0x9e, 0x4d, 0xc5, 0x0e, 0x95, 0x3e, 0xe7, 0x3c,
+	0x9b, 0x3e, 0x0b, 0xd7, 0x65, 0x0b, 0xd6, 0x52, 0x3e, 0x45, 0x1e, 0x87, 0x1e, 0xbc, 0x8a, 0x79,
+	0x20, 0x3c, 0x5c, 0x7c, 0x35, 0xe8, 0xc1, 0x06, 0x80, 0xe3, 0xa8, 0x98, 0xca, 0xaa, 0x74, 0x9c,
+	0x28, 0xaa, 0xff, 0xd6, 0xe0, 0xba, 0x08, 0xeb, 0x8b, 0x2e, 0xbb, 0x78, 0xdd, 0xad, 0xc2, 0x02,
+	0x26, 0xd8, 0x41, 0x3c, 0x58, 0x19, 0x53, 0x2c, 0x06, 0xab, 0x31, 0x13, 0xab, 0xc6, 0xff, 0x4f,
+	0x25, 0xfd, 0x08, 0x36, 0x86, 0xba, 0xac, 0x02, 0xbb, 0x01, 0xe0, 0x53, 0x2b, 0x40, 0x6d, 0xd2,
+	0x43, 0x2e, 0xf7, 0x3e, 0x67, 0xe6, 0x7d, 0x6a, 0x0a, 0x42, 0x19, 0x41, 0xb1, 0x41, 0x3d, 0xb1,
+	0xfa, 0xdf, 0x45, 0xad, 0x5c, 0x86, 0xcd, 0x51, 0x6a, 0x54, 0xd1, 0xff, 0x59, 0x83, 0xab, 0x0d,
+	0xea, 0x7d, 0x41, 0x18, 0xda, 0xb7, 0xe9, 0x61, 0xe0, 0x3b, 0x68, 0x6e, 0x13, 0x3a, 0x81, 0x7f,
+	0x6e, 0x02, 0x5f, 0xe8, 0xb7, 0x61, 0xb1, 0x13, 0xf8, 0x24, 0xf0, 0xd9, 0x99, 0x75, 0x8c, 0x10,
+	0x8f, 0x72, 0xc6, 0x2c, 0x44, 0xb4, 0xa7, 0x88, 0xb3, 0x88, 0x34, 0xe0, 0x6e, 0xbb, 0x89, 0x02,
+	0x9e, 0xe0, 0x8c, 0x59, 0xe0, 0xb4, 0xe7, 0x9c, 0xf4, 0xe3, 0x4c, 0x6e, 0x61, 0x25, 0x5b, 0x5e,
+	0x83, 0x9b, 0x09, 0x4b, 0x95, 0x17, 0x7f, 0xca, 0x2a, 0x2f, 0x22, 0x47, 0xc7, 0x78, 0xb1, 0x0e,
+	0xbc, 0x7e, 0x45, 0xde, 0x45, 0x41, 0xe7, 0x42, 0x02, 0x4f, 0xfb, 0x87, 0x70, 0x83, 0x34, 0x29,
+	0x0a, 0x7a, 0xc8, 0xb5, 0x88, 0x94, 0x35, 0x78, 0x0e, 0xae, 0x46, 0x6f, 0x23, 0x45, 0x1c, 0x55,
+	0x87, 0x52, 0x1a, 0x25, 0xab, 0x0b, 0xf9, 0xde, 0x09, 0x93, 0x6e, 0xad, 0x27, 0xd1, 0x7b, 0xbc,
+	0xde, 0x38, 0x8b, 0xfe, 0x18, 0x8c, 0xb4, 0x90, 0x70, 0x6b, 0x77, 0x29, 0x72, 0x8b, 0xc0, 0x05,
+	0xdc, 0x4c, 0x0a, 0xd8, 0xb7, 0xe9, 0x4b, 0x8a, 0x5c, 0xfd, 0x57, 0x1a, 0xdc, 0x4d, 0xa3, 0xd1,
+	0xf1, 0x31, 0x72, 0x98, 0xdf, 0x43, 0x5c, 0x8e, 0x48, 0x50, 0x81, 0x37, 0xbd, 0x8a, 0x6c, 0x7a,
+	0xf7, 0xa6, 0x68, 0x7a, 0x07, 0x98, 0x99, 0xb7, 0x93, 0x8a, 0x3f, 0x8d, 0x44, 0xab, 0xba, 0x39,
+	0x9c, 0x6c, 0x81, 0x38, 0xa4, 0x16, 0xb9, 0x2b, 0x63, 0x25, 0xf2, 0xd3, 0x4b, 0x27, 0xb0, 0xdc,
+	0xb3, 0x5b, 0x5d, 0x64, 0x05, 0xc8, 0x41, 0x7e, 0xb8, 0x97, 0xf8, 0xb1, 0xb8, 0xf7, 0xd9, 0x8c,
+	0x1d, 0xfb, 0x3f, 0x6f, 0x6e, 0x5d, 0x3f, 0xb3, 0xdb, 0xad, 0xdd, 0x72, 0x5c, 0x5c, 0xd9, 0x5c,
+	0xe2, 0x04, 0x53, 0xae, 0xf5, 0x4f, 0x20, 0x4b, 0x99, 0xcd, 0xba, 0xe2, 0x94, 0x5d, 0xae, 0xdd,
+	0x1f, 0xd9, 0xda, 0xc4, 0x50, 0x26, 0x81, 0x9f, 0x73, 0x8c, 0x29, 0xb1, 0xfa, 0x5d, 0x58, 0x56,
+	0xfe, 0x73, 0x46, 0x79, 0x80, 0x2c, 0x45, 0xd4, 0x7a, 0x48, 0xd4, 0xef, 0x83, 0xae, 0xd8, 0xc2,
+	0xc6, 0x2f, 0xb6, 0x70, 0x8e, 0x07, 0x67, 0x25, 0x7a, 0x73, 0x44, 0xe9, 0xf3, 0x90, 0x1e, 0x6f,
+	0xbc, 0xf9, 0xb9, 0x1a, 0xef, 0xc0, 0x16, 0x8a, 0x62, 0xae, 0xb6, 0xd0, 0x3f, 0x17, 0x60, 0x59,
+	0xbe, 0x3b, 0xc0, 0x93, 0x76, 0x50, 0xd8, 0xa6, 0x10, 0x76, 0x51, 0x20, 0xb7, 0x8f, 0x5c, 0xe9,
+	0xf7, 0xe0, 0xaa, 0x78, 0xb2, 0x12, 0x4d, 0x6f, 0x49, 0x90, 0xeb, 0xf2, 0xb0, 0x30, 0x20, 0x27,
+	0x53, 0x10, 0xc8, 0x03, 0x5d, 0xad, 0xc3, 0xe0, 0x45, 0xcf, 0x32, 0x78, 0x0b, 0x42, 0x44, 0x44,
+	0x15, 0xc1, 0x3b, 0x1f, 0xe2, 0xb2, 0x17, 0x1a, 0xe2, 0x42, 0x2f, 0xdb, 0x88, 0x52, 0xdb, 0x13,
+	0xa1, 0xcf, 0x9b, 0xd1, 0x32, 0x3c, 0x99, 0x7c, 0x3c, 0x70, 0x00, 0xe4, 0xf9, 0xeb, 0x82, 0x8f,
+	0xcf, 0xf7, 0xfd, 0x36, 0xac, 0xfa, 0x78, 0xc8, 0x6e, 0x17, 0x9b, 0x55, 0xf7, 0x71, 0x6a, 0x93,
+	0xc7, 0xba, 0x75, 0x81, 0xb3, 0xa9, 0x6e, 0x1d, 0xcf, 0xf1, 0xe2, 0x7c, 0xc3, 0xd5, 0x3a, 0xe4,
+	0x59, 0xdf, 0x22, 0x81, 0xef, 0xf9, 0xb8, 0xb8, 0x24, 0x82, 0xcb, 0xfa, 0x2f, 0xf8, 0x3a, 0x3c,
+	0xa5, 0x6d, 0x4a, 0x11, 0x2b, 0x2e, 0xf3, 0x17, 0x62, 0xa1, 0xdf, 0x82, 0x02, 0xea, 0x21, 0xcc,
+	0x64, 0xb7, 0xbb, 0xca, 0xad, 0x02, 0x4e, 0xe2, 0x0d, 0x4f, 0x0f, 0x60, 0x8d, 0x8f, 0xe1, 0x0e,
+	0x69, 0x59, 0x0e, 0xc1, 0x2c, 0xb0, 0x1d, 0x66, 0xf5, 0x50, 0x40, 0x7d, 0x82, 0x8b, 0x2b, 0xdc,
+	0xce, 0x9d, 0xca, 0xd8, 0xab, 0x4f, 0xe5, 0x50, 0xe2, 0xeb, 0x12, 0xfe, 0x85, 0x40, 0x9b, 0x37,
+	0x3b, 0xc3, 0x5f, 0xe8, 0x3f, 0x0b, 0xeb, 0xa0, 0x87, 0x02, 0x66, 0x91, 0x0e, 0xf3, 0x09, 0xa6,
+	0xc5, 0x6b, 0xbc, 0xc7, 0xdf, 0x9f, 0xa0, 0xc8, 0xe4, 0xa0, 0x17, 0x02, 0xb3, 0x97, 0x09, 0xcb,
+	0x22, 0xac, 0x9d, 0x01, 0x62, 0xb9, 0x08, 0x37, 0xe2, 0xa5, 0xae, 0x76, 0xc1, 0x33, 0x3e, 0x02,
+	0x3e, 0x69, 0x92, 0x80, 0x7d, 0xce, 0xba, 0xce, 0x69, 0xbd, 0x7e, 0xf4, 0xd3, 0xf1, 0x13, 0xfb,
+	0xb8, 0xd9, 0x68, 0x1d, 0xd6, 0x52, 0xd2, 0x94, 0xaa, 0x1e, 0x1f, 0xd7, 0x4d, 0x74, 0xdc, 0xc5,
+	0x2e, 0x67, 0x41, 0xee, 0x85, 0xb4, 0x89, 0x8d, 0x13, 0x4a, 0x53, 0xe3, 0x9c, 0xe8, 0x58, 0x4b,
+	0x82, 0x2a, 0xe7, 0x39, 0x39, 0x06, 0xa7, 0xf4, 0x2a, 0xbb, 0xbe, 0xd6, 0x60, 0x4d, 0xdd, 0x33,
+	0x4c, 0x9b, 0xa1, 0x67, 0xe2, 0xea, 0xf7, 0x34, 0xbc, 0xc1, 0x8d, 0xb1, 0xce, 0x01, 0x3d, 0x7d,
+	0x53, 0xe4, 0x56, 0x16, 0x6a, 0xd5, 0x49, 0x39, 0x4b, 0xa8, 0x91, 0x69, 0x5b, 0x09, 0x12, 0xf4,
+	0xf2, 0x1d, 0xb8, 0x3d, 0xd2, 0x36, 0xe5, 0xc1, 0xbf, 0x34, 0x58, 0x3f, 0xbf, 0x97, 0xf1, 0x91,
+	0xb7, 0xde, 0xa5, 0x8c, 0xb8, 0x67, 0x17, 0xb8, 0x34, 0x56, 0xe0, 0x7d, 0x8c, 0xbe, 0xb4, 0x1c,
+	0x21, 0x28, 0x11, 0xe2, 0x6b, 0x18, 0x7d, 0x29, 0x55, 0x44, 0x63, 0x73, 0xea, 0x76, 0x90, 0x19,
+	0x72, 0x3b, 0x38, 0x3f, 0xc4, 0x16, 0x2e, 0x76, 0x13, 0xfd, 0x04, 0xee, 0x8c, 0xf1, 0x78, 0x70,
+	0x2e, 0x1d, 0xa8, 0x20, 0x2d, 0x59, 0xaf, 0x6d, 0xd8, 0x54, 0xd1, 0x1d, 0x14, 0x72, 0x68, 0x77,
+	0xa9, 0xec, 0x71, 0xf3, 0x0f, 0x87, 0xa1, 0x0c, 0x1e, 0xae, 0x9c, 0x29, 0x16, 0xe5, 0x03, 0xd8,
+	0x9a, 0xa4, 0x6e, 0x5a, 0xcb, 0x63, 0x45, 0x5b, 0x77, 0x58, 0xff, 0x50, 0x7c, 0x77, 0x98, 0xa2,
+	0x68, 0xd3, 0x9f, 0x29, 0xa6, 0x2c, 0xda, 0xa4, 0x9a, 0xa8, 0x68, 0x9d, 0x04, 0x3d, 0x56, 0xb4,
+	0x49, 0x50, 0xe4, 0x60, 0xed, 0xab, 0x15, 0xb8, 0xdc, 0xa0, 0x9e, 0xfe, 0x5b, 0x0d, 0xf4, 0x21,
+	0x97, 0xa9, 0x0f, 0x27, 0x18, 0x33, 0xf4, 0x3e, 0x62, 0xfc, 0x70, 0x1e, 0x94, 0x8a, 0xf9, 0x6f,
+	0x34, 0xb8, 0x96, 0xfe, 0x9c, 0xf0, 0x70, 0x2a, 0x99, 0x71, 0x90, 0xf1, 0x78, 0x0e, 0x90, 0xb2,
+	0xe3, 0xf7, 0x1a, 0x5c, 0x1f, 0x7e, 0x59, 0xfa, 0xfe, 0x64, 0xb1, 0x43, 0x81, 0xc6, 0x47, 0x73,
+	0x02, 0x95, 0x4d, 0x3d, 0x58, 0x8c, 0xdd, 0x99, 0x2a, 0x93, 0x05, 0x0e, 0xf2, 0x1b, 0x3b, 0xb3,
+	0xf1, 0x27, 0xf5, 0xaa, 0x5b, 0xce, 0x94, 0x7a, 0x23, 0x7e, 0x63, 0x67, 0x36, 0x7e, 0xa5, 0x97,
+	0x42, 0x61, 0x70, 0x34, 0x7c, 0x30, 0x9d, 0x18, 0xc9, 0x6e, 0x7c, 0x6f, 0x26, 0x76, 0xa5, 0xf4,
+	0x17, 0xb0, 0x9c, 0xf8, 0x1a, 0xb3, 0x3d, 0x59, 0x50, 0x1c, 0x61, 0x3c, 0x9a, 0x15, 0xa1, 0xb4,
+	0xff, 0x5a, 0x83, 0x95, 0xd4, 0xd7, 0xbb, 0xda, 0x64, 0x71, 0x49, 0x8c, 0xb1, 0x3b, 0x3b, 0x46,
+	0x19, 0xf1, 0x4b, 0xb8, 0x9a, 0xfc, 0xe6, 0xf9, 0xdd, 0xc9, 0xe2, 0x12, 0x10, 0xe3, 0x07, 0x33,
+	0x43, 0x06, 0x73, 0x90, 0x18, 0x87, 0xa6, 0xc8, 0x41, 0x1c, 0x61, 0x3c, 0x9a, 0x15, 0x11, 0x3b,
+	0x82, 0xd2, 0x23, 0xd2, 0xc3, 0x69, 0x76, 0x6f, 0x02, 0x64, 0x3c, 0x9e, 0x03, 0xa4, 0xec, 0xf8,
+	0x83, 0x06, 0x37, 0x46, 0x4c, 0x44, 0x8f, 0xa6, 0xcd, 0x6e, 0x12, 0x69, 0x7c, 0x3c, 0x2f, 0x52,
+	0x99, 0xf5, 0xb5, 0x06, 0xc5, 0x91, 0x63, 0xce, 0xee, 0xd4, 0x49, 0x4f, 0x61, 0x8d, 0xbd, 0xf9,
+	0xb1, 0xca, 0xb8, 0xbf, 0x68, 0xb0, 0x31, 0x7e, 0x96, 0xf8, 0x68, 0xda, 0x00, 0x8c, 0x10, 0x60,
+	0xec, 0x5f, 0x50, 0xc0, 0x90, 0xfc, 0xa6, 0x86, 0x87, 0xa9, 0xf3, 0x9b, 0x44, 0x1a, 0x1f, 0xcf,
+	0x8b, 0x8c, 0xcc, 0xda, 0xdb, 0xff, 0xe6, 0x6d, 0x49, 0x7b, 0xfd, 0xb6, 0xa4, 0xfd, 0xe3, 0x6d,
+	0x49, 0xfb, 0xdd, 0xbb, 0xd2, 0xa5, 0xd7, 0xef, 0x4a, 0x97, 0xfe, 0xf6, 0xae, 0x74, 0xe9, 0xe7,
+	0x0f, 0x06, 0x26, 0xc4, 0x50, 0xf6, 0x03, 0xf1, 0xbf, 0x13, 0x4c, 0x5c, 0x54, 0xed, 0xc7, 0xfe,
+	0x35, 0x15, 0x0e, 0x8b, 0xcd, 0x2c, 0xbf, 0x65, 0x3d, 0xfc, 0xef, 0x00, 0xb8, 0xc9, 0x9a, 0x8a,
+	0xc8, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1847,6 +1940,7 @@ type MsgClient interface {
 	UpdateRateLimiterFlags(ctx context.Context, in *MsgUpdateRateLimiterFlags, opts ...grpc.CallOption) (*MsgUpdateRateLimiterFlagsResponse, error)
 	MigrateERC20CustodyFunds(ctx context.Context, in *MsgMigrateERC20CustodyFunds, opts ...grpc.CallOption) (*MsgMigrateERC20CustodyFundsResponse, error)
 	UpdateERC20CustodyPauseStatus(ctx context.Context, in *MsgUpdateERC20CustodyPauseStatus, opts ...grpc.CallOption) (*MsgUpdateERC20CustodyPauseStatusResponse, error)
+	UpdateCctxPruningFlags(ctx context.Context, in *MsgUpdateCctxPruningFlags, opts ...grpc.CallOption) (*MsgUpdateCctxPruningFlagsResponse, error)
 }
 
 type msgClient struct {
@@ -1983,6 +2077,15 @@ func (c *msgClient) UpdateERC20CustodyPauseStatus(ctx context.Context, in *MsgUp
 	return out, nil
 }
 
+func (c *msgClient) UpdateCctxPruningFlags(ctx context.Context, in *MsgUpdateCctxPruningFlags, opts ...grpc.CallOption) (*MsgUpdateCctxPruningFlagsResponse, error) {
+	out := new(MsgUpdateCctxPruningFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/UpdateCctxPruningFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddOutboundTracker(context.Context, *MsgAddOutboundTracker) (*MsgAddOutboundTrackerResponse, error)
@@ -1999,6 +2102,7 @@ type MsgServer interface {
 	UpdateRateLimiterFlags(context.Context, *MsgUpdateRateLimiterFlags) (*MsgUpdateRateLimiterFlagsResponse, error)
 	MigrateERC20CustodyFunds(context.Context, *MsgMigrateERC20CustodyFunds) (*MsgMigrateERC20CustodyFundsResponse, error)
 	UpdateERC20CustodyPauseStatus(context.Context, *MsgUpdateERC20CustodyPauseStatus) (*MsgUpdateERC20CustodyPauseStatusResponse, error)
+	UpdateCctxPruningFlags(context.Context, *MsgUpdateCctxPruningFlags) (*MsgUpdateCctxPruningFlagsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2047,6 +2151,9 @@ func (*UnimplementedMsgServer) MigrateERC20CustodyFunds(ctx context.Context, req
 func (*UnimplementedMsgServer) UpdateERC20CustodyPauseStatus(ctx context.Context, req *MsgUpdateERC20CustodyPauseStatus) (*MsgUpdateERC20CustodyPauseStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateERC20CustodyPauseStatus not implemented")
 }
+func (*UnimplementedMsgServer) UpdateCctxPruningFlags(ctx context.Context, req *MsgUpdateCctxPruningFlags) (*MsgUpdateCctxPruningFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCctxPruningFlags not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2304,6 +2411,24 @@ func _Msg_UpdateERC20CustodyPauseStatus_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCctxPruningFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCctxPruningFlags)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCctxPruningFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/UpdateCctxPruningFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCctxPruningFlags(ctx, req.(*MsgUpdateCctxPruningFlags))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.crosschain.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2364,6 +2489,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateERC20CustodyPauseStatus",
 			Handler:    _Msg_UpdateERC20CustodyPauseStatus_Handler,
 		},
+		{
+			MethodName: "UpdateCctxPruningFlags",
+			Handler:    _Msg_UpdateCctxPruningFlags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/crosschain/tx.proto",
@@ -3564,6 +3693,69 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateCctxPruningFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCctxPruningFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCctxPruningFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CctxPruningFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCctxPruningFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCctxPruningFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCctxPruningFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -4107,6 +4299,30 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateCctxPruningFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CctxPruningFlags.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateCctxPruningFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7717,6 +7933,171 @@ func (m *MsgUpdateERC20CustodyPauseStatusResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgUpdateCctxPruningFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCctxPruningFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCctxPruningFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxPruningFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CctxPruningFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCctxPruningFlagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCctxPruningFlagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCctxPruningFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,6 +28,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdGasStabilityPoolBalances(),
 		CmdSystemContract(),
 		CmdQueryCodeHash(),
+		CmdListPrecompileConfig(),
+		CmdShowPrecompileConfig(),
 	)
 
 	return cmd
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func CmdListPrecompileConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-precompile-config",
+		Short: "list all the on-chain configs of stateful precompiled contracts",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PrecompileConfigAll(context.Background(), &types.QueryAllPrecompileConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowPrecompileConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-precompile-config [address]",
+		Short: "shows the on-chain config of a stateful precompiled contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PrecompileConfig(
+				context.Background(),
+				&types.QueryGetPrecompileConfigRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
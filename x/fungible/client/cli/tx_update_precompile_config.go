@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func CmdUpdatePrecompileConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-precompile-config [address] [enabled] [method:gas]...",
+		Short: "Broadcast message UpdatePrecompileConfig",
+		Long: `Broadcast message UpdatePrecompileConfig to enable or disable a stateful precompiled contract
+and override the gas required by its methods, e.g.
+  zetacored tx fungible update-precompile-config 0x0000000000000000000000000000000000000066 true stake:20000`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			methodGas := make([]types.PrecompileMethodGas, 0, len(args)-2)
+			for _, arg := range args[2:] {
+				method, gasStr, found := strings.Cut(arg, ":")
+				if !found {
+					return fmt.Errorf("invalid method gas %s, expected format method:gas", arg)
+				}
+				gas, err := strconv.ParseUint(gasStr, 10, 64)
+				if err != nil {
+					return err
+				}
+				methodGas = append(methodGas, types.PrecompileMethodGas{Method: method, Gas: gas})
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUpdatePrecompileConfig(
+				clientCtx.GetFromAddress().String(),
+				types.PrecompileConfig{
+					Address:   args[0],
+					Enabled:   enabled,
+					MethodGas: methodGas,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
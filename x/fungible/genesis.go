@@ -18,6 +18,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if genState.SystemContract != nil {
 		k.SetSystemContract(ctx, *genState.SystemContract)
 	}
+	// Set all the precompile configs
+	for _, elem := range genState.PrecompileConfigList {
+		k.SetPrecompileConfig(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the fungible module's exported genesis.
@@ -32,5 +36,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.SystemContract = &system
 	}
 
+	genesis.PrecompileConfigList = k.GetAllPrecompileConfig(ctx)
+
 	return &genesis
 }
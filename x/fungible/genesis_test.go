@@ -20,6 +20,10 @@ func TestGenesis(t *testing.T) {
 			sample.ForeignCoins(t, sample.EthAddress().String()),
 		},
 		SystemContract: sample.SystemContract(),
+		PrecompileConfigList: []types.PrecompileConfig{
+			sample.PrecompileConfig("0x0000000000000000000000000000000000000065"),
+			sample.PrecompileConfig("0x0000000000000000000000000000000000000066"),
+		},
 	}
 
 	// Init and export
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// PrecompileConfig returns the on-chain config of a stateful precompiled contract
+func (k Keeper) PrecompileConfig(
+	c context.Context,
+	req *types.QueryGetPrecompileConfigRequest,
+) (*types.QueryGetPrecompileConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if !ethcommon.IsHexAddress(req.Address) {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	config, found := k.GetPrecompileConfig(ctx, ethcommon.HexToAddress(req.Address))
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPrecompileConfigResponse{Config: config}, nil
+}
+
+// PrecompileConfigAll returns all the on-chain configs of stateful precompiled contracts
+func (k Keeper) PrecompileConfigAll(
+	c context.Context,
+	req *types.QueryAllPrecompileConfigRequest,
+) (*types.QueryAllPrecompileConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAllPrecompileConfigResponse{Configs: k.GetAllPrecompileConfig(ctx)}, nil
+}
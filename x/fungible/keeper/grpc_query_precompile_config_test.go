@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestKeeper_PrecompileConfig(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		res, err := k.PrecompileConfig(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if address is invalid", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		res, err := k.PrecompileConfig(ctx, &types.QueryGetPrecompileConfigRequest{Address: "invalid"})
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if config not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		res, err := k.PrecompileConfig(ctx, &types.QueryGetPrecompileConfigRequest{
+			Address: sample.EthAddress().Hex(),
+		})
+		require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+		require.Nil(t, res)
+	})
+
+	t.Run("should return config", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		config := sample.PrecompileConfig(sample.EthAddress().Hex())
+		k.SetPrecompileConfig(ctx, config)
+
+		res, err := k.PrecompileConfig(ctx, &types.QueryGetPrecompileConfigRequest{Address: config.Address})
+		require.NoError(t, err)
+		require.Equal(t, config, res.Config)
+	})
+}
+
+func TestKeeper_PrecompileConfigAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		res, err := k.PrecompileConfigAll(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("should return all configs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		config1 := sample.PrecompileConfig("0x0000000000000000000000000000000000000065")
+		config2 := sample.PrecompileConfig("0x0000000000000000000000000000000000000066")
+		k.SetPrecompileConfig(ctx, config1)
+		k.SetPrecompileConfig(ctx, config2)
+
+		res, err := k.PrecompileConfigAll(ctx, &types.QueryAllPrecompileConfigRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []types.PrecompileConfig{config1, config2}, res.Configs)
+	})
+}
@@ -0,0 +1,606 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	// register the javascript and native tracers
+	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+	"github.com/ethereum/go-ethereum/params"
+	ethermint "github.com/zeta-chain/ethermint/types"
+	"github.com/zeta-chain/ethermint/x/evm/statedb"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+const (
+	// defaultSimulateTraceTimeout is the default timeout of a traced call
+	defaultSimulateTraceTimeout = 5 * time.Second
+
+	// simulateErrCodeVMError is the error code of a simulated call failed for another reason than a revert
+	simulateErrCodeVMError = -32015
+)
+
+var (
+	// errSimulateValidation is returned when a call doesn't pass the validation of the simulation
+	errSimulateValidation = errors.New("validation failed")
+
+	// errSimulateGasLimit is returned when the calls of a simulation use more than the max simulation gas
+	errSimulateGasLimit = fmt.Errorf("simulation gas limit %d reached", types.MaxSimulateGas)
+)
+
+// Simulate simulates blocks of calls on top of the zEVM state of the queried block, with optional state and block
+// overrides. The calls are applied by the EVM keeper, so they run with the stateful precompiles and the Ethermint
+// rules. The state changes of a call are visible to the following calls and blocks, they're never committed.
+// The gas of the simulation is bounded by MaxSimulateGas, it's never taken from the request.
+func (k Keeper) Simulate(c context.Context, req *types.QuerySimulateRequest) (*types.QuerySimulateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var args types.SimulateArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := args.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the changes of the simulation are written to a cache of the queried state that is never written
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx, _ = ctx.
+		WithBlockHeight(max(req.BlockNumber, 1)).
+		WithBlockTime(time.Unix(req.BlockTime, 0).UTC()).
+		WithHeaderHash(ethcommon.HexToHash(req.BlockHash).Bytes()).
+		CacheContext()
+
+	chainID := k.evmKeeper.ChainID()
+	if req.ChainId != 0 {
+		chainID = big.NewInt(req.ChainId)
+	}
+	cfg, err := k.evmKeeper.EVMConfig(ctx, sdk.ConsAddress(req.ProposerAddress), chainID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	// the header of the queried block, the consensus params are not set in a query context
+	blockGasLimit := ethermint.BlockGasLimit(ctx)
+	if blockGasLimit == 0 || blockGasLimit > types.MaxSimulateGas {
+		blockGasLimit = types.MaxSimulateGas
+	}
+	base := &ethtypes.Header{
+		Number:   big.NewInt(ctx.BlockHeight()),
+		Time:     uint64(ctx.BlockTime().Unix()),
+		GasLimit: blockGasLimit,
+		Coinbase: cfg.CoinBase,
+		BaseFee:  cfg.BaseFee,
+	}
+	baseHash := ethcommon.HexToHash(req.BlockHash)
+
+	results := make([]*types.SimulateBlockResult, 0, len(args.Blocks))
+	parent, parentHash := base, baseHash
+	gasLeft := uint64(types.MaxSimulateGas)
+	for i, block := range args.Blocks {
+		header := simulateHeader(parent, parentHash, block.BlockOverrides, args.Validation)
+		if args.InBaseBlock {
+			header = ethtypes.CopyHeader(base)
+			header.ParentHash = ethcommon.Hash{}
+			applyBlockOverrides(header, block.BlockOverrides)
+		} else if err := checkSimulateHeader(header, parent); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "block %d: %s", i, err.Error())
+		}
+		header.GasLimit = min(header.GasLimit, types.MaxSimulateGas)
+
+		result, err := k.simulateBlock(ctx, cfg, args, block, header, gasLeft)
+		if err != nil {
+			code := codes.Internal
+			if errors.Is(err, errSimulateValidation) || errors.Is(err, errSimulateGasLimit) {
+				code = codes.InvalidArgument
+			}
+			return nil, status.Errorf(code, "block %d: %s", i, err.Error())
+		}
+
+		gasLeft -= min(uint64(result.GasUsed), gasLeft)
+		results = append(results, result)
+		parent, parentHash = header, result.Hash
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySimulateResponse{Data: data}, nil
+}
+
+// simulateBlock applies the state overrides and the calls of the block in the context of the header,
+// the calls share the gas cap left in the simulation
+func (k Keeper) simulateBlock(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	args types.SimulateArgs,
+	block types.SimulateBlock,
+	header *ethtypes.Header,
+	gasCap uint64,
+) (*types.SimulateBlockResult, error) {
+	if !header.Number.IsInt64() {
+		return nil, fmt.Errorf("block number %s out of range", header.Number)
+	}
+
+	// #nosec G115 checked in range
+	ctx = ctx.
+		WithBlockHeight(header.Number.Int64()).
+		WithBlockTime(time.Unix(int64(header.Time), 0).UTC()).
+		WithBlockGasMeter(storetypes.NewGasMeter(header.GasLimit))
+	blockCfg := *cfg
+	blockCfg.CoinBase = header.Coinbase
+	blockCfg.BaseFee = header.BaseFee
+	if blockCfg.BaseFee == nil {
+		blockCfg.BaseFee = new(big.Int)
+	}
+
+	if err := k.applyStateOverrides(ctx, block.StateOverrides); err != nil {
+		return nil, err
+	}
+
+	gasLeft := header.GasLimit
+	txConfig := statedb.NewEmptyTxConfig(ethcommon.Hash{})
+	calls := make([]types.SimulateCallResult, 0, len(block.Calls))
+	var blockLogs []*ethtypes.Log
+	for j, call := range block.Calls {
+		gasUsed := header.GasLimit - gasLeft
+		if gasUsed >= gasCap {
+			return nil, fmt.Errorf("call %d: %w", j, errSimulateGasLimit)
+		}
+		callGasCap := min(gasCap-gasUsed, gasLeft)
+
+		estimateGas := call.Gas == nil
+		call, err := k.setCallDefaults(ctx, &blockCfg, call, gasLeft, callGasCap, args.Validation)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", j, err)
+		}
+		if tx := call.ToTransaction(); tx != nil {
+			txConfig.TxHash = tx.AsTransaction().Hash()
+		}
+		// #nosec G115 always positive
+		txConfig.TxIndex = uint(j)
+
+		var result types.SimulateCallResult
+		switch {
+		case args.AccessList:
+			result, err = k.simulateAccessList(ctx, &blockCfg, call, txConfig, estimateGas, gasLeft, callGasCap)
+		case args.TraceConfig != nil:
+			result, err = k.simulateTrace(ctx, &blockCfg, call, txConfig, args.TraceConfig, callGasCap)
+		default:
+			var res *evmtypes.MsgEthereumTxResponse
+			res, err = k.simulateCall(ctx, &blockCfg, call, txConfig, nil, callGasCap)
+			if err == nil {
+				result = newSimulateCallResult(res)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", j, err)
+		}
+
+		gasLeft -= min(uint64(result.GasUsed), gasLeft)
+		txConfig.LogIndex += uint(len(result.Logs))
+		calls = append(calls, result)
+		blockLogs = append(blockLogs, result.Logs...)
+	}
+
+	header.GasUsed = header.GasLimit - gasLeft
+	hash := header.Hash()
+	for _, log := range blockLogs {
+		log.BlockNumber = header.Number.Uint64()
+		log.BlockHash = hash
+	}
+
+	return &types.SimulateBlockResult{
+		Number:        (*hexutil.Big)(header.Number),
+		Hash:          hash,
+		ParentHash:    header.ParentHash,
+		Timestamp:     hexutil.Uint64(header.Time),
+		GasLimit:      hexutil.Uint64(header.GasLimit),
+		GasUsed:       hexutil.Uint64(header.GasUsed),
+		Miner:         header.Coinbase,
+		BaseFeePerGas: (*hexutil.Big)(header.BaseFee),
+		Calls:         calls,
+	}, nil
+}
+
+// setCallDefaults sets the nonce of the sender and the gas of the call if missing.
+// The missing gas is estimated because the EVM keeper charges at least a share of the gas limit of a call.
+// With validation, the nonce, the fee cap and the balance of the sender are checked.
+func (k Keeper) setCallDefaults(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	call evmtypes.TransactionArgs,
+	gasLeft uint64,
+	gasCap uint64,
+	validation bool,
+) (evmtypes.TransactionArgs, error) {
+	from := call.GetFrom()
+	nonce, balance := uint64(0), new(big.Int)
+	if acct := k.evmKeeper.GetAccount(ctx, from); acct != nil {
+		nonce, balance = acct.Nonce, acct.Balance
+	}
+
+	switch {
+	case call.Nonce == nil:
+		call.Nonce = (*hexutil.Uint64)(&nonce)
+	case validation && uint64(*call.Nonce) != nonce:
+		return call, fmt.Errorf("%w: nonce %d, expected %d", errSimulateValidation, *call.Nonce, nonce)
+	}
+
+	if call.Gas == nil {
+		gas := k.estimateCallGas(ctx, call, gasLeft, gasCap)
+		call.Gas = (*hexutil.Uint64)(&gas)
+	}
+	if uint64(*call.Gas) > gasLeft {
+		return call, fmt.Errorf("block gas limit reached: %d > %d", *call.Gas, gasLeft)
+	}
+
+	if validation {
+		msg, err := call.ToMessage(gasCap, cfg.BaseFee)
+		if err != nil {
+			return call, err
+		}
+		if msg.GasFeeCap().Cmp(cfg.BaseFee) < 0 {
+			return call, fmt.Errorf("%w: max fee per gas %s less than block base fee %s",
+				errSimulateValidation, msg.GasFeeCap(), cfg.BaseFee)
+		}
+		cost := new(big.Int).Mul(msg.GasFeeCap(), new(big.Int).SetUint64(msg.Gas()))
+		cost.Add(cost, msg.Value())
+		if balance.Cmp(cost) < 0 {
+			return call, fmt.Errorf("%w: insufficient funds for gas * price + value: have %s want %s",
+				errSimulateValidation, balance, cost)
+		}
+	}
+
+	return call, nil
+}
+
+// estimateCallGas estimates the gas of the call, the gas left in the block if the call fails
+func (k Keeper) estimateCallGas(ctx sdk.Context, call evmtypes.TransactionArgs, gasLeft uint64, gasCap uint64) uint64 {
+	callArgs, err := json.Marshal(call)
+	if err != nil {
+		return gasLeft
+	}
+
+	res, err := k.evmKeeper.EstimateGas(sdk.WrapSDKContext(ctx), &evmtypes.EthCallRequest{
+		Args:            callArgs,
+		GasCap:          min(gasLeft, gasCap),
+		ProposerAddress: sdk.ConsAddress(ctx.BlockHeader().ProposerAddress),
+		ChainId:         k.evmKeeper.ChainID().Int64(),
+	})
+	if err != nil {
+		return gasLeft
+	}
+
+	return res.Gas
+}
+
+// simulateCall applies the call with the EVM keeper and increments the nonce of the sender
+func (k Keeper) simulateCall(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	call evmtypes.TransactionArgs,
+	txConfig statedb.TxConfig,
+	tracer vm.EVMLogger,
+	gasCap uint64,
+) (*evmtypes.MsgEthereumTxResponse, error) {
+	msg, err := call.ToMessage(gasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.evmKeeper.ApplyMessageWithConfig(ctx, msg, tracer, true, cfg, txConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// the EVM keeper only sets the nonce of a contract creation, the nonce of a call is set by the ante handler
+	if msg.To() != nil {
+		acct := k.evmKeeper.GetAccount(ctx, msg.From())
+		if acct == nil {
+			acct = statedb.NewEmptyAccount()
+		}
+		acct.Nonce = msg.Nonce() + 1
+		if err := k.evmKeeper.SetAccount(ctx, msg.From(), *acct); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// simulateTrace applies the call with the tracer of the trace config and returns the result of the tracer
+func (k Keeper) simulateTrace(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	call evmtypes.TransactionArgs,
+	txConfig statedb.TxConfig,
+	traceConfig *evmtypes.TraceConfig,
+	gasCap uint64,
+) (types.SimulateCallResult, error) {
+	var overrides *params.ChainConfig
+	if traceConfig.Overrides != nil {
+		overrides = traceConfig.Overrides.EthereumConfig(cfg.ChainConfig.ChainID)
+	}
+	var tracer tracers.Tracer = logger.NewStructLogger(&logger.Config{
+		EnableMemory:     traceConfig.EnableMemory,
+		DisableStorage:   traceConfig.DisableStorage,
+		DisableStack:     traceConfig.DisableStack,
+		EnableReturnData: traceConfig.EnableReturnData,
+		Debug:            traceConfig.Debug,
+		Limit:            int(traceConfig.Limit),
+		Overrides:        overrides,
+	})
+	if traceConfig.Tracer != "" {
+		var tracerConfig json.RawMessage
+		if traceConfig.TracerJsonConfig != "" {
+			// ignore error. default to no traceConfig
+			_ = json.Unmarshal([]byte(traceConfig.TracerJsonConfig), &tracerConfig)
+		}
+		tCtx := &tracers.Context{
+			BlockHash: txConfig.BlockHash,
+			TxIndex:   int(txConfig.TxIndex),
+			TxHash:    txConfig.TxHash,
+		}
+		var err error
+		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerConfig); err != nil {
+			return types.SimulateCallResult{}, err
+		}
+	}
+
+	timeout := defaultSimulateTraceTimeout
+	if traceConfig.Timeout != "" {
+		var err error
+		if timeout, err = time.ParseDuration(traceConfig.Timeout); err != nil {
+			return types.SimulateCallResult{}, fmt.Errorf("timeout value: %w", err)
+		}
+	}
+
+	// stop the tracer when the timeout is reached
+	deadlineCtx, cancel := context.WithTimeout(ctx.Context(), timeout)
+	defer cancel()
+	go func() {
+		<-deadlineCtx.Done()
+		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			tracer.Stop(errors.New("execution timeout"))
+		}
+	}()
+
+	res, err := k.simulateCall(ctx, cfg, call, txConfig, tracer, gasCap)
+	if err != nil {
+		return types.SimulateCallResult{}, err
+	}
+	trace, err := tracer.GetResult()
+	if err != nil {
+		return types.SimulateCallResult{}, err
+	}
+
+	result := newSimulateCallResult(res)
+	result.Trace = trace
+	return result, nil
+}
+
+// simulateAccessList expands the access list of the call until it's stable, each run starts from the same state.
+// The gas of the call is estimated with the access list of each run if not set by the caller.
+// The state changes of the call are discarded.
+func (k Keeper) simulateAccessList(
+	ctx sdk.Context,
+	cfg *statedb.EVMConfig,
+	call evmtypes.TransactionArgs,
+	txConfig statedb.TxConfig,
+	estimateGas bool,
+	gasLeft uint64,
+	gasCap uint64,
+) (types.SimulateCallResult, error) {
+	from := call.GetFrom()
+	to := crypto.CreateAddress(from, uint64(*call.Nonce))
+	if call.To != nil {
+		to = *call.To
+	}
+
+	// the precompiles are always warm, they're never added to the access list
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	precompiles := vm.DefaultActivePrecompiles(rules)
+	for address := range k.precompileABIs {
+		precompiles = append(precompiles, address)
+	}
+
+	prevTracer := logger.NewAccessListTracer(nil, from, to, precompiles)
+	if call.AccessList != nil {
+		prevTracer = logger.NewAccessListTracer(*call.AccessList, from, to, precompiles)
+	}
+	for {
+		accessList := prevTracer.AccessList()
+		call.AccessList = &accessList
+		if estimateGas {
+			gas := k.estimateCallGas(ctx, call, gasLeft, gasCap)
+			call.Gas = (*hexutil.Uint64)(&gas)
+		}
+
+		callCtx, _ := ctx.CacheContext()
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		res, err := k.simulateCall(callCtx, cfg, call, txConfig, tracer, gasCap)
+		if err != nil {
+			return types.SimulateCallResult{}, fmt.Errorf("failed to apply transaction: %w", err)
+		}
+
+		if tracer.Equal(prevTracer) {
+			result := newSimulateCallResult(res)
+			result.AccessList = &accessList
+			return result, nil
+		}
+		prevTracer = tracer
+	}
+}
+
+// applyStateOverrides overrides the accounts in the state
+func (k Keeper) applyStateOverrides(ctx sdk.Context, overrides *types.StateOverride) error {
+	if overrides == nil {
+		return nil
+	}
+
+	for addr, account := range *overrides {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		acct := k.evmKeeper.GetAccount(ctx, addr)
+		if acct == nil {
+			acct = statedb.NewEmptyAccount()
+		}
+		if account.Nonce != nil {
+			acct.Nonce = uint64(*account.Nonce)
+		}
+		if account.Balance != nil && *account.Balance != nil {
+			acct.Balance = (*account.Balance).ToInt()
+		}
+		if account.Code != nil {
+			code := []byte(*account.Code)
+			acct.CodeHash = crypto.Keccak256(code)
+			k.evmKeeper.SetCode(ctx, acct.CodeHash, code)
+		}
+		if err := k.evmKeeper.SetAccount(ctx, addr, *acct); err != nil {
+			return err
+		}
+
+		// the state replaces the whole storage of the account
+		if account.State != nil {
+			var keys []ethcommon.Hash
+			k.evmKeeper.ForEachStorage(ctx, addr, func(key, _ ethcommon.Hash) bool {
+				keys = append(keys, key)
+				return true
+			})
+			for _, key := range keys {
+				k.evmKeeper.SetState(ctx, addr, key, nil)
+			}
+			for key, value := range *account.State {
+				k.evmKeeper.SetState(ctx, addr, key, value.Bytes())
+			}
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				k.evmKeeper.SetState(ctx, addr, key, value.Bytes())
+			}
+		}
+	}
+
+	return nil
+}
+
+// simulateHeader returns the header of the simulated block following the parent.
+// Without validation, the base fee is zero unless overridden.
+func simulateHeader(
+	parent *ethtypes.Header,
+	parentHash ethcommon.Hash,
+	overrides *types.BlockOverrides,
+	validation bool,
+) *ethtypes.Header {
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		UncleHash:  ethtypes.EmptyUncleHash,
+		Coinbase:   parent.Coinbase,
+		Difficulty: big.NewInt(0),
+		Number:     new(big.Int).Add(parent.Number, big.NewInt(1)),
+		GasLimit:   parent.GasLimit,
+		Time:       parent.Time + 1,
+		Extra:      []byte{},
+	}
+	switch {
+	case validation && parent.BaseFee != nil:
+		header.BaseFee = new(big.Int).Set(parent.BaseFee)
+	case !validation:
+		header.BaseFee = new(big.Int)
+	}
+	applyBlockOverrides(header, overrides)
+
+	return header
+}
+
+// checkSimulateHeader checks the simulated block follows the parent
+func checkSimulateHeader(header, parent *ethtypes.Header) error {
+	if header.Number.Cmp(parent.Number) <= 0 {
+		return fmt.Errorf("block numbers must be in order: %d <= %d", header.Number, parent.Number)
+	}
+	if header.Time <= parent.Time {
+		return fmt.Errorf("block timestamps must be in order: %d <= %d", header.Time, parent.Time)
+	}
+	return nil
+}
+
+// applyBlockOverrides overrides the fields of the header
+func applyBlockOverrides(header *ethtypes.Header, overrides *types.BlockOverrides) {
+	if overrides == nil {
+		return
+	}
+	if overrides.Number != nil {
+		header.Number = new(big.Int).Set(overrides.Number.ToInt())
+	}
+	if overrides.Time != nil {
+		header.Time = uint64(*overrides.Time)
+	}
+	if overrides.GasLimit != nil {
+		header.GasLimit = uint64(*overrides.GasLimit)
+	}
+	if overrides.FeeRecipient != nil {
+		header.Coinbase = *overrides.FeeRecipient
+	}
+	if overrides.BaseFeePerGas != nil {
+		header.BaseFee = new(big.Int).Set(overrides.BaseFeePerGas.ToInt())
+	}
+}
+
+// newSimulateCallResult returns the result of a simulated call
+func newSimulateCallResult(res *evmtypes.MsgEthereumTxResponse) types.SimulateCallResult {
+	logs := evmtypes.LogsToEthereum(res.Logs)
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+	result := types.SimulateCallResult{
+		ReturnValue: res.Ret,
+		Logs:        logs,
+		GasUsed:     hexutil.Uint64(res.GasUsed),
+		Status:      hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if !res.Failed() {
+		return result
+	}
+
+	result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	if res.VmError == vm.ErrExecutionReverted.Error() {
+		revertErr := evmtypes.NewExecErrorWithReason(res.Ret)
+		result.Error = &types.SimulateCallError{
+			Code:    revertErr.ErrorCode(),
+			Message: revertErr.Error(),
+			Data:    hexutil.Encode(res.Ret),
+		}
+	} else {
+		result.ReturnValue = nil
+		result.Error = &types.SimulateCallError{
+			Code:    simulateErrCodeVMError,
+			Message: res.VmError,
+		}
+	}
+	return result
+}
@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+var (
+	// timestampCode returns the timestamp of the block
+	timestampCode = hexutil.MustDecode("0x4260005260206000f3")
+
+	// storeCode stores 42 in slot 0 and returns the value of slot 0 before the store
+	storeCode = hexutil.MustDecode("0x60005460005260" + "2a60005560206000f3")
+
+	// revertCode reverts with an empty reason
+	revertCode = hexutil.MustDecode("0x60006000fd")
+
+	// loopCode loops until it runs out of gas
+	loopCode = hexutil.MustDecode("0x5b600056")
+)
+
+// newSimulateRequest returns a simulation of the args on top of block 100
+func newSimulateRequest(t *testing.T, args types.SimulateArgs) *types.QuerySimulateRequest {
+	bz, err := json.Marshal(args)
+	require.NoError(t, err)
+	return &types.QuerySimulateRequest{
+		Args:        bz,
+		BlockNumber: 100,
+		BlockTime:   1000,
+		BlockHash:   ethcommon.HexToHash("0x64").Hex(),
+	}
+}
+
+// decodeSimulateResponse returns the simulated blocks of the response
+func decodeSimulateResponse(t *testing.T, res *types.QuerySimulateResponse) []*types.SimulateBlockResult {
+	var results []*types.SimulateBlockResult
+	require.NoError(t, json.Unmarshal(res.Data, &results))
+	return results
+}
+
+func TestKeeper_Simulate(t *testing.T) {
+	from := sample.EthAddress()
+	contract := sample.EthAddress()
+	balance := (*hexutil.Big)(big.NewInt(1e18))
+	code := hexutil.Bytes(storeCode)
+	overrides := &types.StateOverride{
+		from:     types.OverrideAccount{Balance: &balance},
+		contract: types.OverrideAccount{Code: &code},
+	}
+
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		res, err := k.Simulate(ctx, nil)
+		require.Error(t, err)
+		require.Nil(t, res)
+	})
+
+	t.Run("should error if the args are invalid", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		_, err := k.Simulate(ctx, newSimulateRequest(t, types.SimulateArgs{}))
+		require.ErrorContains(t, err, "empty input")
+
+		state := map[ethcommon.Hash]ethcommon.Hash{}
+		_, err = k.Simulate(ctx, newSimulateRequest(t, types.SimulateArgs{
+			Blocks: []types.SimulateBlock{{StateOverrides: &types.StateOverride{
+				contract: types.OverrideAccount{State: &state, StateDiff: &state},
+			}}},
+		}))
+		require.ErrorContains(t, err, "both 'state' and 'stateDiff'")
+	})
+
+	t.Run("should apply the calls sequentially on top of the overridden state", func(t *testing.T) {
+		k, ctx, sdkk, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		call := evmtypes.TransactionArgs{From: &from, To: &contract}
+		res, err := k.Simulate(ctx, newSimulateRequest(t, types.SimulateArgs{
+			Blocks: []types.SimulateBlock{
+				{StateOverrides: overrides, Calls: []evmtypes.TransactionArgs{call, call}},
+				{Calls: []evmtypes.TransactionArgs{call}},
+			},
+		}))
+		require.NoError(t, err)
+
+		results := decodeSimulateResponse(t, res)
+		require.Len(t, results, 2)
+		require.EqualValues(t, 101, results[0].Number.ToInt().Int64())
+		require.EqualValues(t, 1001, results[0].Timestamp)
+		require.Equal(t, ethcommon.HexToHash("0x64"), results[0].ParentHash)
+		require.Equal(t, results[0].Hash, results[1].ParentHash)
+		require.EqualValues(t, 102, results[1].Number.ToInt().Int64())
+
+		// the store of the first call is visible to the following calls
+		require.Equal(t, ethcommon.BigToHash(big.NewInt(0)).Bytes(), []byte(results[0].Calls[0].ReturnValue))
+		require.Equal(t, ethcommon.BigToHash(big.NewInt(42)).Bytes(), []byte(results[0].Calls[1].ReturnValue))
+		require.Equal(t, ethcommon.BigToHash(big.NewInt(42)).Bytes(), []byte(results[1].Calls[0].ReturnValue))
+		require.EqualValues(t, 1, results[1].Calls[0].Status)
+
+		// the state is unchanged
+		require.Nil(t, sdkk.EvmKeeper.GetAccount(ctx, contract))
+	})
+
+	t.Run("should check the nonce with validation", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		gas := hexutil.Uint64(100_000)
+		first := evmtypes.TransactionArgs{From: &from, To: &contract, Gas: &gas}
+		nonce := hexutil.Uint64(0)
+		second := evmtypes.TransactionArgs{From: &from, To: &contract, Gas: &gas, Nonce: &nonce}
+		_, err := k.Simulate(ctx, newSimulateRequest(t, types.SimulateArgs{
+			Blocks: []types.SimulateBlock{
+				{StateOverrides: overrides, Calls: []evmtypes.TransactionArgs{first, second}},
+			},
+			Validation: true,
+		}))
+		require.ErrorContains(t, err, "nonce 0, expected 1")
+	})
+
+	t.Run("should override the block", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		code := hexutil.Bytes(timestampCode)
+		time := hexutil.Uint64(2000)
+		res, err := k.Simulate(ctx, newSimulateRequest(t, types.SimulateArgs{
+			Blocks: []types.SimulateBlock{{
+				BlockOverrides: &types.BlockOverrides{Time: &time},
+				StateOverrides: &types.StateOverride{contract: types.OverrideAccount{Code: &code}},
+				Calls:          []evmtypes.TransactionArgs{{From: &from, To: &contract}},
+			}},
+			InBaseBlock: true,
+		}))
+		require.NoError(t, err)
+
+		results := decodeSimulateResponse(t, res)
+		require.EqualValues(t, 100, results[0].Number.ToInt().Int64())
+		require.EqualValues(t, 2000, new(big.Int).SetBytes(results[0].Calls[0].ReturnValue).Int64())
+	})
+
+	t.Run("should return the revert of a call", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		code := hexutil.Bytes(revertCode)
+		res, err := k.Simulate(ctx, newSimulateRequest(t, types.SimulateArgs{
+			Blocks: []types.SimulateBlock{{
+				StateOverrides: &types.StateOverride{contract: types.OverrideAccount{Code: &code}},
+				Calls:          []evmtypes.TransactionArgs{{From: &from, To: &contract}},
+			}},
+		}))
+		require.NoError(t, err)
+
+		call := decodeSimulateResponse(t, res)[0].Calls[0]
+		require.EqualValues(t, 0, call.Status)
+		require.NotNil(t, call.Error)
+		require.Equal(t, 3, call.Error.Code)
+		require.Equal(t, "execution reverted", call.Error.Message)
+	})
+
+	t.Run("should bound the gas of the simulation", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		code := hexutil.Bytes(loopCode)
+		gas := hexutil.Uint64(types.MaxSimulateGas * 4 / 5)
+		call := evmtypes.TransactionArgs{From: &from, To: &contract, Gas: &gas}
+		args := types.SimulateArgs{Blocks: []types.SimulateBlock{
+			{
+				StateOverrides: &types.StateOverride{contract: types.OverrideAccount{Code: &code}},
+				Calls:          []evmtypes.TransactionArgs{call},
+			},
+			{Calls: []evmtypes.TransactionArgs{call}},
+		}}
+
+		// the second call only gets the gas left in the simulation
+		res, err := k.Simulate(ctx, newSimulateRequest(t, args))
+		require.NoError(t, err)
+		results := decodeSimulateResponse(t, res)
+		require.EqualValues(t, types.MaxSimulateGas, results[0].GasLimit)
+		require.EqualValues(t, gas, results[0].Calls[0].GasUsed)
+		require.EqualValues(t, types.MaxSimulateGas-gas, results[1].Calls[0].GasUsed)
+
+		// no gas is left for a third call
+		args.Blocks = append(args.Blocks, types.SimulateBlock{Calls: []evmtypes.TransactionArgs{call}})
+		_, err = k.Simulate(ctx, newSimulateRequest(t, args))
+		require.ErrorContains(t, err, "simulation gas limit")
+	})
+
+	t.Run("should trace the call", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		res, err := k.Simulate(ctx, newSimulateRequest(t, types.SimulateArgs{
+			Blocks: []types.SimulateBlock{{
+				StateOverrides: overrides,
+				Calls:          []evmtypes.TransactionArgs{{From: &from, To: &contract}},
+			}},
+			InBaseBlock: true,
+			TraceConfig: &evmtypes.TraceConfig{Tracer: "callTracer"},
+		}))
+		require.NoError(t, err)
+
+		var trace struct {
+			Type string            `json:"type"`
+			To   ethcommon.Address `json:"to"`
+		}
+		require.NoError(t, json.Unmarshal(decodeSimulateResponse(t, res)[0].Calls[0].Trace, &trace))
+		require.Equal(t, "CALL", trace.Type)
+		require.Equal(t, contract, trace.To)
+	})
+
+	t.Run("should create the access list of the call", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, types.ModuleName)
+
+		res, err := k.Simulate(ctx, newSimulateRequest(t, types.SimulateArgs{
+			Blocks: []types.SimulateBlock{{
+				StateOverrides: overrides,
+				Calls:          []evmtypes.TransactionArgs{{From: &from, To: &contract}},
+			}},
+			InBaseBlock: true,
+			AccessList:  true,
+		}))
+		require.NoError(t, err)
+
+		// the called contract is only in the access list for the slots it touched
+		call := decodeSimulateResponse(t, res)[0].Calls[0]
+		require.Nil(t, call.Error)
+		require.NotNil(t, call.AccessList)
+		require.Equal(t, ethtypes.AccessList{
+			{Address: contract, StorageKeys: []ethcommon.Hash{{}}},
+		}, *call.AccessList)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/zeta-chain/node/x/fungible/types"
 )
@@ -21,6 +23,10 @@ type (
 		bankKeeper      types.BankKeeper
 		observerKeeper  types.ObserverKeeper
 		authorityKeeper types.AuthorityKeeper
+
+		// precompileABIs contains the ABIs of the stateful precompiled contracts registered in the node binary
+		// they are used to validate the precompile configs
+		precompileABIs map[ethcommon.Address]abi.ABI
 	}
 )
 
@@ -69,3 +75,9 @@ func (k Keeper) GetObserverKeeper() types.ObserverKeeper {
 func (k Keeper) GetAuthorityKeeper() types.AuthorityKeeper {
 	return k.authorityKeeper
 }
+
+// SetPrecompileABIs sets the ABIs of the stateful precompiled contracts registered in the node binary
+// the precompiles can't be referenced from the module directly since they depend on the fungible keeper
+func (k *Keeper) SetPrecompileABIs(precompileABIs map[ethcommon.Address]abi.ABI) {
+	k.precompileABIs = precompileABIs
+}
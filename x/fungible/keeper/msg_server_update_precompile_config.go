@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// UpdatePrecompileConfig updates the config of a stateful precompiled contract.
+// The config allows to enable or disable the precompile and to update the gas required by its methods
+// without a binary upgrade, the config is read by the precompiles at execution time.
+//
+// Authorized: admin policy group emergency.
+func (k msgServer) UpdatePrecompileConfig(
+	goCtx context.Context,
+	msg *types.MsgUpdatePrecompileConfig,
+) (*types.MsgUpdatePrecompileConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check authorization
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	if err := k.ValidatePrecompileConfig(msg.Config); err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrInvalidPrecompileConfig, err.Error())
+	}
+
+	k.SetPrecompileConfig(ctx, msg.Config)
+
+	err = ctx.EventManager().EmitTypedEvent(
+		&types.EventPrecompileConfigUpdated{
+			MsgTypeUrl: sdk.MsgTypeURL(&types.MsgUpdatePrecompileConfig{}),
+			Config:     msg.Config,
+			Signer:     msg.Creator,
+		},
+	)
+	if err != nil {
+		k.Logger(ctx).Error("failed to emit event", "error", err.Error())
+		return nil, cosmoserrors.Wrapf(types.ErrEmitEvent, "failed to emit event (%s)", err.Error())
+	}
+
+	return &types.MsgUpdatePrecompileConfigResponse{}, nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/fungible/keeper"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestMsgServer_UpdatePrecompileConfig(t *testing.T) {
+	t.Run("can update the precompile config", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		admin := sample.AccAddress()
+		address := sample.EthAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+		k.SetPrecompileABIs(map[ethcommon.Address]abi.ABI{address: sample.PrecompileABI(t)})
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		// can set the config
+		config := sample.PrecompileConfig(address.Hex())
+		msg := types.NewMsgUpdatePrecompileConfig(admin, config)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.UpdatePrecompileConfig(ctx, msg)
+		require.NoError(t, err)
+
+		got, found := k.GetPrecompileConfig(ctx, address)
+		require.True(t, found)
+		require.Equal(t, config, got)
+
+		// emits an event
+		events := ctx.EventManager().Events()
+		require.NotEmpty(t, events)
+		require.Equal(t, "zetachain.zetacore.fungible.EventPrecompileConfigUpdated", events[len(events)-1].Type)
+
+		// can disable the precompile
+		config = types.PrecompileConfig{Address: address.Hex(), Enabled: false}
+		msg = types.NewMsgUpdatePrecompileConfig(admin, config)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err = msgServer.UpdatePrecompileConfig(ctx, msg)
+		require.NoError(t, err)
+
+		got, found = k.GetPrecompileConfig(ctx, address)
+		require.True(t, found)
+		require.False(t, got.Enabled)
+		require.Empty(t, got.MethodGas)
+	})
+
+	t.Run("should fail if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		address := sample.EthAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+
+		msg := types.NewMsgUpdatePrecompileConfig(admin, sample.PrecompileConfig(address.Hex()))
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+		_, err := msgServer.UpdatePrecompileConfig(ctx, msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+
+		_, found := k.GetPrecompileConfig(ctx, address)
+		require.False(t, found)
+	})
+	t.Run("should fail if the precompile is not registered", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		admin := sample.AccAddress()
+		address := sample.EthAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+		k.SetPrecompileABIs(map[ethcommon.Address]abi.ABI{sample.EthAddress(): sample.PrecompileABI(t)})
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		msg := types.NewMsgUpdatePrecompileConfig(admin, sample.PrecompileConfig(address.Hex()))
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.UpdatePrecompileConfig(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidPrecompileConfig)
+
+		_, found := k.GetPrecompileConfig(ctx, address)
+		require.False(t, found)
+	})
+
+	t.Run("should fail if a method is not in the precompile ABI", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		admin := sample.AccAddress()
+		address := sample.EthAddress()
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+		k.SetPrecompileABIs(map[ethcommon.Address]abi.ABI{address: sample.PrecompileABI(t)})
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		config := sample.PrecompileConfig(address.Hex())
+		config.MethodGas = append(config.MethodGas, types.PrecompileMethodGas{Method: "baz", Gas: 1000})
+		msg := types.NewMsgUpdatePrecompileConfig(admin, config)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := msgServer.UpdatePrecompileConfig(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidPrecompileConfig)
+
+		_, found := k.GetPrecompileConfig(ctx, address)
+		require.False(t, found)
+	})
+}
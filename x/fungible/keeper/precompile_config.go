@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+// SetPrecompileConfig sets the config of a stateful precompiled contract in the store
+func (k Keeper) SetPrecompileConfig(ctx sdk.Context, config types.PrecompileConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrecompileConfigKeyPrefix))
+	b := k.cdc.MustMarshal(&config)
+	store.Set(types.PrecompileConfigKey(ethcommon.HexToAddress(config.Address)), b)
+}
+
+// GetPrecompileConfig returns the config of a stateful precompiled contract
+// the config is not found if the precompile uses the default config of the node binary
+func (k Keeper) GetPrecompileConfig(
+	ctx sdk.Context,
+	address ethcommon.Address,
+) (val types.PrecompileConfig, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrecompileConfigKeyPrefix))
+
+	b := store.Get(types.PrecompileConfigKey(address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllPrecompileConfig returns all the configs of stateful precompiled contracts
+func (k Keeper) GetAllPrecompileConfig(ctx sdk.Context) (list []types.PrecompileConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PrecompileConfigKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PrecompileConfig
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ValidatePrecompileConfig checks the config targets a precompile registered in the node binary
+// and the configured methods exist in the ABI of the precompile
+func (k Keeper) ValidatePrecompileConfig(config types.PrecompileConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	contractABI, found := k.precompileABIs[ethcommon.HexToAddress(config.Address)]
+	if !found {
+		return fmt.Errorf("precompile %s is not registered", config.Address)
+	}
+
+	return config.ValidateMethods(contractABI)
+}
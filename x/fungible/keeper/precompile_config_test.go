@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+)
+
+func TestKeeper_SetPrecompileConfig(t *testing.T) {
+	t.Run("should set and get precompile config", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		address := sample.EthAddress()
+
+		_, found := k.GetPrecompileConfig(ctx, address)
+		require.False(t, found)
+
+		config := sample.PrecompileConfig(address.Hex())
+		k.SetPrecompileConfig(ctx, config)
+
+		got, found := k.GetPrecompileConfig(ctx, address)
+		require.True(t, found)
+		require.Equal(t, config, got)
+	})
+
+	t.Run("should get precompile config regardless of address case", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		address := sample.EthAddress()
+
+		config := sample.PrecompileConfig(address.Hex())
+		k.SetPrecompileConfig(ctx, config)
+
+		got, found := k.GetPrecompileConfig(ctx, ethcommon.HexToAddress(address.String()))
+		require.True(t, found)
+		require.Equal(t, config, got)
+	})
+
+	t.Run("should overwrite precompile config", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeper(t)
+		address := sample.EthAddress()
+
+		config := sample.PrecompileConfig(address.Hex())
+		k.SetPrecompileConfig(ctx, config)
+
+		config.Enabled = false
+		config.MethodGas = nil
+		k.SetPrecompileConfig(ctx, config)
+
+		got, found := k.GetPrecompileConfig(ctx, address)
+		require.True(t, found)
+		require.False(t, got.Enabled)
+		require.Empty(t, got.MethodGas)
+	})
+}
+
+func TestKeeper_GetAllPrecompileConfig(t *testing.T) {
+	k, ctx, _, _ := keepertest.FungibleKeeper(t)
+	require.Empty(t, k.GetAllPrecompileConfig(ctx))
+
+	configs := []string{
+		"0x0000000000000000000000000000000000000065",
+		"0x0000000000000000000000000000000000000066",
+		"0x0000000000000000000000000000000000000067",
+	}
+	for _, address := range configs {
+		k.SetPrecompileConfig(ctx, sample.PrecompileConfig(address))
+	}
+
+	all := k.GetAllPrecompileConfig(ctx)
+	require.Len(t, all, len(configs))
+	for i, address := range configs {
+		require.Equal(t, address, all[i].Address)
+	}
+}
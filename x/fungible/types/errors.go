@@ -29,4 +29,5 @@ var (
 	ErrNilGasPrice             = cosmoserrors.Register(ModuleName, 1127, "nil gas price")
 	ErrAccountNotFound         = cosmoserrors.Register(ModuleName, 1128, "account not found")
 	ErrGatewayContractNotSet   = cosmoserrors.Register(ModuleName, 1129, "gateway contract not set")
+	ErrInvalidPrecompileConfig = cosmoserrors.Register(ModuleName, 1130, "invalid precompile config")
 )
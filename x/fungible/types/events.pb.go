@@ -664,6 +664,66 @@ func (m *EventGatewayContractUpdated) GetSigner() string {
 	return ""
 }
 
+type EventPrecompileConfigUpdated struct {
+	MsgTypeUrl string           `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Config     PrecompileConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+	Signer     string           `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventPrecompileConfigUpdated) Reset()         { *m = EventPrecompileConfigUpdated{} }
+func (m *EventPrecompileConfigUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPrecompileConfigUpdated) ProtoMessage()    {}
+func (*EventPrecompileConfigUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e6611815bc2713b, []int{8}
+}
+func (m *EventPrecompileConfigUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPrecompileConfigUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPrecompileConfigUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPrecompileConfigUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPrecompileConfigUpdated.Merge(m, src)
+}
+func (m *EventPrecompileConfigUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPrecompileConfigUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPrecompileConfigUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPrecompileConfigUpdated proto.InternalMessageInfo
+
+func (m *EventPrecompileConfigUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventPrecompileConfigUpdated) GetConfig() PrecompileConfig {
+	if m != nil {
+		return m.Config
+	}
+	return PrecompileConfig{}
+}
+
+func (m *EventPrecompileConfigUpdated) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventSystemContractUpdated)(nil), "zetachain.zetacore.fungible.EventSystemContractUpdated")
 	proto.RegisterType((*EventZRC20Deployed)(nil), "zetachain.zetacore.fungible.EventZRC20Deployed")
@@ -673,6 +733,7 @@ func init() {
 	proto.RegisterType((*EventSystemContractsDeployed)(nil), "zetachain.zetacore.fungible.EventSystemContractsDeployed")
 	proto.RegisterType((*EventBytecodeUpdated)(nil), "zetachain.zetacore.fungible.EventBytecodeUpdated")
 	proto.RegisterType((*EventGatewayContractUpdated)(nil), "zetachain.zetacore.fungible.EventGatewayContractUpdated")
+	proto.RegisterType((*EventPrecompileConfigUpdated)(nil), "zetachain.zetacore.fungible.EventPrecompileConfigUpdated")
 }
 
 func init() {
@@ -680,56 +741,60 @@ func init() {
 }
 
 var fileDescriptor_1e6611815bc2713b = []byte{
-	// 771 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0xad, 0x9b, 0x36, 0x6d, 0xa6, 0x3f, 0x49, 0xad, 0xe8, 0x93, 0xbf, 0x14, 0x45, 0x55, 0xa0,
-	0x10, 0x0a, 0x24, 0x55, 0x78, 0x02, 0x5a, 0xda, 0x82, 0xc4, 0x02, 0x05, 0x0a, 0x52, 0x37, 0xd6,
-	0xc4, 0xbe, 0x75, 0xac, 0xda, 0x33, 0x96, 0x67, 0x12, 0xd7, 0x7d, 0x0a, 0x96, 0x3c, 0x04, 0x4f,
-	0x00, 0x2f, 0xc0, 0xb2, 0x12, 0x1b, 0x96, 0xa8, 0x7d, 0x09, 0x96, 0x68, 0xc6, 0x63, 0xc7, 0x2e,
-	0x6d, 0xd5, 0xb2, 0x40, 0x62, 0x13, 0xcd, 0x9d, 0x9c, 0xb9, 0xf7, 0xdc, 0xe3, 0x73, 0x67, 0x50,
-	0xfb, 0x04, 0x38, 0xb6, 0x86, 0xd8, 0x25, 0x5d, 0xb9, 0xa2, 0x21, 0x74, 0x0f, 0x47, 0xc4, 0x71,
-	0x07, 0x1e, 0x74, 0x61, 0x0c, 0x84, 0xb3, 0x4e, 0x10, 0x52, 0x4e, 0xf5, 0xd5, 0x0c, 0xd9, 0x49,
-	0x91, 0x9d, 0x14, 0xd9, 0xb8, 0x77, 0x5d, 0x1a, 0x7e, 0x9c, 0xa4, 0x68, 0xd4, 0x1d, 0xea, 0x50,
-	0xb9, 0xec, 0x8a, 0x95, 0xda, 0xbd, 0x7f, 0xc9, 0xd9, 0xe0, 0xc8, 0xe9, 0x5a, 0xd4, 0x25, 0xf2,
-	0x27, 0xc1, 0xb5, 0x3e, 0x6b, 0xa8, 0xb1, 0x23, 0x18, 0xbd, 0x89, 0x19, 0x07, 0x7f, 0x9b, 0x12,
-	0x1e, 0x62, 0x8b, 0xef, 0x07, 0x36, 0xe6, 0x60, 0xeb, 0x6b, 0x68, 0xd1, 0x67, 0x8e, 0xc9, 0xe3,
-	0x00, 0xcc, 0x51, 0xe8, 0x19, 0xda, 0x9a, 0xd6, 0xae, 0xf4, 0x91, 0xcf, 0x9c, 0xb7, 0x71, 0x00,
-	0xfb, 0xa1, 0xa7, 0x6f, 0xa2, 0x3a, 0x81, 0xc8, 0xb4, 0xd4, 0x41, 0x13, 0xdb, 0x76, 0x08, 0x8c,
-	0x19, 0xd3, 0x12, 0xa9, 0x13, 0x88, 0xd2, 0x9c, 0xcf, 0x92, 0x7f, 0xc4, 0x09, 0xea, 0xd9, 0xbf,
-	0x9f, 0x28, 0x25, 0x27, 0xa8, 0x67, 0x5f, 0x3c, 0xf1, 0x1f, 0x2a, 0x33, 0xd7, 0x21, 0x10, 0x1a,
-	0x33, 0x12, 0xa3, 0xa2, 0xd6, 0xa7, 0x69, 0xa4, 0x4b, 0xf2, 0x07, 0xfd, 0xed, 0xde, 0xe6, 0x73,
-	0x08, 0x3c, 0x1a, 0xdf, 0x88, 0xf4, 0xff, 0x68, 0x5e, 0x6a, 0x63, 0xba, 0xb6, 0x24, 0x5a, 0xea,
-	0xcf, 0xc9, 0xf8, 0xa5, 0xad, 0x37, 0xd0, 0x7c, 0xca, 0x4c, 0x31, 0xca, 0x62, 0x5d, 0x47, 0x33,
-	0x04, 0xfb, 0xa0, 0x58, 0xc8, 0xb5, 0xe4, 0x16, 0xfb, 0x03, 0xea, 0x19, 0xb3, 0x8a, 0x9b, 0x8c,
-	0x44, 0x1e, 0x1b, 0x2c, 0xd7, 0xc7, 0x1e, 0x33, 0xca, 0xb2, 0x44, 0x16, 0xeb, 0x5b, 0xa8, 0x22,
-	0x3e, 0x81, 0x64, 0x68, 0xcc, 0xad, 0x69, 0xed, 0xe5, 0xde, 0x7a, 0xe7, 0x12, 0x27, 0x04, 0x47,
-	0x4e, 0x47, 0x7e, 0xab, 0x6d, 0xea, 0x12, 0xc1, 0x5d, 0x70, 0x49, 0x56, 0x7a, 0x1d, 0xcd, 0x42,
-	0x68, 0xf5, 0x36, 0x8d, 0x79, 0x59, 0x36, 0x09, 0xf4, 0x55, 0x54, 0x71, 0x30, 0x33, 0x3d, 0xd7,
-	0x77, 0xb9, 0x51, 0x49, 0xca, 0x3a, 0x98, 0xbd, 0x12, 0x71, 0xeb, 0xe7, 0x34, 0xba, 0x33, 0x91,
-	0xeb, 0xbd, 0xcb, 0x87, 0x76, 0x88, 0xa3, 0x5d, 0x80, 0x9b, 0x7f, 0xed, 0x6b, 0x84, 0x2b, 0x34,
-	0x55, 0xfa, 0xb3, 0xa6, 0xee, 0xa2, 0xa5, 0x13, 0xd1, 0x47, 0xe6, 0x89, 0x44, 0xe9, 0x45, 0xb9,
-	0x99, 0xba, 0xa1, 0x8d, 0x6a, 0xc2, 0x3f, 0x91, 0xe2, 0x6f, 0x1e, 0x02, 0x28, 0xed, 0x97, 0xa9,
-	0x67, 0xe7, 0xda, 0x12, 0x48, 0xe1, 0xcd, 0x02, 0xb2, 0x9c, 0x20, 0x09, 0x44, 0x79, 0xe4, 0xc4,
-	0x61, 0x73, 0x79, 0x87, 0xe9, 0x2d, 0xb4, 0x24, 0x6a, 0x4d, 0x34, 0x4d, 0xd4, 0x5e, 0xa0, 0x9e,
-	0xbd, 0xa7, 0x64, 0x15, 0x18, 0x51, 0xa5, 0xa8, 0x7b, 0xa5, 0xbf, 0x40, 0x20, 0x4a, 0x31, 0xad,
-	0x11, 0xaa, 0x4d, 0x94, 0x7f, 0x8d, 0x47, 0xec, 0x46, 0x6a, 0x3f, 0x40, 0xd5, 0x82, 0x1c, 0x20,
-	0xc6, 0xaa, 0x24, 0xe8, 0xe7, 0x05, 0x81, 0xfc, 0x80, 0x94, 0x0a, 0x03, 0x12, 0xe5, 0xe7, 0x63,
-	0x9f, 0x04, 0x7f, 0xad, 0xf0, 0xc7, 0xd4, 0x6a, 0xc5, 0x6b, 0x85, 0xdd, 0x62, 0x46, 0x1f, 0x23,
-	0x7d, 0x44, 0x5c, 0x16, 0xe1, 0xc0, 0x1c, 0xf7, 0xcc, 0x43, 0x6c, 0x71, 0x1a, 0xc6, 0xea, 0x5a,
-	0xa9, 0xa9, 0x7f, 0xde, 0xf5, 0x76, 0x93, 0x7d, 0x31, 0x0e, 0x91, 0xb0, 0x98, 0xe2, 0x91, 0x04,
-	0xfa, 0x06, 0x5a, 0xc9, 0xe5, 0x08, 0xe9, 0x88, 0x67, 0x77, 0x48, 0x35, 0x4b, 0xd1, 0x97, 0xdb,
-	0xfa, 0x3a, 0x5a, 0xb6, 0x28, 0x21, 0x20, 0xf2, 0x99, 0x27, 0x30, 0xf6, 0x95, 0xa9, 0x96, 0xb2,
-	0xdd, 0x03, 0x18, 0xfb, 0x42, 0x1a, 0x26, 0x7b, 0xca, 0x2e, 0xb0, 0xd4, 0x52, 0xac, 0xd0, 0xea,
-	0x55, 0x96, 0x6a, 0x7d, 0xd3, 0x50, 0x5d, 0x4a, 0xb3, 0x15, 0x73, 0xb0, 0xa8, 0x7d, 0x8b, 0xe9,
-	0x7b, 0x88, 0x6a, 0x57, 0xdc, 0xb3, 0x55, 0xeb, 0xc2, 0x95, 0xb9, 0x81, 0x56, 0x84, 0x29, 0x07,
-	0xaa, 0x86, 0x39, 0xc4, 0x6c, 0xa8, 0xb4, 0xa9, 0x12, 0x88, 0xd2, 0xda, 0x2f, 0x30, 0x1b, 0x0a,
-	0xac, 0x30, 0x79, 0x11, 0xab, 0x54, 0xa2, 0x9e, 0x5d, 0xc0, 0x4e, 0xba, 0x9a, 0x2d, 0x74, 0xf5,
-	0x45, 0x43, 0xab, 0xb2, 0xab, 0x3d, 0xcc, 0x21, 0xc2, 0xf1, 0x3f, 0xf5, 0x90, 0x6c, 0xed, 0x7c,
-	0x3d, 0x6b, 0x6a, 0xa7, 0x67, 0x4d, 0xed, 0xc7, 0x59, 0x53, 0xfb, 0x70, 0xde, 0x9c, 0x3a, 0x3d,
-	0x6f, 0x4e, 0x7d, 0x3f, 0x6f, 0x4e, 0x1d, 0x3c, 0x72, 0x5c, 0x3e, 0x1c, 0x0d, 0x3a, 0x16, 0xf5,
-	0xe5, 0x43, 0xfa, 0x24, 0x79, 0x53, 0x09, 0xb5, 0xa1, 0x7b, 0x9c, 0x7b, 0x8d, 0xe3, 0x00, 0xd8,
-	0xa0, 0x2c, 0xdf, 0xd4, 0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xfc, 0xcd, 0xad, 0x00,
-	0x08, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xc1, 0x6e, 0xeb, 0x44,
+	0x14, 0x8d, 0x9b, 0x36, 0x6d, 0xa6, 0xaf, 0x4d, 0x9e, 0x15, 0x21, 0x93, 0xa2, 0x50, 0x05, 0x1e,
+	0x84, 0x07, 0xcf, 0xa9, 0xf2, 0xbe, 0x80, 0x84, 0xd7, 0x82, 0x60, 0x51, 0x05, 0x0a, 0x52, 0x37,
+	0xd6, 0xc4, 0xbe, 0x71, 0xac, 0xda, 0x33, 0x96, 0x67, 0x12, 0xd7, 0xfd, 0x0a, 0x96, 0xfc, 0x00,
+	0x3b, 0xbe, 0x00, 0x7e, 0xa0, 0xcb, 0x4a, 0x6c, 0x58, 0x21, 0xd4, 0xfe, 0x04, 0x4b, 0x34, 0xe3,
+	0xb1, 0x63, 0x97, 0x36, 0x4a, 0x59, 0x20, 0xbd, 0x4d, 0xe4, 0x3b, 0x39, 0x73, 0xef, 0xb9, 0xc7,
+	0xe7, 0xce, 0x18, 0xf5, 0xae, 0x80, 0x63, 0x7b, 0x86, 0x3d, 0xd2, 0x97, 0x4f, 0x34, 0x82, 0xfe,
+	0x74, 0x4e, 0x5c, 0x6f, 0xe2, 0x43, 0x1f, 0x16, 0x40, 0x38, 0x33, 0xc3, 0x88, 0x72, 0xaa, 0x1f,
+	0xe4, 0x48, 0x33, 0x43, 0x9a, 0x19, 0xb2, 0xfd, 0xe1, 0xaa, 0x34, 0xfc, 0x32, 0x4d, 0xd1, 0x6e,
+	0xb9, 0xd4, 0xa5, 0xf2, 0xb1, 0x2f, 0x9e, 0xd4, 0xea, 0x47, 0x0f, 0xec, 0x0d, 0x2f, 0xdc, 0xbe,
+	0x4d, 0x3d, 0x22, 0x7f, 0x14, 0xee, 0xf5, 0xaa, 0x1a, 0x61, 0x04, 0x36, 0x0d, 0x42, 0xcf, 0x07,
+	0xcb, 0xa6, 0x64, 0xea, 0xb9, 0xe9, 0xa6, 0xee, 0xaf, 0x1a, 0x6a, 0xbf, 0x11, 0x6d, 0x7c, 0x9b,
+	0x30, 0x0e, 0xc1, 0x88, 0x12, 0x1e, 0x61, 0x9b, 0x9f, 0x85, 0x0e, 0xe6, 0xe0, 0xe8, 0x87, 0xe8,
+	0x59, 0xc0, 0x5c, 0x8b, 0x27, 0x21, 0x58, 0xf3, 0xc8, 0x37, 0xb4, 0x43, 0xad, 0x57, 0x1f, 0xa3,
+	0x80, 0xb9, 0xdf, 0x25, 0x21, 0x9c, 0x45, 0xbe, 0x7e, 0x84, 0x5a, 0x04, 0x62, 0xcb, 0x56, 0x1b,
+	0x2d, 0xec, 0x38, 0x11, 0x30, 0x66, 0x6c, 0x48, 0xa4, 0x4e, 0x20, 0xce, 0x72, 0x7e, 0x9e, 0xfe,
+	0x23, 0x76, 0x50, 0xdf, 0xf9, 0xf7, 0x8e, 0x6a, 0xba, 0x83, 0xfa, 0xce, 0xfd, 0x1d, 0xef, 0xa0,
+	0x1a, 0xf3, 0x5c, 0x02, 0x91, 0xb1, 0x29, 0x31, 0x2a, 0xea, 0xfe, 0xb2, 0x81, 0x74, 0x49, 0xfe,
+	0x7c, 0x3c, 0x1a, 0x1c, 0x7d, 0x01, 0xa1, 0x4f, 0x93, 0xb5, 0x48, 0xbf, 0x8b, 0x76, 0xa4, 0x50,
+	0x96, 0xe7, 0x48, 0xa2, 0xd5, 0xf1, 0xb6, 0x8c, 0xbf, 0x72, 0xf4, 0x36, 0xda, 0xc9, 0x98, 0x29,
+	0x46, 0x79, 0xac, 0xeb, 0x68, 0x93, 0xe0, 0x00, 0x14, 0x0b, 0xf9, 0x2c, 0xb9, 0x25, 0xc1, 0x84,
+	0xfa, 0xc6, 0x96, 0xe2, 0x26, 0x23, 0x91, 0xc7, 0x01, 0xdb, 0x0b, 0xb0, 0xcf, 0x8c, 0x9a, 0x2c,
+	0x91, 0xc7, 0xfa, 0x10, 0xd5, 0xc5, 0x7b, 0x93, 0x0c, 0x8d, 0xed, 0x43, 0xad, 0xb7, 0x3f, 0x78,
+	0x61, 0x3e, 0x60, 0x9f, 0xf0, 0xc2, 0x35, 0xe5, 0x0b, 0x1e, 0x51, 0x8f, 0x08, 0xee, 0x82, 0x4b,
+	0xfa, 0xa4, 0xb7, 0xd0, 0x16, 0x44, 0xf6, 0xe0, 0xc8, 0xd8, 0x91, 0x65, 0xd3, 0x40, 0x3f, 0x40,
+	0x75, 0x17, 0x33, 0xcb, 0xf7, 0x02, 0x8f, 0x1b, 0xf5, 0xb4, 0xac, 0x8b, 0xd9, 0x37, 0x22, 0xee,
+	0xfe, 0xbd, 0x81, 0xde, 0x5b, 0xca, 0xf5, 0x83, 0xc7, 0x67, 0x4e, 0x84, 0xe3, 0x63, 0x80, 0xf5,
+	0xdf, 0xf6, 0x0a, 0xe1, 0x4a, 0x4d, 0x55, 0xff, 0x5b, 0x53, 0x1f, 0xa0, 0xbd, 0x2b, 0xd1, 0x47,
+	0xee, 0x89, 0x54, 0xe9, 0x67, 0x72, 0x31, 0x73, 0x43, 0x0f, 0x35, 0x85, 0x7f, 0x62, 0xc5, 0xdf,
+	0x9a, 0x02, 0x28, 0xed, 0xf7, 0xa9, 0xef, 0x14, 0xda, 0x12, 0x48, 0xe1, 0xcd, 0x12, 0xb2, 0x96,
+	0x22, 0x09, 0xc4, 0x45, 0xe4, 0xd2, 0x61, 0xdb, 0x45, 0x87, 0xe9, 0x5d, 0xb4, 0x27, 0x6a, 0x2d,
+	0x35, 0x4d, 0xd5, 0xde, 0xa5, 0xbe, 0x73, 0xa2, 0x64, 0x15, 0x18, 0x51, 0xa5, 0xac, 0x7b, 0x7d,
+	0xbc, 0x4b, 0x20, 0xce, 0x30, 0xdd, 0x39, 0x6a, 0x2e, 0x95, 0x3f, 0xc5, 0x73, 0xb6, 0x96, 0xda,
+	0x1f, 0xa3, 0x46, 0x49, 0x0e, 0x10, 0x63, 0x55, 0x15, 0xf4, 0x8b, 0x82, 0x40, 0x71, 0x40, 0xaa,
+	0xa5, 0x01, 0x89, 0x8b, 0xf3, 0x71, 0x46, 0xc2, 0xff, 0xad, 0xf0, 0x4f, 0x99, 0xd5, 0xca, 0xc7,
+	0x0a, 0x7b, 0xc2, 0x8c, 0x7e, 0x86, 0xf4, 0x39, 0xf1, 0x58, 0x8c, 0x43, 0x6b, 0x31, 0xb0, 0xa6,
+	0xd8, 0xe6, 0x34, 0x4a, 0xd4, 0xb1, 0xd2, 0x54, 0xff, 0x7c, 0x3f, 0x38, 0x4e, 0xd7, 0xc5, 0x38,
+	0xc4, 0xc2, 0x62, 0x8a, 0x47, 0x1a, 0xe8, 0x2f, 0xd1, 0xf3, 0x42, 0x8e, 0x88, 0xce, 0x79, 0x7e,
+	0x86, 0x34, 0xf2, 0x14, 0x63, 0xb9, 0xac, 0xbf, 0x40, 0xfb, 0x36, 0x25, 0x04, 0x44, 0x3e, 0xeb,
+	0x0a, 0x16, 0x81, 0x32, 0xd5, 0x5e, 0xbe, 0x7a, 0x0e, 0x8b, 0x40, 0x48, 0xc3, 0x64, 0x4f, 0xf9,
+	0x01, 0x96, 0x59, 0x8a, 0x95, 0x5a, 0x7d, 0xcc, 0x52, 0xdd, 0xdf, 0x35, 0xd4, 0x92, 0xd2, 0x0c,
+	0x13, 0x0e, 0x36, 0x75, 0x9e, 0x30, 0x7d, 0x9f, 0xa0, 0xe6, 0x23, 0xe7, 0x6c, 0xc3, 0xbe, 0x77,
+	0x64, 0xbe, 0x44, 0xcf, 0x85, 0x29, 0x27, 0xaa, 0x86, 0x35, 0xc3, 0x6c, 0xa6, 0xb4, 0x69, 0x10,
+	0x88, 0xb3, 0xda, 0x5f, 0x62, 0x36, 0x13, 0x58, 0x61, 0xf2, 0x32, 0x56, 0xa9, 0x44, 0x7d, 0xa7,
+	0x84, 0x5d, 0x76, 0xb5, 0x55, 0xea, 0xea, 0x37, 0x0d, 0x1d, 0xc8, 0xae, 0x4e, 0x30, 0x87, 0x18,
+	0x27, 0x6f, 0xd7, 0x45, 0xf2, 0xb3, 0xa6, 0xec, 0x7a, 0x9a, 0x5f, 0x93, 0x23, 0x79, 0x4b, 0xae,
+	0x4f, 0xff, 0x6b, 0x54, 0x4b, 0x2f, 0x56, 0x49, 0x78, 0x77, 0xf0, 0xca, 0x5c, 0xf1, 0x3d, 0x60,
+	0xde, 0xaf, 0x33, 0xdc, 0xbc, 0xfe, 0xf3, 0xfd, 0xca, 0x58, 0xa5, 0x78, 0x6c, 0xac, 0x86, 0x6f,
+	0xae, 0x6f, 0x3b, 0xda, 0xcd, 0x6d, 0x47, 0xfb, 0xeb, 0xb6, 0xa3, 0xfd, 0x78, 0xd7, 0xa9, 0xdc,
+	0xdc, 0x75, 0x2a, 0x7f, 0xdc, 0x75, 0x2a, 0xe7, 0x9f, 0xba, 0x1e, 0x9f, 0xcd, 0x27, 0xa6, 0x4d,
+	0x03, 0x79, 0xfb, 0xbf, 0x4a, 0x3f, 0x04, 0x08, 0x75, 0xa0, 0x7f, 0x59, 0xf8, 0xd4, 0x48, 0x42,
+	0x60, 0x93, 0x9a, 0xbc, 0xfb, 0x5f, 0xff, 0x33, 0x00, 0x51, 0xf3, 0x5f, 0x69, 0xdd, 0x08, 0x00,
+	0x00,
 }
 
 func (m *EventSystemContractUpdated) Marshal() (dAtA []byte, err error) {
@@ -1216,6 +1281,53 @@ func (m *EventGatewayContractUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPrecompileConfigUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPrecompileConfigUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPrecompileConfigUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1473,6 +1585,25 @@ func (m *EventGatewayContractUpdated) Size() (n int) {
 	return n
 }
 
+func (m *EventPrecompileConfigUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3209,6 +3340,153 @@ func (m *EventGatewayContractUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPrecompileConfigUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPrecompileConfigUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPrecompileConfigUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -53,9 +53,21 @@ type EVMKeeper interface {
 		tracer vm.EVMLogger,
 		commit bool,
 	) (*evmtypes.MsgEthereumTxResponse, error)
+	ApplyMessageWithConfig(
+		ctx sdk.Context,
+		msg core.Message,
+		tracer vm.EVMLogger,
+		commit bool,
+		cfg *statedb.EVMConfig,
+		txConfig statedb.TxConfig,
+	) (*evmtypes.MsgEthereumTxResponse, error)
+	EVMConfig(ctx sdk.Context, proposerAddress sdk.ConsAddress, chainID *big.Int) (*statedb.EVMConfig, error)
 	GetAccount(ctx sdk.Context, addr ethcommon.Address) *statedb.Account
 	GetCode(ctx sdk.Context, codeHash ethcommon.Hash) []byte
 	SetAccount(ctx sdk.Context, addr ethcommon.Address, account statedb.Account) error
+	SetCode(ctx sdk.Context, codeHash, code []byte)
+	SetState(ctx sdk.Context, addr ethcommon.Address, key ethcommon.Hash, value []byte)
+	ForEachStorage(ctx sdk.Context, addr ethcommon.Address, cb func(key, value ethcommon.Hash) bool)
 }
 
 type AuthorityKeeper interface {
@@ -2,13 +2,16 @@ package types
 
 import (
 	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
 )
 
 // DefaultGenesis returns the default fungible genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		ForeignCoinsList: []ForeignCoins{},
-		SystemContract:   nil,
+		ForeignCoinsList:     []ForeignCoins{},
+		SystemContract:       nil,
+		PrecompileConfigList: []PrecompileConfig{},
 	}
 }
 
@@ -26,5 +29,19 @@ func (gs GenesisState) Validate() error {
 		foreignCoinsIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated address in precompile configs
+	precompileConfigIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PrecompileConfigList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(PrecompileConfigKey(ethcommon.HexToAddress(elem.Address)))
+		if _, ok := precompileConfigIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for precompileConfig")
+		}
+		precompileConfigIndexMap[index] = struct{}{}
+	}
+
 	return nil
 }
@@ -25,8 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the fungible module's genesis state.
 type GenesisState struct {
-	ForeignCoinsList []ForeignCoins  `protobuf:"bytes,2,rep,name=foreignCoinsList,proto3" json:"foreignCoinsList"`
-	SystemContract   *SystemContract `protobuf:"bytes,3,opt,name=systemContract,proto3" json:"systemContract,omitempty"`
+	ForeignCoinsList     []ForeignCoins     `protobuf:"bytes,2,rep,name=foreignCoinsList,proto3" json:"foreignCoinsList"`
+	SystemContract       *SystemContract    `protobuf:"bytes,3,opt,name=systemContract,proto3" json:"systemContract,omitempty"`
+	PrecompileConfigList []PrecompileConfig `protobuf:"bytes,4,rep,name=precompileConfigList,proto3" json:"precompileConfigList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +77,13 @@ func (m *GenesisState) GetSystemContract() *SystemContract {
 	return nil
 }
 
+func (m *GenesisState) GetPrecompileConfigList() []PrecompileConfig {
+	if m != nil {
+		return m.PrecompileConfigList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.fungible.GenesisState")
 }
@@ -85,24 +93,27 @@ func init() {
 }
 
 var fileDescriptor_75c5ed54ff19cb38 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
+	// 311 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xac, 0x4a, 0x2d, 0x49,
 	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0xd3, 0x4a, 0xf3, 0xd2,
 	0x33, 0x93, 0x72, 0x52, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2,
 	0x4b, 0xf2, 0x85, 0xa4, 0xe1, 0x4a, 0xf5, 0x60, 0x4a, 0xf5, 0x60, 0x4a, 0xa5, 0xf4, 0xf1, 0x99,
 	0x93, 0x96, 0x5f, 0x94, 0x9a, 0x99, 0x9e, 0x17, 0x9f, 0x9c, 0x9f, 0x99, 0x07, 0x35, 0x4d, 0xca,
-	0x10, 0x9f, 0x86, 0xe2, 0xca, 0xe2, 0x92, 0xd4, 0xdc, 0xf8, 0xe4, 0xfc, 0xbc, 0x92, 0xa2, 0xc4,
-	0xe4, 0x12, 0xa8, 0x16, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a,
-	0x1d, 0x60, 0xe4, 0xe2, 0x71, 0x87, 0x38, 0x34, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0x28, 0x9a, 0x4b,
-	0x00, 0x6a, 0xa1, 0x33, 0xc8, 0x3e, 0x9f, 0xcc, 0xe2, 0x12, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e,
-	0x23, 0x4d, 0x3d, 0x3c, 0x5e, 0xd0, 0x73, 0x43, 0xd2, 0xe4, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43,
-	0x10, 0x86, 0x41, 0x42, 0xc1, 0x5c, 0x7c, 0x10, 0xc7, 0x39, 0x43, 0xdd, 0x26, 0xc1, 0xac, 0xc0,
-	0xa8, 0xc1, 0x6d, 0xa4, 0x8d, 0xd7, 0xe8, 0x60, 0x14, 0x2d, 0x41, 0x68, 0x46, 0x38, 0xb9, 0x9e,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x76, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0x2e, 0x38, 0x98, 0x74, 0x21, 0x21, 0x96, 0x97, 0x9f, 0x92, 0xaa, 0x5f,
-	0x81, 0x08, 0xaf, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x80, 0x18, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x97, 0x4b, 0x1e, 0x90, 0xd4, 0x01, 0x00, 0x00,
+	0x18, 0x9f, 0x86, 0x82, 0xa2, 0xd4, 0xe4, 0xfc, 0xdc, 0x82, 0xcc, 0x9c, 0xd4, 0xf8, 0xe4, 0xfc,
+	0xbc, 0xb4, 0xcc, 0x74, 0xa8, 0x26, 0x43, 0x7c, 0x9a, 0x8a, 0x2b, 0x8b, 0x4b, 0x52, 0x73, 0x41,
+	0x1a, 0x4a, 0x8a, 0x12, 0x93, 0x4b, 0xa0, 0x5a, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d,
+	0x10, 0x0b, 0x22, 0xaa, 0xb4, 0x92, 0x89, 0x8b, 0xc7, 0x1d, 0xe2, 0xbb, 0xe0, 0x92, 0xc4, 0x92,
+	0x54, 0xa1, 0x68, 0x2e, 0x01, 0xa8, 0x2b, 0x9d, 0x41, 0x8e, 0xf4, 0xc9, 0x2c, 0x2e, 0x91, 0x60,
+	0x52, 0x60, 0xd6, 0xe0, 0x36, 0xd2, 0xd4, 0xc3, 0xe3, 0x6f, 0x3d, 0x37, 0x24, 0x4d, 0x4e, 0x2c,
+	0x27, 0xee, 0xc9, 0x33, 0x04, 0x61, 0x18, 0x24, 0x14, 0xcc, 0xc5, 0x07, 0x71, 0x9c, 0x33, 0xd4,
+	0x6d, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xda, 0x78, 0x8d, 0x0e, 0x46, 0xd1, 0x12, 0x84,
+	0x66, 0x84, 0x50, 0x3a, 0x97, 0x08, 0x22, 0x98, 0x9c, 0xc1, 0xa1, 0x04, 0x76, 0x35, 0x0b, 0xd8,
+	0xd5, 0xba, 0x78, 0x8d, 0x0e, 0x40, 0xd3, 0x08, 0x75, 0x39, 0x56, 0x03, 0x9d, 0x5c, 0x4f, 0x3c,
+	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
+	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49,
+	0x2f, 0x39, 0x3f, 0x17, 0x1c, 0x1f, 0xba, 0x90, 0xa8, 0xc9, 0xcb, 0x4f, 0x49, 0xd5, 0xaf, 0x40,
+	0x44, 0x4c, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xe4, 0x8d, 0x01, 0x03, 0x00, 0x33,
+	0x11, 0x60, 0x53, 0x72, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +136,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrecompileConfigList) > 0 {
+		for iNdEx := len(m.PrecompileConfigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrecompileConfigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.SystemContract != nil {
 		{
 			size, err := m.SystemContract.MarshalToSizedBuffer(dAtA[:i])
@@ -181,6 +206,12 @@ func (m *GenesisState) Size() (n int) {
 		l = m.SystemContract.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PrecompileConfigList) > 0 {
+		for _, e := range m.PrecompileConfigList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -289,6 +320,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileConfigList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrecompileConfigList = append(m.PrecompileConfigList, PrecompileConfig{})
+			if err := m.PrecompileConfigList[len(m.PrecompileConfigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/zeta-chain/node/testutil/sample"
 	"github.com/zeta-chain/node/x/fungible/types"
 )
 
@@ -48,6 +49,35 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid precompile configs",
+			genState: &types.GenesisState{
+				PrecompileConfigList: []types.PrecompileConfig{
+					sample.PrecompileConfig(sample.EthAddress().String()),
+					sample.PrecompileConfig(sample.EthAddress().String()),
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid precompile config",
+			genState: &types.GenesisState{
+				PrecompileConfigList: []types.PrecompileConfig{
+					sample.PrecompileConfig("invalid"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated precompile config",
+			genState: &types.GenesisState{
+				PrecompileConfigList: []types.PrecompileConfig{
+					sample.PrecompileConfig("0x0000000000000000000000000000000000000065"),
+					sample.PrecompileConfig("0x0000000000000000000000000000000000000065"),
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -0,0 +1,13 @@
+package types
+
+import ethcommon "github.com/ethereum/go-ethereum/common"
+
+const (
+	// PrecompileConfigKeyPrefix is the prefix to retrieve all PrecompileConfig
+	PrecompileConfigKeyPrefix = "PrecompileConfig/value/"
+)
+
+// PrecompileConfigKey returns the store key to retrieve a PrecompileConfig from the precompile address
+func PrecompileConfigKey(address ethcommon.Address) []byte {
+	return address.Bytes()
+}
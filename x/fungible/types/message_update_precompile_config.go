@@ -0,0 +1,50 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdatePrecompileConfig = "update_precompile_config"
+
+var _ sdk.Msg = &MsgUpdatePrecompileConfig{}
+
+func NewMsgUpdatePrecompileConfig(creator string, config PrecompileConfig) *MsgUpdatePrecompileConfig {
+	return &MsgUpdatePrecompileConfig{
+		Creator: creator,
+		Config:  config,
+	}
+}
+
+func (msg *MsgUpdatePrecompileConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdatePrecompileConfig) Type() string {
+	return TypeMsgUpdatePrecompileConfig
+}
+
+func (msg *MsgUpdatePrecompileConfig) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdatePrecompileConfig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdatePrecompileConfig) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Config.Validate(); err != nil {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
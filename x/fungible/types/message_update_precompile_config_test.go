@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestMsgUpdatePrecompileConfig_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdatePrecompileConfig
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.NewMsgUpdatePrecompileConfig(
+				sample.AccAddress(),
+				sample.PrecompileConfig(sample.EthAddress().String()),
+			),
+		},
+		{
+			name: "valid message to disable precompile",
+			msg: types.NewMsgUpdatePrecompileConfig(
+				sample.AccAddress(),
+				types.PrecompileConfig{Address: sample.EthAddress().String(), Enabled: false},
+			),
+		},
+		{
+			name: "invalid creator address",
+			msg: types.NewMsgUpdatePrecompileConfig(
+				"invalid_address",
+				sample.PrecompileConfig(sample.EthAddress().String()),
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid config",
+			msg: types.NewMsgUpdatePrecompileConfig(
+				sample.AccAddress(),
+				sample.PrecompileConfig("invalid_address"),
+			),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdatePrecompileConfig_GetSigners(t *testing.T) {
+	signer := sample.AccAddress()
+	tests := []struct {
+		name   string
+		msg    types.MsgUpdatePrecompileConfig
+		panics bool
+	}{
+		{
+			name: "valid signer",
+			msg: types.MsgUpdatePrecompileConfig{
+				Creator: signer,
+			},
+			panics: false,
+		},
+		{
+			name: "invalid signer",
+			msg: types.MsgUpdatePrecompileConfig{
+				Creator: "invalid",
+			},
+			panics: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.panics {
+				signers := tt.msg.GetSigners()
+				require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(signer)}, signers)
+			} else {
+				require.Panics(t, func() {
+					tt.msg.GetSigners()
+				})
+			}
+		})
+	}
+}
+
+func TestMsgUpdatePrecompileConfig_Type(t *testing.T) {
+	msg := types.MsgUpdatePrecompileConfig{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.TypeMsgUpdatePrecompileConfig, msg.Type())
+}
+
+func TestMsgUpdatePrecompileConfig_Route(t *testing.T) {
+	msg := types.MsgUpdatePrecompileConfig{
+		Creator: sample.AccAddress(),
+	}
+	require.Equal(t, types.RouterKey, msg.Route())
+}
+
+func TestMsgUpdatePrecompileConfig_GetSignBytes(t *testing.T) {
+	msg := types.MsgUpdatePrecompileConfig{
+		Creator: sample.AccAddress(),
+	}
+	require.NotPanics(t, func() {
+		msg.GetSignBytes()
+	})
+}
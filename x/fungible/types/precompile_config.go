@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Validate checks the precompile config is valid
+func (c PrecompileConfig) Validate() error {
+	if !ethcommon.IsHexAddress(c.Address) {
+		return fmt.Errorf("invalid precompile address (%s)", c.Address)
+	}
+
+	methods := make(map[string]struct{}, len(c.MethodGas))
+	for _, methodGas := range c.MethodGas {
+		if methodGas.Method == "" {
+			return fmt.Errorf("empty method name for precompile %s", c.Address)
+		}
+		if _, ok := methods[methodGas.Method]; ok {
+			return fmt.Errorf("duplicated gas for method %s of precompile %s", methodGas.Method, c.Address)
+		}
+		methods[methodGas.Method] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateMethods checks the methods configured exist in the ABI of the precompile
+func (c PrecompileConfig) ValidateMethods(contractABI abi.ABI) error {
+	for _, methodGas := range c.MethodGas {
+		if _, ok := contractABI.Methods[methodGas.Method]; !ok {
+			return fmt.Errorf("method %s not found in precompile %s", methodGas.Method, c.Address)
+		}
+	}
+	return nil
+}
+
+// GasForMethod returns the gas configured for the method, if any
+func (c PrecompileConfig) GasForMethod(method string) (uint64, bool) {
+	for _, methodGas := range c.MethodGas {
+		if methodGas.Method == method {
+			return methodGas.Gas, true
+		}
+	}
+	return 0, false
+}
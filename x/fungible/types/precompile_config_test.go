@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/fungible/types"
+)
+
+func TestPrecompileConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  types.PrecompileConfig
+		isValid bool
+	}{
+		{
+			name:    "valid config",
+			config:  sample.PrecompileConfig(sample.EthAddress().String()),
+			isValid: true,
+		},
+		{
+			name: "valid config without method gas",
+			config: types.PrecompileConfig{
+				Address: sample.EthAddress().String(),
+				Enabled: false,
+			},
+			isValid: true,
+		},
+		{
+			name: "invalid address",
+			config: types.PrecompileConfig{
+				Address: "invalid",
+				Enabled: true,
+			},
+			isValid: false,
+		},
+		{
+			name: "empty method name",
+			config: types.PrecompileConfig{
+				Address:   sample.EthAddress().String(),
+				MethodGas: []types.PrecompileMethodGas{{Method: "", Gas: 1000}},
+			},
+			isValid: false,
+		},
+		{
+			name: "duplicated method",
+			config: types.PrecompileConfig{
+				Address: sample.EthAddress().String(),
+				MethodGas: []types.PrecompileMethodGas{
+					{Method: "foo", Gas: 1000},
+					{Method: "foo", Gas: 2000},
+				},
+			},
+			isValid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.isValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPrecompileConfig_ValidateMethods(t *testing.T) {
+	contractABI := sample.PrecompileABI(t)
+
+	t.Run("valid if all methods are in the ABI", func(t *testing.T) {
+		config := sample.PrecompileConfig(sample.EthAddress().String())
+		require.NoError(t, config.ValidateMethods(contractABI))
+	})
+
+	t.Run("invalid if a method is not in the ABI", func(t *testing.T) {
+		config := sample.PrecompileConfig(sample.EthAddress().String())
+		config.MethodGas = append(config.MethodGas, types.PrecompileMethodGas{Method: "baz", Gas: 1000})
+		require.Error(t, config.ValidateMethods(contractABI))
+	})
+}
+
+func TestPrecompileConfig_GasForMethod(t *testing.T) {
+	config := sample.PrecompileConfig(sample.EthAddress().String())
+
+	gas, found := config.GasForMethod("bar")
+	require.True(t, found)
+	require.Equal(t, uint64(2000), gas)
+
+	_, found = config.GasForMethod("baz")
+	require.False(t, found)
+}
@@ -700,6 +700,309 @@ func (m *QueryCodeHashResponse) GetCodeHash() string {
 	return ""
 }
 
+type QueryGetPrecompileConfigRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetPrecompileConfigRequest) Reset()         { *m = QueryGetPrecompileConfigRequest{} }
+func (m *QueryGetPrecompileConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPrecompileConfigRequest) ProtoMessage()    {}
+func (*QueryGetPrecompileConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd9a7c9e94d3c90, []int{14}
+}
+func (m *QueryGetPrecompileConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPrecompileConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPrecompileConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPrecompileConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPrecompileConfigRequest.Merge(m, src)
+}
+func (m *QueryGetPrecompileConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPrecompileConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPrecompileConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPrecompileConfigRequest proto.InternalMessageInfo
+
+func (m *QueryGetPrecompileConfigRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryGetPrecompileConfigResponse struct {
+	Config PrecompileConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (m *QueryGetPrecompileConfigResponse) Reset()         { *m = QueryGetPrecompileConfigResponse{} }
+func (m *QueryGetPrecompileConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPrecompileConfigResponse) ProtoMessage()    {}
+func (*QueryGetPrecompileConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd9a7c9e94d3c90, []int{15}
+}
+func (m *QueryGetPrecompileConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPrecompileConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPrecompileConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPrecompileConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPrecompileConfigResponse.Merge(m, src)
+}
+func (m *QueryGetPrecompileConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPrecompileConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPrecompileConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPrecompileConfigResponse proto.InternalMessageInfo
+
+func (m *QueryGetPrecompileConfigResponse) GetConfig() PrecompileConfig {
+	if m != nil {
+		return m.Config
+	}
+	return PrecompileConfig{}
+}
+
+type QueryAllPrecompileConfigRequest struct {
+}
+
+func (m *QueryAllPrecompileConfigRequest) Reset()         { *m = QueryAllPrecompileConfigRequest{} }
+func (m *QueryAllPrecompileConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPrecompileConfigRequest) ProtoMessage()    {}
+func (*QueryAllPrecompileConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd9a7c9e94d3c90, []int{16}
+}
+func (m *QueryAllPrecompileConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPrecompileConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPrecompileConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPrecompileConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPrecompileConfigRequest.Merge(m, src)
+}
+func (m *QueryAllPrecompileConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPrecompileConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPrecompileConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPrecompileConfigRequest proto.InternalMessageInfo
+
+type QueryAllPrecompileConfigResponse struct {
+	Configs []PrecompileConfig `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs"`
+}
+
+func (m *QueryAllPrecompileConfigResponse) Reset()         { *m = QueryAllPrecompileConfigResponse{} }
+func (m *QueryAllPrecompileConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPrecompileConfigResponse) ProtoMessage()    {}
+func (*QueryAllPrecompileConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd9a7c9e94d3c90, []int{17}
+}
+func (m *QueryAllPrecompileConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPrecompileConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPrecompileConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPrecompileConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPrecompileConfigResponse.Merge(m, src)
+}
+func (m *QueryAllPrecompileConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPrecompileConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPrecompileConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllPrecompileConfigResponse proto.InternalMessageInfo
+
+func (m *QueryAllPrecompileConfigResponse) GetConfigs() []PrecompileConfig {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
+type QuerySimulateRequest struct {
+	// args is the JSON encoded SimulateArgs
+	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
+	// chain_id is the EIP-155 chain ID of the zEVM
+	ChainId int64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// block_number is the height of the block the simulation starts from
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// block_time is the unix time of the block the simulation starts from
+	BlockTime int64 `protobuf:"varint,5,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// block_hash is the hex encoded hash of the block the simulation starts from
+	BlockHash string `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// proposer_address is the consensus address of the block proposer
+	ProposerAddress []byte `protobuf:"bytes,8,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+}
+
+func (m *QuerySimulateRequest) Reset()         { *m = QuerySimulateRequest{} }
+func (m *QuerySimulateRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateRequest) ProtoMessage()    {}
+func (*QuerySimulateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd9a7c9e94d3c90, []int{18}
+}
+func (m *QuerySimulateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateRequest.Merge(m, src)
+}
+func (m *QuerySimulateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateRequest) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QuerySimulateRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QuerySimulateRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *QuerySimulateRequest) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *QuerySimulateRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *QuerySimulateRequest) GetProposerAddress() []byte {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+type QuerySimulateResponse struct {
+	// data is the JSON encoded list of SimulateBlockResult
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QuerySimulateResponse) Reset()         { *m = QuerySimulateResponse{} }
+func (m *QuerySimulateResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateResponse) ProtoMessage()    {}
+func (*QuerySimulateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9cd9a7c9e94d3c90, []int{19}
+}
+func (m *QuerySimulateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateResponse.Merge(m, src)
+}
+func (m *QuerySimulateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetForeignCoinsRequest)(nil), "zetachain.zetacore.fungible.QueryGetForeignCoinsRequest")
 	proto.RegisterType((*QueryGetForeignCoinsResponse)(nil), "zetachain.zetacore.fungible.QueryGetForeignCoinsResponse")
@@ -716,6 +1019,12 @@ func init() {
 	proto.RegisterType((*QueryAllGasStabilityPoolBalanceResponse_Balance)(nil), "zetachain.zetacore.fungible.QueryAllGasStabilityPoolBalanceResponse.Balance")
 	proto.RegisterType((*QueryCodeHashRequest)(nil), "zetachain.zetacore.fungible.QueryCodeHashRequest")
 	proto.RegisterType((*QueryCodeHashResponse)(nil), "zetachain.zetacore.fungible.QueryCodeHashResponse")
+	proto.RegisterType((*QueryGetPrecompileConfigRequest)(nil), "zetachain.zetacore.fungible.QueryGetPrecompileConfigRequest")
+	proto.RegisterType((*QueryGetPrecompileConfigResponse)(nil), "zetachain.zetacore.fungible.QueryGetPrecompileConfigResponse")
+	proto.RegisterType((*QueryAllPrecompileConfigRequest)(nil), "zetachain.zetacore.fungible.QueryAllPrecompileConfigRequest")
+	proto.RegisterType((*QueryAllPrecompileConfigResponse)(nil), "zetachain.zetacore.fungible.QueryAllPrecompileConfigResponse")
+	proto.RegisterType((*QuerySimulateRequest)(nil), "zetachain.zetacore.fungible.QuerySimulateRequest")
+	proto.RegisterType((*QuerySimulateResponse)(nil), "zetachain.zetacore.fungible.QuerySimulateResponse")
 }
 
 func init() {
@@ -723,62 +1032,80 @@ func init() {
 }
 
 var fileDescriptor_9cd9a7c9e94d3c90 = []byte{
-	// 868 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x4f, 0xdb, 0x48,
-	0x14, 0x8e, 0x61, 0xd9, 0x84, 0x81, 0x65, 0xa5, 0x11, 0xab, 0x65, 0x0d, 0x9b, 0xec, 0x5a, 0x2c,
-	0xb0, 0x81, 0xb5, 0x49, 0x58, 0x69, 0x59, 0x36, 0x5a, 0x6d, 0x92, 0x2d, 0xb4, 0x52, 0x0f, 0x34,
-	0x9c, 0xda, 0x4b, 0x34, 0xb1, 0x07, 0xc7, 0x92, 0xe3, 0x09, 0x19, 0x07, 0x91, 0x46, 0x5c, 0xfa,
-	0x0b, 0x2a, 0xf5, 0x27, 0xf4, 0x1f, 0xf4, 0xd2, 0x4b, 0x7f, 0x00, 0x47, 0xa4, 0x4a, 0x55, 0x7b,
-	0xa9, 0xda, 0xd0, 0x1f, 0xd1, 0x63, 0x95, 0xf1, 0xb3, 0x49, 0x82, 0x9d, 0xa4, 0xe1, 0xe6, 0x19,
-	0xbf, 0xef, 0xbd, 0xef, 0x7b, 0x6f, 0xe6, 0xb3, 0xd1, 0xfa, 0x63, 0xea, 0x12, 0xbd, 0x4a, 0x2c,
-	0x47, 0x13, 0x4f, 0xac, 0x41, 0xb5, 0xe3, 0xa6, 0x63, 0x5a, 0x15, 0x9b, 0x6a, 0x27, 0x4d, 0xda,
-	0x68, 0xa9, 0xf5, 0x06, 0x73, 0x19, 0x5e, 0x0e, 0x02, 0x55, 0x3f, 0x50, 0xf5, 0x03, 0xe5, 0xb4,
-	0xce, 0x78, 0x8d, 0x71, 0xad, 0x42, 0x38, 0xa0, 0xb4, 0xd3, 0x4c, 0x85, 0xba, 0x24, 0xa3, 0xd5,
-	0x89, 0x69, 0x39, 0xc4, 0xb5, 0x98, 0xe3, 0x25, 0x92, 0xb5, 0x61, 0x15, 0x8f, 0x59, 0x83, 0x5a,
-	0xa6, 0x53, 0xd6, 0x99, 0xe5, 0x70, 0x00, 0x64, 0x86, 0x01, 0x78, 0x8b, 0xbb, 0xb4, 0x56, 0xd6,
-	0x99, 0xe3, 0x36, 0x88, 0xee, 0x02, 0x64, 0xd1, 0x64, 0x26, 0x13, 0x8f, 0x5a, 0xf7, 0x09, 0x76,
-	0x57, 0x4c, 0xc6, 0x4c, 0x9b, 0x6a, 0xa4, 0x6e, 0x69, 0xc4, 0x71, 0x98, 0x2b, 0x68, 0x41, 0x19,
-	0x65, 0x07, 0x2d, 0x3f, 0xe8, 0x32, 0x3f, 0xa0, 0xee, 0xbe, 0xc7, 0xa2, 0xd8, 0x25, 0x51, 0xa2,
-	0x27, 0x4d, 0xca, 0x5d, 0xbc, 0x88, 0x66, 0x2c, 0xc7, 0xa0, 0x67, 0x4b, 0xd2, 0x2f, 0xd2, 0xc6,
-	0x6c, 0xc9, 0x5b, 0x28, 0x1c, 0xad, 0x84, 0x83, 0x78, 0x9d, 0x39, 0x9c, 0xe2, 0x23, 0x34, 0x7f,
-	0xdc, 0xb3, 0x2f, 0xc0, 0x73, 0xd9, 0xdf, 0xd5, 0x21, 0xcd, 0x54, 0x7b, 0x13, 0x15, 0xbe, 0xb9,
-	0x78, 0x9f, 0x8a, 0x95, 0xfa, 0x92, 0x28, 0x14, 0x98, 0xe6, 0x6d, 0x3b, 0x8c, 0xe9, 0x3e, 0x42,
-	0xd7, 0x4d, 0x87, 0x8a, 0x6b, 0xaa, 0x37, 0x21, 0xb5, 0x3b, 0x21, 0xd5, 0x9b, 0x2b, 0x4c, 0x48,
-	0x3d, 0x24, 0x26, 0x05, 0x6c, 0xa9, 0x07, 0xa9, 0xbc, 0x92, 0x40, 0xdc, 0x8d, 0x3a, 0x91, 0xe2,
-	0xa6, 0x6f, 0x2d, 0x0e, 0x1f, 0xf4, 0xb1, 0x9f, 0x12, 0xec, 0xd7, 0x47, 0xb2, 0xf7, 0x18, 0xf5,
-	0xd1, 0x4f, 0xa1, 0x9f, 0xfd, 0xd1, 0x1c, 0x89, 0x43, 0x52, 0x84, 0x33, 0x02, 0x5a, 0x95, 0x36,
-	0x4a, 0x46, 0x05, 0x80, 0xc0, 0x87, 0x68, 0xa1, 0xff, 0x0d, 0x74, 0x73, 0x73, 0xa8, 0xc4, 0x7e,
-	0x08, 0x88, 0x1c, 0x48, 0xa4, 0xfc, 0x8a, 0x52, 0x7e, 0xf1, 0x03, 0xc2, 0x8f, 0x5c, 0x52, 0xb1,
-	0x6c, 0xcb, 0x6d, 0x1d, 0x32, 0x66, 0xe7, 0x0d, 0xa3, 0x41, 0x39, 0x57, 0x4e, 0xd0, 0xfa, 0x88,
-	0x90, 0x80, 0xe8, 0x6f, 0x68, 0xc1, 0xeb, 0x50, 0x99, 0x78, 0x6f, 0xe0, 0x94, 0x7e, 0xe7, 0xed,
-	0x42, 0x38, 0x4e, 0xa1, 0x39, 0x7a, 0x5a, 0x0b, 0x62, 0xa6, 0x44, 0x0c, 0xa2, 0xa7, 0x35, 0xbf,
-	0x64, 0x2e, 0x9a, 0x55, 0x81, 0xd8, 0xc4, 0xd1, 0x29, 0xfe, 0x09, 0x25, 0x84, 0xf0, 0xb2, 0x65,
-	0x88, 0x22, 0xd3, 0xa5, 0xb8, 0x58, 0xdf, 0x33, 0x94, 0x62, 0x34, 0x61, 0x40, 0x07, 0x84, 0x97,
-	0x50, 0xbc, 0xe2, 0x6d, 0x01, 0x0b, 0x7f, 0x19, 0x34, 0x26, 0x6f, 0xdb, 0x11, 0x49, 0x94, 0x77,
-	0x12, 0x14, 0x8a, 0x8e, 0x09, 0x0a, 0x39, 0x28, 0x01, 0x99, 0xfd, 0xf3, 0x79, 0x7f, 0xe8, 0xf0,
-	0xc6, 0xcc, 0xab, 0xc2, 0x1a, 0xa6, 0x1b, 0xd4, 0x90, 0xff, 0x45, 0xf1, 0xd1, 0x9d, 0x1a, 0x22,
-	0x7f, 0x1b, 0x2d, 0x0a, 0x0a, 0x45, 0x66, 0xd0, 0xbb, 0x84, 0x57, 0xfd, 0x4b, 0xbd, 0x84, 0xe2,
-	0xfd, 0xa3, 0xf5, 0x97, 0xca, 0x9f, 0xe8, 0x87, 0x01, 0x04, 0x48, 0x5f, 0x46, 0xb3, 0x3a, 0x33,
-	0x68, 0xb9, 0x4a, 0x78, 0x15, 0x40, 0x09, 0x1d, 0x82, 0xb2, 0x9f, 0x11, 0x9a, 0x11, 0x30, 0xfc,
-	0x52, 0x42, 0xf3, 0xbd, 0xb7, 0x12, 0xef, 0x8e, 0x6e, 0x50, 0xb8, 0x47, 0xca, 0x7f, 0x4f, 0x80,
-	0xf4, 0xc8, 0x2a, 0xd9, 0x27, 0xaf, 0x3f, 0x3d, 0x9b, 0xda, 0xc2, 0x69, 0xe1, 0xf1, 0x7f, 0x78,
-	0x76, 0x1f, 0xfe, 0x59, 0xd0, 0xda, 0xc2, 0x7b, 0xcf, 0xf1, 0x0b, 0x09, 0x7d, 0xdf, 0x9b, 0x2c,
-	0x6f, 0xdb, 0xe3, 0x90, 0x0f, 0xb7, 0xcd, 0x71, 0xc8, 0x47, 0x18, 0xa1, 0x92, 0x16, 0xe4, 0x57,
-	0xb1, 0x32, 0x9a, 0x7c, 0xb7, 0xdd, 0x03, 0x5e, 0x80, 0xf7, 0xc6, 0x6a, 0x5b, 0xa8, 0x89, 0xc9,
-	0xff, 0x4c, 0x84, 0x05, 0xde, 0x5b, 0x82, 0xf7, 0x1a, 0x5e, 0x0d, 0xe5, 0x3d, 0xf0, 0x69, 0xc5,
-	0x6f, 0x24, 0xf4, 0x63, 0x84, 0x11, 0xe1, 0xdc, 0x58, 0x34, 0x22, 0xd0, 0xf2, 0xff, 0xb7, 0x41,
-	0x07, 0x6a, 0xfe, 0x12, 0x6a, 0x32, 0x58, 0x0b, 0x55, 0x63, 0x12, 0x5e, 0xe6, 0x3e, 0xbc, 0x5c,
-	0x67, 0xcc, 0xf6, 0x7d, 0x10, 0x7f, 0x0c, 0x11, 0xe6, 0x5f, 0xe2, 0xc9, 0x84, 0x01, 0x7a, 0x42,
-	0x61, 0x03, 0x5e, 0xa3, 0x14, 0x84, 0xb0, 0x1c, 0xde, 0x1b, 0x57, 0x18, 0x98, 0x89, 0xd6, 0xf6,
-	0xfd, 0xe7, 0x1c, 0x77, 0x24, 0x24, 0x47, 0xd4, 0xe9, 0x5e, 0x9b, 0xdc, 0x6d, 0x4c, 0x71, 0x1c,
-	0x99, 0xa3, 0x2d, 0x55, 0xf9, 0x4f, 0xc8, 0xdc, 0xc3, 0xbb, 0xbd, 0x32, 0x6f, 0xfe, 0xf1, 0x45,
-	0xeb, 0xc5, 0xcf, 0x25, 0x94, 0xf0, 0x6d, 0x10, 0x67, 0x46, 0x93, 0x1a, 0x30, 0x59, 0x39, 0xfb,
-	0x35, 0x10, 0x60, 0xbd, 0x2d, 0x58, 0xa7, 0xf1, 0x46, 0xe8, 0x70, 0x02, 0x03, 0xd6, 0xda, 0x70,
-	0xda, 0xce, 0x0b, 0x77, 0x2e, 0x3a, 0x49, 0xe9, 0xb2, 0x93, 0x94, 0x3e, 0x74, 0x92, 0xd2, 0xd3,
-	0xab, 0x64, 0xec, 0xf2, 0x2a, 0x19, 0x7b, 0x7b, 0x95, 0x8c, 0x3d, 0xda, 0x34, 0x2d, 0xb7, 0xda,
-	0xac, 0xa8, 0x3a, 0xab, 0xf5, 0x66, 0x73, 0x98, 0x41, 0xb5, 0xb3, 0xeb, 0xa4, 0x6e, 0xab, 0x4e,
-	0x79, 0xe5, 0x5b, 0xf1, 0xdb, 0xba, 0xf3, 0x25, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xea, 0xc3, 0xa0,
-	0xc2, 0x0b, 0x00, 0x00,
+	// 1155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x4f, 0xe3, 0xc6,
+	0x17, 0xc7, 0x10, 0x48, 0x78, 0xf0, 0x5d, 0xd0, 0x7c, 0x59, 0x95, 0x1a, 0x08, 0xac, 0xb5, 0x0b,
+	0x2c, 0xec, 0xda, 0x10, 0x2a, 0x95, 0x65, 0x69, 0x55, 0x7e, 0x74, 0x69, 0xb7, 0x3f, 0x44, 0x43,
+	0x2f, 0xed, 0x25, 0x9a, 0x38, 0x83, 0xb1, 0xea, 0x78, 0x82, 0xed, 0xa0, 0xa5, 0x88, 0x4b, 0xff,
+	0x82, 0x4a, 0xbd, 0xf4, 0xde, 0xfe, 0x05, 0xbd, 0xf4, 0xd2, 0x53, 0x4f, 0xdb, 0xdb, 0x4a, 0x95,
+	0xaa, 0xf6, 0xd0, 0xaa, 0x85, 0xfe, 0x21, 0x55, 0xc6, 0x6f, 0x4c, 0xe2, 0xb5, 0x93, 0x6c, 0x72,
+	0xb3, 0xc7, 0xef, 0xf3, 0xde, 0xe7, 0xf3, 0xde, 0x9b, 0x79, 0x63, 0x58, 0xfa, 0x92, 0x05, 0xd4,
+	0x3c, 0xa1, 0xb6, 0x6b, 0x88, 0x27, 0xee, 0x31, 0xe3, 0xb8, 0xee, 0x5a, 0x76, 0xd9, 0x61, 0xc6,
+	0x69, 0x9d, 0x79, 0xe7, 0x7a, 0xcd, 0xe3, 0x01, 0x27, 0x33, 0x91, 0xa1, 0x2e, 0x0d, 0x75, 0x69,
+	0xa8, 0xae, 0x98, 0xdc, 0xaf, 0x72, 0xdf, 0x28, 0x53, 0x1f, 0x51, 0xc6, 0xd9, 0x7a, 0x99, 0x05,
+	0x74, 0xdd, 0xa8, 0x51, 0xcb, 0x76, 0x69, 0x60, 0x73, 0x37, 0x74, 0xa4, 0x1a, 0xed, 0x22, 0x1e,
+	0x73, 0x8f, 0xd9, 0x96, 0x5b, 0x32, 0xb9, 0xed, 0xfa, 0x08, 0xd8, 0x68, 0x07, 0xa8, 0x79, 0xcc,
+	0xe4, 0xd5, 0x9a, 0xed, 0xb0, 0x92, 0xc9, 0xdd, 0x63, 0xdb, 0x42, 0xd0, 0x7a, 0x3b, 0x90, 0x7f,
+	0xee, 0x07, 0xac, 0xda, 0x00, 0x04, 0x1e, 0x35, 0x03, 0x84, 0x4c, 0x59, 0xdc, 0xe2, 0xe2, 0xd1,
+	0x68, 0x3c, 0xe1, 0xea, 0xac, 0xc5, 0xb9, 0xe5, 0x30, 0x83, 0xd6, 0x6c, 0x83, 0xba, 0x2e, 0x0f,
+	0x84, 0x16, 0xe4, 0xa6, 0x6d, 0xc0, 0xcc, 0x27, 0x0d, 0xb9, 0x07, 0x2c, 0x78, 0x12, 0x52, 0xdf,
+	0x6b, 0x30, 0x2f, 0xb2, 0xd3, 0x3a, 0xf3, 0x03, 0x32, 0x05, 0xc3, 0xb6, 0x5b, 0x61, 0xcf, 0xa6,
+	0x95, 0x05, 0x65, 0x79, 0xb4, 0x18, 0xbe, 0x68, 0x3e, 0xcc, 0x26, 0x83, 0xfc, 0x1a, 0x77, 0x7d,
+	0x46, 0x8e, 0x60, 0xfc, 0xb8, 0x69, 0x5d, 0x80, 0xc7, 0x0a, 0xf7, 0xf5, 0x36, 0x15, 0xd0, 0x9b,
+	0x1d, 0xed, 0x66, 0x9e, 0xff, 0x35, 0x3f, 0x50, 0x6c, 0x71, 0xa2, 0x31, 0x64, 0xba, 0xe3, 0x38,
+	0x49, 0x4c, 0x9f, 0x00, 0xdc, 0x54, 0x0a, 0x23, 0x2e, 0xea, 0x61, 0x59, 0xf5, 0x46, 0x59, 0xf5,
+	0xb0, 0x19, 0xb0, 0xac, 0xfa, 0x21, 0xb5, 0x18, 0x62, 0x8b, 0x4d, 0x48, 0xed, 0x27, 0x05, 0x66,
+	0x93, 0xe3, 0xa4, 0x8a, 0x1b, 0xea, 0x5b, 0x1c, 0x39, 0x68, 0x61, 0x3f, 0x28, 0xd8, 0x2f, 0x75,
+	0x64, 0x1f, 0x32, 0x6a, 0xa1, 0x3f, 0x0f, 0x73, 0xb2, 0x34, 0x47, 0xa2, 0x49, 0xf6, 0xb0, 0x47,
+	0x50, 0xab, 0x76, 0x01, 0xf9, 0x34, 0x03, 0x14, 0xf8, 0x19, 0xdc, 0x6a, 0xfd, 0x82, 0xd9, 0x5c,
+	0x6d, 0x2b, 0xb1, 0x15, 0x82, 0x22, 0x63, 0x8e, 0xb4, 0x3b, 0x30, 0x2f, 0x83, 0x1f, 0x50, 0xff,
+	0x28, 0xa0, 0x65, 0xdb, 0xb1, 0x83, 0xf3, 0x43, 0xce, 0x9d, 0x9d, 0x4a, 0xc5, 0x63, 0xbe, 0xaf,
+	0x9d, 0xc2, 0x52, 0x07, 0x93, 0x88, 0xe8, 0x3d, 0xb8, 0x15, 0x66, 0xa8, 0x44, 0xc3, 0x2f, 0xd8,
+	0xa5, 0xff, 0x0b, 0x57, 0xd1, 0x9c, 0xcc, 0xc3, 0x18, 0x3b, 0xab, 0x46, 0x36, 0x83, 0xc2, 0x06,
+	0xd8, 0x59, 0x55, 0x86, 0xdc, 0x4e, 0x67, 0xb5, 0x4b, 0x1d, 0xea, 0x9a, 0x8c, 0xbc, 0x0e, 0x39,
+	0x21, 0xbc, 0x64, 0x57, 0x44, 0x90, 0xa1, 0x62, 0x56, 0xbc, 0xbf, 0x5f, 0xd1, 0xf6, 0x60, 0xa9,
+	0x03, 0x3a, 0x22, 0x3c, 0x0d, 0xd9, 0x72, 0xb8, 0x84, 0x2c, 0xe4, 0x6b, 0x94, 0x98, 0x1d, 0xc7,
+	0x49, 0x71, 0xa2, 0xfd, 0xa1, 0xc0, 0x52, 0x07, 0x9b, 0x28, 0x90, 0x0b, 0x39, 0xf4, 0x2c, 0xfb,
+	0xf3, 0xc3, 0xb6, 0xc5, 0xeb, 0xd2, 0xaf, 0x8e, 0xef, 0x58, 0xdd, 0x28, 0x86, 0xfa, 0x36, 0x64,
+	0x3b, 0x67, 0xaa, 0x8d, 0xfc, 0x35, 0x98, 0x12, 0x14, 0xf6, 0x78, 0x85, 0xbd, 0x47, 0xfd, 0x13,
+	0xb9, 0xa9, 0xa7, 0x21, 0xdb, 0x5a, 0x5a, 0xf9, 0xaa, 0xbd, 0x01, 0xb7, 0x63, 0x08, 0x94, 0x3e,
+	0x03, 0xa3, 0x26, 0xaf, 0xb0, 0xd2, 0x09, 0xf5, 0x4f, 0x10, 0x94, 0x33, 0xd1, 0x48, 0x7b, 0x7c,
+	0x53, 0xe9, 0xc3, 0xe8, 0xdc, 0xdd, 0x13, 0xc7, 0x6e, 0xe7, 0x90, 0x1c, 0x16, 0xd2, 0xc1, 0x18,
+	0xfd, 0x03, 0x18, 0x09, 0x4f, 0x71, 0xdc, 0x33, 0x0f, 0xdb, 0xa6, 0x3d, 0xee, 0x06, 0xf3, 0x8a,
+	0x2e, 0x9a, 0x9b, 0x22, 0x85, 0xad, 0x76, 0x0a, 0x0b, 0xe9, 0x26, 0xc8, 0xe9, 0x23, 0xc8, 0x86,
+	0x0e, 0x65, 0x2f, 0xf4, 0x44, 0x4a, 0xfa, 0xd0, 0xfe, 0x54, 0xb0, 0x58, 0x47, 0x76, 0xb5, 0xee,
+	0xd0, 0x40, 0x9e, 0xa2, 0x84, 0x40, 0x86, 0x7a, 0x56, 0x98, 0xb6, 0xf1, 0xa2, 0x78, 0x6e, 0xe9,
+	0x86, 0xa1, 0xd6, 0x6e, 0xb8, 0x03, 0xe3, 0x65, 0x87, 0x9b, 0x5f, 0x94, 0xdc, 0x7a, 0xb5, 0xcc,
+	0xbc, 0xe9, 0x8c, 0xf8, 0x3c, 0x26, 0xd6, 0x3e, 0x16, 0x4b, 0x64, 0x0e, 0x20, 0x34, 0x09, 0xec,
+	0x2a, 0x9b, 0x1e, 0x16, 0x06, 0xa3, 0x62, 0xe5, 0x53, 0xbb, 0xca, 0x6e, 0x3e, 0x8b, 0x5a, 0x8f,
+	0x88, 0x6a, 0x85, 0x9f, 0x1b, 0xc5, 0x26, 0xf7, 0x61, 0xb2, 0xe6, 0xf1, 0x1a, 0xf7, 0x99, 0x17,
+	0x6d, 0xfe, 0x9c, 0xe0, 0x36, 0x21, 0xd7, 0xf1, 0x04, 0x78, 0x9a, 0xc9, 0x0d, 0x4e, 0x0e, 0x3d,
+	0xcd, 0xe4, 0xb2, 0x93, 0x39, 0x6d, 0x15, 0x6e, 0xc7, 0xe4, 0x61, 0x1e, 0x09, 0x64, 0x2a, 0x34,
+	0xa0, 0x52, 0x5f, 0xe3, 0xb9, 0xf0, 0xfd, 0x04, 0x0c, 0x0b, 0x6b, 0xf2, 0xa3, 0x02, 0xe3, 0xcd,
+	0xc7, 0x3c, 0xd9, 0xec, 0xbc, 0xe3, 0x92, 0x87, 0xae, 0xfa, 0xa8, 0x07, 0x64, 0xc8, 0x51, 0x2b,
+	0x7c, 0xf5, 0xeb, 0xbf, 0xdf, 0x0c, 0x3e, 0x20, 0x2b, 0xe2, 0xd2, 0xf0, 0x30, 0xbc, 0x3f, 0x24,
+	0x5f, 0x4e, 0x8c, 0x0b, 0x31, 0xcc, 0x2f, 0xc9, 0x0f, 0x0a, 0x4c, 0x34, 0x3b, 0xdb, 0x71, 0x9c,
+	0x6e, 0xc8, 0x27, 0xcf, 0x61, 0xf5, 0x51, 0x0f, 0x48, 0x24, 0xbf, 0x22, 0xc8, 0xdf, 0x25, 0x5a,
+	0x67, 0xf2, 0x8d, 0x74, 0xc7, 0x86, 0x0b, 0xd9, 0xea, 0x2a, 0x6d, 0x89, 0x53, 0x51, 0x7d, 0xdc,
+	0x13, 0x16, 0x79, 0x3f, 0x10, 0xbc, 0x17, 0xc9, 0xdd, 0x44, 0xde, 0xb1, 0xbb, 0x1a, 0xf9, 0x4d,
+	0x81, 0xd7, 0x52, 0x26, 0x1b, 0xd9, 0xee, 0x8a, 0x46, 0x0a, 0x5a, 0xdd, 0xef, 0x07, 0x1d, 0xa9,
+	0x79, 0x53, 0xa8, 0x59, 0x27, 0x46, 0xa2, 0x1a, 0x8b, 0xfa, 0x25, 0x5f, 0xc2, 0x4b, 0x35, 0xce,
+	0x1d, 0xb9, 0xb7, 0xc8, 0x3f, 0x09, 0xc2, 0xe4, 0x54, 0xe8, 0x4d, 0x18, 0xa2, 0xd5, 0xfd, 0x7e,
+	0xd0, 0x91, 0xb0, 0x5d, 0x21, 0x6c, 0x9b, 0x6c, 0x75, 0x2b, 0x0c, 0xa7, 0x93, 0x71, 0x21, 0x8f,
+	0xb0, 0x4b, 0x72, 0xa5, 0x80, 0x9a, 0x12, 0xa7, 0xb1, 0x6d, 0xb6, 0xfb, 0x99, 0xb2, 0xea, 0x7e,
+	0x3f, 0xe8, 0x48, 0xe6, 0x3b, 0x42, 0xe6, 0x16, 0xd9, 0x6c, 0x96, 0xf9, 0xf2, 0x2f, 0x44, 0xba,
+	0x5e, 0xf2, 0x9d, 0x02, 0x39, 0x39, 0x57, 0xc9, 0x7a, 0x67, 0x52, 0xb1, 0xa9, 0xad, 0x16, 0x5e,
+	0x05, 0x82, 0xac, 0xd7, 0x04, 0xeb, 0x15, 0xb2, 0x9c, 0x58, 0x9c, 0x68, 0xa2, 0x1b, 0x17, 0xd8,
+	0x6d, 0x97, 0xe4, 0x17, 0x05, 0x26, 0xe3, 0xb3, 0xaa, 0xcb, 0x3e, 0x4b, 0x99, 0xa6, 0xea, 0x5b,
+	0x3d, 0xa2, 0x51, 0xc3, 0xa6, 0xd0, 0x50, 0x20, 0x6b, 0x89, 0x1a, 0x5e, 0xfa, 0xd1, 0x6b, 0xd2,
+	0xf2, 0xb3, 0x02, 0xff, 0x8f, 0xbb, 0xed, 0xbe, 0x9f, 0xfa, 0x90, 0xd3, 0xe6, 0xde, 0xa0, 0xe9,
+	0x42, 0xce, 0x32, 0x59, 0xec, 0x4e, 0x0e, 0xf9, 0x56, 0x81, 0x9c, 0x1c, 0x9a, 0xdd, 0xb4, 0x4d,
+	0xec, 0xfe, 0xa0, 0x16, 0x5e, 0x05, 0x82, 0x1c, 0xef, 0x09, 0x8e, 0xf3, 0x64, 0x2e, 0xf9, 0xe8,
+	0x45, 0xf3, 0xdd, 0x77, 0x9f, 0x5f, 0xe5, 0x95, 0x17, 0x57, 0x79, 0xe5, 0xef, 0xab, 0xbc, 0xf2,
+	0xf5, 0x75, 0x7e, 0xe0, 0xc5, 0x75, 0x7e, 0xe0, 0xf7, 0xeb, 0xfc, 0xc0, 0xe7, 0xab, 0x96, 0x1d,
+	0x9c, 0xd4, 0xcb, 0xba, 0xc9, 0xab, 0xcd, 0x2e, 0x5c, 0x5e, 0x61, 0xc6, 0xb3, 0x1b, 0x4f, 0xc1,
+	0x79, 0x8d, 0xf9, 0xe5, 0x11, 0xf1, 0xcf, 0xbc, 0xf1, 0xdf, 0x00, 0xa1, 0x1c, 0xf7, 0x68, 0x74,
+	0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -807,6 +1134,13 @@ type QueryClient interface {
 	GasStabilityPoolBalanceAll(ctx context.Context, in *QueryAllGasStabilityPoolBalance, opts ...grpc.CallOption) (*QueryAllGasStabilityPoolBalanceResponse, error)
 	// Code hash query the code hash of a contract.
 	CodeHash(ctx context.Context, in *QueryCodeHashRequest, opts ...grpc.CallOption) (*QueryCodeHashResponse, error)
+	// Queries the on-chain configuration of a stateful precompiled contract.
+	PrecompileConfig(ctx context.Context, in *QueryGetPrecompileConfigRequest, opts ...grpc.CallOption) (*QueryGetPrecompileConfigResponse, error)
+	// Queries all the on-chain configurations of stateful precompiled contracts.
+	PrecompileConfigAll(ctx context.Context, in *QueryAllPrecompileConfigRequest, opts ...grpc.CallOption) (*QueryAllPrecompileConfigResponse, error)
+	// Simulates calls on top of the zEVM state with optional state and block
+	// overrides, the state changes are never committed.
+	Simulate(ctx context.Context, in *QuerySimulateRequest, opts ...grpc.CallOption) (*QuerySimulateResponse, error)
 }
 
 type queryClient struct {
@@ -880,6 +1214,33 @@ func (c *queryClient) CodeHash(ctx context.Context, in *QueryCodeHashRequest, op
 	return out, nil
 }
 
+func (c *queryClient) PrecompileConfig(ctx context.Context, in *QueryGetPrecompileConfigRequest, opts ...grpc.CallOption) (*QueryGetPrecompileConfigResponse, error) {
+	out := new(QueryGetPrecompileConfigResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Query/PrecompileConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrecompileConfigAll(ctx context.Context, in *QueryAllPrecompileConfigRequest, opts ...grpc.CallOption) (*QueryAllPrecompileConfigResponse, error) {
+	out := new(QueryAllPrecompileConfigResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Query/PrecompileConfigAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Simulate(ctx context.Context, in *QuerySimulateRequest, opts ...grpc.CallOption) (*QuerySimulateResponse, error) {
+	out := new(QuerySimulateResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Query/Simulate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries a ForeignCoins by index.
@@ -896,6 +1257,13 @@ type QueryServer interface {
 	GasStabilityPoolBalanceAll(context.Context, *QueryAllGasStabilityPoolBalance) (*QueryAllGasStabilityPoolBalanceResponse, error)
 	// Code hash query the code hash of a contract.
 	CodeHash(context.Context, *QueryCodeHashRequest) (*QueryCodeHashResponse, error)
+	// Queries the on-chain configuration of a stateful precompiled contract.
+	PrecompileConfig(context.Context, *QueryGetPrecompileConfigRequest) (*QueryGetPrecompileConfigResponse, error)
+	// Queries all the on-chain configurations of stateful precompiled contracts.
+	PrecompileConfigAll(context.Context, *QueryAllPrecompileConfigRequest) (*QueryAllPrecompileConfigResponse, error)
+	// Simulates calls on top of the zEVM state with optional state and block
+	// overrides, the state changes are never committed.
+	Simulate(context.Context, *QuerySimulateRequest) (*QuerySimulateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -923,6 +1291,15 @@ func (*UnimplementedQueryServer) GasStabilityPoolBalanceAll(ctx context.Context,
 func (*UnimplementedQueryServer) CodeHash(ctx context.Context, req *QueryCodeHashRequest) (*QueryCodeHashResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CodeHash not implemented")
 }
+func (*UnimplementedQueryServer) PrecompileConfig(ctx context.Context, req *QueryGetPrecompileConfigRequest) (*QueryGetPrecompileConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrecompileConfig not implemented")
+}
+func (*UnimplementedQueryServer) PrecompileConfigAll(ctx context.Context, req *QueryAllPrecompileConfigRequest) (*QueryAllPrecompileConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrecompileConfigAll not implemented")
+}
+func (*UnimplementedQueryServer) Simulate(ctx context.Context, req *QuerySimulateRequest) (*QuerySimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1054,6 +1431,60 @@ func _Query_CodeHash_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PrecompileConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPrecompileConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrecompileConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.fungible.Query/PrecompileConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrecompileConfig(ctx, req.(*QueryGetPrecompileConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrecompileConfigAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPrecompileConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrecompileConfigAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.fungible.Query/PrecompileConfigAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrecompileConfigAll(ctx, req.(*QueryAllPrecompileConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.fungible.Query/Simulate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Simulate(ctx, req.(*QuerySimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.fungible.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1086,6 +1517,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CodeHash",
 			Handler:    _Query_CodeHash_Handler,
 		},
+		{
+			MethodName: "PrecompileConfig",
+			Handler:    _Query_PrecompileConfig_Handler,
+		},
+		{
+			MethodName: "PrecompileConfigAll",
+			Handler:    _Query_PrecompileConfigAll_Handler,
+		},
+		{
+			MethodName: "Simulate",
+			Handler:    _Query_Simulate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/fungible/query.proto",
@@ -1567,16 +2010,228 @@ func (m *QueryCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetPrecompileConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPrecompileConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPrecompileConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPrecompileConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPrecompileConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPrecompileConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPrecompileConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPrecompileConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPrecompileConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPrecompileConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPrecompileConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPrecompileConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for iNdEx := len(m.Configs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Configs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlockTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryGetForeignCoinsRequest) Size() (n int) {
 	if m == nil {
@@ -1771,6 +2426,97 @@ func (m *QueryCodeHashResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetPrecompileConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPrecompileConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPrecompileConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllPrecompileConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Configs) > 0 {
+		for _, e := range m.Configs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTime))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2995,6 +3741,596 @@ func (m *QueryCodeHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetPrecompileConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPrecompileConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPrecompileConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPrecompileConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPrecompileConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPrecompileConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPrecompileConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPrecompileConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPrecompileConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPrecompileConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPrecompileConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPrecompileConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Configs = append(m.Configs, PrecompileConfig{})
+			if err := m.Configs[len(m.Configs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	evmtypes "github.com/zeta-chain/ethermint/x/evm/types"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks of a simulation
+	MaxSimulateBlocks = 256
+
+	// MaxSimulateCalls is the maximum number of calls of a simulation
+	MaxSimulateCalls = 1000
+
+	// MaxSimulateGas is the maximum gas used by all the calls of a simulation,
+	// the gas limit of the simulated blocks is capped to it
+	MaxSimulateGas = 50_000_000
+)
+
+// SimulateArgs are the arguments of the Simulate query
+type SimulateArgs struct {
+	// Blocks are simulated one after the other on top of the state of the queried block
+	Blocks []SimulateBlock `json:"blocks"`
+
+	// Validation checks the nonce and the fees of the calls, and keeps the base fee in the simulated blocks
+	Validation bool `json:"validation"`
+
+	// InBaseBlock simulates the single block in the queried block rather than in a new block, like eth_call
+	InBaseBlock bool `json:"inBaseBlock"`
+
+	// TraceConfig traces the single call with the configured tracer, the struct logger if no tracer is set
+	TraceConfig *evmtypes.TraceConfig `json:"traceConfig,omitempty"`
+
+	// AccessList creates the access list of the single call
+	AccessList bool `json:"accessList"`
+}
+
+// SimulateBlock is a block of calls simulated sequentially
+type SimulateBlock struct {
+	BlockOverrides *BlockOverrides            `json:"blockOverrides"`
+	StateOverrides *StateOverride             `json:"stateOverrides"`
+	Calls          []evmtypes.TransactionArgs `json:"calls"`
+}
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// BlockOverrides is the set of header fields to override in a block during the execution of
+// a message call.
+type BlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+// SimulateBlockResult is the result of a simulated block.
+type SimulateBlockResult struct {
+	Number        *hexutil.Big         `json:"number"`
+	Hash          common.Hash          `json:"hash"`
+	ParentHash    common.Hash          `json:"parentHash"`
+	Timestamp     hexutil.Uint64       `json:"timestamp"`
+	GasLimit      hexutil.Uint64       `json:"gasLimit"`
+	GasUsed       hexutil.Uint64       `json:"gasUsed"`
+	Miner         common.Address       `json:"miner"`
+	BaseFeePerGas *hexutil.Big         `json:"baseFeePerGas"`
+	Calls         []SimulateCallResult `json:"calls"`
+}
+
+// SimulateCallResult is the result of a simulated call.
+type SimulateCallResult struct {
+	ReturnValue hexutil.Bytes        `json:"returnData"`
+	Logs        []*ethtypes.Log      `json:"logs"`
+	GasUsed     hexutil.Uint64       `json:"gasUsed"`
+	Status      hexutil.Uint64       `json:"status"`
+	Error       *SimulateCallError   `json:"error,omitempty"`
+	Trace       json.RawMessage      `json:"trace,omitempty"`
+	AccessList  *ethtypes.AccessList `json:"accessList,omitempty"`
+}
+
+// SimulateCallError is the error of a failed simulated call.
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// Validate checks the number of blocks and calls of the simulation
+func (args SimulateArgs) Validate() error {
+	numCalls := 0
+	for _, block := range args.Blocks {
+		numCalls += len(block.Calls)
+		if block.BlockOverrides != nil && block.BlockOverrides.PrevRandao != nil {
+			return fmt.Errorf("prevRandao override is not supported")
+		}
+	}
+
+	switch {
+	case len(args.Blocks) == 0:
+		return fmt.Errorf("empty input")
+	case len(args.Blocks) > MaxSimulateBlocks:
+		return fmt.Errorf("too many blocks: %d > %d", len(args.Blocks), MaxSimulateBlocks)
+	case numCalls > MaxSimulateCalls:
+		return fmt.Errorf("too many calls: %d > %d", numCalls, MaxSimulateCalls)
+	case args.InBaseBlock && len(args.Blocks) != 1:
+		return fmt.Errorf("a single block can be simulated in the base block, got %d", len(args.Blocks))
+	case (args.TraceConfig != nil || args.AccessList) && numCalls != 1:
+		return fmt.Errorf("a single call can be traced, got %d", numCalls)
+	case args.TraceConfig != nil && args.TraceConfig.Limit < 0:
+		return fmt.Errorf("output limit cannot be negative, got %d", args.TraceConfig.Limit)
+	}
+
+	return nil
+}
@@ -1009,6 +1009,94 @@ func (m *MsgUpdateGatewayContractResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGatewayContractResponse proto.InternalMessageInfo
 
+type MsgUpdatePrecompileConfig struct {
+	Creator string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Config  PrecompileConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdatePrecompileConfig) Reset()         { *m = MsgUpdatePrecompileConfig{} }
+func (m *MsgUpdatePrecompileConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrecompileConfig) ProtoMessage()    {}
+func (*MsgUpdatePrecompileConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bea9688d1d01113, []int{20}
+}
+func (m *MsgUpdatePrecompileConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrecompileConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrecompileConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrecompileConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrecompileConfig.Merge(m, src)
+}
+func (m *MsgUpdatePrecompileConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrecompileConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrecompileConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePrecompileConfig proto.InternalMessageInfo
+
+func (m *MsgUpdatePrecompileConfig) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdatePrecompileConfig) GetConfig() PrecompileConfig {
+	if m != nil {
+		return m.Config
+	}
+	return PrecompileConfig{}
+}
+
+type MsgUpdatePrecompileConfigResponse struct {
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) Reset()         { *m = MsgUpdatePrecompileConfigResponse{} }
+func (m *MsgUpdatePrecompileConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrecompileConfigResponse) ProtoMessage()    {}
+func (*MsgUpdatePrecompileConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7bea9688d1d01113, []int{21}
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrecompileConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrecompileConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrecompileConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePrecompileConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgDeploySystemContracts)(nil), "zetachain.zetacore.fungible.MsgDeploySystemContracts")
 	proto.RegisterType((*MsgDeploySystemContractsResponse)(nil), "zetachain.zetacore.fungible.MsgDeploySystemContractsResponse")
@@ -1030,6 +1118,8 @@ func init() {
 	proto.RegisterType((*MsgUnpauseZRC20Response)(nil), "zetachain.zetacore.fungible.MsgUnpauseZRC20Response")
 	proto.RegisterType((*MsgUpdateGatewayContract)(nil), "zetachain.zetacore.fungible.MsgUpdateGatewayContract")
 	proto.RegisterType((*MsgUpdateGatewayContractResponse)(nil), "zetachain.zetacore.fungible.MsgUpdateGatewayContractResponse")
+	proto.RegisterType((*MsgUpdatePrecompileConfig)(nil), "zetachain.zetacore.fungible.MsgUpdatePrecompileConfig")
+	proto.RegisterType((*MsgUpdatePrecompileConfigResponse)(nil), "zetachain.zetacore.fungible.MsgUpdatePrecompileConfigResponse")
 }
 
 func init() {
@@ -1037,73 +1127,77 @@ func init() {
 }
 
 var fileDescriptor_7bea9688d1d01113 = []byte{
-	// 1052 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
-	0x17, 0x35, 0xed, 0xf8, 0xef, 0x7e, 0x96, 0xed, 0x6f, 0xa0, 0xc4, 0x34, 0x1d, 0xc8, 0x09, 0xe3,
-	0x26, 0x6e, 0xda, 0x48, 0xa9, 0xea, 0x34, 0x28, 0xd0, 0x24, 0xa8, 0x55, 0x3b, 0x2d, 0x10, 0x01,
-	0x05, 0x63, 0xa7, 0xa8, 0x37, 0xc4, 0x98, 0x1c, 0x53, 0x84, 0xa5, 0x19, 0x96, 0x43, 0x55, 0x51,
-	0x76, 0x05, 0xba, 0x0a, 0x50, 0x20, 0x40, 0x1f, 0xa0, 0xcf, 0xd0, 0xb7, 0xc8, 0x32, 0xcb, 0xa2,
-	0x28, 0x82, 0xc2, 0xde, 0xf5, 0x29, 0x8a, 0x19, 0xfe, 0x84, 0x94, 0x44, 0x49, 0x56, 0xba, 0xb1,
-	0x39, 0xa3, 0x7b, 0xce, 0x9c, 0xb9, 0x73, 0xef, 0x19, 0x12, 0xb6, 0x5e, 0x90, 0x00, 0x5b, 0x0d,
-	0xec, 0xd2, 0x8a, 0x7c, 0x62, 0x3e, 0xa9, 0x9c, 0xb4, 0xa9, 0xe3, 0x1e, 0x37, 0x49, 0x25, 0x78,
-	0x5e, 0xf6, 0x7c, 0x16, 0x30, 0xb4, 0x91, 0x44, 0x95, 0xe3, 0xa8, 0x72, 0x1c, 0xa5, 0x15, 0x1d,
-	0xe6, 0x30, 0x19, 0x57, 0x11, 0x4f, 0x21, 0x44, 0xbb, 0x39, 0x80, 0xd8, 0x3b, 0x75, 0x2a, 0x16,
-	0x73, 0xa9, 0xfc, 0x13, 0xc6, 0xe9, 0x3b, 0xa0, 0xd6, 0xb9, 0xf3, 0x15, 0xf1, 0x9a, 0xac, 0xfb,
-	0xb4, 0xcb, 0x03, 0xd2, 0xaa, 0x31, 0x1a, 0xf8, 0xd8, 0x0a, 0x38, 0x52, 0x61, 0xde, 0xf2, 0x09,
-	0x0e, 0x98, 0xaf, 0x2a, 0xd7, 0x94, 0xed, 0x45, 0x23, 0x1e, 0xea, 0x7f, 0x29, 0x70, 0x2d, 0x0f,
-	0x66, 0x10, 0xee, 0x31, 0xca, 0x09, 0xba, 0x0d, 0xab, 0x6d, 0xea, 0xf2, 0x0e, 0xf6, 0x9e, 0x55,
-	0xf7, 0xb1, 0x15, 0x30, 0xbf, 0x1b, 0xf1, 0xf4, 0xcd, 0xa3, 0x22, 0xcc, 0x76, 0x84, 0x4e, 0x75,
-	0x5a, 0x06, 0x84, 0x03, 0xb4, 0x0d, 0x2b, 0x49, 0xa4, 0xc1, 0xda, 0x01, 0xf1, 0xd5, 0x19, 0xf9,
-	0x7b, 0xef, 0x34, 0xda, 0x82, 0x82, 0xc5, 0x28, 0x25, 0x82, 0xed, 0x68, 0xef, 0x59, 0x5d, 0xbd,
-	0x24, 0xe3, 0xb2, 0x93, 0xe8, 0x26, 0x2c, 0xf3, 0x8c, 0x58, 0x75, 0x56, 0x86, 0xf5, 0xcc, 0xea,
-	0x2f, 0xa7, 0x61, 0xbd, 0xce, 0x9d, 0x43, 0xcf, 0xc6, 0x01, 0x39, 0x32, 0x6a, 0xd5, 0xbb, 0xdf,
-	0xb9, 0x41, 0xc3, 0xf6, 0x71, 0x67, 0x9f, 0x90, 0xfc, 0xb4, 0xa0, 0x1b, 0x50, 0x78, 0xe1, 0x5b,
-	0xd5, 0xbb, 0x26, 0xb6, 0x6d, 0x9f, 0x70, 0x1e, 0xed, 0x66, 0x49, 0x4e, 0x7e, 0x19, 0xce, 0xa1,
-	0xef, 0x61, 0x95, 0x92, 0x8e, 0xd9, 0x89, 0x18, 0xcd, 0x13, 0x42, 0xd4, 0x39, 0x11, 0xb7, 0x5b,
-	0x79, 0xfd, 0x76, 0x73, 0xea, 0xcf, 0xb7, 0x9b, 0xb7, 0x1c, 0x37, 0x68, 0xb4, 0x8f, 0xcb, 0x16,
-	0x6b, 0x55, 0x2c, 0xc6, 0x5b, 0x8c, 0x47, 0xff, 0xee, 0x70, 0xfb, 0xb4, 0x12, 0x74, 0x3d, 0xc2,
-	0xcb, 0x87, 0x2e, 0x0d, 0x8c, 0x65, 0x4a, 0x3a, 0x69, 0x65, 0x4f, 0xa1, 0x20, 0xa8, 0x1d, 0xcc,
-	0xcd, 0xa6, 0xdb, 0x72, 0x03, 0x75, 0x7e, 0x32, 0xde, 0xff, 0x51, 0xd2, 0x79, 0x8c, 0xf9, 0x13,
-	0xc1, 0xa1, 0xdf, 0x80, 0xeb, 0xb9, 0xb9, 0x88, 0xcf, 0x5a, 0xf7, 0x61, 0x2d, 0x09, 0xca, 0xd6,
-	0xc3, 0x90, 0x74, 0x3d, 0x80, 0x0d, 0x21, 0x37, 0x4c, 0xbe, 0x69, 0x45, 0x80, 0x9e, 0xe4, 0xa9,
-	0x94, 0x74, 0xb2, 0x8c, 0x51, 0x22, 0xf5, 0xeb, 0xb0, 0x99, 0xb3, 0x66, 0x22, 0xeb, 0xb7, 0x69,
-	0xd0, 0x92, 0x3a, 0xdd, 0x8f, 0x3a, 0xa6, 0xc6, 0x5c, 0x2a, 0x37, 0x32, 0x44, 0x5a, 0x11, 0x66,
-	0xf7, 0x44, 0x48, 0x5c, 0x8f, 0x72, 0x80, 0xb6, 0x61, 0xf5, 0x84, 0xf9, 0xc4, 0x75, 0xa8, 0x29,
-	0x5b, 0xcb, 0x74, 0x6d, 0x59, 0x90, 0x33, 0xc6, 0x72, 0x34, 0x5f, 0x13, 0xd3, 0xdf, 0xd8, 0x48,
-	0x83, 0x05, 0x9b, 0x58, 0x6e, 0x0b, 0x37, 0xb9, 0x2c, 0xc5, 0x82, 0x91, 0x8c, 0x11, 0x82, 0x4b,
-	0x14, 0xb7, 0x48, 0x54, 0x7b, 0xf2, 0x19, 0x5d, 0x81, 0x39, 0xde, 0x6d, 0x1d, 0xb3, 0x66, 0x58,
-	0x0a, 0x46, 0x34, 0x42, 0xbb, 0xb0, 0x28, 0x9a, 0xd5, 0x14, 0x87, 0x23, 0x4f, 0x73, 0xb9, 0xfa,
-	0x41, 0x79, 0x80, 0x1b, 0x78, 0xa7, 0x4e, 0x59, 0x76, 0xb5, 0xd8, 0xdc, 0x41, 0xd7, 0x23, 0xc6,
-	0x82, 0x15, 0x3d, 0xa1, 0x0d, 0x58, 0x7c, 0x57, 0x11, 0x0b, 0x52, 0xee, 0x82, 0x13, 0x9f, 0xee,
-	0x43, 0xd0, 0xf3, 0x13, 0x94, 0xb4, 0xb2, 0x0a, 0xf3, 0xf1, 0xa9, 0x44, 0x89, 0x8a, 0x86, 0xfa,
-	0x21, 0x14, 0xeb, 0xdc, 0x31, 0x48, 0x8b, 0xfd, 0x48, 0xf6, 0xa3, 0x1c, 0x30, 0x97, 0xbe, 0x67,
-	0x93, 0xe8, 0x25, 0xb8, 0x3a, 0x88, 0x36, 0x39, 0xd8, 0x9f, 0x95, 0x54, 0x87, 0xc6, 0xc7, 0xbe,
-	0xdb, 0x0d, 0x88, 0xc5, 0xec, 0x61, 0x1d, 0xfa, 0x21, 0xac, 0xe6, 0xd4, 0xd9, 0x8a, 0x95, 0x2d,
-	0x2f, 0xa4, 0x87, 0xcd, 0x24, 0x08, 0xcd, 0x06, 0xe6, 0x8d, 0xc8, 0x7a, 0x44, 0x6f, 0xd4, 0x98,
-	0x4d, 0xbe, 0xc6, 0xbc, 0x91, 0xe9, 0x8d, 0x5e, 0x15, 0x89, 0xd6, 0xdf, 0x15, 0x59, 0x84, 0xa9,
-	0x0e, 0x7a, 0xe2, 0xfe, 0xd0, 0x76, 0x6d, 0x37, 0xe8, 0xd6, 0xb0, 0xf7, 0xbe, 0x76, 0x72, 0x00,
-	0x85, 0x66, 0x4c, 0x67, 0x5a, 0xd8, 0x0b, 0x65, 0x5e, 0xbc, 0xe7, 0x97, 0x9a, 0x29, 0x51, 0xfa,
-	0x96, 0x2c, 0x8b, 0x1c, 0xc9, 0xc9, 0xce, 0x0c, 0x28, 0xd4, 0xb9, 0xf3, 0x2d, 0x6e, 0x73, 0x32,
-	0xaa, 0xa1, 0x6e, 0xc1, 0x4a, 0x66, 0x2f, 0x44, 0xec, 0x66, 0x46, 0x78, 0x6f, 0x7a, 0x37, 0x84,
-	0xeb, 0x6b, 0x70, 0x39, 0xc3, 0x99, 0x2c, 0x76, 0x00, 0x2b, 0x42, 0x12, 0xf5, 0xfe, 0xd3, 0xe5,
-	0xd6, 0x43, 0xe3, 0x4a, 0xb1, 0x26, 0x0b, 0xb6, 0xe5, 0xd5, 0x18, 0xe6, 0xe0, 0x31, 0x0e, 0x48,
-	0x07, 0x77, 0xc7, 0x30, 0xb5, 0x47, 0x70, 0x35, 0xf4, 0x60, 0x09, 0xc8, 0x73, 0xb5, 0x75, 0xe9,
-	0xb0, 0x19, 0xce, 0xb8, 0xf4, 0x75, 0x79, 0xb5, 0x0e, 0x5c, 0x36, 0x96, 0x56, 0xfd, 0x07, 0x60,
-	0xa6, 0xce, 0x1d, 0xf4, 0x8b, 0x02, 0x97, 0x07, 0xdf, 0xdd, 0xf7, 0xca, 0x43, 0xde, 0x19, 0xca,
-	0x79, 0x77, 0xb7, 0xf6, 0x60, 0x22, 0x58, 0xe2, 0x13, 0xbf, 0x2a, 0xb0, 0x96, 0x67, 0xb6, 0xf7,
-	0xc7, 0xa3, 0xee, 0x03, 0x6a, 0x8f, 0x26, 0x04, 0x26, 0xaa, 0x7e, 0x52, 0xe0, 0xff, 0xfd, 0x0e,
-	0xf5, 0xc9, 0x28, 0xda, 0x3e, 0x88, 0xf6, 0xf9, 0x85, 0x21, 0x89, 0x86, 0x97, 0x0a, 0x14, 0x07,
-	0x5e, 0x8f, 0x3b, 0xa3, 0x38, 0x07, 0xa1, 0xb4, 0x2f, 0x26, 0x41, 0x25, 0x62, 0x5e, 0x29, 0x70,
-	0x25, 0xc7, 0x3a, 0x3f, 0x1b, 0x8f, 0xb8, 0x17, 0xa7, 0x3d, 0x9c, 0x0c, 0x37, 0x40, 0x52, 0xdf,
-	0xfb, 0xd6, 0x98, 0x92, 0x7a, 0x71, 0xe3, 0x4a, 0xca, 0x7b, 0xa7, 0x91, 0xc5, 0x9c, 0x67, 0xda,
-	0xf7, 0x2f, 0xc0, 0x9d, 0x06, 0x8e, 0x2e, 0xe6, 0x11, 0x9e, 0x8b, 0x9a, 0x00, 0x29, 0xc3, 0xbd,
-	0x3d, 0x8a, 0xee, 0x5d, 0xac, 0x56, 0x1d, 0x3f, 0x36, 0x59, 0xcd, 0x87, 0xa5, 0x8c, 0xe3, 0x7e,
-	0x3c, 0x52, 0x7e, 0x2a, 0x5a, 0xdb, 0xb9, 0x48, 0x74, 0xb2, 0xa6, 0x30, 0xb5, 0xc1, 0xae, 0x7b,
-	0x6f, 0xbc, 0xe4, 0xf5, 0xc0, 0x46, 0x9b, 0xda, 0x50, 0xb3, 0xdd, 0xdd, 0x7b, 0x7d, 0x56, 0x52,
-	0xde, 0x9c, 0x95, 0x94, 0xbf, 0xcf, 0x4a, 0xca, 0xab, 0xf3, 0xd2, 0xd4, 0x9b, 0xf3, 0xd2, 0xd4,
-	0x1f, 0xe7, 0xa5, 0xa9, 0xa3, 0x8f, 0x52, 0x97, 0xab, 0x20, 0xbe, 0x13, 0x7e, 0x70, 0x51, 0x66,
-	0x93, 0xca, 0xf3, 0xd4, 0x77, 0x9c, 0xb8, 0x65, 0x8f, 0xe7, 0xe4, 0x07, 0xd7, 0xa7, 0xff, 0x06,
-	0x00, 0x00, 0xff, 0xff, 0x17, 0x9a, 0xd9, 0x4c, 0xf3, 0x0d, 0x00, 0x00,
+	// 1116 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x6f, 0x4f, 0xdb, 0x46,
+	0x18, 0xc7, 0xfc, 0xe7, 0x81, 0x00, 0xb3, 0x68, 0x31, 0xa6, 0x0a, 0xd4, 0x65, 0x2d, 0xeb, 0x46,
+	0xd2, 0xa5, 0x74, 0xd5, 0xa4, 0x01, 0x1a, 0x19, 0x74, 0xd3, 0x1a, 0xa9, 0x72, 0xa1, 0xd3, 0x78,
+	0x63, 0x19, 0xfb, 0x70, 0x2c, 0x92, 0x3b, 0xcf, 0xe7, 0x2c, 0x4d, 0xdf, 0x6d, 0xda, 0xab, 0x4a,
+	0x93, 0x2a, 0xed, 0x03, 0xec, 0x33, 0xec, 0x5b, 0xf4, 0x65, 0x5f, 0x4e, 0xd3, 0x54, 0x4d, 0xf0,
+	0x39, 0x26, 0x4d, 0x77, 0xb1, 0xaf, 0xb1, 0x13, 0x27, 0xc1, 0xdd, 0x1b, 0xb8, 0xbb, 0x3c, 0xbf,
+	0xdf, 0xfd, 0xee, 0xb9, 0xe7, 0x79, 0xee, 0x31, 0x6c, 0xbc, 0x40, 0x81, 0x69, 0x55, 0x4d, 0x17,
+	0x17, 0xf9, 0x88, 0xf8, 0xa8, 0x78, 0xd6, 0xc0, 0x8e, 0x7b, 0x5a, 0x43, 0xc5, 0xe0, 0x79, 0xc1,
+	0xf3, 0x49, 0x40, 0xe4, 0x55, 0x61, 0x55, 0x88, 0xac, 0x0a, 0x91, 0x95, 0xba, 0xe4, 0x10, 0x87,
+	0x70, 0xbb, 0x22, 0x1b, 0xb5, 0x21, 0xea, 0xfd, 0x7e, 0xc4, 0x9e, 0x8f, 0x2c, 0x52, 0xf7, 0xdc,
+	0x1a, 0x32, 0x2c, 0x82, 0xcf, 0x5c, 0x27, 0x04, 0xdd, 0xee, 0x01, 0xf2, 0xce, 0x9d, 0xa2, 0x45,
+	0x5c, 0xcc, 0xff, 0xb4, 0xed, 0xb4, 0x6d, 0x50, 0x2a, 0xd4, 0xf9, 0x0a, 0x79, 0x35, 0xd2, 0x7a,
+	0xda, 0xa2, 0x01, 0xaa, 0x97, 0x09, 0x0e, 0x7c, 0xd3, 0x0a, 0xa8, 0xac, 0xc0, 0x94, 0xe5, 0x23,
+	0x33, 0x20, 0xbe, 0x22, 0xad, 0x4b, 0x9b, 0x33, 0x7a, 0x34, 0xd5, 0xfe, 0x96, 0x60, 0x3d, 0x0d,
+	0xa6, 0x23, 0xea, 0x11, 0x4c, 0x91, 0x7c, 0x17, 0x16, 0x1b, 0xd8, 0xa5, 0x4d, 0xd3, 0x7b, 0x56,
+	0x3a, 0x34, 0xad, 0x80, 0xf8, 0xad, 0x90, 0xa7, 0x6b, 0x5d, 0x5e, 0x82, 0x89, 0x26, 0xd3, 0xa9,
+	0x8c, 0x72, 0x83, 0xf6, 0x44, 0xde, 0x84, 0x05, 0x61, 0xa9, 0x93, 0x46, 0x80, 0x7c, 0x65, 0x8c,
+	0xff, 0x9e, 0x5c, 0x96, 0x37, 0x20, 0x67, 0x11, 0x8c, 0x11, 0x63, 0x3b, 0x39, 0x78, 0x56, 0x51,
+	0xc6, 0xb9, 0x5d, 0x7c, 0x51, 0xbe, 0x0d, 0xf3, 0x34, 0x26, 0x56, 0x99, 0xe0, 0x66, 0x89, 0x55,
+	0xed, 0xe5, 0x28, 0xac, 0x54, 0xa8, 0x73, 0xec, 0xd9, 0x66, 0x80, 0x4e, 0xf4, 0x72, 0xe9, 0xde,
+	0x77, 0x6e, 0x50, 0xb5, 0x7d, 0xb3, 0x79, 0x88, 0x50, 0xba, 0x5b, 0xe4, 0x5b, 0x90, 0x7b, 0xe1,
+	0x5b, 0xa5, 0x7b, 0x86, 0x69, 0xdb, 0x3e, 0xa2, 0x34, 0x3c, 0xcd, 0x1c, 0x5f, 0xfc, 0xb2, 0xbd,
+	0x26, 0x7f, 0x0f, 0x8b, 0x18, 0x35, 0x8d, 0x66, 0xc8, 0x68, 0x9c, 0x21, 0xa4, 0x4c, 0x32, 0xbb,
+	0xfd, 0xe2, 0xeb, 0xb7, 0x6b, 0x23, 0x7f, 0xbd, 0x5d, 0xbb, 0xe3, 0xb8, 0x41, 0xb5, 0x71, 0x5a,
+	0xb0, 0x48, 0xbd, 0x68, 0x11, 0x5a, 0x27, 0x34, 0xfc, 0xb7, 0x45, 0xed, 0xf3, 0x62, 0xd0, 0xf2,
+	0x10, 0x2d, 0x1c, 0xbb, 0x38, 0xd0, 0xe7, 0x31, 0x6a, 0x76, 0x2a, 0x7b, 0x0a, 0x39, 0x46, 0xed,
+	0x98, 0xd4, 0xa8, 0xb9, 0x75, 0x37, 0x50, 0xa6, 0xb2, 0xf1, 0xce, 0x62, 0xd4, 0x7c, 0x64, 0xd2,
+	0xc7, 0x8c, 0x43, 0xbb, 0x05, 0x37, 0x53, 0x7d, 0x11, 0xdd, 0xb5, 0xe6, 0xc3, 0xb2, 0x30, 0x8a,
+	0xc7, 0x43, 0x1f, 0x77, 0xed, 0xc0, 0x2a, 0x93, 0xdb, 0x76, 0xbe, 0x61, 0x85, 0x80, 0x84, 0xf3,
+	0x14, 0x8c, 0x9a, 0x71, 0xc6, 0xd0, 0x91, 0xda, 0x4d, 0x58, 0x4b, 0xd9, 0x53, 0xc8, 0xfa, 0x7d,
+	0x14, 0x54, 0x11, 0xa7, 0x87, 0x61, 0xce, 0x94, 0x89, 0x8b, 0xf9, 0x41, 0xfa, 0x48, 0x5b, 0x82,
+	0x89, 0x03, 0x66, 0x12, 0xc5, 0x23, 0x9f, 0xc8, 0x9b, 0xb0, 0x78, 0x46, 0x7c, 0xe4, 0x3a, 0xd8,
+	0xe0, 0xa9, 0x65, 0xb8, 0x36, 0x0f, 0xc8, 0x31, 0x7d, 0x3e, 0x5c, 0x2f, 0xb3, 0xe5, 0x6f, 0x6c,
+	0x59, 0x85, 0x69, 0x1b, 0x59, 0x6e, 0xdd, 0xac, 0x51, 0x1e, 0x8a, 0x39, 0x5d, 0xcc, 0x65, 0x19,
+	0xc6, 0xb1, 0x59, 0x47, 0x61, 0xec, 0xf1, 0xb1, 0x7c, 0x1d, 0x26, 0x69, 0xab, 0x7e, 0x4a, 0x6a,
+	0xed, 0x50, 0xd0, 0xc3, 0x99, 0xbc, 0x0f, 0x33, 0x2c, 0x59, 0x0d, 0x76, 0x39, 0xfc, 0x36, 0xe7,
+	0x4b, 0x1f, 0x16, 0x7a, 0x94, 0x10, 0xef, 0xdc, 0x29, 0xf0, 0xac, 0x66, 0x87, 0x3b, 0x6a, 0x79,
+	0x48, 0x9f, 0xb6, 0xc2, 0x91, 0xbc, 0x0a, 0x33, 0xef, 0x22, 0x62, 0x9a, 0xcb, 0x9d, 0x76, 0xa2,
+	0xdb, 0xdd, 0x05, 0x2d, 0xdd, 0x41, 0x22, 0x95, 0x15, 0x98, 0x8a, 0x6e, 0x25, 0x74, 0x54, 0x38,
+	0xd5, 0x8e, 0x61, 0xa9, 0x42, 0x1d, 0x1d, 0xd5, 0xc9, 0x8f, 0xe8, 0x30, 0xf4, 0x01, 0x71, 0xf1,
+	0x7b, 0x26, 0x89, 0x96, 0x87, 0x1b, 0xbd, 0x68, 0xc5, 0xc5, 0xfe, 0x22, 0x75, 0x64, 0x68, 0x74,
+	0xed, 0xfb, 0xad, 0x00, 0x59, 0xc4, 0xee, 0x97, 0xa1, 0x1f, 0xc1, 0x62, 0x4a, 0x9c, 0x2d, 0x58,
+	0xf1, 0xf0, 0x92, 0xb5, 0x76, 0x32, 0x31, 0x42, 0xa3, 0x6a, 0xd2, 0x6a, 0x58, 0x7a, 0x58, 0x6e,
+	0x94, 0x89, 0x8d, 0xbe, 0x36, 0x69, 0x35, 0x96, 0x1b, 0x49, 0x15, 0x42, 0xeb, 0x1f, 0x12, 0xa8,
+	0xc2, 0x8a, 0xfb, 0xf5, 0xb1, 0xfb, 0x43, 0xc3, 0xb5, 0xdd, 0xa0, 0x55, 0x36, 0xbd, 0xf7, 0x2d,
+	0x27, 0x47, 0x90, 0xab, 0x45, 0x74, 0x86, 0x65, 0x7a, 0xca, 0x58, 0xb6, 0x9c, 0x9f, 0xab, 0x75,
+	0x88, 0xd2, 0x36, 0x40, 0x4b, 0x97, 0x2c, 0x4e, 0xa6, 0x43, 0xae, 0x42, 0x9d, 0x27, 0x66, 0x83,
+	0xa2, 0x41, 0x09, 0x75, 0x07, 0x16, 0x62, 0x67, 0x41, 0xec, 0x34, 0x63, 0xac, 0xf6, 0x76, 0x9e,
+	0x06, 0x51, 0x6d, 0x19, 0xae, 0xc5, 0x38, 0xc5, 0x66, 0x47, 0xb0, 0xc0, 0x24, 0x61, 0xef, 0x7f,
+	0xdd, 0x6e, 0x05, 0x96, 0x13, 0xac, 0x62, 0xc3, 0x06, 0x28, 0xc2, 0x07, 0x8f, 0xcc, 0x00, 0x35,
+	0xcd, 0xd6, 0x10, 0x45, 0x6d, 0x0f, 0x6e, 0xb4, 0x6b, 0x30, 0x07, 0xa4, 0x55, 0xb5, 0x15, 0x5e,
+	0x61, 0x63, 0x9c, 0x51, 0xe8, 0x6b, 0xb0, 0x9e, 0xb6, 0xad, 0x90, 0xf6, 0x73, 0x67, 0xf8, 0x3f,
+	0x11, 0x2d, 0x40, 0x99, 0x77, 0x00, 0x7d, 0xc4, 0x7d, 0x0b, 0x93, 0xed, 0x2e, 0x81, 0xcb, 0x98,
+	0x2d, 0x6d, 0x15, 0xfa, 0xb4, 0x23, 0x85, 0x24, 0xf1, 0xfe, 0x38, 0x0b, 0x2a, 0x3d, 0xa4, 0x88,
+	0x05, 0x7f, 0xd2, 0x34, 0x52, 0x5a, 0xfa, 0x77, 0x16, 0xc6, 0x2a, 0xd4, 0x91, 0x7f, 0x95, 0xe0,
+	0x5a, 0xef, 0x2e, 0xe3, 0x41, 0x5f, 0x0d, 0x69, 0x5d, 0x86, 0xba, 0x93, 0x09, 0x26, 0x2a, 0xda,
+	0x6f, 0x12, 0x2c, 0xa7, 0x3d, 0x0b, 0x0f, 0x87, 0xa3, 0xee, 0x02, 0xaa, 0x7b, 0x19, 0x81, 0x42,
+	0xd5, 0x4f, 0x12, 0x7c, 0xd0, 0x5d, 0x4b, 0x3f, 0x1d, 0x44, 0xdb, 0x05, 0x51, 0x3f, 0xbf, 0x32,
+	0x44, 0x68, 0x78, 0x29, 0xc1, 0x52, 0xcf, 0x87, 0x7c, 0x7b, 0x10, 0x67, 0x2f, 0x94, 0xfa, 0x45,
+	0x16, 0x94, 0x10, 0xf3, 0x4a, 0x82, 0xeb, 0x29, 0x45, 0xfe, 0xb3, 0xe1, 0x88, 0x93, 0x38, 0x75,
+	0x37, 0x1b, 0xae, 0x87, 0xa4, 0xae, 0xce, 0x70, 0x48, 0x49, 0x49, 0x9c, 0xba, 0x9b, 0x0d, 0x17,
+	0x0b, 0xe6, 0xb4, 0xe7, 0xe5, 0xe1, 0x15, 0xb8, 0x3b, 0x81, 0xea, 0x5e, 0x46, 0xa0, 0x50, 0x55,
+	0x03, 0xe8, 0x78, 0x1a, 0xee, 0x0e, 0xa2, 0x7b, 0x67, 0xab, 0x96, 0x86, 0xb7, 0x15, 0xbb, 0xf9,
+	0x30, 0x17, 0x7b, 0x1b, 0x3e, 0x19, 0x28, 0xbf, 0xc3, 0x5a, 0xdd, 0xbe, 0x8a, 0xb5, 0xd8, 0x93,
+	0x15, 0xb5, 0xde, 0xef, 0xc3, 0x83, 0xe1, 0x9c, 0x97, 0x80, 0xa9, 0x3b, 0x99, 0x60, 0x3d, 0x42,
+	0xb3, 0xeb, 0x4d, 0x18, 0x32, 0x34, 0x93, 0x38, 0x75, 0x37, 0x1b, 0x2e, 0x92, 0xb4, 0x7f, 0xf0,
+	0xfa, 0x22, 0x2f, 0xbd, 0xb9, 0xc8, 0x4b, 0xff, 0x5c, 0xe4, 0xa5, 0x57, 0x97, 0xf9, 0x91, 0x37,
+	0x97, 0xf9, 0x91, 0x3f, 0x2f, 0xf3, 0x23, 0x27, 0x1f, 0x77, 0x74, 0x26, 0x8c, 0x79, 0xab, 0xfd,
+	0xb5, 0x8a, 0x89, 0x8d, 0x8a, 0xcf, 0x3b, 0xbe, 0x9c, 0x59, 0x8b, 0x72, 0x3a, 0xc9, 0xbf, 0x56,
+	0xef, 0xff, 0x37, 0x00, 0x51, 0x8a, 0x0e, 0x9e, 0x65, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1128,6 +1222,7 @@ type MsgClient interface {
 	PauseZRC20(ctx context.Context, in *MsgPauseZRC20, opts ...grpc.CallOption) (*MsgPauseZRC20Response, error)
 	UnpauseZRC20(ctx context.Context, in *MsgUnpauseZRC20, opts ...grpc.CallOption) (*MsgUnpauseZRC20Response, error)
 	UpdateGatewayContract(ctx context.Context, in *MsgUpdateGatewayContract, opts ...grpc.CallOption) (*MsgUpdateGatewayContractResponse, error)
+	UpdatePrecompileConfig(ctx context.Context, in *MsgUpdatePrecompileConfig, opts ...grpc.CallOption) (*MsgUpdatePrecompileConfigResponse, error)
 }
 
 type msgClient struct {
@@ -1228,6 +1323,15 @@ func (c *msgClient) UpdateGatewayContract(ctx context.Context, in *MsgUpdateGate
 	return out, nil
 }
 
+func (c *msgClient) UpdatePrecompileConfig(ctx context.Context, in *MsgUpdatePrecompileConfig, opts ...grpc.CallOption) (*MsgUpdatePrecompileConfigResponse, error) {
+	out := new(MsgUpdatePrecompileConfigResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.fungible.Msg/UpdatePrecompileConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	DeploySystemContracts(context.Context, *MsgDeploySystemContracts) (*MsgDeploySystemContractsResponse, error)
@@ -1240,6 +1344,7 @@ type MsgServer interface {
 	PauseZRC20(context.Context, *MsgPauseZRC20) (*MsgPauseZRC20Response, error)
 	UnpauseZRC20(context.Context, *MsgUnpauseZRC20) (*MsgUnpauseZRC20Response, error)
 	UpdateGatewayContract(context.Context, *MsgUpdateGatewayContract) (*MsgUpdateGatewayContractResponse, error)
+	UpdatePrecompileConfig(context.Context, *MsgUpdatePrecompileConfig) (*MsgUpdatePrecompileConfigResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1276,6 +1381,9 @@ func (*UnimplementedMsgServer) UnpauseZRC20(ctx context.Context, req *MsgUnpause
 func (*UnimplementedMsgServer) UpdateGatewayContract(ctx context.Context, req *MsgUpdateGatewayContract) (*MsgUpdateGatewayContractResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGatewayContract not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePrecompileConfig(ctx context.Context, req *MsgUpdatePrecompileConfig) (*MsgUpdatePrecompileConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrecompileConfig not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1461,6 +1569,24 @@ func _Msg_UpdateGatewayContract_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePrecompileConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePrecompileConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePrecompileConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.fungible.Msg/UpdatePrecompileConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePrecompileConfig(ctx, req.(*MsgUpdatePrecompileConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.fungible.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1505,6 +1631,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGatewayContract",
 			Handler:    _Msg_UpdateGatewayContract_Handler,
 		},
+		{
+			MethodName: "UpdatePrecompileConfig",
+			Handler:    _Msg_UpdatePrecompileConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/fungible/tx.proto",
@@ -2220,6 +2350,69 @@ func (m *MsgUpdateGatewayContractResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdatePrecompileConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrecompileConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrecompileConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2545,6 +2738,30 @@ func (m *MsgUpdateGatewayContractResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdatePrecompileConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4625,6 +4842,171 @@ func (m *MsgUpdateGatewayContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdatePrecompileConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePrecompileConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePrecompileConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -96,4 +96,33 @@ func TestBeginBlocker(t *testing.T) {
 		require.Equal(t, uint64(observeSetLen), lastObserverCount.Count)
 		require.Equal(t, ctx.BlockHeight(), lastObserverCount.LastChangeHeight)
 	})
+	t.Run("should account missed votes of observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		os := sample.ObserverSet(2)
+		k.SetObserverSet(ctx, os)
+		k.SetLastObserverCount(ctx, &types.LastObserverCount{
+			Count: os.LenUint(),
+		})
+		params := sample.LivenessParams()
+		k.SetLivenessParams(ctx, params)
+
+		ballot := types.Ballot{
+			BallotIdentifier:     "ballot",
+			VoterList:            os.ObserverList,
+			Votes:                []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_NotYetVoted},
+			BallotStatus:         types.BallotStatus_BallotFinalized_SuccessObservation,
+			BallotCreationHeight: 1,
+		}
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+
+		ctx = ctx.WithBlockHeight(1 + params.GracePeriodBlocks)
+		observer.BeginBlocker(ctx, *k)
+
+		liveness, found := k.GetObserverLiveness(ctx, os.ObserverList[1])
+		require.True(t, found)
+		require.EqualValues(t, 1, liveness.IndexOffset)
+		require.EqualValues(t, 1, liveness.MissedVotesCounter)
+	})
 }
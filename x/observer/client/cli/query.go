@@ -43,6 +43,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListPendingNonces(),
 		CmdGetAllTssFundsMigrator(),
 		CmdGetTssFundsMigrator(),
+		CmdShowLivenessParams(),
+		CmdShowObserverLiveness(),
+		CmdListObserverLiveness(),
 	)
 
 	return cmd
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdShowLivenessParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-liveness-params",
+		Short: "shows the params used to track the missed votes of observers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetLivenessParamsRequest{}
+
+			res, err := queryClient.LivenessParams(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowObserverLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-observer-liveness [observer-address]",
+		Short: "shows the missed votes of an observer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetObserverLivenessRequest{
+				ObserverAddress: args[0],
+			}
+
+			res, err := queryClient.ObserverLiveness(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListObserverLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-observer-liveness",
+		Short: "lists the missed votes of all observers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllObserverLivenessRequest{}
+
+			res, err := queryClient.ObserverLivenessAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
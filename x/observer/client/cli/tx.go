@@ -32,6 +32,8 @@ func GetTxCmd() *cobra.Command {
 		CmdEnableCCTX(),
 		CmdDisableCCTX(),
 		CmdUpdateGasPriceIncreaseFlags(),
+		CmdUnjailObserver(),
+		CmdUpdateLivenessParams(),
 	)
 
 	return cmd
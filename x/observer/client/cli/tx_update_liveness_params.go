@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func CmdUpdateLivenessParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-liveness-params [enabled] [windowSize] [maxMissedRatio] [gracePeriodBlocks] [jailDurationBlocks]",
+		Short: "Update the params used to track the missed votes of observers",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+			windowSize, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			maxMissedRatio, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+			gracePeriodBlocks, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			jailDurationBlocks, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+			livenessParams := types.LivenessParams{
+				Enabled:            enabled,
+				WindowSize:         windowSize,
+				MaxMissedRatio:     maxMissedRatio,
+				GracePeriodBlocks:  gracePeriodBlocks,
+				JailDurationBlocks: jailDurationBlocks,
+			}
+			msg := types.NewMsgUpdateLivenessParams(clientCtx.GetFromAddress().String(), livenessParams)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -133,6 +133,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.NonceToCctx {
 		k.SetNonceToCctx(ctx, elem)
 	}
+
+	// Set if defined
+	if genState.LivenessParams != nil {
+		k.SetLivenessParams(ctx, *genState.LivenessParams)
+	} else {
+		k.SetLivenessParams(ctx, types.DefaultLivenessParams())
+	}
+
+	for _, elem := range genState.ObserverLiveness {
+		k.SetObserverLiveness(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the observer module's exported genesis.
@@ -188,6 +199,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		os = observers
 	}
 
+	livenessParams := k.GetLivenessParams(ctx)
+
 	return &types.GenesisState{
 		Ballots:           k.GetAllBallots(ctx),
 		ChainParamsList:   chainParams,
@@ -203,5 +216,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		BlameList:         k.GetAllBlame(ctx),
 		ChainNonces:       k.GetAllChainNonces(ctx),
 		NonceToCctx:       k.GetAllNonceToCctx(ctx),
+		LivenessParams:    &livenessParams,
+		ObserverLiveness:  k.GetAllObserverLiveness(ctx),
 	}
 }
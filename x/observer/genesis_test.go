@@ -15,6 +15,7 @@ import (
 func TestGenesis(t *testing.T) {
 	t.Run("genState fields defined", func(t *testing.T) {
 		tss := sample.Tss()
+		livenessParams := sample.LivenessParams()
 		genesisState := types.GenesisState{
 			Tss:       &tss,
 			BlameList: sample.BlameRecordsList(t, 10),
@@ -39,9 +40,14 @@ func TestGenesis(t *testing.T) {
 				sample.ChainNonces(1),
 				sample.ChainNonces(2),
 			},
-			PendingNonces: sample.PendingNoncesList(t, "sample", 20),
-			NonceToCctx:   sample.NonceToCctxList(t, "sample", 20),
-			TssHistory:    []types.TSS{sample.Tss()},
+			PendingNonces:  sample.PendingNoncesList(t, "sample", 20),
+			NonceToCctx:    sample.NonceToCctxList(t, "sample", 20),
+			TssHistory:     []types.TSS{sample.Tss()},
+			LivenessParams: &livenessParams,
+			ObserverLiveness: []types.ObserverLiveness{
+				sample.ObserverLiveness(sample.AccAddress()),
+				sample.ObserverLiveness(sample.AccAddress()),
+			},
 		}
 
 		// Init and export
@@ -80,6 +86,7 @@ func TestGenesis(t *testing.T) {
 				zetaPrivnetChainParams,
 			},
 		}
+		livenessParams := types.DefaultLivenessParams()
 		expectedGenesisState := types.GenesisState{
 			CrosschainFlags:   types.DefaultCrosschainFlags(),
 			ChainParamsList:   localnetChainParams,
@@ -87,6 +94,7 @@ func TestGenesis(t *testing.T) {
 			Keygen:            &types.Keygen{},
 			LastObserverCount: &types.LastObserverCount{},
 			NodeAccountList:   []*types.NodeAccount{},
+			LivenessParams:    &livenessParams,
 		}
 
 		require.Equal(t, expectedGenesisState, *got)
@@ -122,6 +130,7 @@ func TestGenesis(t *testing.T) {
 		pendingNonces, err := k.GetAllPendingNonces(ctx)
 		require.NoError(t, err)
 		require.NotEmpty(t, pendingNonces)
+		livenessParams := types.DefaultLivenessParams()
 		expectedGenesisState := types.GenesisState{
 			CrosschainFlags:   types.DefaultCrosschainFlags(),
 			ChainParamsList:   localnetChainParams,
@@ -130,6 +139,7 @@ func TestGenesis(t *testing.T) {
 			LastObserverCount: &types.LastObserverCount{},
 			NodeAccountList:   []*types.NodeAccount{},
 			PendingNonces:     pendingNonces,
+			LivenessParams:    &livenessParams,
 		}
 
 		require.Equal(t, expectedGenesisState, *got)
@@ -141,6 +151,7 @@ func TestGenesis(t *testing.T) {
 		got := observer.ExportGenesis(ctx, *k)
 		require.NotNil(t, got)
 
+		livenessParams := types.DefaultLivenessParams()
 		expectedGenesisState := types.GenesisState{
 			CrosschainFlags:   types.DefaultCrosschainFlags(),
 			ChainParamsList:   types.ChainParamsList{},
@@ -154,6 +165,8 @@ func TestGenesis(t *testing.T) {
 			BlameList:         k.GetAllBlame(ctx),
 			ChainNonces:       k.GetAllChainNonces(ctx),
 			NonceToCctx:       k.GetAllNonceToCctx(ctx),
+			LivenessParams:    &livenessParams,
+			ObserverLiveness:  k.GetAllObserverLiveness(ctx),
 		}
 
 		require.Equal(t, expectedGenesisState, *got)
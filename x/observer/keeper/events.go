@@ -48,3 +48,29 @@ func EmitEventAddObserver(
 		ctx.Logger().Error("Error emitting EmitEventAddObserver :", err)
 	}
 }
+
+func EmitEventObserverJailed(
+	ctx sdk.Context,
+	observerAddress string,
+	missedVotesCounter, windowSize, jailedUntil int64,
+) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverJailed{
+		ObserverAddress:    observerAddress,
+		MissedVotesCounter: missedVotesCounter,
+		WindowSize:         windowSize,
+		JailedUntil:        jailedUntil,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverJailed :", err)
+	}
+}
+
+func EmitEventObserverUnjailed(ctx sdk.Context, observerAddress string) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverUnjailed{
+		MsgTypeUrl:      sdk.MsgTypeURL(&types.MsgUnjailObserver{}),
+		ObserverAddress: observerAddress,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverUnjailed :", err)
+	}
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// LivenessParams returns the params used to track the missed votes of observers
+func (k Keeper) LivenessParams(
+	c context.Context,
+	req *types.QueryGetLivenessParamsRequest,
+) (*types.QueryGetLivenessParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetLivenessParamsResponse{LivenessParams: k.GetLivenessParams(ctx)}, nil
+}
+
+// ObserverLiveness returns the missed votes of an observer
+func (k Keeper) ObserverLiveness(
+	c context.Context,
+	req *types.QueryGetObserverLivenessRequest,
+) (*types.QueryGetObserverLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.IsAddressPartOfObserverSet(ctx, req.ObserverAddress) {
+		return nil, status.Error(codes.NotFound, "observer not found")
+	}
+
+	// observers without a record have not missed any vote yet
+	liveness, found := k.GetObserverLiveness(ctx, req.ObserverAddress)
+	if !found {
+		liveness = types.NewObserverLiveness(req.ObserverAddress)
+	}
+	params := k.GetLivenessParams(ctx)
+
+	return &types.QueryGetObserverLivenessResponse{ObserverLiveness: liveness.Info(params.WindowSize)}, nil
+}
+
+// ObserverLivenessAll returns the missed votes of all observers in the observer set
+func (k Keeper) ObserverLivenessAll(
+	c context.Context,
+	req *types.QueryAllObserverLivenessRequest,
+) (*types.QueryAllObserverLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "observer set not found")
+	}
+	params := k.GetLivenessParams(ctx)
+
+	list := make([]types.ObserverLivenessInfo, 0, len(observerSet.ObserverList))
+	for _, observer := range observerSet.ObserverList {
+		liveness, found := k.GetObserverLiveness(ctx, observer)
+		if !found {
+			liveness = types.NewObserverLiveness(observer)
+		}
+		list = append(list, liveness.Info(params.WindowSize))
+	}
+
+	return &types.QueryAllObserverLivenessResponse{ObserverLiveness: list}, nil
+}
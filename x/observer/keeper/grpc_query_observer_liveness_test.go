@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestKeeper_LivenessParams(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.LivenessParams(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return liveness params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := sample.LivenessParams()
+		k.SetLivenessParams(ctx, params)
+
+		res, err := k.LivenessParams(sdk.WrapSDKContext(ctx), &types.QueryGetLivenessParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, &types.QueryGetLivenessParamsResponse{LivenessParams: params}, res)
+	})
+}
+
+func TestKeeper_ObserverLivenessQuery(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverLiveness(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if address is not an observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+
+		res, err := k.ObserverLiveness(sdk.WrapSDKContext(ctx), &types.QueryGetObserverLivenessRequest{
+			ObserverAddress: sample.AccAddress(),
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return empty liveness if observer has no record", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+
+		res, err := k.ObserverLiveness(sdk.WrapSDKContext(ctx), &types.QueryGetObserverLivenessRequest{
+			ObserverAddress: observerSet.ObserverList[0],
+		})
+		require.NoError(t, err)
+		require.Equal(t, observerSet.ObserverList[0], res.ObserverLiveness.ObserverAddress)
+		require.EqualValues(t, 0, res.ObserverLiveness.TotalVotes)
+		require.True(t, res.ObserverLiveness.MissedRatio.IsZero())
+	})
+
+	t.Run("should return liveness of observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		params := sample.LivenessParams()
+		k.SetLivenessParams(ctx, params)
+		liveness := sample.ObserverLiveness(observerSet.ObserverList[0])
+		k.SetObserverLiveness(ctx, liveness)
+
+		res, err := k.ObserverLiveness(sdk.WrapSDKContext(ctx), &types.QueryGetObserverLivenessRequest{
+			ObserverAddress: observerSet.ObserverList[0],
+		})
+		require.NoError(t, err)
+		require.Equal(t, liveness.Info(params.WindowSize), res.ObserverLiveness)
+	})
+}
+
+func TestKeeper_ObserverLivenessAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverLivenessAll(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if observer set not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverLivenessAll(sdk.WrapSDKContext(ctx), &types.QueryAllObserverLivenessRequest{})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return liveness of all observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		params := sample.LivenessParams()
+		k.SetLivenessParams(ctx, params)
+		liveness := sample.ObserverLiveness(observerSet.ObserverList[1])
+		k.SetObserverLiveness(ctx, liveness)
+
+		res, err := k.ObserverLivenessAll(sdk.WrapSDKContext(ctx), &types.QueryAllObserverLivenessRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.ObserverLiveness, 3)
+		require.Equal(t, liveness.Info(params.WindowSize), res.ObserverLiveness[1])
+		require.EqualValues(t, 0, res.ObserverLiveness[0].TotalVotes)
+		require.EqualValues(t, 0, res.ObserverLiveness[2].TotalVotes)
+	})
+}
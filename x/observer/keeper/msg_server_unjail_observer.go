@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UnjailObserver unjails an observer that has been jailed for missing too many votes.
+// The message must be signed by the observer once the jail period is over.
+func (k msgServer) UnjailObserver(
+	goCtx context.Context,
+	msg *types.MsgUnjailObserver,
+) (*types.MsgUnjailObserverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsAddressPartOfObserverSet(ctx, msg.Creator) {
+		return nil, errors.Wrapf(types.ErrNotObserver, "observer %s", msg.Creator)
+	}
+
+	if err := k.Keeper.UnjailObserver(ctx, msg.Creator); err != nil {
+		return nil, errors.Wrapf(err, "observer %s", msg.Creator)
+	}
+
+	EmitEventObserverUnjailed(ctx, msg.Creator)
+
+	return &types.MsgUnjailObserverResponse{}, nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_UnjailObserver(t *testing.T) {
+	t.Run("should fail if sender is not an observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(sample.AccAddress()))
+		require.ErrorIs(t, err, types.ErrNotObserver)
+	})
+
+	t.Run("should fail if observer is not jailed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+
+		_, err := srv.UnjailObserver(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUnjailObserver(observerSet.ObserverList[0]),
+		)
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+
+	t.Run("should fail if jail period is not over", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+
+		liveness := types.NewObserverLiveness(observerSet.ObserverList[0])
+		liveness.Jailed = true
+		liveness.JailedUntil = 100
+		k.SetObserverLiveness(ctx, liveness)
+		ctx = ctx.WithBlockHeight(50)
+
+		_, err := srv.UnjailObserver(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUnjailObserver(observerSet.ObserverList[0]),
+		)
+		require.ErrorIs(t, err, types.ErrObserverStillJailed)
+		require.True(t, k.IsObserverJailed(ctx, observerSet.ObserverList[0]))
+	})
+
+	t.Run("should unjail observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+
+		liveness := types.NewObserverLiveness(observerSet.ObserverList[0])
+		liveness.Jailed = true
+		liveness.JailedUntil = 100
+		k.SetObserverLiveness(ctx, liveness)
+		ctx = ctx.WithBlockHeight(150)
+
+		_, err := srv.UnjailObserver(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUnjailObserver(observerSet.ObserverList[0]),
+		)
+		require.NoError(t, err)
+		require.False(t, k.IsObserverJailed(ctx, observerSet.ObserverList[0]))
+		require.Equal(t, observerSet.ObserverList, k.GetActiveObserverList(ctx))
+	})
+}
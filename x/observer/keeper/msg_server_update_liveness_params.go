@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// UpdateLivenessParams updates the params used to track the missed votes of observers and jail them.
+// The params are updated by the policy account with the groupOperational policy type.
+func (k msgServer) UpdateLivenessParams(
+	goCtx context.Context,
+	msg *types.MsgUpdateLivenessParams,
+) (*types.MsgUpdateLivenessParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check permission
+	err := k.GetAuthorityKeeper().CheckAuthorization(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(authoritytypes.ErrUnauthorized, err.Error())
+	}
+
+	if err := msg.LivenessParams.Validate(); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidLivenessParams, err.Error())
+	}
+
+	// the windows of missed votes are no longer comparable if the window size changes
+	if k.GetLivenessParams(ctx).WindowSize != msg.LivenessParams.WindowSize {
+		k.ResetAllObserverLivenessWindows(ctx)
+	}
+	k.SetLivenessParams(ctx, msg.LivenessParams)
+
+	err = ctx.EventManager().EmitTypedEvents(&types.EventLivenessParamsUpdated{
+		MsgTypeUrl:     sdk.MsgTypeURL(&types.MsgUpdateLivenessParams{}),
+		LivenessParams: &msg.LivenessParams,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventLivenessParamsUpdated :", err)
+	}
+
+	return &types.MsgUpdateLivenessParamsResponse{}, nil
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	authoritytypes "github.com/zeta-chain/node/x/authority/types"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestMsgServer_UpdateLivenessParams(t *testing.T) {
+	t.Run("can update liveness params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.NewMsgUpdateLivenessParams(admin, sample.LivenessParams())
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.Equal(t, sample.LivenessParams(), k.GetLivenessParams(ctx))
+	})
+
+	t.Run("should reset liveness windows if window size is updated", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		observer := sample.AccAddress()
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		k.SetObserverLiveness(ctx, sample.ObserverLiveness(observer))
+
+		params := sample.LivenessParams()
+		params.WindowSize = 200
+		msg := types.NewMsgUpdateLivenessParams(admin, params)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		liveness, found := k.GetObserverLiveness(ctx, observer)
+		require.True(t, found)
+		require.EqualValues(t, 0, liveness.IndexOffset)
+		require.EqualValues(t, 0, liveness.MissedVotesCounter)
+	})
+
+	t.Run("should keep liveness windows if window size is not updated", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		observer := sample.AccAddress()
+		k.SetLivenessParams(ctx, sample.LivenessParams())
+		observerLiveness := sample.ObserverLiveness(observer)
+		k.SetObserverLiveness(ctx, observerLiveness)
+
+		params := sample.LivenessParams()
+		params.JailDurationBlocks = 42
+		msg := types.NewMsgUpdateLivenessParams(admin, params)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+
+		liveness, found := k.GetObserverLiveness(ctx, observer)
+		require.True(t, found)
+		require.Equal(t, observerLiveness, liveness)
+	})
+
+	t.Run("cannot update invalid liveness params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		params := sample.LivenessParams()
+		params.GracePeriodBlocks = 0
+		msg := types.NewMsgUpdateLivenessParams(admin, params)
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, nil)
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrInvalidLivenessParams)
+		require.Equal(t, types.DefaultLivenessParams(), k.GetLivenessParams(ctx))
+	})
+
+	t.Run("cannot update liveness params if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		msg := types.NewMsgUpdateLivenessParams(admin, sample.LivenessParams())
+		keepertest.MockCheckAuthorization(&authorityMock.Mock, msg, authoritytypes.ErrUnauthorized)
+		_, err := srv.UpdateLivenessParams(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, authoritytypes.ErrUnauthorized)
+	})
+}
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// SetLivenessParams sets the liveness params in the store
+func (k Keeper) SetLivenessParams(ctx sdk.Context, params types.LivenessParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessParamsKey))
+	b := k.cdc.MustMarshal(&params)
+	store.Set([]byte{0}, b)
+}
+
+// GetLivenessParams returns the liveness params
+// The default params are returned if they are not defined
+func (k Keeper) GetLivenessParams(ctx sdk.Context) types.LivenessParams {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessParamsKey))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return types.DefaultLivenessParams()
+	}
+
+	var val types.LivenessParams
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// SetObserverLiveness sets the liveness record of an observer in the store
+func (k Keeper) SetObserverLiveness(ctx sdk.Context, liveness types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	b := k.cdc.MustMarshal(&liveness)
+	store.Set([]byte(liveness.ObserverAddress), b)
+}
+
+// GetObserverLiveness returns the liveness record of an observer
+func (k Keeper) GetObserverLiveness(ctx sdk.Context, observerAddress string) (val types.ObserverLiveness, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+
+	b := store.Get([]byte(observerAddress))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllObserverLiveness returns the liveness records of all observers
+func (k Keeper) GetAllObserverLiveness(ctx sdk.Context) (list []types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ObserverLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// RemoveObserverLiveness removes the liveness record of an observer from the store
+func (k Keeper) RemoveObserverLiveness(ctx sdk.Context, observerAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKey))
+	store.Delete([]byte(observerAddress))
+}
+
+// IsObserverJailed returns true if the observer is jailed for missing too many votes
+func (k Keeper) IsObserverJailed(ctx sdk.Context, observerAddress string) bool {
+	liveness, found := k.GetObserverLiveness(ctx, observerAddress)
+	return found && liveness.Jailed
+}
+
+// GetActiveObserverList returns the list of observers from the observer set that are not jailed
+// Only active observers are expected to vote on new ballots. If the active observers are fewer than
+// MinActiveObservers of the set, the full observer set is returned so ballots can't be finalized by a minority.
+func (k Keeper) GetActiveObserverList(ctx sdk.Context) []string {
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found {
+		return []string{}
+	}
+
+	activeObservers := make([]string, 0, len(observerSet.ObserverList))
+	for _, observer := range observerSet.ObserverList {
+		if !k.IsObserverJailed(ctx, observer) {
+			activeObservers = append(activeObservers, observer)
+		}
+	}
+	if len(activeObservers) < types.MinActiveObservers(len(observerSet.ObserverList)) {
+		return append([]string{}, observerSet.ObserverList...)
+	}
+	return activeObservers
+}
+
+// ProcessObserverLiveness accounts the votes of the ballots created GracePeriodBlocks ago.
+// Observers that have not voted on a finalized ballot within the grace period missed the vote,
+// observers whose ratio of missed votes in the window exceeds MaxMissedRatio are jailed.
+func (k Keeper) ProcessObserverLiveness(ctx sdk.Context) {
+	params := k.GetLivenessParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	ballotList, found := k.GetBallotList(ctx, ctx.BlockHeight()-params.GracePeriodBlocks)
+	if !found || len(ballotList.BallotsIndexList) == 0 {
+		return
+	}
+
+	observerSet, found := k.GetObserverSet(ctx)
+	if !found {
+		return
+	}
+	isObserver := make(map[string]bool, len(observerSet.ObserverList))
+	activeCount := 0
+	for _, observer := range observerSet.ObserverList {
+		isObserver[observer] = true
+		if !k.IsObserverJailed(ctx, observer) {
+			activeCount++
+		}
+	}
+	minActive := types.MinActiveObservers(len(observerSet.ObserverList))
+
+	// liveness records are cached to be written once per block
+	updated := make(map[string]*types.ObserverLiveness)
+	var updatedOrder []string
+
+	for _, ballotIdentifier := range ballotList.BallotsIndexList {
+		ballot, found := k.GetBallot(ctx, ballotIdentifier)
+
+		// ballots that never got finalized are not accounted since the observation might be invalid
+		if !found || ballot.BallotStatus == types.BallotStatus_BallotInProgress {
+			continue
+		}
+
+		for i, voter := range ballot.VoterList {
+			if !isObserver[voter] || i >= len(ballot.Votes) {
+				continue
+			}
+
+			liveness, ok := updated[voter]
+			if !ok {
+				l, found := k.GetObserverLiveness(ctx, voter)
+				if !found {
+					l = types.NewObserverLiveness(voter)
+				}
+				liveness = &l
+				updated[voter] = liveness
+				updatedOrder = append(updatedOrder, voter)
+			}
+
+			// votes of jailed observers are no longer tracked
+			if liveness.Jailed {
+				continue
+			}
+			liveness.RecordVote(params.WindowSize, ballot.Votes[i] == types.VoteType_NotYetVoted)
+		}
+	}
+
+	for _, observer := range updatedOrder {
+		liveness := updated[observer]
+		if liveness.ShouldBeJailed(params) {
+			// observers are not jailed below the minimum active set to keep the ballots finalizable
+			if activeCount <= minActive {
+				k.Logger(ctx).Info(fmt.Sprintf(
+					"observer %s not jailed, %d active observers out of %d is the minimum",
+					observer,
+					activeCount,
+					len(observerSet.ObserverList),
+				))
+			} else {
+				k.JailObserver(ctx, liveness, params)
+				activeCount--
+			}
+		}
+		k.SetObserverLiveness(ctx, *liveness)
+	}
+}
+
+// JailObserver jails the observer until JailDurationBlocks have passed
+// The window of missed votes is reset so that the observer starts with a clean record once unjailed
+func (k Keeper) JailObserver(ctx sdk.Context, liveness *types.ObserverLiveness, params types.LivenessParams) {
+	missedVotes := liveness.MissedVotesCounter
+
+	liveness.Jailed = true
+	liveness.JailedUntil = ctx.BlockHeight() + params.JailDurationBlocks
+	liveness.ResetWindow()
+
+	k.Logger(ctx).Info(fmt.Sprintf(
+		"observer %s jailed until height %d for missing %d votes out of %d",
+		liveness.ObserverAddress,
+		liveness.JailedUntil,
+		missedVotes,
+		params.WindowSize,
+	))
+	EmitEventObserverJailed(ctx, liveness.ObserverAddress, missedVotes, params.WindowSize, liveness.JailedUntil)
+}
+
+// UnjailObserver unjails the observer if the jail period is over
+func (k Keeper) UnjailObserver(ctx sdk.Context, observerAddress string) error {
+	liveness, found := k.GetObserverLiveness(ctx, observerAddress)
+	if !found || !liveness.Jailed {
+		return types.ErrObserverNotJailed
+	}
+
+	if ctx.BlockHeight() < liveness.JailedUntil {
+		return types.ErrObserverStillJailed
+	}
+
+	liveness.Jailed = false
+	liveness.JailedUntil = 0
+	liveness.ResetWindow()
+	k.SetObserverLiveness(ctx, liveness)
+	return nil
+}
+
+// ResetAllObserverLivenessWindows clears the window of missed votes of all observers
+// It is used when the window size is updated
+func (k Keeper) ResetAllObserverLivenessWindows(ctx sdk.Context) {
+	for _, liveness := range k.GetAllObserverLiveness(ctx) {
+		liveness.ResetWindow()
+		liveness.MissedVotes = nil
+		k.SetObserverLiveness(ctx, liveness)
+	}
+}
@@ -0,0 +1,315 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/zeta-chain/node/testutil/keeper"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/keeper"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+// setBallots sets finalized ballots created at the given height with the given votes for the observers
+func setBallots(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	height int64,
+	observers []string,
+	votes [][]types.VoteType,
+	status types.BallotStatus,
+) {
+	for i, ballotVotes := range votes {
+		ballot := types.Ballot{
+			BallotIdentifier:     fmt.Sprintf("ballot-%d-%s-%d", height, status.String(), i),
+			VoterList:            observers,
+			Votes:                ballotVotes,
+			BallotStatus:         status,
+			BallotCreationHeight: height,
+		}
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+	}
+}
+
+func TestKeeper_GetLivenessParams(t *testing.T) {
+	t.Run("should return default params if not set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		require.Equal(t, types.DefaultLivenessParams(), k.GetLivenessParams(ctx))
+	})
+
+	t.Run("should return params if set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := sample.LivenessParams()
+		k.SetLivenessParams(ctx, params)
+		require.Equal(t, params, k.GetLivenessParams(ctx))
+	})
+}
+
+func TestKeeper_ObserverLiveness(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	observer1 := sample.AccAddress()
+	observer2 := sample.AccAddress()
+
+	_, found := k.GetObserverLiveness(ctx, observer1)
+	require.False(t, found)
+
+	liveness1 := sample.ObserverLiveness(observer1)
+	liveness2 := sample.ObserverLiveness(observer2)
+	k.SetObserverLiveness(ctx, liveness1)
+	k.SetObserverLiveness(ctx, liveness2)
+
+	got, found := k.GetObserverLiveness(ctx, observer1)
+	require.True(t, found)
+	require.Equal(t, liveness1, got)
+	require.ElementsMatch(t, []types.ObserverLiveness{liveness1, liveness2}, k.GetAllObserverLiveness(ctx))
+
+	k.RemoveObserverLiveness(ctx, observer1)
+	_, found = k.GetObserverLiveness(ctx, observer1)
+	require.False(t, found)
+}
+
+func TestKeeper_GetActiveObserverList(t *testing.T) {
+	t.Run("should return empty list if observer set not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		require.Empty(t, k.GetActiveObserverList(ctx))
+	})
+
+	t.Run("should not return jailed observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+
+		jailed := types.NewObserverLiveness(observerSet.ObserverList[1])
+		jailed.Jailed = true
+		k.SetObserverLiveness(ctx, jailed)
+		k.SetObserverLiveness(ctx, types.NewObserverLiveness(observerSet.ObserverList[2]))
+
+		require.True(t, k.IsObserverJailed(ctx, observerSet.ObserverList[1]))
+		require.False(t, k.IsObserverJailed(ctx, observerSet.ObserverList[2]))
+		require.Equal(
+			t,
+			[]string{observerSet.ObserverList[0], observerSet.ObserverList[2]},
+			k.GetActiveObserverList(ctx),
+		)
+	})
+
+	t.Run("should return the full observer set below the minimum active set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+
+		for _, observer := range observerSet.ObserverList[1:] {
+			jailed := types.NewObserverLiveness(observer)
+			jailed.Jailed = true
+			k.SetObserverLiveness(ctx, jailed)
+		}
+
+		require.Equal(t, observerSet.ObserverList, k.GetActiveObserverList(ctx))
+	})
+}
+
+func TestKeeper_ProcessObserverLiveness(t *testing.T) {
+	params := types.LivenessParams{
+		Enabled:            true,
+		WindowSize:         4,
+		MaxMissedRatio:     sdk.MustNewDecFromStr("0.5"),
+		GracePeriodBlocks:  10,
+		JailDurationBlocks: 100,
+	}
+	success := types.VoteType_SuccessObservation
+	missed := types.VoteType_NotYetVoted
+
+	t.Run("should not track votes if disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(2)
+		k.SetObserverSet(ctx, observerSet)
+		disabledParams := params
+		disabledParams.Enabled = false
+		k.SetLivenessParams(ctx, disabledParams)
+
+		setBallots(ctx, k, 1, observerSet.ObserverList, [][]types.VoteType{{success, missed}},
+			types.BallotStatus_BallotFinalized_SuccessObservation)
+		ctx = ctx.WithBlockHeight(11)
+		k.ProcessObserverLiveness(ctx)
+
+		require.Empty(t, k.GetAllObserverLiveness(ctx))
+	})
+
+	t.Run("should track missed votes of finalized ballots after the grace period", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(2)
+		k.SetObserverSet(ctx, observerSet)
+		k.SetLivenessParams(ctx, params)
+
+		setBallots(ctx, k, 1, observerSet.ObserverList, [][]types.VoteType{
+			{success, missed},
+			{success, success},
+		}, types.BallotStatus_BallotFinalized_SuccessObservation)
+		setBallots(ctx, k, 1, observerSet.ObserverList, [][]types.VoteType{
+			{missed, missed},
+		}, types.BallotStatus_BallotInProgress)
+
+		// ballots are not accounted before the end of the grace period
+		ctx = ctx.WithBlockHeight(10)
+		k.ProcessObserverLiveness(ctx)
+		require.Empty(t, k.GetAllObserverLiveness(ctx))
+
+		ctx = ctx.WithBlockHeight(11)
+		k.ProcessObserverLiveness(ctx)
+
+		liveness0, found := k.GetObserverLiveness(ctx, observerSet.ObserverList[0])
+		require.True(t, found)
+		require.EqualValues(t, 2, liveness0.IndexOffset)
+		require.EqualValues(t, 0, liveness0.MissedVotesCounter)
+
+		liveness1, found := k.GetObserverLiveness(ctx, observerSet.ObserverList[1])
+		require.True(t, found)
+		require.EqualValues(t, 2, liveness1.IndexOffset)
+		require.EqualValues(t, 1, liveness1.MissedVotesCounter)
+		require.False(t, liveness1.Jailed)
+	})
+
+	t.Run("should not track votes of addresses not in the observer set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(1)
+		k.SetObserverSet(ctx, observerSet)
+		k.SetLivenessParams(ctx, params)
+		removed := sample.AccAddress()
+
+		setBallots(ctx, k, 1, []string{observerSet.ObserverList[0], removed}, [][]types.VoteType{
+			{success, missed},
+		}, types.BallotStatus_BallotFinalized_SuccessObservation)
+		ctx = ctx.WithBlockHeight(11)
+		k.ProcessObserverLiveness(ctx)
+
+		_, found := k.GetObserverLiveness(ctx, removed)
+		require.False(t, found)
+	})
+
+	t.Run("should jail observer missing too many votes", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(4)
+		k.SetObserverSet(ctx, observerSet)
+		k.SetLivenessParams(ctx, params)
+
+		setBallots(ctx, k, 1, observerSet.ObserverList, [][]types.VoteType{
+			{success, missed, success, success},
+			{success, missed, success, success},
+			{success, missed, success, success},
+			{success, success, success, success},
+		}, types.BallotStatus_BallotFinalized_SuccessObservation)
+		ctx = ctx.WithBlockHeight(11)
+		k.ProcessObserverLiveness(ctx)
+
+		liveness0, found := k.GetObserverLiveness(ctx, observerSet.ObserverList[0])
+		require.True(t, found)
+		require.False(t, liveness0.Jailed)
+
+		liveness1, found := k.GetObserverLiveness(ctx, observerSet.ObserverList[1])
+		require.True(t, found)
+		require.True(t, liveness1.Jailed)
+		require.EqualValues(t, 111, liveness1.JailedUntil)
+		require.EqualValues(t, 0, liveness1.IndexOffset)
+		require.EqualValues(t, 0, liveness1.MissedVotesCounter)
+		require.Equal(
+			t,
+			[]string{observerSet.ObserverList[0], observerSet.ObserverList[2], observerSet.ObserverList[3]},
+			k.GetActiveObserverList(ctx),
+		)
+
+		// votes are no longer tracked while jailed
+		setBallots(ctx, k, 2, observerSet.ObserverList, [][]types.VoteType{
+			{success, missed, success, success},
+		}, types.BallotStatus_BallotFinalized_SuccessObservation)
+		ctx = ctx.WithBlockHeight(12)
+		k.ProcessObserverLiveness(ctx)
+
+		liveness1, found = k.GetObserverLiveness(ctx, observerSet.ObserverList[1])
+		require.True(t, found)
+		require.EqualValues(t, 0, liveness1.IndexOffset)
+	})
+
+	t.Run("should not jail observers below the minimum active set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observerSet := sample.ObserverSet(2)
+		k.SetObserverSet(ctx, observerSet)
+		k.SetLivenessParams(ctx, params)
+
+		setBallots(ctx, k, 1, observerSet.ObserverList, [][]types.VoteType{
+			{success, missed},
+			{success, missed},
+			{success, missed},
+			{success, success},
+		}, types.BallotStatus_BallotFinalized_SuccessObservation)
+		ctx = ctx.WithBlockHeight(11)
+		k.ProcessObserverLiveness(ctx)
+
+		liveness1, found := k.GetObserverLiveness(ctx, observerSet.ObserverList[1])
+		require.True(t, found)
+		require.False(t, liveness1.Jailed)
+		require.EqualValues(t, 3, liveness1.MissedVotesCounter)
+		require.Equal(t, observerSet.ObserverList, k.GetActiveObserverList(ctx))
+	})
+}
+
+func TestKeeper_UnjailObserver(t *testing.T) {
+	t.Run("should fail if observer has no liveness record", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		err := k.UnjailObserver(ctx, sample.AccAddress())
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+
+	t.Run("should fail if observer is not jailed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observer := sample.AccAddress()
+		k.SetObserverLiveness(ctx, types.NewObserverLiveness(observer))
+
+		err := k.UnjailObserver(ctx, observer)
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+
+	t.Run("should fail if jail period is not over", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observer := sample.AccAddress()
+		liveness := types.NewObserverLiveness(observer)
+		liveness.Jailed = true
+		liveness.JailedUntil = 100
+		k.SetObserverLiveness(ctx, liveness)
+
+		ctx = ctx.WithBlockHeight(99)
+		err := k.UnjailObserver(ctx, observer)
+		require.ErrorIs(t, err, types.ErrObserverStillJailed)
+	})
+
+	t.Run("should unjail observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observer := sample.AccAddress()
+		liveness := types.NewObserverLiveness(observer)
+		liveness.Jailed = true
+		liveness.JailedUntil = 100
+		k.SetObserverLiveness(ctx, liveness)
+
+		ctx = ctx.WithBlockHeight(100)
+		err := k.UnjailObserver(ctx, observer)
+		require.NoError(t, err)
+		require.False(t, k.IsObserverJailed(ctx, observer))
+	})
+}
+
+func TestKeeper_ResetAllObserverLivenessWindows(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	k.SetObserverLiveness(ctx, sample.ObserverLiveness(sample.AccAddress()))
+	k.SetObserverLiveness(ctx, sample.ObserverLiveness(sample.AccAddress()))
+
+	k.ResetAllObserverLivenessWindows(ctx)
+
+	for _, liveness := range k.GetAllObserverLiveness(ctx) {
+		require.EqualValues(t, 0, liveness.IndexOffset)
+		require.EqualValues(t, 0, liveness.MissedVotesCounter)
+		require.Empty(t, liveness.MissedVotes)
+	}
+}
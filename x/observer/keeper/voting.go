@@ -67,7 +67,8 @@ func (k Keeper) FindBallot(
 	isNew = false
 	ballot, found := k.GetBallot(ctx, index)
 	if !found {
-		observerSet, _ := k.GetObserverSet(ctx)
+		// jailed observers are not expected to vote on new ballots
+		voterList := k.GetActiveObserverList(ctx)
 
 		cp, found := k.GetChainParamsByChainID(ctx, chain.ChainId)
 		if !found || cp == nil || !cp.IsSupported {
@@ -77,8 +78,8 @@ func (k Keeper) FindBallot(
 		ballot = types.Ballot{
 			Index:                "",
 			BallotIdentifier:     index,
-			VoterList:            observerSet.ObserverList,
-			Votes:                types.CreateVotes(len(observerSet.ObserverList)),
+			VoterList:            voterList,
+			Votes:                types.CreateVotes(len(voterList)),
 			ObservationType:      observationType,
 			BallotThreshold:      cp.BallotThreshold,
 			BallotStatus:         types.BallotStatus_BallotInProgress,
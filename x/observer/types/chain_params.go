@@ -93,6 +93,45 @@ func ValidateChainParams(params *ChainParams) error {
 		)
 	}
 
+	// check confirmation mode, block tags are only supported on EVM chains
+	if _, ok := ConfirmationMode_name[int32(params.ConfirmationMode)]; !ok {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid ConfirmationMode %d",
+			params.ConfirmationMode,
+		)
+	}
+	if params.ConfirmationMode != ConfirmationMode_FixedCount &&
+		(chains.IsBitcoinChain(params.ChainId, nil) || chains.IsSolanaChain(params.ChainId, nil)) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"ConfirmationMode %s not supported for chain %d",
+			params.ConfirmationMode,
+			params.ChainId,
+		)
+	}
+
+	// check trace mode, call traces are only supported on EVM chains
+	if _, ok := TraceMode_name[int32(params.TraceMode)]; !ok {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"invalid TraceMode %d",
+			params.TraceMode,
+		)
+	}
+	if params.TraceMode != TraceMode_BlockScan && !chains.IsEVMChain(params.ChainId, nil) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"TraceMode %s not supported for chain %d",
+			params.TraceMode,
+			params.ChainId,
+		)
+	}
+
+	if err := validateConfirmationTiers(params.ConfirmationTiers, params.ConfirmationCount); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	// if contract addresses are defined, check validity
 	if params.ZetaTokenContractAddress != "" && !validChainContractAddress(params.ZetaTokenContractAddress) {
 		return errorsmod.Wrapf(
@@ -372,5 +411,24 @@ func ChainParamsEqual(params1, params2 ChainParams) bool {
 		params1.BallotThreshold.Equal(params2.BallotThreshold) &&
 		params1.MinObserverDelegation.Equal(params2.MinObserverDelegation) &&
 		params1.IsSupported == params2.IsSupported &&
-		params1.GatewayAddress == params2.GatewayAddress
+		params1.GatewayAddress == params2.GatewayAddress &&
+		params1.ConfirmationMode == params2.ConfirmationMode &&
+		params1.TraceMode == params2.TraceMode &&
+		confirmationTiersEqual(params1.ConfirmationTiers, params2.ConfirmationTiers)
+}
+
+// confirmationTiersEqual returns true if two lists of confirmation tiers are equal
+func confirmationTiersEqual(tiers1, tiers2 []ConfirmationTier) bool {
+	if len(tiers1) != len(tiers2) {
+		return false
+	}
+	for i := range tiers1 {
+		if tiers1[i].CoinType != tiers2[i].CoinType ||
+			tiers1[i].Asset != tiers2[i].Asset ||
+			!tiers1[i].MinAmount.Equal(tiers2[i].MinAmount) ||
+			tiers1[i].ConfirmationCount != tiers2[i].ConfirmationCount {
+			return false
+		}
+	}
+	return true
 }
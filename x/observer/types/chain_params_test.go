@@ -50,6 +50,14 @@ func TestChainParamsEqual(t *testing.T) {
 	params := types.GetDefaultChainParams()
 	require.True(t, types.ChainParamsEqual(*params.ChainParams[0], *params.ChainParams[0]))
 	require.False(t, types.ChainParamsEqual(*params.ChainParams[0], *params.ChainParams[1]))
+
+	finalizedParams := *params.ChainParams[0]
+	finalizedParams.ConfirmationMode = types.ConfirmationMode_FinalizedTag
+	require.False(t, types.ChainParamsEqual(*params.ChainParams[0], finalizedParams))
+
+	tracedParams := *params.ChainParams[0]
+	tracedParams.TraceMode = types.TraceMode_TraceFilter
+	require.False(t, types.ChainParamsEqual(*params.ChainParams[0], tracedParams))
 }
 
 func (s *UpdateChainParamsSuite) SetupTest() {
@@ -107,6 +115,42 @@ func (s *UpdateChainParamsSuite) TestBTCParamsInvalid() {
 	require.NotNil(s.T(), err)
 }
 
+func (s *UpdateChainParamsSuite) TestConfirmationMode() {
+	copy := *s.evmParams
+	copy.ConfirmationMode = types.ConfirmationMode_SafeTag
+	err := types.ValidateChainParams(&copy)
+	require.Nil(s.T(), err)
+	copy.ConfirmationMode = types.ConfirmationMode_FinalizedTag
+	err = types.ValidateChainParams(&copy)
+	require.Nil(s.T(), err)
+	copy.ConfirmationMode = types.ConfirmationMode(3)
+	err = types.ValidateChainParams(&copy)
+	require.NotNil(s.T(), err)
+
+	copy = *s.btcParams
+	copy.ConfirmationMode = types.ConfirmationMode_FinalizedTag
+	err = types.ValidateChainParams(&copy)
+	require.NotNil(s.T(), err)
+}
+
+func (s *UpdateChainParamsSuite) TestTraceMode() {
+	copy := *s.evmParams
+	copy.TraceMode = types.TraceMode_TraceFilter
+	err := types.ValidateChainParams(&copy)
+	require.Nil(s.T(), err)
+	copy.TraceMode = types.TraceMode_DebugTrace
+	err = types.ValidateChainParams(&copy)
+	require.Nil(s.T(), err)
+	copy.TraceMode = types.TraceMode(3)
+	err = types.ValidateChainParams(&copy)
+	require.NotNil(s.T(), err)
+
+	copy = *s.btcParams
+	copy.TraceMode = types.TraceMode_TraceFilter
+	err = types.ValidateChainParams(&copy)
+	require.NotNil(s.T(), err)
+}
+
 func (s *UpdateChainParamsSuite) TestCoreContractAddresses() {
 	copy := *s.evmParams
 	copy.ZetaTokenContractAddress = "0x123"
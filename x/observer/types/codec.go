@@ -20,6 +20,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEnableCCTX{}, "observer/EnableCCTX", nil)
 	cdc.RegisterConcrete(&MsgDisableCCTX{}, "observer/DisableCCTX", nil)
 	cdc.RegisterConcrete(&MsgUpdateGasPriceIncreaseFlags{}, "observer/UpdateGasPriceIncreaseFlags", nil)
+	cdc.RegisterConcrete(&MsgUnjailObserver{}, "observer/UnjailObserver", nil)
+	cdc.RegisterConcrete(&MsgUpdateLivenessParams{}, "observer/UpdateLivenessParams", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -36,6 +38,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgEnableCCTX{},
 		&MsgDisableCCTX{},
 		&MsgUpdateGasPriceIncreaseFlags{},
+		&MsgUnjailObserver{},
+		&MsgUpdateLivenessParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/zeta-chain/node/pkg/coin"
+)
+
+// Validate checks the confirmation tier is valid
+func (t ConfirmationTier) Validate() error {
+	switch t.CoinType {
+	case coin.CoinType_Gas, coin.CoinType_Zeta:
+		if t.Asset != "" {
+			return fmt.Errorf("asset must be empty for coin type %s", t.CoinType)
+		}
+	case coin.CoinType_ERC20:
+		if t.Asset == "" {
+			return fmt.Errorf("asset cannot be empty for coin type %s", t.CoinType)
+		}
+	default:
+		return fmt.Errorf("coin type %s not supported", t.CoinType)
+	}
+
+	if t.MinAmount.IsNil() {
+		return fmt.Errorf("min amount cannot be nil")
+	}
+	if t.ConfirmationCount == 0 {
+		return fmt.Errorf("confirmation count must be greater than 0")
+	}
+	return nil
+}
+
+// matches returns true if the tier applies to the given asset
+func (t ConfirmationTier) matches(coinType coin.CoinType, asset string) bool {
+	if t.CoinType != coinType {
+		return false
+	}
+
+	// EVM addresses are case insensitive
+	if ethcommon.IsHexAddress(t.Asset) && ethcommon.IsHexAddress(asset) {
+		return ethcommon.HexToAddress(t.Asset) == ethcommon.HexToAddress(asset)
+	}
+	return t.Asset == asset
+}
+
+// validateConfirmationTiers checks the confirmation tiers are valid, not duplicated, and require more confirmations
+// for greater amounts. A tier can't require fewer confirmations than the confirmation count of the chain.
+func validateConfirmationTiers(tiers []ConfirmationTier, confirmationCount uint64) error {
+	for i, tier := range tiers {
+		if err := tier.Validate(); err != nil {
+			return fmt.Errorf("invalid confirmation tier %d: %w", i, err)
+		}
+		if tier.ConfirmationCount < confirmationCount {
+			return fmt.Errorf(
+				"confirmation tier %d requires %d confirmations, less than confirmation count %d",
+				i,
+				tier.ConfirmationCount,
+				confirmationCount,
+			)
+		}
+
+		for j := 0; j < i; j++ {
+			other := tiers[j]
+			if !other.matches(tier.CoinType, tier.Asset) {
+				continue
+			}
+			if other.MinAmount.Equal(tier.MinAmount) {
+				return fmt.Errorf("confirmation tiers %d and %d have the same min amount", j, i)
+			}
+
+			// the tier with the greater min amount can't require fewer confirmations
+			lower, upper := other, tier
+			if lower.MinAmount.GT(upper.MinAmount) {
+				lower, upper = upper, lower
+			}
+			if upper.ConfirmationCount < lower.ConfirmationCount {
+				return fmt.Errorf("confirmation tiers %d and %d require fewer confirmations for a greater amount", j, i)
+			}
+		}
+	}
+	return nil
+}
+
+// ConfirmationCountForAmount returns the confirmation count of the highest confirmation tier reached by the amount
+// of the given asset. It returns false if no tier is reached.
+func (cp ChainParams) ConfirmationCountForAmount(coinType coin.CoinType, asset string, amount *big.Int) (uint64, bool) {
+	tiers := make([]ConfirmationTier, 0, len(cp.ConfirmationTiers))
+	for _, tier := range cp.ConfirmationTiers {
+		if tier.matches(coinType, asset) && tier.MinAmount.BigInt().Cmp(amount) <= 0 {
+			tiers = append(tiers, tier)
+		}
+	}
+	if len(tiers) == 0 {
+		return 0, false
+	}
+
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].MinAmount.LT(tiers[j].MinAmount)
+	})
+	return tiers[len(tiers)-1].ConfirmationCount, true
+}
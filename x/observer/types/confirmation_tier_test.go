@@ -0,0 +1,201 @@
+package types_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestConfirmationTier_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		tier   types.ConfirmationTier
+		errMsg string
+	}{
+		{
+			name: "valid gas tier",
+			tier: types.ConfirmationTier{
+				CoinType:          coin.CoinType_Gas,
+				MinAmount:         sdkmath.NewUint(1000),
+				ConfirmationCount: 10,
+			},
+		},
+		{
+			name: "valid erc20 tier",
+			tier: types.ConfirmationTier{
+				CoinType:          coin.CoinType_ERC20,
+				Asset:             sample.EthAddress().Hex(),
+				MinAmount:         sdkmath.NewUint(1000),
+				ConfirmationCount: 10,
+			},
+		},
+		{
+			name: "asset set for gas tier",
+			tier: types.ConfirmationTier{
+				CoinType:          coin.CoinType_Gas,
+				Asset:             sample.EthAddress().Hex(),
+				MinAmount:         sdkmath.NewUint(1000),
+				ConfirmationCount: 10,
+			},
+			errMsg: "asset must be empty",
+		},
+		{
+			name: "asset not set for erc20 tier",
+			tier: types.ConfirmationTier{
+				CoinType:          coin.CoinType_ERC20,
+				MinAmount:         sdkmath.NewUint(1000),
+				ConfirmationCount: 10,
+			},
+			errMsg: "asset cannot be empty",
+		},
+		{
+			name: "coin type not supported",
+			tier: types.ConfirmationTier{
+				CoinType:          coin.CoinType_Cmd,
+				MinAmount:         sdkmath.NewUint(1000),
+				ConfirmationCount: 10,
+			},
+			errMsg: "not supported",
+		},
+		{
+			name: "nil min amount",
+			tier: types.ConfirmationTier{
+				CoinType:          coin.CoinType_Gas,
+				ConfirmationCount: 10,
+			},
+			errMsg: "min amount cannot be nil",
+		},
+		{
+			name: "zero confirmation count",
+			tier: types.ConfirmationTier{
+				CoinType:  coin.CoinType_Gas,
+				MinAmount: sdkmath.NewUint(1000),
+			},
+			errMsg: "confirmation count must be greater than 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tier.Validate()
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateChainParams_ConfirmationTiers(t *testing.T) {
+	asset := sample.EthAddress().Hex()
+
+	tests := []struct {
+		name   string
+		tiers  []types.ConfirmationTier
+		errMsg string
+	}{
+		{
+			name: "valid tiers",
+			tiers: []types.ConfirmationTier{
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(1000), ConfirmationCount: 20},
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(100), ConfirmationCount: 15},
+				{CoinType: coin.CoinType_ERC20, Asset: asset, MinAmount: sdkmath.NewUint(100), ConfirmationCount: 30},
+			},
+		},
+		{
+			name: "invalid tier",
+			tiers: []types.ConfirmationTier{
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(1000)},
+			},
+			errMsg: "invalid confirmation tier 0",
+		},
+		{
+			name: "fewer confirmations than confirmation count",
+			tiers: []types.ConfirmationTier{
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(1000), ConfirmationCount: 1},
+			},
+			errMsg: "less than confirmation count",
+		},
+		{
+			name: "same min amount",
+			tiers: []types.ConfirmationTier{
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(1000), ConfirmationCount: 20},
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(1000), ConfirmationCount: 30},
+			},
+			errMsg: "same min amount",
+		},
+		{
+			name: "fewer confirmations for greater amount",
+			tiers: []types.ConfirmationTier{
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(100), ConfirmationCount: 20},
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(1000), ConfirmationCount: 15},
+			},
+			errMsg: "fewer confirmations for a greater amount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.GetDefaultEthMainnetChainParams()
+			params.ConfirmationTiers = tt.tiers
+
+			err := types.ValidateChainParams(params)
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestChainParams_ConfirmationCountForAmount(t *testing.T) {
+	asset := sample.EthAddress()
+	params := types.ChainParams{
+		ConfirmationCount: 10,
+		ConfirmationTiers: []types.ConfirmationTier{
+			{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(1000), ConfirmationCount: 30},
+			{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(100), ConfirmationCount: 20},
+			{CoinType: coin.CoinType_ERC20, Asset: asset.Hex(), MinAmount: sdkmath.NewUint(50), ConfirmationCount: 40},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		coinType      coin.CoinType
+		asset         string
+		amount        int64
+		expectedCount uint64
+		expectedFound bool
+	}{
+		{name: "below lowest tier", coinType: coin.CoinType_Gas, amount: 99},
+		{name: "lowest tier", coinType: coin.CoinType_Gas, amount: 100, expectedCount: 20, expectedFound: true},
+		{name: "highest tier", coinType: coin.CoinType_Gas, amount: 5000, expectedCount: 30, expectedFound: true},
+		{name: "other coin type", coinType: coin.CoinType_Zeta, amount: 5000},
+		{
+			name:          "asset tier case insensitive",
+			coinType:      coin.CoinType_ERC20,
+			asset:         strings.ToLower(asset.Hex()),
+			amount:        50,
+			expectedCount: 40,
+			expectedFound: true,
+		},
+		{name: "other asset", coinType: coin.CoinType_ERC20, asset: sample.EthAddress().Hex(), amount: 5000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, found := params.ConfirmationCountForAmount(tt.coinType, tt.asset, big.NewInt(tt.amount))
+			require.Equal(t, tt.expectedFound, found)
+			require.Equal(t, tt.expectedCount, count)
+		})
+	}
+}
@@ -52,4 +52,7 @@ var (
 	ErrDuplicateObserver      = errorsmod.Register(ModuleName, 1135, "observer already exists")
 	ErrObserverNotFound       = errorsmod.Register(ModuleName, 1136, "observer not found")
 	ErrInvalidObserverAddress = errorsmod.Register(ModuleName, 1137, "invalid observer address")
+	ErrObserverNotJailed      = errorsmod.Register(ModuleName, 1138, "observer is not jailed")
+	ErrObserverStillJailed    = errorsmod.Register(ModuleName, 1139, "observer jail period is not over")
+	ErrInvalidLivenessParams  = errorsmod.Register(ModuleName, 1140, "invalid liveness params")
 )
@@ -407,6 +407,178 @@ func (m *EventGasPriceIncreaseFlagsUpdated) GetGasPriceIncreaseFlags() *GasPrice
 	return nil
 }
 
+type EventObserverJailed struct {
+	ObserverAddress    string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	MissedVotesCounter int64  `protobuf:"varint,2,opt,name=missed_votes_counter,json=missedVotesCounter,proto3" json:"missed_votes_counter,omitempty"`
+	WindowSize         int64  `protobuf:"varint,3,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	JailedUntil        int64  `protobuf:"varint,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *EventObserverJailed) Reset()         { *m = EventObserverJailed{} }
+func (m *EventObserverJailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverJailed) ProtoMessage()    {}
+func (*EventObserverJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{6}
+}
+func (m *EventObserverJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverJailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverJailed.Merge(m, src)
+}
+func (m *EventObserverJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverJailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverJailed proto.InternalMessageInfo
+
+func (m *EventObserverJailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *EventObserverJailed) GetMissedVotesCounter() int64 {
+	if m != nil {
+		return m.MissedVotesCounter
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetWindowSize() int64 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+type EventObserverUnjailed struct {
+	MsgTypeUrl      string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ObserverAddress string `protobuf:"bytes,2,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+}
+
+func (m *EventObserverUnjailed) Reset()         { *m = EventObserverUnjailed{} }
+func (m *EventObserverUnjailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverUnjailed) ProtoMessage()    {}
+func (*EventObserverUnjailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{7}
+}
+func (m *EventObserverUnjailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverUnjailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverUnjailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverUnjailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverUnjailed.Merge(m, src)
+}
+func (m *EventObserverUnjailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverUnjailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverUnjailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverUnjailed proto.InternalMessageInfo
+
+func (m *EventObserverUnjailed) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventObserverUnjailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+type EventLivenessParamsUpdated struct {
+	MsgTypeUrl     string          `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	LivenessParams *LivenessParams `protobuf:"bytes,2,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params,omitempty"`
+}
+
+func (m *EventLivenessParamsUpdated) Reset()         { *m = EventLivenessParamsUpdated{} }
+func (m *EventLivenessParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventLivenessParamsUpdated) ProtoMessage()    {}
+func (*EventLivenessParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_067e682d8234d605, []int{8}
+}
+func (m *EventLivenessParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLivenessParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLivenessParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLivenessParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLivenessParamsUpdated.Merge(m, src)
+}
+func (m *EventLivenessParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLivenessParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLivenessParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLivenessParamsUpdated proto.InternalMessageInfo
+
+func (m *EventLivenessParamsUpdated) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventLivenessParamsUpdated) GetLivenessParams() *LivenessParams {
+	if m != nil {
+		return m.LivenessParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventBallotCreated)(nil), "zetachain.zetacore.observer.EventBallotCreated")
 	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "zetachain.zetacore.observer.EventKeygenBlockUpdated")
@@ -414,6 +586,9 @@ func init() {
 	proto.RegisterType((*EventCCTXDisabled)(nil), "zetachain.zetacore.observer.EventCCTXDisabled")
 	proto.RegisterType((*EventCCTXEnabled)(nil), "zetachain.zetacore.observer.EventCCTXEnabled")
 	proto.RegisterType((*EventGasPriceIncreaseFlagsUpdated)(nil), "zetachain.zetacore.observer.EventGasPriceIncreaseFlagsUpdated")
+	proto.RegisterType((*EventObserverJailed)(nil), "zetachain.zetacore.observer.EventObserverJailed")
+	proto.RegisterType((*EventObserverUnjailed)(nil), "zetachain.zetacore.observer.EventObserverUnjailed")
+	proto.RegisterType((*EventLivenessParamsUpdated)(nil), "zetachain.zetacore.observer.EventLivenessParamsUpdated")
 }
 
 func init() {
@@ -421,44 +596,53 @@ func init() {
 }
 
 var fileDescriptor_067e682d8234d605 = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
-	0x10, 0x8d, 0xdb, 0x7e, 0x9f, 0x60, 0x53, 0x68, 0x62, 0x51, 0xea, 0x06, 0xc9, 0xb4, 0x91, 0x90,
-	0x4a, 0x0b, 0x8e, 0x14, 0x4e, 0x20, 0x2e, 0x24, 0x84, 0x12, 0x81, 0x68, 0x15, 0xb5, 0x12, 0xe2,
-	0x62, 0xad, 0xed, 0xa9, 0x6d, 0xc5, 0xd9, 0x8d, 0x76, 0xd7, 0x85, 0x70, 0xe7, 0x0a, 0x5c, 0xf9,
-	0x15, 0xfc, 0x0d, 0x8e, 0x3d, 0x72, 0xe0, 0x80, 0x92, 0x3f, 0x82, 0x76, 0xd7, 0x76, 0x82, 0x12,
-	0x45, 0xb9, 0x71, 0x5b, 0xcd, 0xbc, 0xf7, 0xf6, 0xbd, 0x59, 0x7b, 0xd0, 0xc1, 0x47, 0x10, 0xd8,
-	0x8f, 0x70, 0x4c, 0x1a, 0xea, 0x44, 0x19, 0x34, 0xa8, 0xc7, 0x81, 0x5d, 0x02, 0x6b, 0xc0, 0x25,
-	0x10, 0xc1, 0x9d, 0x21, 0xa3, 0x82, 0x9a, 0x77, 0x0a, 0xa4, 0x93, 0x23, 0x9d, 0x1c, 0x59, 0xbb,
-	0x15, 0xd2, 0x90, 0x2a, 0x5c, 0x43, 0x9e, 0x34, 0xa5, 0xd6, 0x5c, 0x26, 0xee, 0x33, 0xca, 0xb9,
-	0x6a, 0xba, 0x17, 0x09, 0x0e, 0xb3, 0x6b, 0x6a, 0x87, 0xcb, 0x38, 0xf9, 0x41, 0x63, 0xeb, 0xbf,
-	0x0c, 0x64, 0x76, 0xa4, 0xc7, 0x16, 0x4e, 0x12, 0x2a, 0xda, 0x0c, 0xb0, 0x80, 0xc0, 0xdc, 0x43,
-	0x9b, 0x03, 0x1e, 0xba, 0x62, 0x34, 0x04, 0x37, 0x65, 0x89, 0x65, 0xec, 0x19, 0x07, 0xd7, 0x7b,
-	0x68, 0xc0, 0xc3, 0xb3, 0xd1, 0x10, 0xce, 0x59, 0x62, 0x1e, 0xa1, 0xaa, 0xa7, 0x28, 0x6e, 0x1c,
-	0x00, 0x11, 0xf1, 0x45, 0x0c, 0xcc, 0x5a, 0x53, 0xb0, 0x8a, 0x6e, 0x74, 0x8b, 0xba, 0x79, 0x1f,
-	0x55, 0xf4, 0xbd, 0x58, 0xc4, 0x94, 0xb8, 0x11, 0xe6, 0x91, 0xb5, 0xae, 0xb0, 0x5b, 0x33, 0xf5,
-	0x97, 0x98, 0x47, 0x52, 0x77, 0x16, 0xaa, 0x62, 0x58, 0x1b, 0x5a, 0x77, 0xa6, 0xd1, 0x96, 0x75,
-	0xf3, 0x2e, 0x2a, 0x67, 0x26, 0xa4, 0x53, 0xeb, 0x3f, 0xed, 0x52, 0x97, 0xa4, 0xd1, 0xfa, 0x27,
-	0x03, 0xed, 0xa8, 0x78, 0xaf, 0x60, 0x14, 0x02, 0x69, 0x25, 0xd4, 0xef, 0x9f, 0x0f, 0x83, 0x15,
-	0x33, 0xee, 0xa3, 0xcd, 0xbe, 0xe2, 0xb9, 0x9e, 0x24, 0x66, 0xf1, 0xca, 0xfd, 0xa9, 0x96, 0x79,
-	0x0f, 0xdd, 0xcc, 0x20, 0xc3, 0xd4, 0xeb, 0xc3, 0x88, 0x67, 0xb9, 0x6e, 0xe8, 0xea, 0xa9, 0x2e,
-	0xd6, 0xbf, 0xad, 0xa1, 0x6d, 0xe5, 0xe3, 0x0d, 0xbc, 0x3f, 0xc9, 0x5e, 0xe0, 0x59, 0x10, 0xac,
-	0xe4, 0xa2, 0x18, 0x1e, 0x30, 0x17, 0x07, 0x01, 0x03, 0xce, 0x33, 0x27, 0x5b, 0x74, 0x2a, 0x25,
-	0xcb, 0xe6, 0x53, 0x54, 0x53, 0x2f, 0x9e, 0xc4, 0x40, 0x84, 0x1b, 0x32, 0x4c, 0x04, 0x40, 0x41,
-	0xd2, 0xce, 0xac, 0x29, 0xe2, 0x58, 0x03, 0x72, 0xf6, 0x13, 0xb4, 0xbb, 0x80, 0xad, 0x73, 0x65,
-	0x4f, 0xb0, 0x33, 0x47, 0xd6, 0x09, 0xcd, 0xc7, 0x68, 0xb7, 0x30, 0x99, 0x60, 0x2e, 0xf4, 0xc4,
-	0x5c, 0x9f, 0xa6, 0x44, 0xa8, 0x77, 0xd9, 0xe8, 0xdd, 0xce, 0x01, 0xaf, 0x31, 0x17, 0x6a, 0x7a,
-	0x6d, 0xd9, 0xad, 0x7f, 0x31, 0x50, 0x55, 0xcd, 0xa6, 0xdd, 0x3e, 0x7b, 0xfb, 0x3c, 0xe6, 0xd8,
-	0x4b, 0x56, 0x9a, 0xcb, 0x21, 0xaa, 0xc4, 0xbc, 0x4b, 0x3c, 0x9a, 0x92, 0xa0, 0x43, 0x14, 0x4b,
-	0xcd, 0xe5, 0x5a, 0x6f, 0xae, 0x6e, 0x3e, 0x40, 0xd5, 0x98, 0x9f, 0xa4, 0xe2, 0x2f, 0xf0, 0xba,
-	0x02, 0xcf, 0x37, 0xea, 0x9f, 0x0d, 0x54, 0x29, 0x1c, 0xe5, 0x12, 0xff, 0xd2, 0xd0, 0x77, 0x03,
-	0xed, 0x2b, 0x43, 0xc7, 0x98, 0x9f, 0xb2, 0xd8, 0x87, 0x2e, 0xf1, 0x19, 0x60, 0x0e, 0x2f, 0xe4,
-	0x6f, 0xbf, 0xfa, 0x07, 0x1d, 0xa1, 0xed, 0x70, 0x91, 0x82, 0xb2, 0x59, 0x6e, 0x36, 0x9d, 0x25,
-	0x0b, 0xca, 0x59, 0x78, 0x77, 0x6f, 0xb1, 0x60, 0xab, 0xf3, 0x63, 0x6c, 0x1b, 0x57, 0x63, 0xdb,
-	0xf8, 0x3d, 0xb6, 0x8d, 0xaf, 0x13, 0xbb, 0x74, 0x35, 0xb1, 0x4b, 0x3f, 0x27, 0x76, 0xe9, 0xdd,
-	0x51, 0x18, 0x8b, 0x28, 0xf5, 0x1c, 0x9f, 0x0e, 0xd4, 0x7a, 0x7a, 0xa8, 0x37, 0x15, 0xa1, 0x01,
-	0x34, 0x3e, 0x4c, 0xf7, 0x94, 0x4c, 0xc1, 0xbd, 0xff, 0xd5, 0x96, 0x7a, 0xf4, 0x27, 0x00, 0x00,
-	0xff, 0xff, 0xfb, 0x7d, 0x42, 0xa2, 0x64, 0x05, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcd, 0x6e, 0x13, 0x3b,
+	0x14, 0xee, 0x34, 0xbd, 0x57, 0xf7, 0x3a, 0xa5, 0x4d, 0x87, 0x96, 0xa6, 0x41, 0x0a, 0xed, 0x48,
+	0x48, 0xa5, 0x85, 0x04, 0xb5, 0x2b, 0x10, 0x1b, 0x1a, 0x42, 0x29, 0x54, 0xb4, 0x1a, 0x1a, 0x84,
+	0xd8, 0x58, 0x9e, 0xcc, 0xe9, 0xc4, 0x64, 0x62, 0x47, 0xb6, 0x93, 0x92, 0xee, 0xd9, 0x02, 0x0b,
+	0x36, 0x3c, 0x05, 0x3b, 0x9e, 0x81, 0x65, 0x97, 0x2c, 0x58, 0xa0, 0xf6, 0x45, 0x90, 0xed, 0xc9,
+	0x9f, 0x1a, 0x45, 0x41, 0x42, 0x62, 0xe7, 0x39, 0xe7, 0xfb, 0x8e, 0xbf, 0xf3, 0x33, 0xc7, 0x68,
+	0xfd, 0x14, 0x14, 0xa9, 0xd6, 0x08, 0x65, 0x45, 0x73, 0xe2, 0x02, 0x8a, 0x3c, 0x90, 0x20, 0xda,
+	0x20, 0x8a, 0xd0, 0x06, 0xa6, 0x64, 0xa1, 0x29, 0xb8, 0xe2, 0xee, 0xf5, 0x1e, 0xb2, 0xd0, 0x45,
+	0x16, 0xba, 0xc8, 0xdc, 0x62, 0xc4, 0x23, 0x6e, 0x70, 0x45, 0x7d, 0xb2, 0x94, 0xdc, 0xd6, 0xb8,
+	0xe0, 0x55, 0xc1, 0xa5, 0x34, 0x4e, 0x7c, 0x1c, 0x93, 0x28, 0xb9, 0x26, 0xb7, 0x31, 0x8e, 0xd3,
+	0x3d, 0x24, 0xd8, 0xed, 0x49, 0xb0, 0x38, 0xa6, 0x6d, 0x60, 0x20, 0x93, 0x0b, 0xbc, 0x1f, 0x0e,
+	0x72, 0xcb, 0x3a, 0xb1, 0x1d, 0x12, 0xc7, 0x5c, 0x95, 0x04, 0x10, 0x05, 0xa1, 0xbb, 0x8a, 0x66,
+	0x1b, 0x32, 0xc2, 0xaa, 0xd3, 0x04, 0xdc, 0x12, 0x71, 0xd6, 0x59, 0x75, 0xd6, 0xff, 0xf7, 0x51,
+	0x43, 0x46, 0x47, 0x9d, 0x26, 0x54, 0x44, 0xec, 0x6e, 0xa2, 0x85, 0xc0, 0x50, 0x30, 0x0d, 0x81,
+	0x29, 0x7a, 0x4c, 0x41, 0x64, 0xa7, 0x0d, 0x2c, 0x63, 0x1d, 0x7b, 0x3d, 0xbb, 0x7b, 0x0b, 0x65,
+	0xac, 0x00, 0xa2, 0x28, 0x67, 0xb8, 0x46, 0x64, 0x2d, 0x9b, 0x32, 0xd8, 0xf9, 0x01, 0xfb, 0x13,
+	0x22, 0x6b, 0x3a, 0xee, 0x20, 0xd4, 0xe4, 0x93, 0x9d, 0xb1, 0x71, 0x07, 0x1c, 0x25, 0x6d, 0x77,
+	0x6f, 0xa0, 0x74, 0x22, 0x42, 0x2b, 0xcd, 0xfe, 0x63, 0x55, 0x5a, 0x93, 0x16, 0xea, 0xbd, 0x73,
+	0xd0, 0xb2, 0x49, 0xef, 0x19, 0x74, 0x22, 0x60, 0x3b, 0x31, 0xaf, 0xd6, 0x2b, 0xcd, 0x70, 0xc2,
+	0x1c, 0xd7, 0xd0, 0x6c, 0xdd, 0xf0, 0x70, 0xa0, 0x89, 0x49, 0x7a, 0xe9, 0x7a, 0x3f, 0x96, 0x7b,
+	0x13, 0xcd, 0x25, 0x90, 0x66, 0x2b, 0xa8, 0x43, 0x47, 0x26, 0x79, 0x5d, 0xb1, 0xd6, 0x43, 0x6b,
+	0xf4, 0x3e, 0x4f, 0xa3, 0x25, 0xa3, 0xe3, 0x39, 0x9c, 0x1c, 0x24, 0xad, 0x78, 0x18, 0x86, 0x13,
+	0xa9, 0xe8, 0x15, 0x0f, 0x04, 0x26, 0x61, 0x28, 0x40, 0xca, 0xec, 0xf4, 0x60, 0xf1, 0x4c, 0x28,
+	0x6d, 0x76, 0x1f, 0xa0, 0x9c, 0x69, 0x7d, 0x4c, 0x81, 0x29, 0x1c, 0x09, 0xc2, 0x14, 0x40, 0x8f,
+	0x64, 0x95, 0x65, 0xfb, 0x88, 0x5d, 0x0b, 0xe8, 0xb2, 0xef, 0xa3, 0x95, 0x11, 0x6c, 0x9b, 0x57,
+	0xd2, 0x82, 0xe5, 0x4b, 0x64, 0x9b, 0xa1, 0x7b, 0x0f, 0xad, 0xf4, 0x47, 0x8c, 0x48, 0x65, 0x2b,
+	0x86, 0xab, 0xbc, 0xc5, 0x94, 0xe9, 0xcb, 0x8c, 0x7f, 0xad, 0x0b, 0xd8, 0x27, 0x52, 0x99, 0xea,
+	0x95, 0xb4, 0xd7, 0xfb, 0xe0, 0xa0, 0x05, 0x53, 0x9b, 0x52, 0xe9, 0xe8, 0xd5, 0x23, 0x2a, 0x49,
+	0x10, 0x4f, 0x54, 0x97, 0x0d, 0x94, 0xa1, 0x72, 0x8f, 0x05, 0xbc, 0xc5, 0xc2, 0x32, 0x33, 0x2c,
+	0x53, 0x97, 0xff, 0xfc, 0x4b, 0x76, 0xf7, 0x36, 0x5a, 0xa0, 0xf2, 0xa0, 0xa5, 0x86, 0xc0, 0x29,
+	0x03, 0xbe, 0xec, 0xf0, 0xde, 0x3b, 0x28, 0xd3, 0x53, 0x54, 0x66, 0x7f, 0x5f, 0xd0, 0x17, 0x07,
+	0xad, 0x19, 0x41, 0xbb, 0x44, 0x1e, 0x0a, 0x5a, 0x85, 0x3d, 0x56, 0x15, 0x40, 0x24, 0x3c, 0xd6,
+	0xbb, 0x62, 0xf2, 0x81, 0xae, 0xa1, 0xa5, 0x68, 0x54, 0x04, 0x23, 0x33, 0xbd, 0xb5, 0x55, 0x18,
+	0xb3, 0xd5, 0x0a, 0x23, 0xef, 0xf6, 0x47, 0x07, 0xf4, 0xbe, 0x3a, 0xe8, 0xaa, 0x51, 0xdc, 0x9d,
+	0xf6, 0xa7, 0x84, 0xea, 0xbc, 0x47, 0x0d, 0xb3, 0x33, 0x7a, 0x98, 0xef, 0xa2, 0xc5, 0x06, 0x95,
+	0x12, 0x42, 0xdc, 0xe6, 0x0a, 0xa4, 0x9d, 0xa5, 0x64, 0xc9, 0xa4, 0x7c, 0xd7, 0xfa, 0x5e, 0x6a,
+	0x57, 0xc9, 0x7a, 0xf4, 0x3a, 0x38, 0xa1, 0x2c, 0xe4, 0x27, 0x58, 0xd2, 0x53, 0x30, 0xe5, 0x4c,
+	0xf9, 0xc8, 0x9a, 0x5e, 0xd0, 0x53, 0xd0, 0x3f, 0xf4, 0x1b, 0xa3, 0x03, 0xb7, 0x98, 0xa2, 0xb1,
+	0x19, 0xea, 0x94, 0x9f, 0xb6, 0xb6, 0x8a, 0x36, 0x79, 0x21, 0x5a, 0x1a, 0xd2, 0x5d, 0x61, 0xd6,
+	0xfb, 0x47, 0x7f, 0x54, 0xef, 0x93, 0x83, 0x72, 0xe6, 0x9a, 0xfd, 0x64, 0x1d, 0x1f, 0x12, 0x41,
+	0x1a, 0xbf, 0xd1, 0xc9, 0x23, 0x34, 0xdf, 0xdd, 0xe4, 0xb8, 0x69, 0xb8, 0x49, 0x0f, 0x37, 0xc7,
+	0xf6, 0x70, 0xf8, 0x3a, 0x7f, 0x2e, 0x1e, 0xfa, 0xde, 0x29, 0x7f, 0x3b, 0xcf, 0x3b, 0x67, 0xe7,
+	0x79, 0xe7, 0xe7, 0x79, 0xde, 0xf9, 0x78, 0x91, 0x9f, 0x3a, 0xbb, 0xc8, 0x4f, 0x7d, 0xbf, 0xc8,
+	0x4f, 0xbd, 0xde, 0x8c, 0xa8, 0xaa, 0xb5, 0x82, 0x42, 0x95, 0x37, 0xcc, 0xeb, 0x72, 0xc7, 0x3e,
+	0x34, 0x8c, 0x87, 0x50, 0x7c, 0xdb, 0x7f, 0x66, 0xb4, 0x62, 0x19, 0xfc, 0x6b, 0xde, 0x96, 0xed,
+	0x5f, 0x03, 0x00, 0xae, 0xba, 0x65, 0xc1, 0x4f, 0x07, 0x00, 0x00,
 }
 
 func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
@@ -761,6 +945,130 @@ func (m *EventGasPriceIncreaseFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *EventObserverJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowSize != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedVotesCounter != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissedVotesCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObserverUnjailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverUnjailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverUnjailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLivenessParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLivenessParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLivenessParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LivenessParams != nil {
+		{
+			size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -905,23 +1213,79 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventObserverJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MissedVotesCounter != 0 {
+		n += 1 + sovEvents(uint64(m.MissedVotesCounter))
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovEvents(uint64(m.WindowSize))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	return n
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventObserverUnjailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
 }
-func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
+
+func (m *EventLivenessParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.LivenessParams != nil {
+		l = m.LivenessParams.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -1098,8 +1462,351 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.BallotType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+			}
+			m.ObserverLastBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1121,7 +1828,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1144,10 +1851,10 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCCTXDisabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCCTXDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1183,10 +1890,10 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1196,29 +1903,17 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsInboundEnabled = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1228,24 +1923,12 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1267,7 +1950,7 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1290,10 +1973,10 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCCTXEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCCTXEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1329,10 +2012,10 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1342,29 +2025,17 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsInboundEnabled = bool(v != 0)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1374,27 +2045,65 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+			m.IsOutboundEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1422,13 +2131,13 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			m.ObserverLastBlockCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1438,11 +2147,28 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1464,7 +2190,7 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
+func (m *EventObserverJailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1487,15 +2213,15 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCCTXDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverJailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCCTXDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverJailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1523,13 +2249,13 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotesCounter", wireType)
 			}
-			var v int
+			m.MissedVotesCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1539,17 +2265,16 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.MissedVotesCounter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
 			}
-			var v int
+			m.WindowSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1559,12 +2284,30 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.WindowSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1586,7 +2329,7 @@ func (m *EventCCTXDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
+func (m *EventObserverUnjailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,10 +2352,10 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCCTXEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverUnjailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCCTXEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverUnjailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1648,10 +2391,10 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1661,32 +2404,24 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.IsOutboundEnabled = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1708,7 +2443,7 @@ func (m *EventCCTXEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventLivenessParamsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1731,10 +2466,10 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLivenessParamsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventGasPriceIncreaseFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLivenessParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1771,7 +2506,7 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1798,10 +2533,10 @@ func (m *EventGasPriceIncreaseFlagsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			if m.LivenessParams == nil {
+				m.LivenessParams = &LivenessParams{}
 			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
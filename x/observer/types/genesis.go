@@ -9,6 +9,7 @@ import (
 
 // DefaultGenesis returns the default observer genesis state
 func DefaultGenesis() *GenesisState {
+	livenessParams := DefaultLivenessParams()
 	return &GenesisState{
 		Ballots:           nil,
 		Observers:         ObserverSet{},
@@ -17,6 +18,7 @@ func DefaultGenesis() *GenesisState {
 		Keygen:            nil,
 		LastObserverCount: nil,
 		ChainNonces:       []ChainNonces{},
+		LivenessParams:    &livenessParams,
 	}
 }
 
@@ -47,6 +49,23 @@ func (gs GenesisState) Validate() error {
 		chainNoncesIndexMap[elem.ChainId] = true
 	}
 
+	// check for invalid liveness params
+	if gs.LivenessParams != nil {
+		if err := gs.LivenessParams.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// Check for duplicated index in observerLiveness
+	observerLivenessIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.ObserverLiveness {
+		if _, ok := observerLivenessIndexMap[elem.ObserverAddress]; ok {
+			return fmt.Errorf("duplicated index for observerLiveness")
+		}
+		observerLivenessIndexMap[elem.ObserverAddress] = true
+	}
+
 	return gs.Observers.Validate()
 }
 
@@ -40,6 +40,8 @@ type GenesisState struct {
 	PendingNonces     []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
 	ChainNonces       []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
 	NonceToCctx       []NonceToCctx         `protobuf:"bytes,15,rep,name=nonce_to_cctx,json=nonceToCctx,proto3" json:"nonce_to_cctx"`
+	LivenessParams    *LivenessParams       `protobuf:"bytes,16,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params,omitempty"`
+	ObserverLiveness  []ObserverLiveness    `protobuf:"bytes,17,rep,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -180,6 +182,20 @@ func (m *GenesisState) GetNonceToCctx() []NonceToCctx {
 	return nil
 }
 
+func (m *GenesisState) GetLivenessParams() *LivenessParams {
+	if m != nil {
+		return m.LivenessParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObserverLiveness() []ObserverLiveness {
+	if m != nil {
+		return m.ObserverLiveness
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.observer.GenesisState")
 }
@@ -189,47 +205,51 @@ func init() {
 }
 
 var fileDescriptor_7679b0952a0823f4 = []byte{
-	// 640 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4e, 0x13, 0x41,
-	0x1c, 0x6e, 0x05, 0x41, 0xa6, 0x40, 0x61, 0xf4, 0x30, 0xc1, 0x64, 0x25, 0x18, 0x63, 0x45, 0xdd,
-	0x25, 0xd5, 0x9b, 0xf1, 0x20, 0x44, 0xd0, 0x88, 0xa8, 0x5b, 0x12, 0x13, 0x0f, 0x6c, 0xa6, 0xd3,
-	0x61, 0xd9, 0xb8, 0x9d, 0x69, 0x76, 0xa6, 0x06, 0x7c, 0x0a, 0x9f, 0xc4, 0xe7, 0xe0, 0xc8, 0xd1,
-	0x93, 0x31, 0xf4, 0x45, 0xcc, 0xfc, 0x6b, 0xbb, 0x3d, 0x0c, 0x7b, 0x9b, 0xfc, 0xe6, 0xfb, 0xbe,
-	0x7c, 0xbf, 0xbf, 0xe0, 0xc9, 0x4f, 0x2a, 0x31, 0x39, 0xc3, 0x19, 0x8b, 0xf4, 0x8b, 0x17, 0x34,
-	0xe2, 0x5d, 0x41, 0x8b, 0x1f, 0xb4, 0x88, 0x52, 0xca, 0xa8, 0xc8, 0x44, 0x38, 0x28, 0xb8, 0xe4,
-	0xf0, 0xfe, 0x18, 0x1a, 0x3a, 0x68, 0xe8, 0xa0, 0x1b, 0xf7, 0x52, 0x9e, 0x72, 0x8d, 0x8b, 0xd4,
-	0xcb, 0x50, 0x36, 0x5a, 0x3e, 0xf5, 0x2e, 0xce, 0x73, 0x2e, 0x2d, 0xf2, 0xb1, 0x17, 0x99, 0xe3,
-	0x3e, 0xb5, 0xc0, 0xd0, 0x07, 0xd4, 0xf1, 0x84, 0x71, 0x46, 0xa8, 0x75, 0xbd, 0xd1, 0xf6, 0xe2,
-	0x0b, 0x2e, 0x84, 0x21, 0x9d, 0xe6, 0x38, 0x15, 0x55, 0x6c, 0x7f, 0xa7, 0x17, 0x29, 0x65, 0x55,
-	0xdc, 0x30, 0xde, 0xa3, 0x09, 0x26, 0x84, 0x0f, 0x99, 0x4b, 0x33, 0xf2, 0xe3, 0x19, 0xa1, 0x89,
-	0xe4, 0x09, 0x21, 0xf2, 0xdc, 0x12, 0xb6, 0x7d, 0x04, 0xf7, 0xa8, 0x62, 0x7b, 0x80, 0x0b, 0xdc,
-	0x77, 0x09, 0xee, 0x78, 0x91, 0x94, 0xf5, 0x32, 0x96, 0x96, 0xcb, 0xf8, 0xc8, 0xc7, 0x90, 0xc2,
-	0xc1, 0x5e, 0xde, 0x00, 0x4b, 0x4e, 0x87, 0xac, 0x27, 0x92, 0x7e, 0x96, 0x16, 0x58, 0x72, 0x6b,
-	0x7c, 0xeb, 0xf7, 0x12, 0x58, 0x3e, 0x30, 0xb3, 0xd6, 0x91, 0x58, 0x52, 0xf8, 0x1a, 0x2c, 0x9a,
-	0xe9, 0x10, 0xa8, 0xbe, 0x39, 0xd7, 0x6a, 0xb4, 0x1f, 0x86, 0x9e, 0xe1, 0x0b, 0x77, 0x35, 0x36,
-	0x76, 0x1c, 0x78, 0x08, 0x96, 0xdc, 0x9f, 0x40, 0xb7, 0x36, 0xeb, 0xad, 0x46, 0xbb, 0xe5, 0x15,
-	0xf8, 0x64, 0x1f, 0x1d, 0x2a, 0x77, 0xe7, 0x2f, 0xff, 0x3e, 0xa8, 0xc5, 0x13, 0x01, 0x18, 0x83,
-	0xa6, 0xea, 0xe4, 0x1b, 0xd3, 0xc8, 0xc3, 0x4c, 0x48, 0x34, 0xa7, 0x4d, 0xf9, 0x35, 0x8f, 0x26,
-	0x9c, 0x78, 0x56, 0x00, 0x7e, 0x05, 0x6b, 0xb3, 0xb3, 0x87, 0xe6, 0xb5, 0xd1, 0x67, 0x5e, 0xd1,
-	0xbd, 0x31, 0x69, 0x5f, 0x71, 0xe2, 0x26, 0x29, 0x07, 0xe0, 0x2b, 0xb0, 0x60, 0x3a, 0x8d, 0x6e,
-	0x6b, 0x39, 0x7f, 0xe1, 0x3e, 0x6b, 0x68, 0x6c, 0x29, 0x8a, 0x6c, 0xa6, 0x1b, 0x2d, 0x54, 0x20,
-	0x7f, 0xd0, 0xd0, 0xd8, 0x52, 0xe0, 0x09, 0xb8, 0x9b, 0x63, 0x21, 0x13, 0xf7, 0x9f, 0xe8, 0x6c,
-	0xd1, 0xa2, 0x56, 0x0a, 0xbd, 0x4a, 0x87, 0x58, 0x48, 0xd7, 0x82, 0x3d, 0x5d, 0xb0, 0xf5, 0x7c,
-	0x36, 0x04, 0x4f, 0xc0, 0xba, 0xa9, 0x96, 0x31, 0x9b, 0xe4, 0xaa, 0x11, 0x77, 0xaa, 0xd4, 0x4c,
-	0xc5, 0x4d, 0xa6, 0xaa, 0xf6, 0xb6, 0xc1, 0x4d, 0x52, 0x0e, 0xc3, 0x36, 0x98, 0x93, 0x42, 0xa0,
-	0x25, 0xad, 0xb8, 0xe9, 0x55, 0x3c, 0xee, 0x74, 0x62, 0x05, 0x86, 0x07, 0xa0, 0xa1, 0x86, 0xfa,
-	0x2c, 0x13, 0x92, 0x17, 0x17, 0x08, 0xe8, 0xb1, 0xb8, 0x91, 0x6b, 0x1d, 0x00, 0x29, 0xc4, 0x3b,
-	0xc3, 0x84, 0x3d, 0x00, 0xdd, 0x76, 0x8c, 0x97, 0x43, 0xa0, 0x86, 0xd6, 0xdb, 0xf1, 0xeb, 0x09,
-	0xb1, 0x3f, 0x64, 0xbd, 0x8f, 0x96, 0xf4, 0x9e, 0x9d, 0x72, 0xab, 0xbf, 0x26, 0xcb, 0x5f, 0xca,
-	0x2e, 0xd0, 0xa7, 0xd4, 0xd4, 0x6e, 0x59, 0xab, 0x6f, 0xf9, 0x37, 0x4b, 0xc1, 0xdd, 0x4a, 0x68,
-	0xae, 0x1d, 0xdf, 0xd5, 0xf2, 0x95, 0x40, 0x2b, 0x5a, 0x6c, 0xdb, 0x3f, 0x6d, 0x86, 0x72, 0xa4,
-	0x19, 0x56, 0x74, 0x65, 0x30, 0x1d, 0x84, 0x5f, 0xc0, 0xf2, 0xf4, 0x0d, 0x47, 0xab, 0x15, 0x16,
-	0x4d, 0xf7, 0xb7, 0x24, 0xda, 0x20, 0x93, 0x10, 0x8c, 0xc1, 0x4a, 0xe9, 0xb0, 0xa2, 0x66, 0xa5,
-	0xe5, 0x65, 0x84, 0x1e, 0xf3, 0x3d, 0x22, 0xcf, 0x9d, 0x26, 0x9b, 0x0a, 0xbd, 0xbd, 0xbc, 0x0e,
-	0xea, 0x57, 0xd7, 0x41, 0xfd, 0xdf, 0x75, 0x50, 0xff, 0x35, 0x0a, 0x6a, 0x57, 0xa3, 0xa0, 0xf6,
-	0x67, 0x14, 0xd4, 0xbe, 0x3d, 0x4d, 0x33, 0x79, 0x36, 0xec, 0x86, 0x84, 0xf7, 0xf5, 0x05, 0x7c,
-	0x6e, 0x8e, 0xa1, 0xda, 0xff, 0xe8, 0x7c, 0xea, 0x14, 0x5e, 0x0c, 0xa8, 0xe8, 0x2e, 0xe8, 0xf3,
-	0xf7, 0xe2, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xb9, 0x0b, 0x93, 0x85, 0x07, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x4f, 0x13, 0x41,
+	0x14, 0x6d, 0x2d, 0x82, 0x4c, 0x81, 0xd2, 0xd1, 0x87, 0x09, 0x26, 0xb5, 0xc1, 0x18, 0x2b, 0xc8,
+	0x96, 0x14, 0xdf, 0x8c, 0x0f, 0x42, 0x04, 0x8d, 0x88, 0xba, 0x25, 0x31, 0xf1, 0x81, 0x75, 0x3a,
+	0x1d, 0x96, 0x8d, 0xdb, 0x99, 0x66, 0x67, 0x4a, 0xc0, 0x5f, 0xe1, 0xcf, 0xe2, 0x91, 0x47, 0x9f,
+	0x8c, 0x81, 0xc4, 0xdf, 0x61, 0xe6, 0xab, 0x65, 0x6b, 0x32, 0xec, 0xdb, 0xf6, 0xf6, 0x9c, 0x93,
+	0x3b, 0xf7, 0x9e, 0x7b, 0xc0, 0xb3, 0x1f, 0x54, 0x62, 0x72, 0x82, 0x13, 0xd6, 0xd6, 0x5f, 0x3c,
+	0xa3, 0x6d, 0xde, 0x13, 0x34, 0x3b, 0xa5, 0x59, 0x3b, 0xa6, 0x8c, 0x8a, 0x44, 0x04, 0xc3, 0x8c,
+	0x4b, 0x0e, 0x1f, 0x8e, 0xa1, 0x81, 0x83, 0x06, 0x0e, 0xba, 0xf2, 0x20, 0xe6, 0x31, 0xd7, 0xb8,
+	0xb6, 0xfa, 0x32, 0x94, 0x95, 0x96, 0x4f, 0xbd, 0x87, 0xd3, 0x94, 0x4b, 0x8b, 0x7c, 0xea, 0x45,
+	0xa6, 0x78, 0x40, 0x2d, 0x30, 0xf0, 0x01, 0x75, 0x3d, 0x62, 0x9c, 0x11, 0x6a, 0xbb, 0x5e, 0xe9,
+	0x78, 0xf1, 0x19, 0x17, 0xc2, 0x90, 0x8e, 0x53, 0x1c, 0x8b, 0x22, 0x6d, 0x7f, 0xa7, 0xe7, 0x31,
+	0x65, 0x45, 0xba, 0x61, 0xbc, 0x4f, 0x23, 0x4c, 0x08, 0x1f, 0x31, 0xf7, 0xcc, 0xb6, 0x1f, 0xcf,
+	0x08, 0x8d, 0x24, 0x8f, 0x08, 0x91, 0x67, 0x96, 0xb0, 0xe6, 0x23, 0xb8, 0x0f, 0x8b, 0xdd, 0x2a,
+	0x82, 0x8d, 0xd2, 0xe4, 0x94, 0x32, 0x2a, 0x0a, 0xbd, 0x75, 0x88, 0x33, 0x3c, 0x70, 0xc8, 0x4d,
+	0x2f, 0x92, 0xb2, 0x7e, 0xc2, 0xe2, 0xfc, 0xec, 0x9f, 0xf8, 0x18, 0x72, 0xdc, 0xc2, 0x8b, 0x5b,
+	0x60, 0xd1, 0xf1, 0x88, 0xf5, 0x45, 0x34, 0x48, 0xe2, 0x0c, 0x4b, 0x6e, 0x5f, 0xbb, 0xfa, 0x17,
+	0x80, 0x85, 0x3d, 0x63, 0xd0, 0xae, 0xc4, 0x92, 0xc2, 0x57, 0x60, 0xce, 0x58, 0x4a, 0xa0, 0x72,
+	0xb3, 0xd2, 0xaa, 0x76, 0x1e, 0x07, 0x1e, 0xc7, 0x06, 0xdb, 0x1a, 0x1b, 0x3a, 0x0e, 0xdc, 0x07,
+	0xf3, 0xee, 0x3f, 0x81, 0xee, 0x34, 0xcb, 0xad, 0x6a, 0xa7, 0xe5, 0x15, 0xf8, 0x68, 0x3f, 0xba,
+	0x54, 0x6e, 0xcf, 0x5c, 0xfc, 0x7e, 0x54, 0x0a, 0x27, 0x02, 0x30, 0x04, 0x35, 0xb5, 0xfe, 0xd7,
+	0x66, 0xfb, 0xfb, 0x89, 0x90, 0xa8, 0xd2, 0xac, 0xdc, 0xaa, 0x79, 0x30, 0xe1, 0x84, 0xd3, 0x02,
+	0xf0, 0x0b, 0x58, 0x9e, 0x36, 0x2c, 0x9a, 0xd1, 0x8d, 0x3e, 0xf7, 0x8a, 0xee, 0x8c, 0x49, 0xbb,
+	0x8a, 0x13, 0xd6, 0x48, 0xbe, 0x00, 0x5f, 0x82, 0x59, 0xb3, 0x69, 0x74, 0xb7, 0x59, 0xbe, 0x75,
+	0x70, 0x9f, 0x34, 0x34, 0xb4, 0x14, 0x45, 0x36, 0x27, 0x81, 0x66, 0x0b, 0x90, 0xdf, 0x6b, 0x68,
+	0x68, 0x29, 0xf0, 0x08, 0xdc, 0x4f, 0xb1, 0x90, 0xd1, 0xd8, 0x9d, 0xfa, 0xb5, 0x68, 0x4e, 0x2b,
+	0x05, 0x5e, 0xa5, 0x7d, 0x2c, 0xa4, 0x5b, 0xc1, 0x8e, 0x1e, 0x58, 0x3d, 0x9d, 0x2e, 0xc1, 0x23,
+	0x50, 0x37, 0xd3, 0x32, 0xcd, 0x46, 0xa9, 0x5a, 0xc4, 0xbd, 0x22, 0x33, 0x53, 0x75, 0xf3, 0x52,
+	0x35, 0x7b, 0xbb, 0xe0, 0x1a, 0xc9, 0x97, 0x61, 0x07, 0x54, 0xa4, 0x10, 0x68, 0x5e, 0x2b, 0x36,
+	0xbd, 0x8a, 0x87, 0xdd, 0x6e, 0xa8, 0xc0, 0x70, 0x0f, 0x54, 0x95, 0xa9, 0x4f, 0x12, 0x21, 0x79,
+	0x76, 0x8e, 0x40, 0xb3, 0x52, 0x84, 0x6b, 0x3b, 0x00, 0x52, 0x88, 0xb7, 0x86, 0x09, 0xfb, 0x00,
+	0xba, 0xeb, 0x18, 0x1f, 0x87, 0x40, 0x55, 0xad, 0xb7, 0xe9, 0xd7, 0x13, 0x62, 0x77, 0xc4, 0xfa,
+	0x1f, 0x2c, 0xe9, 0x1d, 0x3b, 0xe6, 0x56, 0x7f, 0x59, 0xe6, 0xff, 0x52, 0xed, 0x02, 0x9d, 0xbf,
+	0x66, 0x76, 0x0b, 0x5a, 0x7d, 0xd5, 0x7f, 0x59, 0x0a, 0xee, 0x4e, 0x42, 0x73, 0xad, 0x7d, 0x97,
+	0xf2, 0x29, 0x81, 0x16, 0xb5, 0xd8, 0x9a, 0xdf, 0x6d, 0x86, 0x72, 0xa0, 0x19, 0x56, 0x74, 0x71,
+	0x78, 0xb3, 0x08, 0x3f, 0x83, 0x85, 0x9b, 0xc1, 0x8f, 0x96, 0x0a, 0x1c, 0x9a, 0xde, 0x6f, 0x4e,
+	0xb4, 0x4a, 0x26, 0x25, 0x18, 0x82, 0xc5, 0x5c, 0x1a, 0xa3, 0x5a, 0xa1, 0xe3, 0x65, 0x84, 0x1e,
+	0xf2, 0x1d, 0x22, 0xcf, 0x9c, 0x26, 0x9b, 0x94, 0xe0, 0x21, 0xa8, 0xb9, 0xec, 0xb5, 0x76, 0x44,
+	0xcb, 0xda, 0x37, 0xeb, 0x7e, 0x9f, 0x5b, 0x8e, 0x3d, 0xbb, 0xa5, 0x34, 0xf7, 0x1b, 0x7e, 0x03,
+	0xf5, 0xff, 0xa2, 0x1d, 0xd5, 0x75, 0xb7, 0x1b, 0x85, 0xe2, 0xcb, 0xe9, 0x3b, 0x03, 0xf0, 0xe9,
+	0xfa, 0x9b, 0x8b, 0xab, 0x46, 0xf9, 0xf2, 0xaa, 0x51, 0xfe, 0x73, 0xd5, 0x28, 0xff, 0xbc, 0x6e,
+	0x94, 0x2e, 0xaf, 0x1b, 0xa5, 0x5f, 0xd7, 0x8d, 0xd2, 0xd7, 0xf5, 0x38, 0x91, 0x27, 0xa3, 0x5e,
+	0x40, 0xf8, 0x40, 0x27, 0xf7, 0x86, 0x09, 0x71, 0x95, 0x5b, 0xed, 0xb3, 0x1b, 0x11, 0x7e, 0x3e,
+	0xa4, 0xa2, 0x37, 0xab, 0x63, 0x7b, 0xeb, 0xdf, 0x00, 0x3d, 0x14, 0x8d, 0x5f, 0x72, 0x08, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -252,6 +272,36 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ObserverLiveness) > 0 {
+		for iNdEx := len(m.ObserverLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.LivenessParams != nil {
+		{
+			size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.NonceToCctx) > 0 {
 		for iNdEx := len(m.NonceToCctx) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -536,6 +586,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.LivenessParams != nil {
+		l = m.LivenessParams.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ObserverLiveness) > 0 {
+		for _, e := range m.ObserverLiveness {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1092,6 +1152,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LivenessParams == nil {
+				m.LivenessParams = &LivenessParams{}
+			}
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverLiveness = append(m.ObserverLiveness, ObserverLiveness{})
+			if err := m.ObserverLiveness[len(m.ObserverLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
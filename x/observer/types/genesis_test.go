@@ -22,6 +22,13 @@ func TestGenesisState_Validate(t *testing.T) {
 	chainNonce := sample.ChainNonces(0)
 	gsWithDuplicateChainNonces.ChainNonces = []types.ChainNonces{chainNonce, chainNonce}
 
+	gsWithInvalidLivenessParams := types.DefaultGenesis()
+	gsWithInvalidLivenessParams.LivenessParams.WindowSize = 0
+
+	gsWithDuplicateObserverLiveness := types.DefaultGenesis()
+	observerLiveness := sample.ObserverLiveness(sample.AccAddress())
+	gsWithDuplicateObserverLiveness.ObserverLiveness = []types.ObserverLiveness{observerLiveness, observerLiveness}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -52,6 +59,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: gsWithDuplicateChainNonces,
 			valid:    false,
 		},
+		{
+			desc:     "invalid genesis state invalid liveness params",
+			genState: gsWithInvalidLivenessParams,
+			valid:    false,
+		},
+		{
+			desc:     "invalid genesis state duplicate observer liveness",
+			genState: gsWithDuplicateObserverLiveness,
+			valid:    false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -80,6 +80,12 @@ const (
 	NonceToCctxKeyPrefix   = "NonceToCctx-value-"
 
 	ParamsKey = "Params-value-"
+
+	// LivenessParamsKey is the key for the observer liveness params
+	LivenessParamsKey = "LivenessParams-value-"
+
+	// ObserverLivenessKey is the key prefix for the missed votes of each observer
+	ObserverLivenessKey = "ObserverLiveness-value-"
 )
 
 func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) string {
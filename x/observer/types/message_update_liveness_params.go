@@ -0,0 +1,52 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgUpdateLivenessParams = "update_liveness_params"
+)
+
+var _ sdk.Msg = &MsgUpdateLivenessParams{}
+
+func NewMsgUpdateLivenessParams(creator string, params LivenessParams) *MsgUpdateLivenessParams {
+	return &MsgUpdateLivenessParams{
+		Creator:        creator,
+		LivenessParams: params,
+	}
+}
+
+func (msg *MsgUpdateLivenessParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateLivenessParams) Type() string {
+	return TypeMsgUpdateLivenessParams
+}
+
+func (msg *MsgUpdateLivenessParams) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateLivenessParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateLivenessParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if err := msg.LivenessParams.Validate(); err != nil {
+		return cosmoserrors.Wrap(ErrInvalidLivenessParams, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultLivenessParams returns the default liveness params used when not defined
+// Liveness tracking is disabled by default and must be enabled by the operational policy
+func DefaultLivenessParams() LivenessParams {
+	return LivenessParams{
+		Enabled: false,
+
+		// WindowSize is the number of finalized ballots tracked for each observer
+		WindowSize: 1000,
+
+		// MaxMissedRatio is the ratio of missed votes in the window above which an observer is jailed
+		MaxMissedRatio: sdk.MustNewDecFromStr("0.5"),
+
+		// GracePeriodBlocks is the number of blocks observers have to vote on a ballot
+		GracePeriodBlocks: 100,
+
+		// JailDurationBlocks is the number of blocks an observer stays jailed, approximately one day
+		JailDurationBlocks: 14400,
+	}
+}
+
+// Validate checks the liveness params are valid
+func (lp LivenessParams) Validate() error {
+	if lp.WindowSize <= 0 {
+		return errors.New("window size must be positive")
+	}
+	if lp.MaxMissedRatio.IsNil() || !lp.MaxMissedRatio.IsPositive() || lp.MaxMissedRatio.GT(sdk.OneDec()) {
+		return errors.New("max missed ratio must be in the range (0, 1]")
+	}
+	if lp.GracePeriodBlocks <= 0 {
+		return errors.New("grace period blocks must be positive")
+	}
+	if lp.JailDurationBlocks <= 0 {
+		return errors.New("jail duration blocks must be positive")
+	}
+	return nil
+}
+
+// MinActiveObservers returns the minimum number of active observers for an observer set of the given size,
+// two thirds of the set rounded up and at least one. Observers are not jailed below this number so the ballots
+// keep a quorum of the full observer set.
+func MinActiveObservers(observerCount int) int {
+	return max((2*observerCount+2)/3, 1)
+}
+
+// NewObserverLiveness returns a new liveness record with an empty window for the observer
+func NewObserverLiveness(observerAddress string) ObserverLiveness {
+	return ObserverLiveness{
+		ObserverAddress: observerAddress,
+	}
+}
+
+// RecordVote records in the sliding window of size windowSize whether the observer missed the vote on a ballot
+func (m *ObserverLiveness) RecordVote(windowSize int64, missed bool) {
+	if windowSize <= 0 {
+		return
+	}
+
+	// the bit array is resized if the window size has been updated
+	bitArraySize := (windowSize + 7) / 8
+	if int64(len(m.MissedVotes)) != bitArraySize {
+		m.ResetWindow()
+		m.MissedVotes = make([]byte, bitArraySize)
+	}
+
+	index := m.IndexOffset % windowSize
+	m.IndexOffset++
+
+	previous := m.missedVoteAt(index)
+	switch {
+	case !previous && missed:
+		m.MissedVotes[index/8] |= 1 << (index % 8)
+		m.MissedVotesCounter++
+	case previous && !missed:
+		m.MissedVotes[index/8] &^= 1 << (index % 8)
+		m.MissedVotesCounter--
+	}
+}
+
+// ResetWindow clears the sliding window of missed votes
+func (m *ObserverLiveness) ResetWindow() {
+	m.IndexOffset = 0
+	m.MissedVotesCounter = 0
+	for i := range m.MissedVotes {
+		m.MissedVotes[i] = 0
+	}
+}
+
+// TotalVotes returns the number of ballots accounted in the current window
+func (m ObserverLiveness) TotalVotes(windowSize int64) int64 {
+	if m.IndexOffset < windowSize {
+		return m.IndexOffset
+	}
+	return windowSize
+}
+
+// MissedRatio returns the ratio of missed votes in the current window
+func (m ObserverLiveness) MissedRatio(windowSize int64) sdk.Dec {
+	total := m.TotalVotes(windowSize)
+	if total <= 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDec(m.MissedVotesCounter).QuoInt64(total)
+}
+
+// ShouldBeJailed returns true if the window is complete and the ratio of missed votes exceeds the maximum ratio
+func (m ObserverLiveness) ShouldBeJailed(params LivenessParams) bool {
+	if m.Jailed || m.IndexOffset < params.WindowSize {
+		return false
+	}
+	return m.MissedRatio(params.WindowSize).GT(params.MaxMissedRatio)
+}
+
+// Info returns the summary of the liveness of the observer
+func (m ObserverLiveness) Info(windowSize int64) ObserverLivenessInfo {
+	return ObserverLivenessInfo{
+		ObserverAddress: m.ObserverAddress,
+		MissedVotes:     m.MissedVotesCounter,
+		TotalVotes:      m.TotalVotes(windowSize),
+		MissedRatio:     m.MissedRatio(windowSize),
+		Jailed:          m.Jailed,
+		JailedUntil:     m.JailedUntil,
+	}
+}
+
+func (m ObserverLiveness) missedVoteAt(index int64) bool {
+	return m.MissedVotes[index/8]&(1<<(index%8)) != 0
+}
@@ -0,0 +1,861 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: zetachain/zetacore/observer/observer_liveness.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LivenessParams defines how observers voting activity is tracked and when
+// observers that miss too many votes are jailed
+type LivenessParams struct {
+	// enable the tracking of missed votes and the jailing of observers
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// number of finalized ballots tracked in the sliding window of each observer
+	WindowSize int64 `protobuf:"varint,2,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// ratio of missed votes in the window above which an observer is jailed
+	MaxMissedRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_missed_ratio,json=maxMissedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_missed_ratio"`
+	// number of blocks after the creation of a ballot before the votes are
+	// accounted, observers that haven't voted after this period missed the vote
+	GracePeriodBlocks int64 `protobuf:"varint,4,opt,name=grace_period_blocks,json=gracePeriodBlocks,proto3" json:"grace_period_blocks,omitempty"`
+	// number of blocks an observer remains jailed before it can be unjailed
+	JailDurationBlocks int64 `protobuf:"varint,5,opt,name=jail_duration_blocks,json=jailDurationBlocks,proto3" json:"jail_duration_blocks,omitempty"`
+}
+
+func (m *LivenessParams) Reset()         { *m = LivenessParams{} }
+func (m *LivenessParams) String() string { return proto.CompactTextString(m) }
+func (*LivenessParams) ProtoMessage()    {}
+func (*LivenessParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75c1e27cc283ff08, []int{0}
+}
+func (m *LivenessParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LivenessParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LivenessParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LivenessParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LivenessParams.Merge(m, src)
+}
+func (m *LivenessParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *LivenessParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LivenessParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LivenessParams proto.InternalMessageInfo
+
+func (m *LivenessParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *LivenessParams) GetWindowSize() int64 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *LivenessParams) GetGracePeriodBlocks() int64 {
+	if m != nil {
+		return m.GracePeriodBlocks
+	}
+	return 0
+}
+
+func (m *LivenessParams) GetJailDurationBlocks() int64 {
+	if m != nil {
+		return m.JailDurationBlocks
+	}
+	return 0
+}
+
+// ObserverLiveness tracks the votes missed by an observer in the sliding window
+type ObserverLiveness struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	// total number of ballots accounted since the window was last reset
+	IndexOffset int64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// number of votes missed in the current window
+	MissedVotesCounter int64 `protobuf:"varint,3,opt,name=missed_votes_counter,json=missedVotesCounter,proto3" json:"missed_votes_counter,omitempty"`
+	// bit array of the votes missed in the current window
+	MissedVotes []byte `protobuf:"bytes,4,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`
+	Jailed      bool   `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// height from which the observer can be unjailed
+	JailedUntil int64 `protobuf:"varint,6,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *ObserverLiveness) Reset()         { *m = ObserverLiveness{} }
+func (m *ObserverLiveness) String() string { return proto.CompactTextString(m) }
+func (*ObserverLiveness) ProtoMessage()    {}
+func (*ObserverLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75c1e27cc283ff08, []int{1}
+}
+func (m *ObserverLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverLiveness.Merge(m, src)
+}
+func (m *ObserverLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverLiveness proto.InternalMessageInfo
+
+func (m *ObserverLiveness) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverLiveness) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetMissedVotesCounter() int64 {
+	if m != nil {
+		return m.MissedVotesCounter
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetMissedVotes() []byte {
+	if m != nil {
+		return m.MissedVotes
+	}
+	return nil
+}
+
+func (m *ObserverLiveness) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ObserverLiveness) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LivenessParams)(nil), "zetachain.zetacore.observer.LivenessParams")
+	proto.RegisterType((*ObserverLiveness)(nil), "zetachain.zetacore.observer.ObserverLiveness")
+}
+
+func init() {
+	proto.RegisterFile("zetachain/zetacore/observer/observer_liveness.proto", fileDescriptor_75c1e27cc283ff08)
+}
+
+var fileDescriptor_75c1e27cc283ff08 = []byte{
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x52, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0xcd, 0x36, 0x10, 0x5a, 0xa7, 0x2a, 0xc1, 0x54, 0x68, 0x05, 0xd2, 0x26, 0xf4, 0x80, 0x82,
+	0x50, 0x77, 0x91, 0xfa, 0x05, 0x84, 0x72, 0x03, 0xb5, 0x5a, 0x04, 0x42, 0x5c, 0x2c, 0x67, 0x3d,
+	0x4d, 0x4d, 0x77, 0xed, 0xc8, 0x76, 0xd2, 0x90, 0x1f, 0xe0, 0xca, 0x67, 0xf5, 0xd8, 0x23, 0xe2,
+	0x50, 0xa1, 0xe4, 0x07, 0xf8, 0x04, 0xe4, 0xf1, 0x6e, 0xc8, 0xc9, 0xe3, 0xf7, 0xe6, 0x8d, 0x66,
+	0xde, 0x0c, 0x39, 0x59, 0x82, 0xe3, 0xc5, 0x25, 0x97, 0x2a, 0xc3, 0x48, 0x1b, 0xc8, 0xf4, 0xd8,
+	0x82, 0x99, 0x83, 0xd9, 0x04, 0xac, 0x94, 0x73, 0x50, 0x60, 0x6d, 0x3a, 0x35, 0xda, 0x69, 0xfa,
+	0x6c, 0x23, 0x4a, 0x1b, 0x51, 0xda, 0xe4, 0x3e, 0x3d, 0x9c, 0xe8, 0x89, 0xc6, 0xbc, 0xcc, 0x47,
+	0x41, 0x72, 0xf4, 0x63, 0x87, 0x1c, 0xbc, 0xaf, 0xab, 0x9c, 0x73, 0xc3, 0x2b, 0x4b, 0x63, 0xf2,
+	0x00, 0x14, 0x1f, 0x97, 0x20, 0xe2, 0x68, 0x10, 0x0d, 0x77, 0xf3, 0xe6, 0x4b, 0xfb, 0xa4, 0x7b,
+	0x2d, 0x95, 0xd0, 0xd7, 0xcc, 0xca, 0x25, 0xc4, 0x3b, 0x83, 0x68, 0xd8, 0xce, 0x49, 0x80, 0x3e,
+	0xca, 0x25, 0xd0, 0x2f, 0xa4, 0x57, 0xf1, 0x05, 0xab, 0xa4, 0xb5, 0x20, 0x98, 0xe1, 0x4e, 0xea,
+	0xb8, 0x3d, 0x88, 0x86, 0x7b, 0xa3, 0xf4, 0xe6, 0xae, 0xdf, 0xfa, 0x7d, 0xd7, 0x7f, 0x31, 0x91,
+	0xee, 0x72, 0x36, 0x4e, 0x0b, 0x5d, 0x65, 0x85, 0xb6, 0x95, 0xb6, 0xf5, 0x73, 0x6c, 0xc5, 0x55,
+	0xe6, 0xbe, 0x4f, 0xc1, 0xa6, 0xa7, 0x50, 0xe4, 0x07, 0x15, 0x5f, 0x7c, 0xc0, 0x32, 0xb9, 0xaf,
+	0x42, 0x53, 0xf2, 0x78, 0x62, 0x78, 0x01, 0x6c, 0x0a, 0x46, 0x6a, 0xc1, 0xc6, 0xa5, 0x2e, 0xae,
+	0x6c, 0x7c, 0x0f, 0x5b, 0x78, 0x84, 0xd4, 0x39, 0x32, 0x23, 0x24, 0xe8, 0x6b, 0x72, 0xf8, 0x8d,
+	0xcb, 0x92, 0x89, 0x19, 0x76, 0xa1, 0x1a, 0xc1, 0x7d, 0x14, 0x50, 0xcf, 0x9d, 0xd6, 0x54, 0x50,
+	0x1c, 0xfd, 0x8d, 0x48, 0xef, 0xac, 0x36, 0xab, 0x71, 0x84, 0xbe, 0x24, 0xbd, 0x8d, 0xd9, 0x5c,
+	0x08, 0x03, 0xd6, 0xa2, 0x29, 0x7b, 0xf9, 0xc3, 0x06, 0x7f, 0x13, 0x60, 0xfa, 0x9c, 0xec, 0x4b,
+	0x25, 0x60, 0xc1, 0xf4, 0xc5, 0x85, 0x05, 0x57, 0xbb, 0xd3, 0x45, 0xec, 0x0c, 0x21, 0xdf, 0x54,
+	0x6d, 0xcd, 0x5c, 0x3b, 0xb0, 0xac, 0xd0, 0x33, 0xe5, 0xc0, 0xa0, 0x45, 0xed, 0x9c, 0x06, 0xee,
+	0xb3, 0xa7, 0xde, 0x06, 0xc6, 0x17, 0xdd, 0x56, 0xe0, 0xbc, 0xfb, 0x79, 0x77, 0x2b, 0x93, 0x3e,
+	0x21, 0x1d, 0x3f, 0x0d, 0x08, 0x9c, 0x6d, 0x37, 0xaf, 0x7f, 0x5e, 0x1a, 0x22, 0x36, 0x53, 0x4e,
+	0x96, 0x71, 0x27, 0xf4, 0x13, 0xb0, 0x4f, 0x1e, 0x1a, 0xbd, 0xbb, 0x59, 0x25, 0xd1, 0xed, 0x2a,
+	0x89, 0xfe, 0xac, 0x92, 0xe8, 0xe7, 0x3a, 0x69, 0xdd, 0xae, 0x93, 0xd6, 0xaf, 0x75, 0xd2, 0xfa,
+	0xfa, 0x6a, 0x6b, 0x4d, 0xfe, 0x94, 0x8e, 0xc3, 0x29, 0x2a, 0x2d, 0x20, 0x5b, 0xfc, 0x3f, 0x44,
+	0xdc, 0xd7, 0xb8, 0x83, 0xa7, 0x74, 0xf2, 0x6f, 0x00, 0x44, 0x51, 0xee, 0x28, 0xb4, 0x02, 0x00,
+	0x00,
+}
+
+func (m *LivenessParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LivenessParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LivenessParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailDurationBlocks != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.JailDurationBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GracePeriodBlocks != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.GracePeriodBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxMissedRatio.Size()
+		i -= size
+		if _, err := m.MaxMissedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowSize != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObserverLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailedUntil != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MissedVotes) > 0 {
+		i -= len(m.MissedVotes)
+		copy(dAtA[i:], m.MissedVotes)
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(len(m.MissedVotes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MissedVotesCounter != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.MissedVotesCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintObserverLiveness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovObserverLiveness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LivenessParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.WindowSize))
+	}
+	l = m.MaxMissedRatio.Size()
+	n += 1 + l + sovObserverLiveness(uint64(l))
+	if m.GracePeriodBlocks != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.GracePeriodBlocks))
+	}
+	if m.JailDurationBlocks != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.JailDurationBlocks))
+	}
+	return n
+}
+
+func (m *ObserverLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovObserverLiveness(uint64(l))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.IndexOffset))
+	}
+	if m.MissedVotesCounter != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.MissedVotesCounter))
+	}
+	l = len(m.MissedVotes)
+	if l > 0 {
+		n += 1 + l + sovObserverLiveness(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.JailedUntil))
+	}
+	return n
+}
+
+func sovObserverLiveness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozObserverLiveness(x uint64) (n int) {
+	return sovObserverLiveness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LivenessParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserverLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LivenessParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LivenessParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+			}
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMissedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodBlocks", wireType)
+			}
+			m.GracePeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriodBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDurationBlocks", wireType)
+			}
+			m.JailDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserverLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObserverLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserverLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotesCounter", wireType)
+			}
+			m.MissedVotesCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotesCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedVotes = append(m.MissedVotes[:0], dAtA[iNdEx:postIndex]...)
+			if m.MissedVotes == nil {
+				m.MissedVotes = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserverLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObserverLiveness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObserverLiveness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObserverLiveness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupObserverLiveness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthObserverLiveness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthObserverLiveness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObserverLiveness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupObserverLiveness = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,178 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/x/observer/types"
+)
+
+func TestLivenessParams_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		update      func(*types.LivenessParams)
+		errContains string
+	}{
+		{
+			name:   "default params are valid",
+			update: func(*types.LivenessParams) {},
+		},
+		{
+			name:        "invalid window size",
+			update:      func(lp *types.LivenessParams) { lp.WindowSize = 0 },
+			errContains: "window size must be positive",
+		},
+		{
+			name:        "nil max missed ratio",
+			update:      func(lp *types.LivenessParams) { lp.MaxMissedRatio = sdk.Dec{} },
+			errContains: "max missed ratio must be in the range (0, 1]",
+		},
+		{
+			name:        "zero max missed ratio",
+			update:      func(lp *types.LivenessParams) { lp.MaxMissedRatio = sdk.ZeroDec() },
+			errContains: "max missed ratio must be in the range (0, 1]",
+		},
+		{
+			name:        "max missed ratio above one",
+			update:      func(lp *types.LivenessParams) { lp.MaxMissedRatio = sdk.MustNewDecFromStr("1.1") },
+			errContains: "max missed ratio must be in the range (0, 1]",
+		},
+		{
+			name:        "invalid grace period",
+			update:      func(lp *types.LivenessParams) { lp.GracePeriodBlocks = 0 },
+			errContains: "grace period blocks must be positive",
+		},
+		{
+			name:        "zero jail duration",
+			update:      func(lp *types.LivenessParams) { lp.JailDurationBlocks = 0 },
+			errContains: "jail duration blocks must be positive",
+		},
+		{
+			name:        "negative jail duration",
+			update:      func(lp *types.LivenessParams) { lp.JailDurationBlocks = -1 },
+			errContains: "jail duration blocks must be positive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.DefaultLivenessParams()
+			tt.update(&params)
+			err := params.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestObserverLiveness_RecordVote(t *testing.T) {
+	t.Run("missed votes are counted in the window", func(t *testing.T) {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		liveness.RecordVote(10, true)
+		liveness.RecordVote(10, false)
+		liveness.RecordVote(10, true)
+
+		require.EqualValues(t, 3, liveness.IndexOffset)
+		require.EqualValues(t, 2, liveness.MissedVotesCounter)
+		require.EqualValues(t, 3, liveness.TotalVotes(10))
+		require.Len(t, liveness.MissedVotes, 2)
+	})
+
+	t.Run("votes slide out of the window", func(t *testing.T) {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		for i := 0; i < 4; i++ {
+			liveness.RecordVote(4, true)
+		}
+		require.EqualValues(t, 4, liveness.MissedVotesCounter)
+
+		// overwriting missed votes with successful votes decreases the counter
+		liveness.RecordVote(4, false)
+		liveness.RecordVote(4, false)
+		require.EqualValues(t, 2, liveness.MissedVotesCounter)
+		require.EqualValues(t, 4, liveness.TotalVotes(4))
+		require.True(t, liveness.MissedRatio(4).Equal(sdk.MustNewDecFromStr("0.5")))
+	})
+
+	t.Run("window is reset if the window size changes", func(t *testing.T) {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		liveness.RecordVote(4, true)
+		liveness.RecordVote(4, true)
+		liveness.RecordVote(16, false)
+
+		require.EqualValues(t, 1, liveness.IndexOffset)
+		require.EqualValues(t, 0, liveness.MissedVotesCounter)
+		require.Len(t, liveness.MissedVotes, 2)
+	})
+
+	t.Run("invalid window size is ignored", func(t *testing.T) {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		liveness.RecordVote(0, true)
+		require.EqualValues(t, 0, liveness.IndexOffset)
+	})
+}
+
+func TestObserverLiveness_MissedRatio(t *testing.T) {
+	liveness := types.NewObserverLiveness(sample.AccAddress())
+	require.True(t, liveness.MissedRatio(10).IsZero())
+
+	liveness.RecordVote(10, true)
+	liveness.RecordVote(10, false)
+	liveness.RecordVote(10, false)
+	liveness.RecordVote(10, false)
+	require.True(t, liveness.MissedRatio(10).Equal(sdk.MustNewDecFromStr("0.25")))
+}
+
+func TestObserverLiveness_ShouldBeJailed(t *testing.T) {
+	params := types.DefaultLivenessParams()
+	params.WindowSize = 4
+	params.MaxMissedRatio = sdk.MustNewDecFromStr("0.5")
+
+	t.Run("not jailed before the window is complete", func(t *testing.T) {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		for i := 0; i < 3; i++ {
+			liveness.RecordVote(params.WindowSize, true)
+		}
+		require.False(t, liveness.ShouldBeJailed(params))
+	})
+
+	t.Run("not jailed if the ratio equals the max ratio", func(t *testing.T) {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		liveness.RecordVote(params.WindowSize, true)
+		liveness.RecordVote(params.WindowSize, true)
+		liveness.RecordVote(params.WindowSize, false)
+		liveness.RecordVote(params.WindowSize, false)
+		require.False(t, liveness.ShouldBeJailed(params))
+	})
+
+	t.Run("jailed if the ratio exceeds the max ratio", func(t *testing.T) {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		liveness.RecordVote(params.WindowSize, true)
+		liveness.RecordVote(params.WindowSize, true)
+		liveness.RecordVote(params.WindowSize, true)
+		liveness.RecordVote(params.WindowSize, false)
+		require.True(t, liveness.ShouldBeJailed(params))
+	})
+
+	t.Run("not jailed again if already jailed", func(t *testing.T) {
+		liveness := types.NewObserverLiveness(sample.AccAddress())
+		for i := 0; i < 4; i++ {
+			liveness.RecordVote(params.WindowSize, true)
+		}
+		liveness.Jailed = true
+		require.False(t, liveness.ShouldBeJailed(params))
+	})
+}
+
+func TestMinActiveObservers(t *testing.T) {
+	require.Equal(t, 1, types.MinActiveObservers(0))
+	require.Equal(t, 1, types.MinActiveObservers(1))
+	require.Equal(t, 2, types.MinActiveObservers(2))
+	require.Equal(t, 2, types.MinActiveObservers(3))
+	require.Equal(t, 3, types.MinActiveObservers(4))
+	require.Equal(t, 7, types.MinActiveObservers(10))
+}
@@ -8,6 +8,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	coin "github.com/zeta-chain/node/pkg/coin"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -24,6 +25,73 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConfirmationMode defines how observers determine that a block of a chain is
+// confirmed
+type ConfirmationMode int32
+
+const (
+	// a block is confirmed once followed by confirmation_count blocks
+	ConfirmationMode_FixedCount ConfirmationMode = 0
+	// a block is confirmed once the safe head of the chain reaches it
+	ConfirmationMode_SafeTag ConfirmationMode = 1
+	// a block is confirmed once the finalized head of the chain reaches it
+	ConfirmationMode_FinalizedTag ConfirmationMode = 2
+)
+
+var ConfirmationMode_name = map[int32]string{
+	0: "FixedCount",
+	1: "SafeTag",
+	2: "FinalizedTag",
+}
+
+var ConfirmationMode_value = map[string]int32{
+	"FixedCount":   0,
+	"SafeTag":      1,
+	"FinalizedTag": 2,
+}
+
+func (x ConfirmationMode) String() string {
+	return proto.EnumName(ConfirmationMode_name, int32(x))
+}
+
+func (ConfirmationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e7fa4666eddf88e5, []int{0}
+}
+
+// TraceMode defines how observers find the native token deposits to TSS and
+// the TSS outbounds of an EVM chain
+type TraceMode int32
+
+const (
+	// every block of the chain is scanned with its full transactions
+	TraceMode_BlockScan TraceMode = 0
+	// the calls from and to TSS are found with the trace_filter method
+	TraceMode_TraceFilter TraceMode = 1
+	// the calls from and to TSS are found with the debug_traceBlockByNumber
+	// method and the call tracer
+	TraceMode_DebugTrace TraceMode = 2
+)
+
+var TraceMode_name = map[int32]string{
+	0: "BlockScan",
+	1: "TraceFilter",
+	2: "DebugTrace",
+}
+
+var TraceMode_value = map[string]int32{
+	"BlockScan":   0,
+	"TraceFilter": 1,
+	"DebugTrace":  2,
+}
+
+func (x TraceMode) String() string {
+	return proto.EnumName(TraceMode_name, int32(x))
+}
+
+func (TraceMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e7fa4666eddf88e5, []int{1}
+}
+
 type ChainParamsList struct {
 	ChainParams []*ChainParams `protobuf:"bytes,1,rep,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
 }
@@ -68,6 +136,71 @@ func (m *ChainParamsList) GetChainParams() []*ChainParams {
 	return nil
 }
 
+// ConfirmationTier defines the number of confirmations required by the
+// inbounds of an asset with an amount greater than or equal to min_amount
+type ConfirmationTier struct {
+	CoinType coin.CoinType `protobuf:"varint,1,opt,name=coin_type,json=coinType,proto3,enum=zetachain.zetacore.pkg.coin.CoinType" json:"coin_type,omitempty"`
+	// asset is the address of the asset, empty for the gas and zeta tokens
+	Asset string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	// min_amount is denominated in the smallest unit of the asset
+	MinAmount         github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"min_amount"`
+	ConfirmationCount uint64                                  `protobuf:"varint,4,opt,name=confirmation_count,json=confirmationCount,proto3" json:"confirmation_count,omitempty"`
+}
+
+func (m *ConfirmationTier) Reset()         { *m = ConfirmationTier{} }
+func (m *ConfirmationTier) String() string { return proto.CompactTextString(m) }
+func (*ConfirmationTier) ProtoMessage()    {}
+func (*ConfirmationTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7fa4666eddf88e5, []int{1}
+}
+func (m *ConfirmationTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfirmationTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConfirmationTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConfirmationTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmationTier.Merge(m, src)
+}
+func (m *ConfirmationTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfirmationTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmationTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmationTier proto.InternalMessageInfo
+
+func (m *ConfirmationTier) GetCoinType() coin.CoinType {
+	if m != nil {
+		return m.CoinType
+	}
+	return coin.CoinType_Zeta
+}
+
+func (m *ConfirmationTier) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *ConfirmationTier) GetConfirmationCount() uint64 {
+	if m != nil {
+		return m.ConfirmationCount
+	}
+	return 0
+}
+
 type ChainParams struct {
 	ChainId                     int64                                  `protobuf:"varint,11,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 	ConfirmationCount           uint64                                 `protobuf:"varint,1,opt,name=confirmation_count,json=confirmationCount,proto3" json:"confirmation_count,omitempty"`
@@ -84,13 +217,24 @@ type ChainParams struct {
 	MinObserverDelegation       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=min_observer_delegation,json=minObserverDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_observer_delegation"`
 	IsSupported                 bool                                   `protobuf:"varint,16,opt,name=is_supported,json=isSupported,proto3" json:"is_supported,omitempty"`
 	GatewayAddress              string                                 `protobuf:"bytes,17,opt,name=gateway_address,json=gatewayAddress,proto3" json:"gateway_address,omitempty"`
+	// confirmation_mode defines how blocks are considered confirmed, block tags
+	// are only supported on EVM chains and fall back to confirmation_count if not
+	// supported by the RPC
+	ConfirmationMode ConfirmationMode `protobuf:"varint,18,opt,name=confirmation_mode,json=confirmationMode,proto3,enum=zetachain.zetacore.observer.ConfirmationMode" json:"confirmation_mode,omitempty"`
+	// confirmation_tiers defines the confirmations required by inbounds
+	// depending on their amount, inbounds are held until their tier is met
+	ConfirmationTiers []ConfirmationTier `protobuf:"bytes,19,rep,name=confirmation_tiers,json=confirmationTiers,proto3" json:"confirmation_tiers"`
+	// trace_mode defines how the TSS deposits and outbounds are found, call
+	// traces are only supported on EVM chains and require the RPC to support
+	// the trace method
+	TraceMode TraceMode `protobuf:"varint,20,opt,name=trace_mode,json=traceMode,proto3,enum=zetachain.zetacore.observer.TraceMode" json:"trace_mode,omitempty"`
 }
 
 func (m *ChainParams) Reset()         { *m = ChainParams{} }
 func (m *ChainParams) String() string { return proto.CompactTextString(m) }
 func (*ChainParams) ProtoMessage()    {}
 func (*ChainParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fa4666eddf88e5, []int{1}
+	return fileDescriptor_e7fa4666eddf88e5, []int{2}
 }
 func (m *ChainParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,6 +354,27 @@ func (m *ChainParams) GetGatewayAddress() string {
 	return ""
 }
 
+func (m *ChainParams) GetConfirmationMode() ConfirmationMode {
+	if m != nil {
+		return m.ConfirmationMode
+	}
+	return ConfirmationMode_FixedCount
+}
+
+func (m *ChainParams) GetConfirmationTiers() []ConfirmationTier {
+	if m != nil {
+		return m.ConfirmationTiers
+	}
+	return nil
+}
+
+func (m *ChainParams) GetTraceMode() TraceMode {
+	if m != nil {
+		return m.TraceMode
+	}
+	return TraceMode_BlockScan
+}
+
 // Deprecated(v17)
 type Params struct {
 	// Deprecated(v17):Moved into the emissions module
@@ -220,7 +385,7 @@ func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7fa4666eddf88e5, []int{2}
+	return fileDescriptor_e7fa4666eddf88e5, []int{3}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +422,10 @@ func (m *Params) GetBallotMaturityBlocks() int64 {
 }
 
 func init() {
+	proto.RegisterEnum("zetachain.zetacore.observer.ConfirmationMode", ConfirmationMode_name, ConfirmationMode_value)
+	proto.RegisterEnum("zetachain.zetacore.observer.TraceMode", TraceMode_name, TraceMode_value)
 	proto.RegisterType((*ChainParamsList)(nil), "zetachain.zetacore.observer.ChainParamsList")
+	proto.RegisterType((*ConfirmationTier)(nil), "zetachain.zetacore.observer.ConfirmationTier")
 	proto.RegisterType((*ChainParams)(nil), "zetachain.zetacore.observer.ChainParams")
 	proto.RegisterType((*Params)(nil), "zetachain.zetacore.observer.Params")
 }
@@ -267,48 +435,64 @@ func init() {
 }
 
 var fileDescriptor_e7fa4666eddf88e5 = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x86, 0xe3, 0x1b, 0x2e, 0x17, 0x26, 0x90, 0x80, 0xc5, 0xbd, 0xd7, 0x80, 0x64, 0x52, 0xa4,
-	0xb6, 0x16, 0x15, 0x76, 0x45, 0xbb, 0x6c, 0x91, 0x4a, 0xe8, 0x02, 0x95, 0xaa, 0xc8, 0xd0, 0x45,
-	0xbb, 0xe8, 0x68, 0x32, 0x1e, 0xec, 0x51, 0x6c, 0x9f, 0x68, 0x66, 0x0c, 0xa4, 0x4f, 0xd1, 0xc7,
-	0x62, 0xd1, 0x05, 0xcb, 0xaa, 0x0b, 0x54, 0xc1, 0x8b, 0x54, 0x1e, 0x8f, 0x43, 0x0a, 0x15, 0x8b,
-	0xae, 0x32, 0x3e, 0xff, 0xf7, 0xff, 0x9e, 0xf1, 0x39, 0x19, 0xe4, 0x7d, 0x66, 0x8a, 0xd0, 0x84,
-	0xf0, 0x3c, 0xd0, 0x2b, 0x10, 0x2c, 0x80, 0xbe, 0x64, 0xe2, 0x84, 0x89, 0x60, 0x48, 0x04, 0xc9,
-	0xa4, 0x3f, 0x14, 0xa0, 0xc0, 0x5e, 0x1d, 0x93, 0x7e, 0x4d, 0xfa, 0x35, 0xb9, 0xb2, 0x14, 0x43,
-	0x0c, 0x9a, 0x0b, 0xca, 0x55, 0x65, 0x59, 0xd9, 0xb8, 0x2f, 0xbc, 0x5e, 0x54, 0xec, 0xfa, 0x27,
-	0xd4, 0xe9, 0x95, 0xe4, 0x81, 0x7e, 0xe7, 0x3e, 0x97, 0xca, 0x7e, 0x83, 0xe6, 0xb4, 0x19, 0x57,
-	0xfb, 0x70, 0xac, 0x6e, 0xd3, 0x6b, 0x6d, 0x79, 0xfe, 0x3d, 0x1b, 0xf1, 0x27, 0x32, 0xc2, 0x16,
-	0xbd, 0x79, 0x58, 0xff, 0x3a, 0x8d, 0x5a, 0x13, 0xa2, 0xbd, 0x8c, 0x66, 0xaa, 0x70, 0x1e, 0x39,
-	0xad, 0xae, 0xe5, 0x35, 0xc3, 0x7f, 0xf4, 0xf3, 0x5e, 0x64, 0x6f, 0x22, 0x9b, 0x42, 0x7e, 0xcc,
-	0x45, 0x46, 0x14, 0x87, 0x1c, 0x53, 0x28, 0x72, 0xe5, 0x58, 0x5d, 0xcb, 0x9b, 0x0a, 0x17, 0x27,
-	0x95, 0x5e, 0x29, 0xd8, 0x1e, 0x5a, 0x88, 0x89, 0xc4, 0x43, 0xc1, 0x29, 0xc3, 0x8a, 0xd3, 0x01,
-	0x13, 0xce, 0x5f, 0x1a, 0x6e, 0xc7, 0x44, 0x1e, 0x94, 0xe5, 0x23, 0x5d, 0xb5, 0x1f, 0xa2, 0x36,
-	0xcf, 0xfb, 0x50, 0xe4, 0x51, 0xcd, 0x35, 0x35, 0x37, 0x6f, 0xaa, 0x06, 0x7b, 0x8c, 0x3a, 0x50,
-	0xa8, 0x5f, 0xb8, 0xa9, 0x2a, 0xaf, 0x2e, 0x1b, 0x70, 0x03, 0x2d, 0x9e, 0x12, 0x45, 0x13, 0x5c,
-	0xa8, 0x33, 0xa8, 0xd1, 0xbf, 0x35, 0xda, 0xd1, 0xc2, 0x7b, 0x75, 0x06, 0x86, 0x7d, 0x89, 0x74,
-	0x03, 0xb1, 0x82, 0x01, 0x2b, 0x8f, 0x94, 0x2b, 0x41, 0xa8, 0xc2, 0x24, 0x8a, 0x04, 0x93, 0xd2,
-	0x99, 0xe9, 0x5a, 0xde, 0x6c, 0xe8, 0x94, 0xc8, 0x51, 0x49, 0xf4, 0x0c, 0xf0, 0xaa, 0xd2, 0xed,
-	0x17, 0x68, 0x85, 0x42, 0x9e, 0x33, 0xaa, 0x40, 0xdc, 0x75, 0xcf, 0x56, 0xee, 0x31, 0x71, 0xdb,
-	0xdd, 0x43, 0x2e, 0x13, 0x74, 0xeb, 0x29, 0xa6, 0x85, 0x54, 0x10, 0x8d, 0xee, 0x26, 0x20, 0x9d,
-	0xb0, 0xaa, 0xa9, 0x5e, 0x05, 0xfd, 0x66, 0x0b, 0xe3, 0xcf, 0x22, 0x69, 0xc2, 0xa2, 0x22, 0x65,
-	0x98, 0xe7, 0x8a, 0x89, 0x13, 0x92, 0x3a, 0x73, 0xba, 0x87, 0x4e, 0x4d, 0x1c, 0x1a, 0x60, 0xcf,
-	0xe8, 0xf6, 0x36, 0x5a, 0xbd, 0xeb, 0x4e, 0x01, 0x06, 0x24, 0x61, 0x24, 0x72, 0xe6, 0xb5, 0x7d,
-	0xf9, 0xb6, 0x7d, 0xbf, 0x06, 0xec, 0x0f, 0x68, 0xa1, 0x4f, 0xd2, 0x14, 0x14, 0x56, 0x89, 0x60,
-	0x32, 0x81, 0x34, 0x72, 0xda, 0xe5, 0xa6, 0x77, 0xfc, 0xf3, 0xcb, 0xb5, 0xc6, 0xf7, 0xcb, 0xb5,
-	0x47, 0x31, 0x57, 0x49, 0xd1, 0xf7, 0x29, 0x64, 0x01, 0x05, 0x99, 0x81, 0x34, 0x3f, 0x9b, 0x32,
-	0x1a, 0x04, 0x6a, 0x34, 0x64, 0xd2, 0xdf, 0x65, 0x34, 0xec, 0x54, 0x39, 0x47, 0x75, 0x8c, 0x7d,
-	0x8c, 0xfe, 0xcf, 0x78, 0x8e, 0xeb, 0x19, 0xc6, 0x11, 0x4b, 0x59, 0xac, 0x07, 0xcc, 0xe9, 0xfc,
-	0xd1, 0x1b, 0xfe, 0xcd, 0x78, 0xfe, 0xce, 0xa4, 0xed, 0x8e, 0xc3, 0xec, 0x07, 0x68, 0x8e, 0x4b,
-	0x2c, 0x8b, 0xe1, 0x10, 0x84, 0x62, 0x91, 0xb3, 0xd0, 0xb5, 0xbc, 0x99, 0xb0, 0xc5, 0xe5, 0x61,
-	0x5d, 0x2a, 0x47, 0x2f, 0x26, 0x8a, 0x9d, 0x92, 0xd1, 0xb8, 0x33, 0x8b, 0xba, 0x33, 0x6d, 0x53,
-	0x36, 0xcd, 0x58, 0xdf, 0x46, 0xd3, 0xe6, 0x8f, 0xf4, 0x1c, 0xfd, 0x67, 0x3e, 0x4c, 0x46, 0x54,
-	0x21, 0xb8, 0x1a, 0xe1, 0x7e, 0x0a, 0x74, 0x20, 0xf5, 0x70, 0x37, 0xc3, 0xa5, 0x4a, 0x7d, 0x6b,
-	0xc4, 0x1d, 0xad, 0xed, 0xbc, 0x3e, 0xbf, 0x72, 0xad, 0x8b, 0x2b, 0xd7, 0xfa, 0x71, 0xe5, 0x5a,
-	0x5f, 0xae, 0xdd, 0xc6, 0xc5, 0xb5, 0xdb, 0xf8, 0x76, 0xed, 0x36, 0x3e, 0x3e, 0x99, 0x38, 0x64,
-	0x39, 0x8e, 0x9b, 0xd5, 0x05, 0x92, 0x43, 0xc4, 0x82, 0xb3, 0x9b, 0xeb, 0x43, 0x9f, 0xb6, 0x3f,
-	0xad, 0x2f, 0x8f, 0x67, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x74, 0xb1, 0xf1, 0x72, 0xc7, 0x04,
-	0x00, 0x00,
+	// 904 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xc7, 0xc5, 0x48, 0x49, 0xa4, 0x95, 0x2d, 0xd1, 0x5b, 0xb7, 0x65, 0x6c, 0x40, 0x51, 0x0d,
+	0xc4, 0x11, 0x5c, 0x58, 0x2a, 0xdc, 0x1e, 0xdb, 0x00, 0x96, 0x1c, 0x17, 0x41, 0x93, 0x36, 0xa0,
+	0x95, 0x43, 0x73, 0x28, 0xb1, 0x5a, 0xae, 0xa9, 0x85, 0xc8, 0x5d, 0x62, 0x77, 0x99, 0x58, 0x79,
+	0x8a, 0x3e, 0x44, 0x0f, 0x7d, 0x94, 0x1c, 0x03, 0xf4, 0x52, 0xf4, 0x10, 0x14, 0xf6, 0xb1, 0x2f,
+	0x51, 0xec, 0x90, 0x54, 0xe4, 0x8f, 0x18, 0x46, 0x2e, 0xd2, 0x72, 0xe6, 0x37, 0x7f, 0xce, 0xee,
+	0xce, 0x0c, 0x51, 0xef, 0x0d, 0x33, 0x84, 0x4e, 0x09, 0x17, 0x03, 0x58, 0x49, 0xc5, 0x06, 0x72,
+	0xa2, 0x99, 0x7a, 0xc5, 0xd4, 0x20, 0x25, 0x8a, 0x24, 0xba, 0x9f, 0x2a, 0x69, 0x24, 0xde, 0x5c,
+	0x90, 0xfd, 0x92, 0xec, 0x97, 0xe4, 0xc6, 0x7a, 0x24, 0x23, 0x09, 0xdc, 0xc0, 0xae, 0xf2, 0x90,
+	0x8d, 0x9d, 0xeb, 0xc4, 0xcb, 0x45, 0xc1, 0x6e, 0x5f, 0xc1, 0xa6, 0xb3, 0x68, 0x40, 0x25, 0x17,
+	0xf0, 0x93, 0x73, 0x5b, 0xbf, 0xa1, 0xf6, 0xc8, 0x52, 0xcf, 0x21, 0xb7, 0xa7, 0x5c, 0x1b, 0xfc,
+	0x13, 0x5a, 0x81, 0xc0, 0x20, 0xcf, 0xd7, 0x73, 0xba, 0xd5, 0x5e, 0x73, 0xaf, 0xd7, 0xbf, 0x26,
+	0xe1, 0xfe, 0x92, 0x86, 0xdf, 0xa4, 0x1f, 0x1e, 0xb6, 0xfe, 0x73, 0x90, 0x3b, 0x92, 0xe2, 0x98,
+	0xab, 0x84, 0x18, 0x2e, 0xc5, 0x98, 0x33, 0x85, 0x87, 0xa8, 0x61, 0x53, 0x08, 0xcc, 0x3c, 0x65,
+	0x9e, 0xd3, 0x75, 0x7a, 0xad, 0xbd, 0x07, 0x57, 0xc9, 0xa7, 0xb3, 0xa8, 0x0f, 0xb9, 0x8e, 0x24,
+	0x17, 0xe3, 0x79, 0xca, 0xfc, 0x3a, 0x2d, 0x56, 0x78, 0x1d, 0xdd, 0x26, 0x5a, 0x33, 0xe3, 0xdd,
+	0xea, 0x3a, 0xbd, 0x86, 0x9f, 0x3f, 0xe0, 0x9f, 0x11, 0x4a, 0xb8, 0x08, 0x48, 0x22, 0x33, 0x61,
+	0xbc, 0xaa, 0x75, 0x0d, 0x07, 0x6f, 0xdf, 0xdf, 0xaf, 0xfc, 0xf3, 0xfe, 0xfe, 0xc3, 0x88, 0x9b,
+	0x69, 0x36, 0xe9, 0x53, 0x99, 0x0c, 0xa8, 0xd4, 0x89, 0xd4, 0xc5, 0xdf, 0xae, 0x0e, 0x67, 0x03,
+	0x9b, 0x8b, 0xee, 0xbf, 0xe0, 0xc2, 0xf8, 0x8d, 0x84, 0x8b, 0x7d, 0x50, 0xc0, 0xbb, 0x08, 0xd3,
+	0xa5, 0xec, 0x03, 0x0a, 0xba, 0xb5, 0xae, 0xd3, 0xab, 0xf9, 0x6b, 0xcb, 0x9e, 0x91, 0x75, 0x6c,
+	0xfd, 0x55, 0x47, 0xcd, 0xa5, 0xa3, 0xc0, 0xf7, 0x50, 0x3d, 0x3f, 0x4a, 0x1e, 0x7a, 0xcd, 0xae,
+	0xd3, 0xab, 0xfa, 0x77, 0xe1, 0xf9, 0x49, 0xf8, 0x11, 0x65, 0xe7, 0x23, 0xca, 0xb8, 0x87, 0xdc,
+	0x88, 0xe8, 0x20, 0x55, 0x9c, 0xb2, 0xc0, 0x70, 0x3a, 0x63, 0x0a, 0x76, 0x5e, 0xf3, 0x5b, 0x11,
+	0xd1, 0xcf, 0xad, 0x79, 0x0c, 0x56, 0xfc, 0x00, 0xb5, 0xb8, 0x98, 0xc8, 0x4c, 0x84, 0x25, 0x57,
+	0x05, 0x6e, 0xb5, 0xb0, 0x16, 0xd8, 0x43, 0xd4, 0x96, 0x99, 0x39, 0xc7, 0xe5, 0xdb, 0x6a, 0x95,
+	0xe6, 0x02, 0xdc, 0x41, 0x6b, 0xaf, 0x89, 0xa1, 0xd3, 0x20, 0x33, 0x27, 0xb2, 0x44, 0x6f, 0x03,
+	0xda, 0x06, 0xc7, 0x0b, 0x73, 0x22, 0x0b, 0xf6, 0x07, 0x04, 0x65, 0x1d, 0x18, 0x39, 0x63, 0x76,
+	0x4b, 0xc2, 0x28, 0x42, 0x4d, 0x40, 0xc2, 0x50, 0x31, 0xad, 0xbd, 0x3a, 0x5c, 0x95, 0x67, 0x91,
+	0xb1, 0x25, 0x46, 0x05, 0xb0, 0x9f, 0xfb, 0xf1, 0xf7, 0x68, 0x83, 0x4a, 0x21, 0x18, 0x35, 0x52,
+	0x5d, 0x8e, 0x6e, 0xe4, 0xd1, 0x0b, 0xe2, 0x62, 0xf4, 0x08, 0x75, 0x98, 0xa2, 0x7b, 0xdf, 0x04,
+	0x34, 0xd3, 0x46, 0x86, 0xf3, 0xcb, 0x0a, 0x08, 0x14, 0x36, 0x81, 0x1a, 0xe5, 0xd0, 0x15, 0x29,
+	0x2c, 0x8e, 0x45, 0xd3, 0x29, 0x0b, 0xb3, 0x98, 0x05, 0x5c, 0x18, 0xa6, 0x5e, 0x91, 0xd8, 0x5b,
+	0x81, 0x3b, 0xf4, 0x4a, 0xe2, 0xa8, 0x00, 0x9e, 0x14, 0x7e, 0xfc, 0x08, 0x6d, 0x5e, 0x8e, 0x8e,
+	0xa5, 0x9c, 0x91, 0x29, 0x23, 0xa1, 0xb7, 0x0a, 0xe1, 0xf7, 0x2e, 0x86, 0x3f, 0x2d, 0x01, 0xfc,
+	0x2b, 0x72, 0x27, 0x24, 0x8e, 0xa5, 0x09, 0xcc, 0x54, 0x31, 0x3d, 0x95, 0x71, 0xe8, 0xb5, 0xa0,
+	0x88, 0xfb, 0x45, 0x11, 0x6f, 0xdf, 0xa0, 0x88, 0x0f, 0x18, 0xf5, 0xdb, 0xb9, 0xce, 0xb8, 0x94,
+	0xc1, 0xc7, 0xe8, 0x4b, 0xdb, 0x19, 0x65, 0xc7, 0x06, 0x21, 0x8b, 0x59, 0x04, 0x05, 0xe6, 0xb5,
+	0x3f, 0xe9, 0x0d, 0x9f, 0x27, 0x5c, 0xfc, 0x52, 0xa8, 0x1d, 0x2c, 0xc4, 0xf0, 0x57, 0x68, 0x85,
+	0xeb, 0x40, 0x67, 0x69, 0x2a, 0x95, 0x61, 0xa1, 0xe7, 0x76, 0x9d, 0x5e, 0xdd, 0x6f, 0x72, 0x7d,
+	0x54, 0x9a, 0x6c, 0xe9, 0x45, 0xc4, 0xb0, 0xd7, 0x64, 0xbe, 0xb8, 0x99, 0x35, 0xb8, 0x99, 0x56,
+	0x61, 0x2e, 0x2f, 0xe3, 0x25, 0x3a, 0xd7, 0x09, 0x41, 0x22, 0x43, 0xe6, 0x61, 0x98, 0x17, 0xbb,
+	0xd7, 0x8f, 0xa3, 0xa5, 0xa8, 0x67, 0x32, 0x64, 0xbe, 0x4b, 0x2f, 0x58, 0xf0, 0xe4, 0x42, 0xff,
+	0x19, 0xce, 0x94, 0xf6, 0x3e, 0x83, 0x59, 0x77, 0x73, 0x71, 0x3b, 0xce, 0x86, 0x35, 0x7b, 0x72,
+	0xe7, 0x9b, 0xd6, 0xda, 0x35, 0x7e, 0x8c, 0x90, 0x2d, 0x2e, 0x96, 0x27, 0xbe, 0x0e, 0x89, 0x6f,
+	0x5f, 0xab, 0x3d, 0xb6, 0x38, 0x64, 0xdc, 0x30, 0xe5, 0x72, 0xeb, 0x11, 0xba, 0x53, 0xcc, 0x93,
+	0xef, 0xd0, 0x17, 0x45, 0x7d, 0x24, 0xc4, 0x64, 0x8a, 0x9b, 0x79, 0x30, 0x89, 0x25, 0x9d, 0x69,
+	0xe8, 0xf1, 0xaa, 0xbf, 0x9e, 0x7b, 0x9f, 0x15, 0xce, 0x21, 0xf8, 0x76, 0x7e, 0x3c, 0x3f, 0x82,
+	0x61, 0xfb, 0x2d, 0x84, 0x0e, 0xf9, 0x09, 0x0b, 0x61, 0xba, 0xb8, 0x15, 0xdc, 0x44, 0x77, 0x8f,
+	0xc8, 0x31, 0x1b, 0x93, 0xc8, 0x75, 0xb0, 0x8b, 0x56, 0x0e, 0xb9, 0x20, 0x31, 0x7f, 0xc3, 0x42,
+	0x6b, 0xb9, 0xb5, 0x51, 0xfb, 0xf3, 0x8f, 0x8e, 0xb3, 0xb3, 0x8f, 0x1a, 0x8b, 0x04, 0xf1, 0x2a,
+	0x6a, 0x80, 0xfe, 0x11, 0x25, 0xc2, 0xad, 0xe0, 0x36, 0x6a, 0x82, 0xef, 0x90, 0xc7, 0x86, 0x29,
+	0xd7, 0xb1, 0x6f, 0x38, 0x60, 0x93, 0x2c, 0x02, 0x6b, 0x29, 0x31, 0x7c, 0xfc, 0xf6, 0xb4, 0xe3,
+	0xbc, 0x3b, 0xed, 0x38, 0xff, 0x9e, 0x76, 0x9c, 0xdf, 0xcf, 0x3a, 0x95, 0x77, 0x67, 0x9d, 0xca,
+	0xdf, 0x67, 0x9d, 0xca, 0xcb, 0xaf, 0x97, 0xea, 0xce, 0x1e, 0xcc, 0x6e, 0xfe, 0xf5, 0x12, 0x32,
+	0x64, 0x83, 0x93, 0x0f, 0xdf, 0x39, 0x28, 0xc0, 0xc9, 0x1d, 0xf8, 0x7a, 0x7d, 0xfb, 0xff, 0x00,
+	0x47, 0x3c, 0x72, 0x60, 0x70, 0x07, 0x00, 0x00,
 }
 
 func (m *ChainParamsList) Marshal() (dAtA []byte, err error) {
@@ -348,6 +532,56 @@ func (m *ChainParamsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConfirmationTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfirmationTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfirmationTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfirmationCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConfirmationCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -368,6 +602,36 @@ func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TraceMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TraceMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.ConfirmationTiers) > 0 {
+		for iNdEx := len(m.ConfirmationTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfirmationTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.ConfirmationMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConfirmationMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.GatewayAddress) > 0 {
 		i -= len(m.GatewayAddress)
 		copy(dAtA[i:], m.GatewayAddress)
@@ -527,6 +791,27 @@ func (m *ChainParamsList) Size() (n int) {
 	return n
 }
 
+func (m *ConfirmationTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CoinType != 0 {
+		n += 1 + sovParams(uint64(m.CoinType))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ConfirmationCount != 0 {
+		n += 1 + sovParams(uint64(m.ConfirmationCount))
+	}
+	return n
+}
+
 func (m *ChainParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -580,6 +865,18 @@ func (m *ChainParams) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if m.ConfirmationMode != 0 {
+		n += 2 + sovParams(uint64(m.ConfirmationMode))
+	}
+	if len(m.ConfirmationTiers) > 0 {
+		for _, e := range m.ConfirmationTiers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.TraceMode != 0 {
+		n += 2 + sovParams(uint64(m.TraceMode))
+	}
 	return n
 }
 
@@ -685,6 +982,160 @@ func (m *ChainParamsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConfirmationTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfirmationTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfirmationTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= coin.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationCount", wireType)
+			}
+			m.ConfirmationCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ChainParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1082,6 +1533,78 @@ func (m *ChainParams) Unmarshal(dAtA []byte) error {
 			}
 			m.GatewayAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationMode", wireType)
+			}
+			m.ConfirmationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationMode |= ConfirmationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfirmationTiers = append(m.ConfirmationTiers, ConfirmationTier{})
+			if err := m.ConfirmationTiers[len(m.ConfirmationTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceMode", wireType)
+			}
+			m.TraceMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TraceMode |= TraceMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
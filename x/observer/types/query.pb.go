@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -32,6 +33,334 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryGetLivenessParamsRequest struct {
+}
+
+func (m *QueryGetLivenessParamsRequest) Reset()         { *m = QueryGetLivenessParamsRequest{} }
+func (m *QueryGetLivenessParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLivenessParamsRequest) ProtoMessage()    {}
+func (*QueryGetLivenessParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{0}
+}
+func (m *QueryGetLivenessParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLivenessParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLivenessParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetLivenessParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLivenessParamsRequest.Merge(m, src)
+}
+func (m *QueryGetLivenessParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLivenessParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLivenessParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetLivenessParamsRequest proto.InternalMessageInfo
+
+type QueryGetLivenessParamsResponse struct {
+	LivenessParams LivenessParams `protobuf:"bytes,1,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params"`
+}
+
+func (m *QueryGetLivenessParamsResponse) Reset()         { *m = QueryGetLivenessParamsResponse{} }
+func (m *QueryGetLivenessParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetLivenessParamsResponse) ProtoMessage()    {}
+func (*QueryGetLivenessParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{1}
+}
+func (m *QueryGetLivenessParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetLivenessParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetLivenessParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetLivenessParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetLivenessParamsResponse.Merge(m, src)
+}
+func (m *QueryGetLivenessParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetLivenessParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetLivenessParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetLivenessParamsResponse proto.InternalMessageInfo
+
+func (m *QueryGetLivenessParamsResponse) GetLivenessParams() LivenessParams {
+	if m != nil {
+		return m.LivenessParams
+	}
+	return LivenessParams{}
+}
+
+// ObserverLivenessInfo summarizes the voting activity of an observer
+type ObserverLivenessInfo struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	// number of votes missed in the current window
+	MissedVotes int64 `protobuf:"varint,2,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`
+	// number of ballots accounted in the current window
+	TotalVotes  int64                                  `protobuf:"varint,3,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	MissedRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=missed_ratio,json=missedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"missed_ratio"`
+	Jailed      bool                                   `protobuf:"varint,5,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	JailedUntil int64                                  `protobuf:"varint,6,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+}
+
+func (m *ObserverLivenessInfo) Reset()         { *m = ObserverLivenessInfo{} }
+func (m *ObserverLivenessInfo) String() string { return proto.CompactTextString(m) }
+func (*ObserverLivenessInfo) ProtoMessage()    {}
+func (*ObserverLivenessInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{2}
+}
+func (m *ObserverLivenessInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverLivenessInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverLivenessInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverLivenessInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverLivenessInfo.Merge(m, src)
+}
+func (m *ObserverLivenessInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverLivenessInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverLivenessInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverLivenessInfo proto.InternalMessageInfo
+
+func (m *ObserverLivenessInfo) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverLivenessInfo) GetMissedVotes() int64 {
+	if m != nil {
+		return m.MissedVotes
+	}
+	return 0
+}
+
+func (m *ObserverLivenessInfo) GetTotalVotes() int64 {
+	if m != nil {
+		return m.TotalVotes
+	}
+	return 0
+}
+
+func (m *ObserverLivenessInfo) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ObserverLivenessInfo) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+type QueryGetObserverLivenessRequest struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+}
+
+func (m *QueryGetObserverLivenessRequest) Reset()         { *m = QueryGetObserverLivenessRequest{} }
+func (m *QueryGetObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryGetObserverLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{3}
+}
+func (m *QueryGetObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetObserverLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetObserverLivenessRequest.Merge(m, src)
+}
+func (m *QueryGetObserverLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetObserverLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetObserverLivenessRequest proto.InternalMessageInfo
+
+func (m *QueryGetObserverLivenessRequest) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+type QueryGetObserverLivenessResponse struct {
+	ObserverLiveness ObserverLivenessInfo `protobuf:"bytes,1,opt,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
+}
+
+func (m *QueryGetObserverLivenessResponse) Reset()         { *m = QueryGetObserverLivenessResponse{} }
+func (m *QueryGetObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryGetObserverLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{4}
+}
+func (m *QueryGetObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetObserverLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetObserverLivenessResponse.Merge(m, src)
+}
+func (m *QueryGetObserverLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetObserverLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetObserverLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryGetObserverLivenessResponse) GetObserverLiveness() ObserverLivenessInfo {
+	if m != nil {
+		return m.ObserverLiveness
+	}
+	return ObserverLivenessInfo{}
+}
+
+type QueryAllObserverLivenessRequest struct {
+}
+
+func (m *QueryAllObserverLivenessRequest) Reset()         { *m = QueryAllObserverLivenessRequest{} }
+func (m *QueryAllObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryAllObserverLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{5}
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllObserverLivenessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObserverLivenessRequest.Merge(m, src)
+}
+func (m *QueryAllObserverLivenessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObserverLivenessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllObserverLivenessRequest proto.InternalMessageInfo
+
+type QueryAllObserverLivenessResponse struct {
+	ObserverLiveness []ObserverLivenessInfo `protobuf:"bytes,1,rep,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
+}
+
+func (m *QueryAllObserverLivenessResponse) Reset()         { *m = QueryAllObserverLivenessResponse{} }
+func (m *QueryAllObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryAllObserverLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_25b2aa420449a0c0, []int{6}
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllObserverLivenessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllObserverLivenessResponse.Merge(m, src)
+}
+func (m *QueryAllObserverLivenessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllObserverLivenessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllObserverLivenessResponse proto.InternalMessageInfo
+
+func (m *QueryAllObserverLivenessResponse) GetObserverLiveness() []ObserverLivenessInfo {
+	if m != nil {
+		return m.ObserverLiveness
+	}
+	return nil
+}
+
 type QueryTssFundsMigratorInfoAllRequest struct {
 }
 
@@ -39,7 +368,7 @@ func (m *QueryTssFundsMigratorInfoAllRequest) Reset()         { *m = QueryTssFun
 func (m *QueryTssFundsMigratorInfoAllRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoAllRequest) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{0}
+	return fileDescriptor_25b2aa420449a0c0, []int{7}
 }
 func (m *QueryTssFundsMigratorInfoAllRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +405,7 @@ func (m *QueryTssFundsMigratorInfoAllResponse) Reset()         { *m = QueryTssFu
 func (m *QueryTssFundsMigratorInfoAllResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoAllResponse) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{1}
+	return fileDescriptor_25b2aa420449a0c0, []int{8}
 }
 func (m *QueryTssFundsMigratorInfoAllResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +449,7 @@ func (m *QueryTssFundsMigratorInfoRequest) Reset()         { *m = QueryTssFundsM
 func (m *QueryTssFundsMigratorInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoRequest) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{2}
+	return fileDescriptor_25b2aa420449a0c0, []int{9}
 }
 func (m *QueryTssFundsMigratorInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,7 +493,7 @@ func (m *QueryTssFundsMigratorInfoResponse) Reset()         { *m = QueryTssFunds
 func (m *QueryTssFundsMigratorInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssFundsMigratorInfoResponse) ProtoMessage()    {}
 func (*QueryTssFundsMigratorInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{3}
+	return fileDescriptor_25b2aa420449a0c0, []int{10}
 }
 func (m *QueryTssFundsMigratorInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +537,7 @@ func (m *QueryGetChainNoncesRequest) Reset()         { *m = QueryGetChainNoncesR
 func (m *QueryGetChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesRequest) ProtoMessage()    {}
 func (*QueryGetChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{4}
+	return fileDescriptor_25b2aa420449a0c0, []int{11}
 }
 func (m *QueryGetChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -252,7 +581,7 @@ func (m *QueryGetChainNoncesResponse) Reset()         { *m = QueryGetChainNonces
 func (m *QueryGetChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesResponse) ProtoMessage()    {}
 func (*QueryGetChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{5}
+	return fileDescriptor_25b2aa420449a0c0, []int{12}
 }
 func (m *QueryGetChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +625,7 @@ func (m *QueryAllChainNoncesRequest) Reset()         { *m = QueryAllChainNoncesR
 func (m *QueryAllChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesRequest) ProtoMessage()    {}
 func (*QueryAllChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{6}
+	return fileDescriptor_25b2aa420449a0c0, []int{13}
 }
 func (m *QueryAllChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -341,7 +670,7 @@ func (m *QueryAllChainNoncesResponse) Reset()         { *m = QueryAllChainNonces
 func (m *QueryAllChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesResponse) ProtoMessage()    {}
 func (*QueryAllChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{7}
+	return fileDescriptor_25b2aa420449a0c0, []int{14}
 }
 func (m *QueryAllChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,7 +721,7 @@ func (m *QueryAllPendingNoncesRequest) Reset()         { *m = QueryAllPendingNon
 func (m *QueryAllPendingNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesRequest) ProtoMessage()    {}
 func (*QueryAllPendingNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{8}
+	return fileDescriptor_25b2aa420449a0c0, []int{15}
 }
 func (m *QueryAllPendingNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +766,7 @@ func (m *QueryAllPendingNoncesResponse) Reset()         { *m = QueryAllPendingNo
 func (m *QueryAllPendingNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesResponse) ProtoMessage()    {}
 func (*QueryAllPendingNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{9}
+	return fileDescriptor_25b2aa420449a0c0, []int{16}
 }
 func (m *QueryAllPendingNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +817,7 @@ func (m *QueryPendingNoncesByChainRequest) Reset()         { *m = QueryPendingNo
 func (m *QueryPendingNoncesByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainRequest) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{10}
+	return fileDescriptor_25b2aa420449a0c0, []int{17}
 }
 func (m *QueryPendingNoncesByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +861,7 @@ func (m *QueryPendingNoncesByChainResponse) Reset()         { *m = QueryPendingN
 func (m *QueryPendingNoncesByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainResponse) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{11}
+	return fileDescriptor_25b2aa420449a0c0, []int{18}
 }
 func (m *QueryPendingNoncesByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +904,7 @@ func (m *QueryGetTSSRequest) Reset()         { *m = QueryGetTSSRequest{} }
 func (m *QueryGetTSSRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSRequest) ProtoMessage()    {}
 func (*QueryGetTSSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{12}
+	return fileDescriptor_25b2aa420449a0c0, []int{19}
 }
 func (m *QueryGetTSSRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +941,7 @@ func (m *QueryGetTSSResponse) Reset()         { *m = QueryGetTSSResponse{} }
 func (m *QueryGetTSSResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSResponse) ProtoMessage()    {}
 func (*QueryGetTSSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{13}
+	return fileDescriptor_25b2aa420449a0c0, []int{20}
 }
 func (m *QueryGetTSSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -656,7 +985,7 @@ func (m *QueryGetTssAddressRequest) Reset()         { *m = QueryGetTssAddressReq
 func (m *QueryGetTssAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressRequest) ProtoMessage()    {}
 func (*QueryGetTssAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{14}
+	return fileDescriptor_25b2aa420449a0c0, []int{21}
 }
 func (m *QueryGetTssAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,7 +1030,7 @@ func (m *QueryGetTssAddressResponse) Reset()         { *m = QueryGetTssAddressRe
 func (m *QueryGetTssAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressResponse) ProtoMessage()    {}
 func (*QueryGetTssAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{15}
+	return fileDescriptor_25b2aa420449a0c0, []int{22}
 }
 func (m *QueryGetTssAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +1086,7 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) String() string {
 }
 func (*QueryGetTssAddressByFinalizedHeightRequest) ProtoMessage() {}
 func (*QueryGetTssAddressByFinalizedHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{16}
+	return fileDescriptor_25b2aa420449a0c0, []int{23}
 }
 func (m *QueryGetTssAddressByFinalizedHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -813,7 +1142,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) String() string {
 }
 func (*QueryGetTssAddressByFinalizedHeightResponse) ProtoMessage() {}
 func (*QueryGetTssAddressByFinalizedHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{17}
+	return fileDescriptor_25b2aa420449a0c0, []int{24}
 }
 func (m *QueryGetTssAddressByFinalizedHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +1193,7 @@ func (m *QueryTssHistoryRequest) Reset()         { *m = QueryTssHistoryRequest{}
 func (m *QueryTssHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssHistoryRequest) ProtoMessage()    {}
 func (*QueryTssHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{18}
+	return fileDescriptor_25b2aa420449a0c0, []int{25}
 }
 func (m *QueryTssHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,7 +1238,7 @@ func (m *QueryTssHistoryResponse) Reset()         { *m = QueryTssHistoryResponse
 func (m *QueryTssHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssHistoryResponse) ProtoMessage()    {}
 func (*QueryTssHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{19}
+	return fileDescriptor_25b2aa420449a0c0, []int{26}
 }
 func (m *QueryTssHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -961,7 +1290,7 @@ func (m *QueryHasVotedRequest) Reset()         { *m = QueryHasVotedRequest{} }
 func (m *QueryHasVotedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedRequest) ProtoMessage()    {}
 func (*QueryHasVotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{20}
+	return fileDescriptor_25b2aa420449a0c0, []int{27}
 }
 func (m *QueryHasVotedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1341,7 @@ func (m *QueryHasVotedResponse) Reset()         { *m = QueryHasVotedResponse{} }
 func (m *QueryHasVotedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedResponse) ProtoMessage()    {}
 func (*QueryHasVotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{21}
+	return fileDescriptor_25b2aa420449a0c0, []int{28}
 }
 func (m *QueryHasVotedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,7 +1385,7 @@ func (m *QueryBallotByIdentifierRequest) Reset()         { *m = QueryBallotByIde
 func (m *QueryBallotByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBallotByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{22}
+	return fileDescriptor_25b2aa420449a0c0, []int{29}
 }
 func (m *QueryBallotByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1101,7 +1430,7 @@ func (m *VoterList) Reset()         { *m = VoterList{} }
 func (m *VoterList) String() string { return proto.CompactTextString(m) }
 func (*VoterList) ProtoMessage()    {}
 func (*VoterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{23}
+	return fileDescriptor_25b2aa420449a0c0, []int{30}
 }
 func (m *VoterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1155,7 +1484,7 @@ func (m *QueryBallotByIdentifierResponse) Reset()         { *m = QueryBallotById
 func (m *QueryBallotByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBallotByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{24}
+	return fileDescriptor_25b2aa420449a0c0, []int{31}
 }
 func (m *QueryBallotByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1219,7 +1548,7 @@ func (m *QueryObserverSet) Reset()         { *m = QueryObserverSet{} }
 func (m *QueryObserverSet) String() string { return proto.CompactTextString(m) }
 func (*QueryObserverSet) ProtoMessage()    {}
 func (*QueryObserverSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{25}
+	return fileDescriptor_25b2aa420449a0c0, []int{32}
 }
 func (m *QueryObserverSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1256,7 +1585,7 @@ func (m *QueryObserverSetResponse) Reset()         { *m = QueryObserverSetRespon
 func (m *QueryObserverSetResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryObserverSetResponse) ProtoMessage()    {}
 func (*QueryObserverSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{26}
+	return fileDescriptor_25b2aa420449a0c0, []int{33}
 }
 func (m *QueryObserverSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1628,7 @@ func (m *QuerySupportedChains) Reset()         { *m = QuerySupportedChains{} }
 func (m *QuerySupportedChains) String() string { return proto.CompactTextString(m) }
 func (*QuerySupportedChains) ProtoMessage()    {}
 func (*QuerySupportedChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{27}
+	return fileDescriptor_25b2aa420449a0c0, []int{34}
 }
 func (m *QuerySupportedChains) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1336,7 +1665,7 @@ func (m *QuerySupportedChainsResponse) Reset()         { *m = QuerySupportedChai
 func (m *QuerySupportedChainsResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupportedChainsResponse) ProtoMessage()    {}
 func (*QuerySupportedChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{28}
+	return fileDescriptor_25b2aa420449a0c0, []int{35}
 }
 func (m *QuerySupportedChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1709,7 @@ func (m *QueryGetChainParamsForChainRequest) Reset()         { *m = QueryGetChai
 func (m *QueryGetChainParamsForChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsForChainRequest) ProtoMessage()    {}
 func (*QueryGetChainParamsForChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{29}
+	return fileDescriptor_25b2aa420449a0c0, []int{36}
 }
 func (m *QueryGetChainParamsForChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1424,7 +1753,7 @@ func (m *QueryGetChainParamsForChainResponse) Reset()         { *m = QueryGetCha
 func (m *QueryGetChainParamsForChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsForChainResponse) ProtoMessage()    {}
 func (*QueryGetChainParamsForChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{30}
+	return fileDescriptor_25b2aa420449a0c0, []int{37}
 }
 func (m *QueryGetChainParamsForChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1796,7 @@ func (m *QueryGetChainParamsRequest) Reset()         { *m = QueryGetChainParamsR
 func (m *QueryGetChainParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsRequest) ProtoMessage()    {}
 func (*QueryGetChainParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{31}
+	return fileDescriptor_25b2aa420449a0c0, []int{38}
 }
 func (m *QueryGetChainParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1504,7 +1833,7 @@ func (m *QueryGetChainParamsResponse) Reset()         { *m = QueryGetChainParams
 func (m *QueryGetChainParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainParamsResponse) ProtoMessage()    {}
 func (*QueryGetChainParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{32}
+	return fileDescriptor_25b2aa420449a0c0, []int{39}
 }
 func (m *QueryGetChainParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1548,7 +1877,7 @@ func (m *QueryGetNodeAccountRequest) Reset()         { *m = QueryGetNodeAccountR
 func (m *QueryGetNodeAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNodeAccountRequest) ProtoMessage()    {}
 func (*QueryGetNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{33}
+	return fileDescriptor_25b2aa420449a0c0, []int{40}
 }
 func (m *QueryGetNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1921,7 @@ func (m *QueryGetNodeAccountResponse) Reset()         { *m = QueryGetNodeAccount
 func (m *QueryGetNodeAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNodeAccountResponse) ProtoMessage()    {}
 func (*QueryGetNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{34}
+	return fileDescriptor_25b2aa420449a0c0, []int{41}
 }
 func (m *QueryGetNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1636,7 +1965,7 @@ func (m *QueryAllNodeAccountRequest) Reset()         { *m = QueryAllNodeAccountR
 func (m *QueryAllNodeAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllNodeAccountRequest) ProtoMessage()    {}
 func (*QueryAllNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{35}
+	return fileDescriptor_25b2aa420449a0c0, []int{42}
 }
 func (m *QueryAllNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1681,7 +2010,7 @@ func (m *QueryAllNodeAccountResponse) Reset()         { *m = QueryAllNodeAccount
 func (m *QueryAllNodeAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllNodeAccountResponse) ProtoMessage()    {}
 func (*QueryAllNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{36}
+	return fileDescriptor_25b2aa420449a0c0, []int{43}
 }
 func (m *QueryAllNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +2060,7 @@ func (m *QueryGetCrosschainFlagsRequest) Reset()         { *m = QueryGetCrosscha
 func (m *QueryGetCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCrosschainFlagsRequest) ProtoMessage()    {}
 func (*QueryGetCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{37}
+	return fileDescriptor_25b2aa420449a0c0, []int{44}
 }
 func (m *QueryGetCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1768,7 +2097,7 @@ func (m *QueryGetCrosschainFlagsResponse) Reset()         { *m = QueryGetCrossch
 func (m *QueryGetCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetCrosschainFlagsResponse) ProtoMessage()    {}
 func (*QueryGetCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{38}
+	return fileDescriptor_25b2aa420449a0c0, []int{45}
 }
 func (m *QueryGetCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1811,7 +2140,7 @@ func (m *QueryGetKeygenRequest) Reset()         { *m = QueryGetKeygenRequest{} }
 func (m *QueryGetKeygenRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeygenRequest) ProtoMessage()    {}
 func (*QueryGetKeygenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{39}
+	return fileDescriptor_25b2aa420449a0c0, []int{46}
 }
 func (m *QueryGetKeygenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +2177,7 @@ func (m *QueryGetKeygenResponse) Reset()         { *m = QueryGetKeygenResponse{}
 func (m *QueryGetKeygenResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetKeygenResponse) ProtoMessage()    {}
 func (*QueryGetKeygenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{40}
+	return fileDescriptor_25b2aa420449a0c0, []int{47}
 }
 func (m *QueryGetKeygenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1891,7 +2220,7 @@ func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserver
 func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryShowObserverCountRequest) ProtoMessage()    {}
 func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{41}
+	return fileDescriptor_25b2aa420449a0c0, []int{48}
 }
 func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1928,7 +2257,7 @@ func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserve
 func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryShowObserverCountResponse) ProtoMessage()    {}
 func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{42}
+	return fileDescriptor_25b2aa420449a0c0, []int{49}
 }
 func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1972,7 +2301,7 @@ func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdent
 func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{43}
+	return fileDescriptor_25b2aa420449a0c0, []int{50}
 }
 func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2016,7 +2345,7 @@ func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIden
 func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{44}
+	return fileDescriptor_25b2aa420449a0c0, []int{51}
 }
 func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2389,7 @@ func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecord
 func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
 func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{45}
+	return fileDescriptor_25b2aa420449a0c0, []int{52}
 }
 func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2105,7 +2434,7 @@ func (m *QueryAllBlameRecordsResponse) Reset()         { *m = QueryAllBlameRecor
 func (m *QueryAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllBlameRecordsResponse) ProtoMessage()    {}
 func (*QueryAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{46}
+	return fileDescriptor_25b2aa420449a0c0, []int{53}
 }
 func (m *QueryAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2157,7 +2486,7 @@ func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByCh
 func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
 func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{47}
+	return fileDescriptor_25b2aa420449a0c0, []int{54}
 }
 func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2208,7 +2537,7 @@ func (m *QueryBlameByChainAndNonceResponse) Reset()         { *m = QueryBlameByC
 func (m *QueryBlameByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlameByChainAndNonceResponse) ProtoMessage()    {}
 func (*QueryBlameByChainAndNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_25b2aa420449a0c0, []int{48}
+	return fileDescriptor_25b2aa420449a0c0, []int{55}
 }
 func (m *QueryBlameByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2245,6 +2574,13 @@ func (m *QueryBlameByChainAndNonceResponse) GetBlameInfo() []*Blame {
 }
 
 func init() {
+	proto.RegisterType((*QueryGetLivenessParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetLivenessParamsRequest")
+	proto.RegisterType((*QueryGetLivenessParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetLivenessParamsResponse")
+	proto.RegisterType((*ObserverLivenessInfo)(nil), "zetachain.zetacore.observer.ObserverLivenessInfo")
+	proto.RegisterType((*QueryGetObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryGetObserverLivenessRequest")
+	proto.RegisterType((*QueryGetObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryGetObserverLivenessResponse")
+	proto.RegisterType((*QueryAllObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryAllObserverLivenessRequest")
+	proto.RegisterType((*QueryAllObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryAllObserverLivenessResponse")
 	proto.RegisterType((*QueryTssFundsMigratorInfoAllRequest)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllRequest")
 	proto.RegisterType((*QueryTssFundsMigratorInfoAllResponse)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoAllResponse")
 	proto.RegisterType((*QueryTssFundsMigratorInfoRequest)(nil), "zetachain.zetacore.observer.QueryTssFundsMigratorInfoRequest")
@@ -2301,151 +2637,171 @@ func init() {
 }
 
 var fileDescriptor_25b2aa420449a0c0 = []byte{
-	// 2300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf6, 0x4a, 0x89, 0x22, 0x8d, 0x6c, 0xfd, 0x18, 0xcb, 0xb6, 0x42, 0x3b, 0xb2, 0xbc, 0x92,
-	0x63, 0x59, 0x96, 0xb8, 0xb6, 0xec, 0xd4, 0xbf, 0xe2, 0xd8, 0xa2, 0x6b, 0x49, 0x76, 0x12, 0xdb,
-	0x21, 0xdd, 0x06, 0x30, 0xda, 0xb2, 0x4b, 0xee, 0x90, 0xdc, 0x7a, 0xb5, 0xc3, 0xec, 0x8c, 0x9c,
-	0x30, 0xaa, 0x80, 0xa2, 0xb7, 0xe6, 0x50, 0x14, 0x28, 0xd0, 0xde, 0x8a, 0x02, 0x45, 0x8f, 0x05,
-	0x8a, 0x00, 0x41, 0x0b, 0x14, 0x3d, 0xe4, 0xd4, 0x1c, 0x7a, 0x48, 0xd1, 0xa2, 0xe8, 0xa9, 0x35,
-	0xec, 0xfe, 0x21, 0xc5, 0xce, 0xbc, 0x25, 0x77, 0x97, 0xbb, 0xcb, 0x21, 0xad, 0x9e, 0xc8, 0x9d,
-	0x99, 0xf7, 0xe6, 0xfb, 0xde, 0xce, 0xbc, 0xf9, 0x66, 0x66, 0xd1, 0x99, 0x4f, 0x09, 0x37, 0xab,
-	0x0d, 0xd3, 0x76, 0x0d, 0xf1, 0x8f, 0x7a, 0xc4, 0xa0, 0x15, 0x46, 0xbc, 0xa7, 0xc4, 0x33, 0x3e,
-	0xda, 0x21, 0x5e, 0x2b, 0xdf, 0xf4, 0x28, 0xa7, 0xf8, 0x78, 0xbb, 0x61, 0x3e, 0x68, 0x98, 0x0f,
-	0x1a, 0xe6, 0x96, 0xab, 0x94, 0x6d, 0x53, 0x66, 0x54, 0x4c, 0x46, 0xa4, 0x95, 0xf1, 0xf4, 0x42,
-	0x85, 0x70, 0xf3, 0x82, 0xd1, 0x34, 0xeb, 0xb6, 0x6b, 0x72, 0x9b, 0xba, 0xd2, 0x51, 0x6e, 0xa6,
-	0x4e, 0xeb, 0x54, 0xfc, 0x35, 0xfc, 0x7f, 0x50, 0x7a, 0xa2, 0x4e, 0x69, 0xdd, 0x21, 0x86, 0xd9,
-	0xb4, 0x0d, 0xd3, 0x75, 0x29, 0x17, 0x26, 0x0c, 0x6a, 0x97, 0xb2, 0x50, 0x56, 0x4c, 0xc7, 0xa1,
-	0x1c, 0x5a, 0x66, 0xf2, 0xa9, 0x38, 0xe6, 0x36, 0x81, 0x86, 0xf9, 0xac, 0x86, 0xa2, 0xbc, 0xec,
-	0x52, 0xb7, 0x4a, 0x02, 0x08, 0x6b, 0x99, 0xed, 0x3d, 0xca, 0x98, 0x34, 0xaa, 0x39, 0x66, 0x5d,
-	0x09, 0xf6, 0x13, 0xd2, 0xaa, 0x13, 0x57, 0x05, 0x8d, 0x4b, 0x2d, 0x52, 0x36, 0xab, 0x55, 0xba,
-	0xe3, 0x06, 0x34, 0x97, 0xb3, 0xda, 0x07, 0x7f, 0x54, 0x50, 0x34, 0x4d, 0xcf, 0xdc, 0x0e, 0xf0,
-	0x9e, 0xcf, 0x6c, 0x49, 0x5c, 0xcb, 0x76, 0xeb, 0xd1, 0xa8, 0x9c, 0xce, 0xb2, 0xe0, 0x8c, 0x65,
-	0xc0, 0x6d, 0x3e, 0xa9, 0xcb, 0x38, 0x33, 0xf8, 0xe9, 0xd1, 0xb6, 0xe9, 0x51, 0x5a, 0x63, 0xf0,
-	0x03, 0x6d, 0x2f, 0xf5, 0xe8, 0xbe, 0x5c, 0xdb, 0x71, 0x2d, 0x56, 0xde, 0xb6, 0xeb, 0x9e, 0xc9,
-	0x29, 0x04, 0x44, 0x3f, 0x8d, 0x16, 0x3e, 0xf0, 0xc7, 0xe8, 0x23, 0xc6, 0x36, 0xfc, 0xfa, 0xf7,
-	0xa1, 0xfa, 0xae, 0x5b, 0xa3, 0xeb, 0x8e, 0x53, 0x24, 0x1f, 0xed, 0x10, 0xc6, 0xf5, 0x9f, 0x6a,
-	0x68, 0x31, 0xbb, 0x1d, 0x6b, 0x52, 0x97, 0x11, 0x5c, 0x43, 0x87, 0xbb, 0xfb, 0x62, 0xb3, 0xda,
-	0xfc, 0xf0, 0xd2, 0xf8, 0xda, 0xf9, 0x7c, 0xc6, 0xc4, 0xc9, 0x83, 0xeb, 0xb0, 0xe7, 0xc2, 0x2b,
-	0x5f, 0xfd, 0xfb, 0xe4, 0x81, 0xe2, 0x34, 0x8f, 0xf5, 0xca, 0xf4, 0x1b, 0x68, 0x3e, 0x15, 0x0f,
-	0x80, 0xc6, 0xaf, 0xa3, 0x51, 0x39, 0x0e, 0x6d, 0x6b, 0x56, 0x9b, 0xd7, 0x96, 0x86, 0x8b, 0xaf,
-	0x89, 0xe7, 0xbb, 0x96, 0xfe, 0x13, 0x0d, 0x9d, 0xca, 0xb0, 0x07, 0x32, 0x16, 0xc2, 0xdd, 0x64,
-	0x84, 0xab, 0xc1, 0xb9, 0x4c, 0xc5, 0xb9, 0xe8, 0x97, 0x51, 0x4e, 0x40, 0xd9, 0x24, 0xfc, 0xb6,
-	0xef, 0xee, 0xbe, 0x18, 0x54, 0x0a, 0x24, 0x28, 0x3a, 0x9e, 0x68, 0x08, 0xe8, 0x1f, 0xa2, 0xf1,
-	0x50, 0x31, 0xc0, 0x5e, 0xca, 0x84, 0x1d, 0x6a, 0x0f, 0x70, 0xc3, 0x2e, 0x74, 0x0b, 0x90, 0xae,
-	0x3b, 0x4e, 0x02, 0xd2, 0x0d, 0x84, 0x3a, 0x09, 0x0e, 0xba, 0x7b, 0x33, 0x2f, 0xb3, 0x61, 0xde,
-	0xcf, 0x86, 0x79, 0x99, 0x43, 0x21, 0x1b, 0xe6, 0x1f, 0x9a, 0x75, 0x02, 0xb6, 0xc5, 0x90, 0xa5,
-	0xfe, 0x47, 0x0d, 0x78, 0xc5, 0xbb, 0x49, 0xe3, 0x35, 0xfc, 0x92, 0xbc, 0xf0, 0x66, 0x04, 0xf9,
-	0x90, 0x40, 0x7e, 0xa6, 0x27, 0x72, 0x09, 0x27, 0x02, 0xbd, 0x86, 0x4e, 0x04, 0xc8, 0x1f, 0xca,
-	0x14, 0xf1, 0xff, 0x09, 0xd1, 0x97, 0x1a, 0x7a, 0x23, 0xa5, 0x23, 0x08, 0xd2, 0x87, 0x68, 0x22,
-	0x9a, 0xa4, 0x20, 0x4e, 0xcb, 0x99, 0x71, 0x8a, 0xf8, 0x82, 0x48, 0x1d, 0x6a, 0x86, 0x0b, 0xf7,
-	0x2f, 0x56, 0xc1, 0x0c, 0x8e, 0xf6, 0xd9, 0x12, 0xef, 0x45, 0x61, 0xf0, 0xff, 0x10, 0x26, 0x70,
-	0xb2, 0x79, 0x46, 0x14, 0xb4, 0x7d, 0x88, 0x82, 0x3e, 0x83, 0x70, 0x30, 0xf5, 0x1e, 0x95, 0x4a,
-	0x41, 0x96, 0x7c, 0x80, 0x0e, 0x47, 0x4a, 0x01, 0xc5, 0x15, 0x34, 0xfc, 0xa8, 0x54, 0x82, 0xae,
-	0xe7, 0xb3, 0xf3, 0x46, 0xa9, 0x04, 0x1d, 0xfa, 0x26, 0xfa, 0x1d, 0xf4, 0x7a, 0xdb, 0x21, 0x63,
-	0xeb, 0x96, 0xe5, 0x11, 0xd6, 0x1e, 0x4c, 0x4b, 0x68, 0xaa, 0x62, 0xf3, 0x2a, 0xb5, 0xdd, 0x72,
-	0x3b, 0x48, 0x43, 0x22, 0x48, 0x13, 0x50, 0x7e, 0x1b, 0x62, 0x75, 0xab, 0x93, 0x61, 0xc2, 0x6e,
-	0x00, 0xde, 0x14, 0x1a, 0x26, 0xbc, 0x21, 0xe0, 0x8d, 0x15, 0xfd, 0xbf, 0x7e, 0x49, 0x85, 0x57,
-	0x85, 0xb3, 0xb1, 0xa2, 0xff, 0x57, 0xff, 0x4c, 0x43, 0xcb, 0xdd, 0x2e, 0x0a, 0xad, 0x0d, 0xdb,
-	0x35, 0x1d, 0xfb, 0x53, 0x62, 0x6d, 0x11, 0xbb, 0xde, 0xe0, 0x01, 0xb4, 0x35, 0x74, 0xa4, 0x16,
-	0xd4, 0x94, 0x7d, 0x96, 0xe5, 0x86, 0xa8, 0x87, 0x97, 0x78, 0xb8, 0x5d, 0xf9, 0x98, 0x70, 0x53,
-	0x9a, 0xf6, 0x41, 0xe7, 0x03, 0x74, 0x4e, 0x09, 0x4b, 0x1f, 0xfc, 0xbe, 0x8f, 0x8e, 0x06, 0xcb,
-	0xc1, 0x96, 0xcd, 0x38, 0xf5, 0x5a, 0xfb, 0x3d, 0x65, 0x7f, 0xab, 0xa1, 0x63, 0x5d, 0x5d, 0x00,
-	0xc2, 0x75, 0x34, 0xea, 0xaf, 0x33, 0x8e, 0xcd, 0x38, 0x4c, 0x53, 0xd5, 0x51, 0xf2, 0x1a, 0x67,
-	0xec, 0x3d, 0x9b, 0xf1, 0xfd, 0x9b, 0x96, 0x0d, 0x34, 0x23, 0x60, 0x6e, 0x99, 0xec, 0xdb, 0x94,
-	0x13, 0x2b, 0x88, 0xc3, 0x39, 0x34, 0x2d, 0xc5, 0x65, 0xd9, 0xb6, 0x88, 0xcb, 0xed, 0x9a, 0x4d,
-	0x3c, 0x88, 0xe9, 0x94, 0xac, 0xb8, 0xdb, 0x2e, 0xc7, 0x0b, 0xe8, 0xd0, 0x53, 0xca, 0x89, 0x57,
-	0x36, 0xe5, 0xcb, 0x81, 0x50, 0x1f, 0x14, 0x85, 0xf0, 0xc2, 0xf4, 0x4b, 0xe8, 0x48, 0xac, 0x27,
-	0x08, 0xc7, 0x71, 0x34, 0xd6, 0x30, 0x59, 0xd9, 0x6f, 0x2c, 0xa7, 0xfd, 0x68, 0x71, 0xb4, 0x01,
-	0x8d, 0xf4, 0xf7, 0xd1, 0x9c, 0xb0, 0x2a, 0x88, 0x3e, 0x0b, 0xad, 0x4e, 0xaf, 0x83, 0x20, 0xd5,
-	0x39, 0x1a, 0xf3, 0xfd, 0x7a, 0x22, 0x88, 0x5d, 0xb0, 0xb5, 0x6e, 0xd8, 0xb8, 0x80, 0xc6, 0xfc,
-	0xe7, 0x32, 0x6f, 0x35, 0x89, 0xe0, 0x35, 0xb1, 0x76, 0x3a, 0xf3, 0x6d, 0xf9, 0xfe, 0x1f, 0xb5,
-	0x9a, 0xa4, 0x38, 0xfa, 0x14, 0xfe, 0xe9, 0x7f, 0x18, 0x42, 0x27, 0x53, 0x59, 0x40, 0x14, 0xfa,
-	0x0a, 0xf8, 0x3b, 0x68, 0x44, 0x80, 0xf4, 0x23, 0x3d, 0x2c, 0x46, 0x68, 0x2f, 0x44, 0x82, 0x71,
-	0x11, 0xac, 0xf0, 0x87, 0x68, 0x4a, 0xd6, 0x8a, 0x41, 0x20, 0xb9, 0x0d, 0x0b, 0x6e, 0x2b, 0x99,
-	0x9e, 0x1e, 0x74, 0x8c, 0x04, 0xc5, 0x49, 0x1a, 0x2d, 0xc0, 0xf7, 0xd1, 0x21, 0x60, 0xc1, 0xb8,
-	0xc9, 0x77, 0xd8, 0xec, 0x2b, 0xc2, 0xeb, 0xd9, 0x4c, 0xaf, 0x32, 0x2a, 0x25, 0x61, 0x50, 0x3c,
-	0x58, 0x09, 0x3d, 0xe9, 0x18, 0x4d, 0x89, 0xc0, 0x3d, 0x80, 0xb6, 0x25, 0xc2, 0xf5, 0x2b, 0x68,
-	0x36, 0x5e, 0xd6, 0x8e, 0xe2, 0x09, 0x34, 0x16, 0xb8, 0x95, 0x4b, 0xe0, 0x58, 0xb1, 0x53, 0xa0,
-	0x1f, 0x85, 0xc1, 0x5e, 0xda, 0x69, 0x36, 0xa9, 0xc7, 0x89, 0x25, 0x52, 0x0c, 0xd3, 0x2b, 0xb0,
-	0x8e, 0xc7, 0xca, 0xdb, 0x5e, 0x0b, 0x68, 0x44, 0xea, 0x74, 0x98, 0xae, 0x8b, 0x49, 0x74, 0x9a,
-	0x4f, 0xea, 0x79, 0x50, 0xf3, 0xc2, 0x1c, 0xa6, 0x2c, 0x58, 0xea, 0x37, 0x91, 0x1e, 0x51, 0x6f,
-	0x0f, 0xc5, 0xee, 0x63, 0x83, 0x7a, 0xaa, 0x2b, 0xa0, 0x07, 0xd2, 0x3d, 0xcd, 0x01, 0x60, 0x7d,
-	0x17, 0x1d, 0x94, 0x1e, 0xe4, 0xf6, 0x46, 0x5d, 0x07, 0x4a, 0x7f, 0xc5, 0xf1, 0x6a, 0xe7, 0x41,
-	0x3f, 0x11, 0xd3, 0xaa, 0xd0, 0x06, 0xd6, 0x3f, 0x37, 0x26, 0x48, 0x83, 0x5a, 0x40, 0xf2, 0x20,
-	0x11, 0xc9, 0x8a, 0x2a, 0x12, 0x31, 0x60, 0x23, 0x68, 0xd6, 0x3a, 0x68, 0xee, 0x53, 0x8b, 0xac,
-	0xcb, 0x6d, 0x61, 0x10, 0xba, 0x19, 0xf4, 0xaa, 0xed, 0x5a, 0xe4, 0x13, 0x98, 0x34, 0xf2, 0x41,
-	0xff, 0x41, 0x07, 0x63, 0xc4, 0xa6, 0x13, 0xad, 0xf0, 0x16, 0x53, 0x29, 0x5a, 0x61, 0x3f, 0xe3,
-	0x6e, 0xe7, 0x21, 0xac, 0x97, 0x13, 0xf0, 0xed, 0xd7, 0xca, 0xf2, 0x79, 0x48, 0x2f, 0x27, 0x51,
-	0xba, 0x87, 0xc6, 0x43, 0xc5, 0x4a, 0x7a, 0x39, 0xc2, 0x28, 0xf4, 0xb0, 0x7f, 0xcb, 0xcc, 0x3c,
-	0xa4, 0x71, 0x7f, 0xa8, 0xb4, 0x0f, 0x0c, 0x36, 0x1c, 0xb3, 0xde, 0x1e, 0x4c, 0x3f, 0xd2, 0x20,
-	0x47, 0x26, 0x35, 0x01, 0x6a, 0xdf, 0x45, 0x53, 0xf1, 0xe3, 0x06, 0xb5, 0x51, 0x15, 0xf5, 0x07,
-	0x33, 0x73, 0xb2, 0x1a, 0x2d, 0xd6, 0x8f, 0xc1, 0x0a, 0xb5, 0x49, 0xf8, 0xbb, 0xe2, 0x84, 0x22,
-	0xc0, 0xf6, 0x2d, 0x90, 0x0b, 0xa1, 0x0a, 0x40, 0x74, 0x1d, 0x8d, 0xc8, 0xc3, 0x0c, 0xc0, 0xb1,
-	0x90, 0x89, 0x03, 0x8c, 0xc1, 0x44, 0x3f, 0x09, 0xaa, 0xbe, 0xd4, 0xa0, 0x1f, 0x07, 0xc9, 0xec,
-	0x76, 0x68, 0xc8, 0xf8, 0x31, 0x99, 0x4b, 0x6b, 0x01, 0x00, 0xbe, 0x87, 0x0e, 0x3b, 0x26, 0xe3,
-	0xe5, 0xa0, 0x8f, 0x72, 0x78, 0x1c, 0xe7, 0x33, 0xd1, 0xbc, 0x67, 0x32, 0x1e, 0x75, 0x3a, 0xed,
-	0xc4, 0x8b, 0xf4, 0x7b, 0x80, 0xb1, 0xe0, 0x98, 0xdb, 0x24, 0x69, 0xf9, 0x3d, 0x8b, 0xa6, 0xc4,
-	0xd9, 0x52, 0xf7, 0xb2, 0x35, 0x29, 0xca, 0x43, 0x8b, 0x6f, 0x35, 0x58, 0xcb, 0xbb, 0x7d, 0xb5,
-	0x95, 0x11, 0x02, 0x67, 0x6e, 0x8d, 0x02, 0x09, 0x3d, 0x7b, 0xed, 0xf0, 0x9b, 0x17, 0xc7, 0x64,
-	0x57, 0x6e, 0x8d, 0xea, 0xa4, 0x33, 0x3b, 0x64, 0x1d, 0xa9, 0x52, 0xcf, 0xda, 0xf7, 0x2d, 0xd9,
-	0xef, 0xb5, 0xce, 0xde, 0x2f, 0xda, 0x0f, 0x50, 0xd9, 0x8c, 0x51, 0x19, 0x56, 0xa3, 0x02, 0x63,
-	0xb3, 0x43, 0x68, 0xff, 0xe6, 0x60, 0x09, 0x76, 0x60, 0x10, 0x7e, 0x91, 0x6a, 0xd7, 0x5d, 0x4b,
-	0x6c, 0x71, 0x7a, 0xaf, 0x3f, 0x7e, 0x7e, 0x15, 0x9b, 0x2a, 0x50, 0xe9, 0xf2, 0x41, 0xaf, 0xc1,
-	0xbe, 0x2c, 0xd9, 0x69, 0xca, 0x6b, 0x1d, 0xee, 0xfb, 0xb5, 0xae, 0xfd, 0x66, 0x11, 0xbd, 0x2a,
-	0x3a, 0xc2, 0x7f, 0xd6, 0xd0, 0x68, 0xa0, 0x21, 0xf1, 0x85, 0x4c, 0x2f, 0x49, 0xca, 0x36, 0xb7,
-	0xd6, 0x8f, 0x89, 0x24, 0xa0, 0xdf, 0xfb, 0xf1, 0xdf, 0xff, 0xfb, 0xf3, 0xa1, 0x6f, 0xe2, 0x82,
-	0x38, 0x6b, 0x5b, 0x95, 0xc7, 0x6e, 0xed, 0xd3, 0xb6, 0xb6, 0x7a, 0x35, 0x76, 0xbb, 0x24, 0xdc,
-	0x9e, 0xb1, 0x1b, 0xd1, 0x98, 0x7b, 0xf8, 0x9f, 0x1a, 0xc2, 0xdd, 0x3a, 0x10, 0x5f, 0xef, 0x0d,
-	0x2b, 0x55, 0x03, 0xe7, 0xde, 0x1e, 0xcc, 0x18, 0xd8, 0xdd, 0x11, 0xec, 0x6e, 0xe2, 0x1b, 0x89,
-	0xec, 0x80, 0x52, 0xa5, 0x15, 0x62, 0x95, 0x44, 0x14, 0xff, 0x4a, 0x43, 0xe3, 0x21, 0x4d, 0x86,
-	0x57, 0x7b, 0x83, 0x0a, 0x35, 0xcf, 0xbd, 0xd5, 0x57, 0xf3, 0x36, 0xf8, 0xb3, 0x02, 0xfc, 0x02,
-	0x3e, 0x95, 0x08, 0xbe, 0x9d, 0x16, 0x19, 0xe1, 0xf8, 0x77, 0x1a, 0x9a, 0x8c, 0x49, 0x3c, 0x95,
-	0x01, 0x14, 0x33, 0xc9, 0x5d, 0xed, 0xdb, 0xa4, 0x0d, 0x76, 0x45, 0x80, 0x7d, 0x13, 0x2f, 0x26,
-	0x82, 0x65, 0x31, 0x6c, 0xff, 0xd1, 0xd0, 0xd1, 0x64, 0xb5, 0x87, 0x6f, 0xf6, 0xc6, 0x90, 0x29,
-	0x34, 0x73, 0xb7, 0x06, 0x77, 0x00, 0x5c, 0x0a, 0x82, 0xcb, 0xdb, 0xf8, 0x5a, 0x22, 0x97, 0x3a,
-	0xe1, 0xe5, 0xb0, 0xfa, 0x2b, 0xd7, 0xa8, 0x27, 0x0b, 0x8c, 0xdd, 0x20, 0xc3, 0xec, 0xe1, 0xcf,
-	0x35, 0x34, 0x11, 0xed, 0x06, 0x5f, 0xee, 0x17, 0x58, 0xc0, 0xe8, 0x4a, 0xff, 0x86, 0xc0, 0x64,
-	0x55, 0x30, 0x39, 0x83, 0x4f, 0x2b, 0x31, 0xf1, 0x41, 0x47, 0x44, 0x92, 0x1a, 0xe2, 0x6e, 0x45,
-	0xa8, 0x88, 0x38, 0x41, 0xe3, 0xe9, 0xe7, 0x05, 0xe2, 0x65, 0xbc, 0x94, 0x88, 0x38, 0xa4, 0x49,
-	0x8d, 0x5d, 0x21, 0x83, 0xf7, 0xfc, 0xb1, 0x3f, 0x11, 0xf2, 0xb4, 0xee, 0x38, 0x2a, 0xb8, 0x13,
-	0x95, 0xac, 0x0a, 0xee, 0x64, 0x6d, 0xaa, 0x2f, 0x09, 0xdc, 0x3a, 0x9e, 0xef, 0x85, 0x1b, 0xff,
-	0x49, 0x43, 0x93, 0x31, 0xd9, 0xa6, 0x92, 0x22, 0x53, 0xf5, 0xa5, 0x4a, 0x8a, 0x4c, 0x57, 0x9e,
-	0x3d, 0x86, 0x48, 0x5c, 0x94, 0xe2, 0x5f, 0x68, 0x68, 0x44, 0x8a, 0x3d, 0xbc, 0xa6, 0xd4, 0x6f,
-	0x44, 0x6f, 0xe6, 0x2e, 0xf6, 0x65, 0x03, 0x10, 0x17, 0x04, 0xc4, 0x37, 0xf0, 0xf1, 0x44, 0x88,
-	0x52, 0x72, 0xe2, 0xbf, 0x68, 0x68, 0xba, 0x4b, 0x4c, 0xe2, 0x6b, 0x0a, 0x19, 0x2d, 0x45, 0xa3,
-	0xe6, 0xae, 0x0f, 0x64, 0x0b, 0x98, 0xaf, 0x0a, 0xcc, 0x17, 0xf1, 0x85, 0x30, 0xe6, 0xee, 0xeb,
-	0x2c, 0xd6, 0xa0, 0x1f, 0xc7, 0x14, 0x2e, 0xfe, 0x9b, 0x86, 0xa6, 0xbb, 0x84, 0xa4, 0x0a, 0x93,
-	0x34, 0x25, 0xab, 0xc2, 0x24, 0x55, 0xb9, 0xea, 0xb7, 0x05, 0x93, 0x1b, 0xf8, 0x7a, 0xf2, 0x1a,
-	0x2a, 0xd4, 0x4f, 0x7c, 0x09, 0x8d, 0xc9, 0xe6, 0x3d, 0x5f, 0xda, 0xe0, 0x4d, 0xc2, 0x63, 0x92,
-	0x12, 0xab, 0xcd, 0xb7, 0x04, 0xb5, 0xab, 0xb2, 0x54, 0xa5, 0xe8, 0x57, 0x7d, 0x4d, 0x10, 0x5a,
-	0xc1, 0xcb, 0xa9, 0x49, 0xd1, 0x74, 0x9c, 0xb2, 0xe4, 0xe0, 0x01, 0xd0, 0x67, 0x1a, 0x3a, 0x22,
-	0x9c, 0xb1, 0x98, 0x12, 0xc4, 0x37, 0x94, 0x63, 0x9b, 0x24, 0x4b, 0x73, 0xef, 0x0c, 0x6a, 0x0e,
-	0x64, 0xb6, 0x04, 0x99, 0x02, 0xbe, 0x95, 0xfd, 0x76, 0xe4, 0x14, 0x36, 0x5d, 0x4b, 0x5e, 0x1f,
-	0x84, 0x56, 0x2a, 0x63, 0x57, 0x94, 0xec, 0xe1, 0x2f, 0x35, 0x74, 0x28, 0x72, 0x10, 0x8d, 0xbf,
-	0xa1, 0x34, 0x59, 0xbb, 0xce, 0xf3, 0x73, 0x97, 0xfb, 0xb6, 0x03, 0x32, 0x37, 0x05, 0x99, 0xab,
-	0xf8, 0x72, 0xea, 0x9b, 0xe1, 0x8c, 0x05, 0x7a, 0xd3, 0xd8, 0x8d, 0x9f, 0xb2, 0xef, 0xe1, 0x5f,
-	0x0e, 0xa1, 0xb9, 0xec, 0xc3, 0x74, 0xbc, 0xd9, 0x27, 0xb8, 0xb4, 0xab, 0x81, 0xdc, 0xd6, 0xcb,
-	0x3b, 0x02, 0xda, 0x15, 0x41, 0xfb, 0x3b, 0xf8, 0xb1, 0x0a, 0xed, 0x72, 0x43, 0x9c, 0xb9, 0xdb,
-	0x55, 0xd3, 0x31, 0x76, 0x13, 0xef, 0x26, 0xf6, 0x92, 0x22, 0xf3, 0x99, 0x26, 0xee, 0x6e, 0xb0,
-	0xa1, 0x86, 0xba, 0x7d, 0x15, 0x94, 0x3b, 0xaf, 0x6e, 0x00, 0x74, 0xe6, 0x05, 0x9d, 0x1c, 0x9e,
-	0x4d, 0xa4, 0xe3, 0x83, 0xf8, 0xb5, 0x86, 0x50, 0xe7, 0xf6, 0x00, 0x2b, 0x2c, 0x0a, 0x5d, 0xd7,
-	0x19, 0xb9, 0x4b, 0xfd, 0x19, 0x01, 0xb6, 0x33, 0x02, 0xdb, 0x29, 0x7c, 0x32, 0x11, 0x1b, 0xef,
-	0x60, 0xfa, 0x42, 0x43, 0x53, 0x91, 0xeb, 0x33, 0x5f, 0x57, 0xa8, 0x25, 0x9d, 0xa4, 0x0b, 0xd3,
-	0xdc, 0xb5, 0x41, 0x4c, 0x01, 0xf4, 0xb2, 0x00, 0xbd, 0x88, 0xf5, 0x44, 0xd0, 0xd1, 0x5b, 0xcd,
-	0xbf, 0x6a, 0x68, 0x26, 0xe9, 0x26, 0x51, 0x25, 0x4f, 0x65, 0x5c, 0x60, 0xaa, 0xe4, 0xa9, 0xac,
-	0x0b, 0x4c, 0xfd, 0x2d, 0xc1, 0xc1, 0xc0, 0xab, 0xbd, 0x39, 0x84, 0x65, 0xf4, 0x17, 0x5a, 0xe4,
-	0x8e, 0xbc, 0x1f, 0x0d, 0x1d, 0x8d, 0xff, 0x95, 0xfe, 0x0d, 0x01, 0xf9, 0x45, 0x81, 0x7c, 0x15,
-	0x9f, 0x4b, 0x16, 0x48, 0x1d, 0x8b, 0x30, 0x6e, 0x5f, 0x94, 0x86, 0x9c, 0xa9, 0x8b, 0xd2, 0xc1,
-	0xa0, 0x27, 0x7f, 0x60, 0xd0, 0x43, 0x94, 0x86, 0xa0, 0xfb, 0xea, 0x69, 0x26, 0xe9, 0x0b, 0x12,
-	0x95, 0x61, 0x93, 0xf1, 0xe5, 0x8a, 0xca, 0xb0, 0xc9, 0xfa, 0x70, 0xa5, 0xc7, 0x76, 0xa0, 0x2e,
-	0xf2, 0x6b, 0xc4, 0x1a, 0xff, 0x43, 0x43, 0xc7, 0x52, 0xbe, 0xed, 0xc1, 0xb7, 0x06, 0x43, 0xd3,
-	0xf9, 0x7c, 0x28, 0xb7, 0xfe, 0x12, 0x1e, 0x80, 0xd2, 0x25, 0x41, 0x29, 0x8f, 0x57, 0xd2, 0x28,
-	0xad, 0x3b, 0x4e, 0xdc, 0x07, 0x2b, 0xdc, 0xf9, 0xea, 0xf9, 0x9c, 0xf6, 0xf5, 0xf3, 0x39, 0xed,
-	0xd9, 0xf3, 0x39, 0xed, 0x67, 0x2f, 0xe6, 0x0e, 0x7c, 0xfd, 0x62, 0xee, 0xc0, 0xbf, 0x5e, 0xcc,
-	0x1d, 0x78, 0x7c, 0xae, 0x6e, 0xf3, 0xc6, 0x4e, 0x25, 0x5f, 0xa5, 0xdb, 0x61, 0x8f, 0xfe, 0x96,
-	0xc3, 0xf8, 0x24, 0x94, 0xdb, 0x5a, 0x4d, 0xc2, 0x2a, 0x23, 0xe2, 0x63, 0xa9, 0x8b, 0xff, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0x77, 0x67, 0x2d, 0x08, 0x22, 0x28, 0x00, 0x00,
+	// 2612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xf5, 0xf6, 0x88, 0x89, 0x22, 0x1d, 0xd9, 0x12, 0x75, 0x2d, 0xdb, 0xca, 0x48, 0xd6, 0x63, 0xe4,
+	0x87, 0x2c, 0x5b, 0x1c, 0x5b, 0x76, 0x7e, 0x96, 0x2d, 0xbf, 0x48, 0xc7, 0x96, 0xed, 0x38, 0x7e,
+	0x90, 0xce, 0x2f, 0x80, 0xd1, 0x96, 0x1d, 0x72, 0x2e, 0xc9, 0x89, 0x47, 0x33, 0x0c, 0xef, 0x48,
+	0x89, 0xa2, 0x08, 0x28, 0xba, 0x6a, 0xb3, 0x28, 0x0a, 0x04, 0x68, 0x77, 0x45, 0x37, 0x5d, 0x16,
+	0x28, 0x02, 0x04, 0x29, 0x50, 0x74, 0x91, 0x6e, 0x9a, 0x45, 0x17, 0xe9, 0x03, 0x45, 0xd1, 0x45,
+	0x1a, 0xd8, 0xfd, 0x1f, 0xba, 0x2d, 0xe6, 0xde, 0x33, 0xe4, 0xcc, 0x70, 0x38, 0x1c, 0xd2, 0xea,
+	0x8a, 0x9c, 0x3b, 0xe7, 0x9c, 0xfb, 0x7d, 0xe7, 0xbe, 0xbe, 0xb9, 0xf7, 0xc2, 0xc9, 0x8f, 0xa8,
+	0xa3, 0x95, 0x6b, 0x9a, 0x61, 0xa9, 0xfc, 0x9f, 0xdd, 0xa0, 0xaa, 0x5d, 0x62, 0xb4, 0xb1, 0x45,
+	0x1b, 0xea, 0xfb, 0x9b, 0xb4, 0xb1, 0x9d, 0xa9, 0x37, 0x6c, 0xc7, 0x26, 0x53, 0x4d, 0xc3, 0x8c,
+	0x67, 0x98, 0xf1, 0x0c, 0xe5, 0xa5, 0xb2, 0xcd, 0x36, 0x6c, 0xa6, 0x96, 0x34, 0x46, 0x85, 0x97,
+	0xba, 0x75, 0xae, 0x44, 0x1d, 0xed, 0x9c, 0x5a, 0xd7, 0xaa, 0x86, 0xa5, 0x39, 0x86, 0x6d, 0x89,
+	0x40, 0xf2, 0x44, 0xd5, 0xae, 0xda, 0xfc, 0xaf, 0xea, 0xfe, 0xc3, 0xd2, 0xe9, 0xaa, 0x6d, 0x57,
+	0x4d, 0xaa, 0x6a, 0x75, 0x43, 0xd5, 0x2c, 0xcb, 0x76, 0xb8, 0x0b, 0xc3, 0xb7, 0x8b, 0x71, 0x28,
+	0x4b, 0x9a, 0x69, 0xda, 0x0e, 0x5a, 0xc6, 0xf2, 0x29, 0x99, 0xda, 0x06, 0x45, 0xc3, 0x4c, 0x9c,
+	0x21, 0x2f, 0x2f, 0x5a, 0xb6, 0x55, 0xa6, 0x1e, 0x84, 0x95, 0x58, 0xfb, 0x86, 0xcd, 0x98, 0x70,
+	0xaa, 0x98, 0x5a, 0x35, 0x11, 0xec, 0x67, 0x74, 0xbb, 0x4a, 0xad, 0x24, 0x68, 0x2c, 0x5b, 0xa7,
+	0x45, 0xad, 0x5c, 0xb6, 0x37, 0x2d, 0x8f, 0xe6, 0x52, 0x9c, 0xbd, 0xf7, 0x07, 0x6d, 0xcf, 0x27,
+	0xb1, 0x2d, 0x9a, 0xc6, 0x16, 0xb5, 0x28, 0x4b, 0x04, 0xbd, 0xae, 0x35, 0xb4, 0x0d, 0xcf, 0xf2,
+	0x6c, 0xac, 0x25, 0xb5, 0x74, 0xc3, 0xaa, 0x06, 0x53, 0x79, 0x3c, 0xce, 0xc3, 0x61, 0x2c, 0x86,
+	0x63, 0xfd, 0x59, 0x55, 0x34, 0x0e, 0xc3, 0x9f, 0x2e, 0xb6, 0xf5, 0x86, 0x6d, 0x57, 0x18, 0xfe,
+	0xa0, 0xed, 0x85, 0x2e, 0xd5, 0x17, 0x2b, 0x9b, 0x96, 0xce, 0x8a, 0x1b, 0x46, 0xb5, 0xa1, 0x39,
+	0x36, 0x66, 0x51, 0x99, 0x85, 0xa3, 0x8f, 0xdd, 0x8e, 0xbd, 0x4e, 0x9d, 0xfb, 0x98, 0xaa, 0x47,
+	0x3c, 0x0d, 0x79, 0xfa, 0xfe, 0x26, 0x65, 0x8e, 0xf2, 0x31, 0xcc, 0x74, 0x32, 0x60, 0x75, 0xdb,
+	0x62, 0x94, 0x3c, 0x85, 0x31, 0x2f, 0xcb, 0x45, 0x91, 0xc2, 0x49, 0x69, 0x4e, 0x5a, 0x1c, 0x59,
+	0x39, 0x9d, 0x89, 0x19, 0x5c, 0x99, 0x60, 0xb4, 0xdc, 0x2b, 0x5f, 0x7d, 0x33, 0xbb, 0x2f, 0x3f,
+	0x6a, 0x06, 0x4a, 0x95, 0x4f, 0x07, 0x60, 0xe2, 0x21, 0x7a, 0x78, 0x0e, 0x77, 0xad, 0x8a, 0x4d,
+	0x4e, 0x41, 0xba, 0xd9, 0xc6, 0x9a, 0xae, 0x37, 0x28, 0x13, 0xb5, 0x0e, 0xe7, 0xc7, 0xbc, 0xf2,
+	0xac, 0x28, 0x26, 0xf3, 0xb0, 0x7f, 0xc3, 0x60, 0x8c, 0xea, 0xc5, 0x2d, 0xdb, 0xa1, 0x6c, 0x72,
+	0x60, 0x4e, 0x5a, 0x4c, 0xe5, 0x47, 0x44, 0xd9, 0xff, 0xbb, 0x45, 0x64, 0x16, 0x46, 0x1c, 0xdb,
+	0xd1, 0x4c, 0xb4, 0x48, 0x71, 0x0b, 0xe0, 0x45, 0xc2, 0xe0, 0x71, 0x33, 0x46, 0xc3, 0x1d, 0xc1,
+	0x93, 0xaf, 0xb8, 0x55, 0xe5, 0x32, 0x2e, 0xe6, 0x7f, 0x7e, 0x33, 0x7b, 0xa2, 0x6a, 0x38, 0xb5,
+	0xcd, 0x52, 0xa6, 0x6c, 0x6f, 0xa8, 0x38, 0x65, 0x88, 0x9f, 0x65, 0xa6, 0x3f, 0x53, 0x9d, 0xed,
+	0x3a, 0x65, 0x99, 0x37, 0x69, 0xd9, 0xab, 0x33, 0xef, 0x86, 0x20, 0x87, 0x61, 0xf0, 0x3d, 0xcd,
+	0x30, 0xa9, 0x3e, 0xf9, 0xea, 0x9c, 0xb4, 0x38, 0x94, 0xc7, 0x27, 0x17, 0xae, 0xf8, 0x57, 0xdc,
+	0xb4, 0x1c, 0xc3, 0x9c, 0x1c, 0x14, 0x70, 0x45, 0xd9, 0x3b, 0x6e, 0x91, 0x72, 0x1f, 0x66, 0xbd,
+	0x36, 0x09, 0x27, 0x07, 0x9b, 0xad, 0x87, 0xfc, 0x28, 0x3f, 0x92, 0x60, 0xae, 0x73, 0x38, 0x6c,
+	0x64, 0x1d, 0xc6, 0xdb, 0xc6, 0x14, 0x36, 0xf3, 0xb9, 0xd8, 0x66, 0x8e, 0x6a, 0x3d, 0x6c, 0xec,
+	0xb4, 0x1d, 0x7a, 0xa7, 0xcc, 0x23, 0xb1, 0xac, 0x69, 0x76, 0x20, 0xd6, 0x42, 0x1b, 0x69, 0x13,
+	0x8f, 0x36, 0xb5, 0xb7, 0x68, 0x8f, 0xc3, 0x02, 0x47, 0xf2, 0x84, 0xb1, 0xdb, 0xee, 0xd8, 0x7a,
+	0x1b, 0x87, 0x96, 0xeb, 0x94, 0x35, 0x4d, 0x0f, 0xf1, 0x4f, 0x24, 0x38, 0x16, 0x6f, 0x87, 0xa8,
+	0x2b, 0x70, 0xb0, 0x7d, 0x9c, 0x7a, 0xb8, 0xcf, 0xc6, 0xe2, 0xc6, 0xd0, 0xfe, 0xc8, 0x08, 0x7b,
+	0xdc, 0x09, 0xd5, 0xca, 0x94, 0xab, 0x98, 0xc1, 0x28, 0x3c, 0x5e, 0xff, 0x79, 0x1d, 0x86, 0xc4,
+	0xc4, 0x6f, 0xe8, 0xbc, 0x99, 0x53, 0xf9, 0xd7, 0xf8, 0xf3, 0x5d, 0x5d, 0xf9, 0xb1, 0x04, 0xf3,
+	0x31, 0xfe, 0xcd, 0x26, 0x20, 0xed, 0x64, 0xb0, 0xc7, 0xf4, 0xcb, 0x25, 0x1d, 0xe6, 0xa2, 0x5c,
+	0x04, 0xd9, 0xeb, 0xba, 0x37, 0xdd, 0x70, 0x0f, 0xf8, 0x84, 0x9c, 0x80, 0x84, 0x0d, 0x53, 0x91,
+	0x8e, 0x88, 0xfe, 0x11, 0x8c, 0xf8, 0x8a, 0x11, 0xf6, 0x62, 0x2c, 0x6c, 0x9f, 0x3d, 0xc2, 0xf5,
+	0x87, 0x50, 0x74, 0x44, 0x9a, 0x35, 0xcd, 0x08, 0xa4, 0xb7, 0x01, 0x5a, 0x8a, 0x02, 0xab, 0x3b,
+	0x91, 0x11, 0x93, 0x48, 0xa6, 0xa4, 0x31, 0x9a, 0x11, 0xa2, 0x05, 0xe5, 0x47, 0xe6, 0x91, 0x56,
+	0xa5, 0xe8, 0x9b, 0xf7, 0x79, 0x2a, 0xbf, 0x95, 0x60, 0x2a, 0xb2, 0x9a, 0x4e, 0xbc, 0x52, 0x2f,
+	0xc9, 0x8b, 0xac, 0x07, 0x90, 0x0f, 0x70, 0xe4, 0x27, 0xbb, 0x22, 0x17, 0x70, 0x02, 0xd0, 0x2b,
+	0x30, 0xed, 0x21, 0x7f, 0x24, 0x96, 0xd7, 0xff, 0x4d, 0x8a, 0xbe, 0x94, 0xe0, 0x68, 0x87, 0x8a,
+	0x30, 0x49, 0xef, 0xc2, 0x68, 0x70, 0x81, 0xc7, 0x3c, 0x2d, 0xc5, 0xe6, 0x29, 0x10, 0x0b, 0x33,
+	0x75, 0xa0, 0xee, 0x2f, 0xdc, 0xbb, 0x5c, 0x79, 0x23, 0x38, 0x58, 0xe7, 0x36, 0x6f, 0x97, 0x04,
+	0x9d, 0xff, 0x63, 0x98, 0x8f, 0x71, 0x8f, 0xc9, 0x82, 0xb4, 0x07, 0x59, 0x50, 0x26, 0x80, 0x78,
+	0x43, 0xef, 0x49, 0xa1, 0xe0, 0xcd, 0x92, 0x0f, 0xe1, 0x60, 0xa0, 0x14, 0x51, 0xac, 0x42, 0xea,
+	0x49, 0xa1, 0x80, 0x55, 0xcf, 0xc5, 0xcf, 0x1b, 0x85, 0x02, 0x56, 0xe8, 0xba, 0x28, 0xb7, 0xe0,
+	0xf5, 0x66, 0x40, 0xc6, 0x70, 0xb1, 0xf3, 0x92, 0xb3, 0x08, 0xe9, 0x92, 0xe1, 0x94, 0x6d, 0xc3,
+	0x2a, 0x36, 0x93, 0x24, 0x74, 0xc1, 0x28, 0x96, 0xdf, 0xc4, 0x5c, 0xdd, 0x00, 0x39, 0x2a, 0x0c,
+	0xc2, 0x4b, 0x43, 0x8a, 0x3a, 0x35, 0x5c, 0x59, 0xdd, 0xbf, 0x6e, 0x49, 0xc9, 0x29, 0xf3, 0x60,
+	0xc3, 0x79, 0xf7, 0xaf, 0xf2, 0x89, 0x04, 0x4b, 0xed, 0x21, 0x72, 0xdb, 0xb7, 0x0d, 0x4b, 0x33,
+	0x8d, 0x8f, 0xa8, 0x7e, 0x87, 0x1a, 0xd5, 0x9a, 0xe3, 0x41, 0x5b, 0x81, 0x43, 0x15, 0xef, 0x4d,
+	0xd1, 0x65, 0x59, 0xac, 0xf1, 0xf7, 0xd8, 0x88, 0x07, 0x9b, 0x2f, 0x9f, 0x52, 0x47, 0x13, 0xae,
+	0x3d, 0xd0, 0x79, 0x0c, 0xa7, 0x13, 0x61, 0xe9, 0x81, 0xdf, 0xf7, 0xe1, 0xb0, 0xb7, 0x1c, 0xdc,
+	0x31, 0x98, 0x63, 0x37, 0xb6, 0xf7, 0x7a, 0xc8, 0xfe, 0x4a, 0x82, 0x23, 0x6d, 0x55, 0x20, 0xc2,
+	0x2c, 0x0c, 0xb9, 0xeb, 0x8c, 0x69, 0x30, 0x07, 0x87, 0x69, 0xd2, 0x5e, 0xf2, 0x9a, 0xc3, 0xd8,
+	0x7d, 0x83, 0x39, 0x7b, 0x37, 0x2c, 0x6b, 0x30, 0xc1, 0x61, 0xde, 0xd1, 0x98, 0x2b, 0x1b, 0x75,
+	0x2f, 0x0f, 0xa7, 0x61, 0x5c, 0x7c, 0xcd, 0x15, 0x0d, 0x9d, 0x5a, 0x8e, 0x51, 0x31, 0x68, 0x03,
+	0x73, 0x9a, 0x16, 0x2f, 0xee, 0x36, 0xcb, 0xc9, 0x02, 0x1c, 0xd8, 0xb2, 0x1d, 0x9f, 0x6c, 0x13,
+	0xa9, 0xde, 0xcf, 0x0b, 0x3d, 0xcd, 0x76, 0x01, 0x0e, 0x85, 0x6a, 0xc2, 0x74, 0x4c, 0xc1, 0x70,
+	0x4d, 0x63, 0x5c, 0xc7, 0x8a, 0x61, 0x3f, 0x94, 0x1f, 0xaa, 0xa1, 0x91, 0xf2, 0x36, 0x6a, 0xf9,
+	0x1c, 0xaf, 0x33, 0xb7, 0xdd, 0xaa, 0xb5, 0x1f, 0xa4, 0x8a, 0x03, 0xc3, 0x6e, 0xdc, 0x06, 0x4f,
+	0x62, 0x1b, 0x6c, 0xa9, 0x1d, 0x36, 0xc9, 0xc1, 0xb0, 0xfb, 0x5c, 0x74, 0x25, 0x31, 0xe7, 0x35,
+	0xba, 0x72, 0x3c, 0xb6, 0xb5, 0xdc, 0xf8, 0x4f, 0xb6, 0xeb, 0x34, 0x3f, 0xb4, 0x85, 0xff, 0x94,
+	0x2f, 0x06, 0x60, 0xb6, 0x23, 0x0b, 0xcc, 0x42, 0x4f, 0x09, 0xbf, 0x06, 0x83, 0x1c, 0xa4, 0x9b,
+	0xe9, 0x14, 0xef, 0xa1, 0xdd, 0x10, 0x71, 0xc6, 0x79, 0xf4, 0x22, 0xef, 0x7a, 0x52, 0x9b, 0x77,
+	0x02, 0xc1, 0x2d, 0xc5, 0xb9, 0x9d, 0x49, 0xa0, 0x35, 0xb9, 0x13, 0xa7, 0x38, 0x66, 0x07, 0x0b,
+	0xc8, 0x03, 0x38, 0x80, 0x2c, 0x98, 0xa3, 0x39, 0x9b, 0x8c, 0x7f, 0x75, 0x8c, 0xae, 0x9c, 0x8a,
+	0x8d, 0x2a, 0xb2, 0x52, 0xe0, 0x0e, 0xf9, 0xfd, 0x25, 0xdf, 0x93, 0x42, 0x20, 0xcd, 0x13, 0xe7,
+	0x89, 0xdc, 0x02, 0x75, 0x94, 0x55, 0x98, 0x0c, 0x97, 0x35, 0xb3, 0x38, 0x0d, 0xc3, 0x5e, 0x58,
+	0xb1, 0x04, 0x0e, 0xe7, 0x5b, 0x05, 0xca, 0x61, 0xec, 0xec, 0x85, 0xcd, 0x7a, 0xdd, 0x6e, 0x38,
+	0x54, 0xe7, 0x53, 0x0c, 0x53, 0x4a, 0x30, 0x1d, 0x55, 0xde, 0x8c, 0x9a, 0x83, 0x41, 0x8e, 0xdd,
+	0x5b, 0x55, 0x8f, 0x45, 0xd1, 0xa9, 0x3f, 0xab, 0x66, 0x84, 0x95, 0xd0, 0x1f, 0x38, 0x64, 0xd1,
+	0x53, 0xb9, 0x0e, 0x4a, 0x40, 0xbd, 0x89, 0xaf, 0xc5, 0xdb, 0x76, 0x23, 0xe9, 0x0a, 0xd8, 0x80,
+	0x85, 0xd8, 0x00, 0x88, 0xf5, 0x2d, 0xd8, 0x2f, 0x22, 0x04, 0xbe, 0x6b, 0x13, 0xe8, 0x25, 0xfc,
+	0x44, 0x1e, 0x29, 0xb7, 0x1e, 0x94, 0xe9, 0x90, 0x56, 0x0d, 0x7e, 0x67, 0x5b, 0x30, 0x15, 0xf9,
+	0x16, 0x91, 0x3c, 0x8c, 0x44, 0x72, 0x26, 0x29, 0x12, 0xde, 0x61, 0x03, 0x68, 0x56, 0x5a, 0x68,
+	0x1e, 0xd8, 0x3a, 0xcd, 0x8a, 0x7d, 0x18, 0x2f, 0x75, 0x13, 0xf0, 0xaa, 0x61, 0xe9, 0xf4, 0x43,
+	0x1c, 0x34, 0xe2, 0x41, 0x79, 0x0f, 0xa6, 0x22, 0x7d, 0x5a, 0xd9, 0xf2, 0xef, 0xe9, 0x24, 0xca,
+	0x96, 0x3f, 0xce, 0x88, 0xd5, 0x7a, 0xf0, 0xeb, 0xe5, 0x08, 0x7c, 0x7b, 0xb5, 0xb2, 0x7c, 0xe6,
+	0xd3, 0xcb, 0x51, 0x94, 0xee, 0xc1, 0x88, 0xaf, 0x38, 0x91, 0x5e, 0x0e, 0x30, 0xf2, 0x3d, 0xec,
+	0xdd, 0x32, 0x33, 0xd7, 0xda, 0x92, 0xb9, 0xd9, 0xdc, 0xa1, 0xbb, 0xed, 0x6e, 0xd0, 0x79, 0x9d,
+	0xe9, 0x07, 0x12, 0xcc, 0x76, 0x34, 0x41, 0x6a, 0xdf, 0x85, 0x74, 0x78, 0x7f, 0x2f, 0x59, 0xaf,
+	0x0a, 0xc6, 0xc3, 0x91, 0x39, 0x56, 0x0e, 0x16, 0x2b, 0x47, 0x70, 0x85, 0x5a, 0xa7, 0xce, 0x5b,
+	0x7c, 0x4b, 0xd0, 0xc3, 0xf6, 0x0e, 0x1c, 0x0e, 0xbf, 0x40, 0x44, 0x6b, 0x30, 0x28, 0x76, 0x0f,
+	0x11, 0xc7, 0x42, 0x2c, 0x0e, 0x74, 0x46, 0x97, 0xe6, 0x46, 0x56, 0xa1, 0x66, 0x7f, 0xe0, 0x4d,
+	0x66, 0x37, 0x7d, 0x5d, 0xc6, 0xcd, 0xc9, 0x4c, 0x27, 0x0b, 0x04, 0xf0, 0x3d, 0x38, 0x68, 0x6a,
+	0xcc, 0x29, 0x36, 0xf7, 0x0e, 0xfc, 0xfd, 0x38, 0x13, 0xbf, 0x9b, 0xa5, 0x31, 0x27, 0x18, 0x74,
+	0xdc, 0x0c, 0x17, 0x29, 0xf7, 0x10, 0x63, 0xce, 0xdd, 0xb0, 0x8d, 0x5a, 0x7e, 0x4f, 0x41, 0x9a,
+	0x6f, 0xe6, 0xb6, 0x2f, 0x5b, 0x63, 0xbc, 0xdc, 0xb7, 0xf8, 0x96, 0xbd, 0xb5, 0xbc, 0x3d, 0x56,
+	0x53, 0x19, 0x01, 0x06, 0xb3, 0x2a, 0x36, 0x92, 0x50, 0xe2, 0xd7, 0x0e, 0xd7, 0x3c, 0x3f, 0x2c,
+	0xaa, 0xb2, 0x2a, 0xb6, 0x42, 0x5b, 0xa3, 0x43, 0xbc, 0xa3, 0x65, 0xbb, 0xa1, 0xef, 0xf9, 0x27,
+	0xd9, 0x6f, 0x24, 0x98, 0x8e, 0xae, 0x07, 0xa9, 0xac, 0x87, 0xa8, 0xa4, 0x92, 0x51, 0xc1, 0xbe,
+	0xd9, 0x22, 0xb4, 0x77, 0x63, 0xb0, 0x80, 0x5f, 0x60, 0x98, 0x7e, 0x3e, 0xd5, 0x66, 0x2d, 0x9d,
+	0x7f, 0xe2, 0x74, 0x5f, 0x7f, 0xdc, 0xf9, 0x95, 0x7f, 0x54, 0xa1, 0x4a, 0x17, 0x0f, 0x4a, 0x05,
+	0xe6, 0x63, 0x82, 0x76, 0x68, 0xd6, 0x54, 0xcf, 0xcd, 0xba, 0xf2, 0x9f, 0x45, 0x78, 0x95, 0x57,
+	0x44, 0x7e, 0x2f, 0xc1, 0x90, 0xa7, 0x21, 0x49, 0xfc, 0xd6, 0x58, 0x94, 0xb2, 0x95, 0x57, 0x7a,
+	0x71, 0x11, 0x04, 0x94, 0x7b, 0x3f, 0xfc, 0xeb, 0xbf, 0x3f, 0x1d, 0x78, 0x93, 0xe4, 0xf8, 0x3e,
+	0xf5, 0x32, 0x77, 0x6e, 0xed, 0x54, 0x37, 0xd5, 0xab, 0xba, 0xd3, 0x26, 0xe1, 0x76, 0xd5, 0x9d,
+	0x80, 0xc6, 0xdc, 0x25, 0x7f, 0x97, 0x80, 0xb4, 0xeb, 0x40, 0xb2, 0xd6, 0x1d, 0x56, 0x47, 0x0d,
+	0x2c, 0x5f, 0xe9, 0xcf, 0x19, 0xd9, 0xdd, 0xe2, 0xec, 0xae, 0x93, 0xab, 0x91, 0xec, 0x90, 0x52,
+	0x69, 0xdb, 0xc7, 0x2a, 0x8a, 0x28, 0xf9, 0x85, 0x04, 0x23, 0x3e, 0x4d, 0x46, 0x96, 0xbb, 0x83,
+	0xf2, 0x99, 0xcb, 0x6f, 0xf4, 0x64, 0xde, 0x04, 0x7f, 0x8a, 0x83, 0x5f, 0x20, 0xf3, 0x91, 0xe0,
+	0xbd, 0x3f, 0x45, 0x46, 0x1d, 0xf2, 0x6b, 0x09, 0xc6, 0x42, 0x12, 0x2f, 0x49, 0x07, 0x0a, 0xb9,
+	0xc8, 0x97, 0x7a, 0x76, 0x69, 0x82, 0x3d, 0xc3, 0xc1, 0x9e, 0x20, 0xc7, 0x22, 0xc1, 0xb2, 0x10,
+	0xb6, 0x7f, 0x49, 0x70, 0x38, 0x5a, 0xed, 0x91, 0xeb, 0xdd, 0x31, 0xc4, 0x0a, 0x4d, 0xf9, 0x46,
+	0xff, 0x01, 0x90, 0x4b, 0x8e, 0x73, 0xb9, 0x42, 0x2e, 0x47, 0x72, 0xa9, 0x52, 0xa7, 0xe8, 0x57,
+	0x7f, 0xc5, 0x8a, 0xdd, 0x10, 0x05, 0xea, 0x8e, 0x37, 0xc3, 0xec, 0x92, 0xcf, 0x24, 0x18, 0x0d,
+	0x56, 0x43, 0x2e, 0xf6, 0x0a, 0xcc, 0x63, 0xb4, 0xda, 0xbb, 0x23, 0x32, 0x59, 0xe6, 0x4c, 0x4e,
+	0x92, 0xe3, 0x89, 0x98, 0xb8, 0xa0, 0x03, 0x22, 0x29, 0x19, 0xe2, 0x76, 0x45, 0x28, 0xaf, 0xf6,
+	0xee, 0x88, 0x88, 0xcf, 0x72, 0xc4, 0x4b, 0x64, 0x31, 0x12, 0xb1, 0x4f, 0x93, 0xaa, 0x3b, 0x5c,
+	0x06, 0xef, 0xba, 0x7d, 0x7f, 0xd4, 0x17, 0x29, 0x6b, 0x9a, 0x49, 0x70, 0x47, 0x2a, 0x59, 0x79,
+	0xb5, 0x77, 0x47, 0xc4, 0xbd, 0xc8, 0x71, 0x2b, 0x64, 0xae, 0x1b, 0x6e, 0xf2, 0x3b, 0x09, 0xc6,
+	0x42, 0xb2, 0x8d, 0xac, 0x25, 0x6b, 0xe1, 0x48, 0x7d, 0x29, 0x5f, 0xe9, 0xcf, 0x39, 0x51, 0x17,
+	0x09, 0x8b, 0x52, 0xf2, 0x33, 0x09, 0x06, 0x85, 0xd8, 0x23, 0x2b, 0x89, 0xea, 0x0d, 0xe8, 0x4d,
+	0xf9, 0x7c, 0x4f, 0x3e, 0x08, 0x71, 0x81, 0x43, 0x3c, 0x4a, 0xa6, 0x22, 0x21, 0x0a, 0xc9, 0x49,
+	0xfe, 0x28, 0xc1, 0x78, 0x9b, 0x98, 0x24, 0x97, 0x13, 0xcc, 0x68, 0x1d, 0x34, 0xaa, 0xbc, 0xd6,
+	0x97, 0x2f, 0x62, 0xbe, 0xc4, 0x31, 0x9f, 0x27, 0xe7, 0xfc, 0x98, 0xdb, 0x8f, 0x82, 0x59, 0xcd,
+	0xfe, 0x20, 0xa4, 0x70, 0xc9, 0x9f, 0x25, 0x18, 0x6f, 0x13, 0x92, 0x49, 0x98, 0x74, 0x52, 0xb2,
+	0xf2, 0x5a, 0x5f, 0xbe, 0xc8, 0xe4, 0x26, 0x67, 0x72, 0x95, 0xac, 0x45, 0xaf, 0xa1, 0x5c, 0xfd,
+	0x84, 0x97, 0xd0, 0x90, 0x6c, 0xde, 0x75, 0xa5, 0x0d, 0x59, 0xa7, 0x4e, 0x48, 0x52, 0x92, 0x64,
+	0xe3, 0x2d, 0x42, 0xed, 0xca, 0x97, 0xfa, 0xf0, 0x44, 0x42, 0x2b, 0x9c, 0xd0, 0x19, 0xb2, 0xd4,
+	0x71, 0x52, 0xd4, 0x4c, 0xb3, 0x28, 0x38, 0x34, 0x10, 0xe8, 0xb7, 0x12, 0x1c, 0xe2, 0xc1, 0x58,
+	0x48, 0x09, 0x92, 0xab, 0x89, 0x73, 0x1b, 0x25, 0x4b, 0xe5, 0x6b, 0xfd, 0xba, 0x23, 0x99, 0x3b,
+	0x9c, 0x4c, 0x8e, 0xdc, 0x88, 0x6f, 0x1d, 0x31, 0x84, 0x35, 0x4b, 0x17, 0xc7, 0x07, 0xbe, 0x95,
+	0x4a, 0xdd, 0xe1, 0x25, 0xbb, 0xe4, 0x4b, 0x09, 0x0e, 0x04, 0x36, 0xa2, 0xc9, 0xff, 0x25, 0x1a,
+	0xac, 0x6d, 0xfb, 0xf9, 0xf2, 0xc5, 0x9e, 0xfd, 0x90, 0xcc, 0x75, 0x4e, 0xe6, 0x12, 0xb9, 0xd8,
+	0xb1, 0x65, 0xdc, 0xdd, 0x65, 0xd4, 0x9b, 0xea, 0x4e, 0x78, 0x97, 0x7d, 0x97, 0xfc, 0x7c, 0x00,
+	0x66, 0xe2, 0x37, 0xd3, 0xc9, 0x7a, 0x8f, 0xe0, 0x3a, 0x1d, 0x0d, 0xc8, 0x77, 0x5e, 0x3e, 0x10,
+	0xd2, 0x2e, 0x71, 0xda, 0xdf, 0x21, 0x4f, 0x93, 0xd0, 0x2e, 0xd6, 0xf8, 0x9e, 0xbb, 0x51, 0xd6,
+	0x4c, 0x75, 0x27, 0xf2, 0x6c, 0x62, 0x37, 0x2a, 0x33, 0x9f, 0x48, 0xfc, 0xec, 0x86, 0xa8, 0xc9,
+	0x50, 0x37, 0x8f, 0x82, 0xe4, 0xb3, 0xc9, 0x1d, 0x90, 0xce, 0x1c, 0xa7, 0x23, 0x93, 0xc9, 0x48,
+	0x3a, 0x2e, 0x88, 0x5f, 0x4a, 0x00, 0xad, 0xd3, 0x03, 0x92, 0x60, 0x51, 0x68, 0x3b, 0xce, 0x90,
+	0x2f, 0xf4, 0xe6, 0x84, 0xd8, 0x4e, 0x72, 0x6c, 0xf3, 0x64, 0x36, 0x12, 0x9b, 0xd3, 0xc2, 0xf4,
+	0xb9, 0x04, 0xe9, 0xc0, 0xf1, 0x99, 0xab, 0x2b, 0x92, 0x4d, 0x3a, 0x51, 0x07, 0xa6, 0xf2, 0xe5,
+	0x7e, 0x5c, 0x11, 0xf4, 0x12, 0x07, 0x7d, 0x8c, 0x28, 0x91, 0xa0, 0x83, 0xa7, 0x9a, 0x7f, 0x92,
+	0x60, 0x22, 0xea, 0x24, 0x31, 0xc9, 0x3c, 0x15, 0x73, 0x80, 0x29, 0x5f, 0xeb, 0xd7, 0x1d, 0x39,
+	0xbc, 0xc1, 0x39, 0xa8, 0x64, 0xb9, 0x3b, 0x07, 0xbf, 0x8c, 0xfe, 0x5c, 0x0a, 0x9c, 0x91, 0xf7,
+	0xa2, 0xa1, 0x83, 0xf9, 0x5f, 0xed, 0xdd, 0x11, 0x91, 0x9f, 0xe7, 0xc8, 0x97, 0xc9, 0xe9, 0x68,
+	0x81, 0xd4, 0xf2, 0xf0, 0xe3, 0x76, 0x45, 0xa9, 0x2f, 0x58, 0x72, 0x51, 0xda, 0x1f, 0xf4, 0xe8,
+	0x0b, 0x06, 0x5d, 0x44, 0xa9, 0x0f, 0xba, 0xab, 0x9e, 0x26, 0xa2, 0x6e, 0x90, 0x24, 0xe9, 0x36,
+	0x31, 0x37, 0x57, 0xe4, 0x6b, 0xfd, 0xba, 0x27, 0xfa, 0x1c, 0xa8, 0x52, 0x27, 0xec, 0x4d, 0xfe,
+	0x26, 0xc1, 0x91, 0x0e, 0x77, 0x7b, 0xc8, 0x8d, 0xfe, 0xd0, 0xb4, 0xae, 0x0f, 0xc9, 0xd9, 0x97,
+	0x88, 0x80, 0x94, 0x2e, 0x70, 0x4a, 0x19, 0x72, 0xa6, 0x13, 0xa5, 0xac, 0x69, 0x86, 0x63, 0x30,
+	0xf2, 0x85, 0x04, 0xa3, 0xc1, 0x4b, 0x7a, 0x49, 0x14, 0x61, 0xa7, 0x8b, 0x84, 0xf2, 0x5a, 0x5f,
+	0xbe, 0x89, 0xbe, 0xf5, 0x43, 0xd7, 0x0f, 0xc9, 0x5f, 0x24, 0x48, 0x87, 0x6f, 0x72, 0x91, 0x64,
+	0xdf, 0x2c, 0x1d, 0xae, 0x9d, 0xc9, 0x57, 0xfb, 0xf4, 0x46, 0xfc, 0x59, 0x8e, 0x7f, 0x8d, 0x5c,
+	0x8a, 0xdf, 0x58, 0xf1, 0x88, 0xa8, 0x3b, 0xe1, 0xcb, 0x7b, 0xbb, 0xe4, 0x0f, 0x12, 0x1c, 0x0c,
+	0xc7, 0x77, 0x7b, 0xd8, 0x95, 0x44, 0x63, 0xf5, 0x25, 0x78, 0xc5, 0x5c, 0xb4, 0x53, 0x32, 0x9c,
+	0xd7, 0x22, 0x39, 0x91, 0x8c, 0x57, 0xee, 0xd6, 0x57, 0xcf, 0x67, 0xa4, 0xaf, 0x9f, 0xcf, 0x48,
+	0xdf, 0x3e, 0x9f, 0x91, 0x7e, 0xfa, 0x62, 0x66, 0xdf, 0xd7, 0x2f, 0x66, 0xf6, 0xfd, 0xe3, 0xc5,
+	0xcc, 0xbe, 0xa7, 0xa7, 0x7d, 0x77, 0x28, 0x7d, 0xb1, 0x2c, 0x5b, 0xa7, 0xea, 0x87, 0xad, 0x90,
+	0xfc, 0x32, 0x65, 0x69, 0x90, 0x5f, 0x5e, 0x3d, 0xff, 0xdf, 0x01, 0x00, 0xa8, 0xce, 0x7c, 0xfc,
+	0xe7, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2502,6 +2858,12 @@ type QueryClient interface {
 	TssFundsMigratorInfo(ctx context.Context, in *QueryTssFundsMigratorInfoRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoResponse, error)
 	// Queries all TssFundMigratorInfo
 	TssFundsMigratorInfoAll(ctx context.Context, in *QueryTssFundsMigratorInfoAllRequest, opts ...grpc.CallOption) (*QueryTssFundsMigratorInfoAllResponse, error)
+	// Queries the liveness params
+	LivenessParams(ctx context.Context, in *QueryGetLivenessParamsRequest, opts ...grpc.CallOption) (*QueryGetLivenessParamsResponse, error)
+	// Queries the missed votes of an observer
+	ObserverLiveness(ctx context.Context, in *QueryGetObserverLivenessRequest, opts ...grpc.CallOption) (*QueryGetObserverLivenessResponse, error)
+	// Queries the missed votes of all observers
+	ObserverLivenessAll(ctx context.Context, in *QueryAllObserverLivenessRequest, opts ...grpc.CallOption) (*QueryAllObserverLivenessResponse, error)
 }
 
 type queryClient struct {
@@ -2728,6 +3090,33 @@ func (c *queryClient) TssFundsMigratorInfoAll(ctx context.Context, in *QueryTssF
 	return out, nil
 }
 
+func (c *queryClient) LivenessParams(ctx context.Context, in *QueryGetLivenessParamsRequest, opts ...grpc.CallOption) (*QueryGetLivenessParamsResponse, error) {
+	out := new(QueryGetLivenessParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/LivenessParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ObserverLiveness(ctx context.Context, in *QueryGetObserverLivenessRequest, opts ...grpc.CallOption) (*QueryGetObserverLivenessResponse, error) {
+	out := new(QueryGetObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLiveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ObserverLivenessAll(ctx context.Context, in *QueryAllObserverLivenessRequest, opts ...grpc.CallOption) (*QueryAllObserverLivenessResponse, error) {
+	out := new(QueryAllObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLivenessAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Query if a voter has voted for a ballot
@@ -2772,6 +3161,12 @@ type QueryServer interface {
 	TssFundsMigratorInfo(context.Context, *QueryTssFundsMigratorInfoRequest) (*QueryTssFundsMigratorInfoResponse, error)
 	// Queries all TssFundMigratorInfo
 	TssFundsMigratorInfoAll(context.Context, *QueryTssFundsMigratorInfoAllRequest) (*QueryTssFundsMigratorInfoAllResponse, error)
+	// Queries the liveness params
+	LivenessParams(context.Context, *QueryGetLivenessParamsRequest) (*QueryGetLivenessParamsResponse, error)
+	// Queries the missed votes of an observer
+	ObserverLiveness(context.Context, *QueryGetObserverLivenessRequest) (*QueryGetObserverLivenessResponse, error)
+	// Queries the missed votes of all observers
+	ObserverLivenessAll(context.Context, *QueryAllObserverLivenessRequest) (*QueryAllObserverLivenessResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2850,6 +3245,15 @@ func (*UnimplementedQueryServer) TssFundsMigratorInfo(ctx context.Context, req *
 func (*UnimplementedQueryServer) TssFundsMigratorInfoAll(ctx context.Context, req *QueryTssFundsMigratorInfoAllRequest) (*QueryTssFundsMigratorInfoAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TssFundsMigratorInfoAll not implemented")
 }
+func (*UnimplementedQueryServer) LivenessParams(ctx context.Context, req *QueryGetLivenessParamsRequest) (*QueryGetLivenessParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LivenessParams not implemented")
+}
+func (*UnimplementedQueryServer) ObserverLiveness(ctx context.Context, req *QueryGetObserverLivenessRequest) (*QueryGetObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLiveness not implemented")
+}
+func (*UnimplementedQueryServer) ObserverLivenessAll(ctx context.Context, req *QueryAllObserverLivenessRequest) (*QueryAllObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLivenessAll not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3287,6 +3691,60 @@ func _Query_TssFundsMigratorInfoAll_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LivenessParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLivenessParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LivenessParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/LivenessParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LivenessParams(ctx, req.(*QueryGetLivenessParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObserverLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLiveness(ctx, req.(*QueryGetObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ObserverLivenessAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLivenessAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLivenessAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLivenessAll(ctx, req.(*QueryAllObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.observer.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3387,12 +3845,24 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TssFundsMigratorInfoAll",
 			Handler:    _Query_TssFundsMigratorInfoAll_Handler,
 		},
+		{
+			MethodName: "LivenessParams",
+			Handler:    _Query_LivenessParams_Handler,
+		},
+		{
+			MethodName: "ObserverLiveness",
+			Handler:    _Query_ObserverLiveness_Handler,
+		},
+		{
+			MethodName: "ObserverLivenessAll",
+			Handler:    _Query_ObserverLivenessAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/observer/query.proto",
 }
 
-func (m *QueryTssFundsMigratorInfoAllRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetLivenessParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3402,12 +3872,12 @@ func (m *QueryTssFundsMigratorInfoAllRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetLivenessParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssFundsMigratorInfoAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetLivenessParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3415,7 +3885,7 @@ func (m *QueryTssFundsMigratorInfoAllRequest) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetLivenessParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3425,34 +3895,30 @@ func (m *QueryTssFundsMigratorInfoAllResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetLivenessParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetLivenessParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TssFundsMigrators) > 0 {
-		for iNdEx := len(m.TssFundsMigrators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TssFundsMigrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *ObserverLivenessInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3462,58 +3928,62 @@ func (m *QueryTssFundsMigratorInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObserverLivenessInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObserverLivenessInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.JailedUntil != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JailedUntil))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x30
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryTssFundsMigratorInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTssFundsMigratorInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTssFundsMigratorInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	{
-		size, err := m.TssFundsMigrator.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MissedRatio.Size()
+		i -= size
+		if _, err := m.MissedRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x22
+	if m.TotalVotes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissedVotes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedVotes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3523,25 +3993,27 @@ func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3551,18 +4023,18 @@ func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ObserverLiveness.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3574,7 +4046,7 @@ func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3584,32 +4056,20 @@ func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3619,32 +4079,20 @@ func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainNonces) > 0 {
-		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ObserverLiveness) > 0 {
+		for iNdEx := len(m.ObserverLiveness) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ObserverLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3658,7 +4106,7 @@ func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigratorInfoAllRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3668,32 +4116,20 @@ func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoAllRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigratorInfoAllResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3703,32 +4139,20 @@ func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoAllResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PendingNonces) > 0 {
-		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TssFundsMigrators) > 0 {
+		for iNdEx := len(m.TssFundsMigrators) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TssFundsMigrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3742,7 +4166,7 @@ func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigratorInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3752,12 +4176,12 @@ func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3770,7 +4194,7 @@ func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssFundsMigratorInfoResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3780,18 +4204,18 @@ func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssFundsMigratorInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.TssFundsMigrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3803,7 +4227,7 @@ func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3813,20 +4237,25 @@ func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3836,18 +4265,18 @@ func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3859,35 +4288,7 @@ func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3897,67 +4298,32 @@ func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.FinalizedZetaHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedZetaHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3967,34 +4333,46 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.ChainNonces) > 0 {
+		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4004,12 +4382,12 @@ func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4029,7 +4407,7 @@ func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4039,12 +4417,12 @@ func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4061,10 +4439,10 @@ func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.TssList) > 0 {
-		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingNonces) > 0 {
+		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4078,7 +4456,7 @@ func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4088,34 +4466,25 @@ func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4125,30 +4494,30 @@ func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.HasVoted {
-		i--
-		if m.HasVoted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4158,27 +4527,20 @@ func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *VoterList) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4188,32 +4550,30 @@ func (m *VoterList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.VoteType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4223,51 +4583,25 @@ func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BallotStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.ObservationType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voters) > 0 {
-		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4277,20 +4611,34 @@ func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryObserverSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4300,29 +4648,30 @@ func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Observers) > 0 {
-		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Observers[iNdEx])
-			copy(dAtA[i:], m.Observers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FinalizedZetaHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedZetaHeight))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4332,20 +4681,34 @@ func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4355,34 +4718,32 @@ func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4392,25 +4753,46 @@ func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.TssList) > 0 {
+		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4420,32 +4802,34 @@ func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4455,20 +4839,30 @@ func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.HasVoted {
+		i--
+		if m.HasVoted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4478,32 +4872,27 @@ func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *VoterList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4513,27 +4902,32 @@ func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+	if m.VoteType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4543,32 +4937,51 @@ func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NodeAccount != nil {
-		{
-			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.BallotStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ObservationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voters) > 0 {
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
+	}
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4578,32 +4991,20 @@ func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserverSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4613,38 +5014,21 @@ func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NodeAccount) > 0 {
-		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
+	if len(m.Observers) > 0 {
+		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Observers[iNdEx])
+			copy(dAtA[i:], m.Observers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4652,7 +5036,7 @@ func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4662,12 +5046,12 @@ func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4675,7 +5059,7 @@ func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4685,30 +5069,34 @@ func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4718,20 +5106,25 @@ func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4741,19 +5134,19 @@ func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Keygen != nil {
+	if m.ChainParams != nil {
 		{
-			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4766,7 +5159,7 @@ func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4776,12 +5169,12 @@ func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4789,7 +5182,7 @@ func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4799,19 +5192,19 @@ func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
+	if m.ChainParams != nil {
 		{
-			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4824,7 +5217,7 @@ func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4834,27 +5227,27 @@ func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameIdentifier) > 0 {
-		i -= len(m.BlameIdentifier)
-		copy(dAtA[i:], m.BlameIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4864,19 +5257,19 @@ func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlameInfo != nil {
+	if m.NodeAccount != nil {
 		{
-			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4889,7 +5282,7 @@ func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4899,12 +5292,12 @@ func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4924,7 +5317,7 @@ func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4934,12 +5327,12 @@ func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4956,10 +5349,10 @@ func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.NodeAccount) > 0 {
+		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4973,7 +5366,7 @@ func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4983,30 +5376,20 @@ func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5016,398 +5399,447 @@ func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryTssFundsMigratorInfoAllRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTssFundsMigratorInfoAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TssFundsMigrators) > 0 {
-		for _, e := range m.TssFundsMigrators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssFundsMigratorInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssFundsMigratorInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TssFundsMigrator.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if m.Keygen != nil {
+		{
+			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ChainNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ChainNonces) > 0 {
-		for _, e := range m.ChainNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PendingNonces) > 0 {
-		for _, e := range m.PendingNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.LastObserverCount != nil {
+		{
+			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PendingNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTSSRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.BlameIdentifier) > 0 {
+		i -= len(m.BlameIdentifier)
+		copy(dAtA[i:], m.BlameIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTSSResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TSS.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	if m.BlameInfo != nil {
+		{
+			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FinalizedZetaHeight != 0 {
-		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTssHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TssList) > 0 {
-		for _, e := range m.TssList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHasVotedRequest) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetLivenessParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
-func (m *QueryHasVotedResponse) Size() (n int) {
+func (m *QueryGetLivenessParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.HasVoted {
-		n += 2
-	}
+	l = m.LivenessParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryBallotByIdentifierRequest) Size() (n int) {
+func (m *ObserverLivenessInfo) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
+	l = len(m.ObserverAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.MissedVotes != 0 {
+		n += 1 + sovQuery(uint64(m.MissedVotes))
+	}
+	if m.TotalVotes != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotes))
+	}
+	l = m.MissedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovQuery(uint64(m.JailedUntil))
+	}
 	return n
 }
 
-func (m *VoterList) Size() (n int) {
+func (m *QueryGetObserverLivenessRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.VoterAddress)
+	l = len(m.ObserverAddress)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.VoteType != 0 {
-		n += 1 + sovQuery(uint64(m.VoteType))
-	}
 	return n
 }
 
-func (m *QueryBallotByIdentifierResponse) Size() (n int) {
+func (m *QueryGetObserverLivenessResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Voters) > 0 {
-		for _, e := range m.Voters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.ObservationType != 0 {
-		n += 1 + sovQuery(uint64(m.ObservationType))
-	}
-	if m.BallotStatus != 0 {
-		n += 1 + sovQuery(uint64(m.BallotStatus))
-	}
+	l = m.ObserverLiveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryObserverSet) Size() (n int) {
+func (m *QueryAllObserverLivenessRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5416,22 +5848,22 @@ func (m *QueryObserverSet) Size() (n int) {
 	return n
 }
 
-func (m *QueryObserverSetResponse) Size() (n int) {
+func (m *QueryAllObserverLivenessResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Observers) > 0 {
-		for _, s := range m.Observers {
-			l = len(s)
+	if len(m.ObserverLiveness) > 0 {
+		for _, e := range m.ObserverLiveness {
+			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *QuerySupportedChains) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoAllRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5440,14 +5872,14 @@ func (m *QuerySupportedChains) Size() (n int) {
 	return n
 }
 
-func (m *QuerySupportedChainsResponse) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoAllResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
+	if len(m.TssFundsMigrators) > 0 {
+		for _, e := range m.TssFundsMigrators {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -5455,7 +5887,7 @@ func (m *QuerySupportedChainsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5467,68 +5899,73 @@ func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetChainParamsForChainResponse) Size() (n int) {
+func (m *QueryTssFundsMigratorInfoResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.TssFundsMigrator.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetChainParamsRequest) Size() (n int) {
+func (m *QueryGetChainNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
 	return n
 }
 
-func (m *QueryGetChainParamsResponse) Size() (n int) {
+func (m *QueryGetChainNoncesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.ChainNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetNodeAccountRequest) Size() (n int) {
+func (m *QueryAllChainNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryGetNodeAccountResponse) Size() (n int) {
+func (m *QueryAllChainNoncesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.NodeAccount != nil {
-		l = m.NodeAccount.Size()
+	if len(m.ChainNonces) > 0 {
+		for _, e := range m.ChainNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAllNodeAccountRequest) Size() (n int) {
+func (m *QueryAllPendingNoncesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5541,14 +5978,14 @@ func (m *QueryAllNodeAccountRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllNodeAccountResponse) Size() (n int) {
+func (m *QueryAllPendingNoncesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.NodeAccount) > 0 {
-		for _, e := range m.NodeAccount {
+	if len(m.PendingNonces) > 0 {
+		for _, e := range m.PendingNonces {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -5560,27 +5997,30 @@ func (m *QueryAllNodeAccountResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
+func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
 	return n
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
+func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.CrosschainFlags.Size()
+	l = m.PendingNonces.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryGetKeygenRequest) Size() (n int) {
+func (m *QueryGetTSSRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5589,68 +6029,79 @@ func (m *QueryGetKeygenRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryGetKeygenResponse) Size() (n int) {
+func (m *QueryGetTSSResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Keygen != nil {
-		l = m.Keygen.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	l = m.TSS.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryShowObserverCountRequest) Size() (n int) {
+func (m *QueryGetTssAddressRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
 	return n
 }
 
-func (m *QueryShowObserverCountResponse) Size() (n int) {
+func (m *QueryGetTssAddressResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
-		l = m.LastObserverCount.Size()
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryBlameByIdentifierRequest) Size() (n int) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BlameIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.FinalizedZetaHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
+	}
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
 	}
 	return n
 }
 
-func (m *QueryBlameByIdentifierResponse) Size() (n int) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BlameInfo != nil {
-		l = m.BlameInfo.Size()
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryAllBlameRecordsRequest) Size() (n int) {
+func (m *QueryTssHistoryRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5663,14 +6114,14 @@ func (m *QueryAllBlameRecordsRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllBlameRecordsResponse) Size() (n int) {
+func (m *QueryTssHistoryResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
+	if len(m.TssList) > 0 {
+		for _, e := range m.TssList {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -5682,41 +6133,1024 @@ func (m *QueryAllBlameRecordsResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
+func (m *QueryHasVotedRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
+func (m *QueryHasVotedResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
+	if m.HasVoted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VoterList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VoteType != 0 {
+		n += 1 + sovQuery(uint64(m.VoteType))
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.ObservationType != 0 {
+		n += 1 + sovQuery(uint64(m.ObservationType))
+	}
+	if m.BallotStatus != 0 {
+		n += 1 + sovQuery(uint64(m.BallotStatus))
+	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryObserverSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryObserverSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Observers) > 0 {
+		for _, s := range m.Observers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySupportedChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupportedChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeAccount != nil {
+		l = m.NodeAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeAccount) > 0 {
+		for _, e := range m.NodeAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CrosschainFlags.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetKeygenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keygen != nil {
+		l = m.Keygen.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowObserverCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShowObserverCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		l = m.LastObserverCount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlameIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlameInfo != nil {
+		l = m.BlameInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlameRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlameRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetLivenessParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLivenessParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLivenessParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetLivenessParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetLivenessParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetLivenessParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObserverLivenessInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverLivenessInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverLivenessInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
+			}
+			m.MissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			m.TotalVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MissedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetObserverLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetObserverLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetObserverLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetObserverLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObserverLiveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllObserverLivenessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllObserverLivenessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverLiveness = append(m.ObserverLiveness, ObserverLivenessInfo{})
+			if err := m.ObserverLiveness[len(m.ObserverLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *QueryTssFundsMigratorInfoAllRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1240,6 +1240,174 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGasPriceIncreaseFlagsResponse proto.InternalMessageInfo
 
+type MsgUnjailObserver struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgUnjailObserver) Reset()         { *m = MsgUnjailObserver{} }
+func (m *MsgUnjailObserver) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserver) ProtoMessage()    {}
+func (*MsgUnjailObserver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{24}
+}
+func (m *MsgUnjailObserver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailObserver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserver.Merge(m, src)
+}
+func (m *MsgUnjailObserver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailObserver proto.InternalMessageInfo
+
+func (m *MsgUnjailObserver) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type MsgUnjailObserverResponse struct {
+}
+
+func (m *MsgUnjailObserverResponse) Reset()         { *m = MsgUnjailObserverResponse{} }
+func (m *MsgUnjailObserverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserverResponse) ProtoMessage()    {}
+func (*MsgUnjailObserverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{25}
+}
+func (m *MsgUnjailObserverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailObserverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserverResponse.Merge(m, src)
+}
+func (m *MsgUnjailObserverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailObserverResponse proto.InternalMessageInfo
+
+type MsgUpdateLivenessParams struct {
+	Creator        string         `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	LivenessParams LivenessParams `protobuf:"bytes,2,opt,name=liveness_params,json=livenessParams,proto3" json:"liveness_params"`
+}
+
+func (m *MsgUpdateLivenessParams) Reset()         { *m = MsgUpdateLivenessParams{} }
+func (m *MsgUpdateLivenessParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLivenessParams) ProtoMessage()    {}
+func (*MsgUpdateLivenessParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{26}
+}
+func (m *MsgUpdateLivenessParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLivenessParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLivenessParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateLivenessParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLivenessParams.Merge(m, src)
+}
+func (m *MsgUpdateLivenessParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLivenessParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLivenessParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateLivenessParams proto.InternalMessageInfo
+
+func (m *MsgUpdateLivenessParams) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateLivenessParams) GetLivenessParams() LivenessParams {
+	if m != nil {
+		return m.LivenessParams
+	}
+	return LivenessParams{}
+}
+
+type MsgUpdateLivenessParamsResponse struct {
+}
+
+func (m *MsgUpdateLivenessParamsResponse) Reset()         { *m = MsgUpdateLivenessParamsResponse{} }
+func (m *MsgUpdateLivenessParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLivenessParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateLivenessParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eda6e3b1d16a4021, []int{27}
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLivenessParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLivenessParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLivenessParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLivenessParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateLivenessParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateObserver)(nil), "zetachain.zetacore.observer.MsgUpdateObserver")
 	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "zetachain.zetacore.observer.MsgUpdateObserverResponse")
@@ -1265,6 +1433,10 @@ func init() {
 	proto.RegisterType((*MsgDisableCCTXResponse)(nil), "zetachain.zetacore.observer.MsgDisableCCTXResponse")
 	proto.RegisterType((*MsgUpdateGasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlags")
 	proto.RegisterType((*MsgUpdateGasPriceIncreaseFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateGasPriceIncreaseFlagsResponse")
+	proto.RegisterType((*MsgUnjailObserver)(nil), "zetachain.zetacore.observer.MsgUnjailObserver")
+	proto.RegisterType((*MsgUnjailObserverResponse)(nil), "zetachain.zetacore.observer.MsgUnjailObserverResponse")
+	proto.RegisterType((*MsgUpdateLivenessParams)(nil), "zetachain.zetacore.observer.MsgUpdateLivenessParams")
+	proto.RegisterType((*MsgUpdateLivenessParamsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateLivenessParamsResponse")
 }
 
 func init() {
@@ -1272,86 +1444,93 @@ func init() {
 }
 
 var fileDescriptor_eda6e3b1d16a4021 = []byte{
-	// 1262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x13, 0x37, 0xff, 0xce, 0xc3, 0x1e, 0x59, 0xb2, 0xc3, 0xbf, 0x93, 0xc8, 0x74, 0xa3, 0x06, 0x44,
-	0xe2, 0x28, 0x8f, 0x4a, 0xb1, 0x52, 0xf4, 0x79, 0xf2, 0x23, 0xb1, 0xdd, 0xd4, 0xb1, 0x41, 0xb9,
-	0x41, 0x91, 0x0b, 0xb1, 0x22, 0xd7, 0x14, 0x6b, 0x6a, 0x57, 0xe0, 0x52, 0x7e, 0xa4, 0x41, 0x81,
-	0x1e, 0x0b, 0xf4, 0xd0, 0x0f, 0x50, 0xa0, 0xf7, 0x7e, 0x83, 0xf6, 0xdc, 0x43, 0x7a, 0xcb, 0xb1,
-	0xa7, 0xa2, 0xb0, 0x4f, 0xfd, 0x16, 0x05, 0x77, 0x97, 0xab, 0xa7, 0x29, 0x29, 0x45, 0x4f, 0x12,
-	0x67, 0x7f, 0xbf, 0x99, 0xdf, 0xec, 0xce, 0xce, 0x90, 0x70, 0xeb, 0x25, 0x8e, 0x90, 0x53, 0x47,
-	0x3e, 0x29, 0xf3, 0x7f, 0x34, 0xc4, 0x65, 0x5a, 0x63, 0x38, 0x3c, 0xc4, 0x61, 0x39, 0x3a, 0x2e,
-	0x35, 0x43, 0x1a, 0x51, 0x7d, 0x51, 0xa1, 0x4a, 0x09, 0xaa, 0x94, 0xa0, 0x8c, 0x79, 0x8f, 0x7a,
-	0x94, 0xe3, 0xca, 0xf1, 0x3f, 0x41, 0x31, 0xee, 0xa4, 0x39, 0xae, 0x05, 0xa8, 0x81, 0x25, 0xb0,
-	0x92, 0x06, 0x74, 0x42, 0xca, 0x18, 0x5f, 0xb4, 0xf7, 0x03, 0xe4, 0x31, 0xc9, 0xb9, 0x97, 0xc6,
-	0x49, 0xfe, 0x48, 0x6c, 0x31, 0x0d, 0xdb, 0x44, 0x21, 0x6a, 0x24, 0x5e, 0x1f, 0xa6, 0x22, 0x31,
-	0x71, 0x7d, 0xe2, 0xd9, 0x84, 0x12, 0x07, 0x27, 0x8c, 0xdb, 0xa9, 0xbb, 0xc7, 0xd2, 0xe4, 0x36,
-	0x0f, 0xbc, 0x32, 0x37, 0x31, 0xf9, 0x33, 0x04, 0xdb, 0x0c, 0x29, 0xdd, 0x67, 0xf2, 0x47, 0x60,
-	0xcd, 0xbf, 0x35, 0xb8, 0xb2, 0xcd, 0xbc, 0x2f, 0x9a, 0x2e, 0x8a, 0xf0, 0x8e, 0x8c, 0xab, 0xe7,
-	0xe1, 0xb2, 0x13, 0x62, 0x14, 0xd1, 0x30, 0xaf, 0xdd, 0xd4, 0x8a, 0xd3, 0x56, 0xf2, 0xa8, 0x3f,
-	0x84, 0x79, 0x1a, 0xb8, 0x76, 0xa2, 0xd0, 0x46, 0xae, 0x1b, 0x62, 0xc6, 0xf2, 0xff, 0xe3, 0x30,
-	0x9d, 0x06, 0x6e, 0xe2, 0x64, 0x45, 0xac, 0xc4, 0x0c, 0x82, 0x8f, 0xfa, 0x19, 0x93, 0x82, 0x41,
-	0xf0, 0x51, 0x2f, 0xe3, 0x39, 0x64, 0x5b, 0x5c, 0x8f, 0x1d, 0x62, 0xc4, 0x28, 0xc9, 0x5f, 0xb8,
-	0xa9, 0x15, 0x73, 0x95, 0xe5, 0x52, 0x4a, 0x09, 0x95, 0x12, 0x27, 0x22, 0x13, 0x8b, 0x13, 0xad,
-	0x99, 0x56, 0xc7, 0x93, 0xb9, 0x08, 0x0b, 0x7d, 0xa9, 0x5a, 0x98, 0x35, 0x29, 0x61, 0xd8, 0xfc,
-	0x5d, 0x03, 0x7d, 0x9b, 0x79, 0xcf, 0x69, 0x84, 0x57, 0x03, 0xea, 0x1c, 0x6c, 0x62, 0xe4, 0xa6,
-	0xee, 0xc4, 0x02, 0x4c, 0x89, 0xaa, 0xf2, 0x5d, 0x9e, 0xfd, 0xa4, 0x75, 0x99, 0x3f, 0x6f, 0xb9,
-	0xfa, 0x0d, 0x80, 0x5a, 0xec, 0xc3, 0xae, 0x23, 0x56, 0xe7, 0x89, 0xce, 0x58, 0xd3, 0xdc, 0xb2,
-	0x89, 0x58, 0x5d, 0xbf, 0x06, 0x97, 0xea, 0xd8, 0xf7, 0xea, 0x11, 0x4f, 0x6c, 0xd2, 0x92, 0x4f,
-	0xfa, 0x46, 0x6c, 0x8f, 0xa3, 0xe6, 0x2f, 0xde, 0xd4, 0x8a, 0x99, 0xca, 0xdd, 0x41, 0x09, 0x37,
-	0x0f, 0xbc, 0x92, 0x3c, 0x41, 0x21, 0x71, 0x1d, 0x45, 0x68, 0xf5, 0xc2, 0xeb, 0x3f, 0xdf, 0x9d,
-	0xb0, 0x24, 0xdd, 0xfc, 0x0a, 0x8c, 0xfe, 0x54, 0x92, 0x4c, 0xf5, 0xdb, 0x90, 0xab, 0xa1, 0x20,
-	0xa0, 0x91, 0xcd, 0x53, 0xc1, 0x2e, 0xcf, 0x6c, 0xca, 0xca, 0x0a, 0xeb, 0x9a, 0x30, 0xc6, 0xb0,
-	0x43, 0x1a, 0x61, 0x7b, 0xdf, 0x27, 0x28, 0xf0, 0x5f, 0x62, 0x91, 0xe5, 0x94, 0x95, 0x8d, 0xad,
-	0x4f, 0x12, 0xa3, 0xf9, 0x0a, 0xe6, 0xd5, 0xa6, 0xae, 0xc5, 0x52, 0x77, 0xf9, 0x7d, 0x48, 0xd9,
-	0xb8, 0xcf, 0x20, 0xe3, 0xb4, 0x81, 0xdc, 0x6b, 0xa6, 0x52, 0x4c, 0x3d, 0xdc, 0x0e, 0xc7, 0x56,
-	0x27, 0xd9, 0x2c, 0xc0, 0x3b, 0x83, 0xa2, 0xab, 0x53, 0x7d, 0xca, 0xd5, 0x59, 0xb8, 0x41, 0x0f,
-	0x47, 0x54, 0x77, 0xfe, 0xb1, 0xca, 0x60, 0x7d, 0xce, 0x54, 0xb0, 0xdf, 0x34, 0xc8, 0x6d, 0x33,
-	0x6f, 0xc5, 0x75, 0x47, 0xb8, 0x48, 0x77, 0x61, 0xee, 0x9c, 0x4b, 0x34, 0x4b, 0x7b, 0xee, 0xc3,
-	0x27, 0xb0, 0xc0, 0xb7, 0x24, 0xf0, 0x31, 0x89, 0x6c, 0x2f, 0x44, 0x24, 0xc2, 0xd8, 0x6e, 0xb6,
-	0x6a, 0x07, 0xf8, 0x44, 0x5e, 0xa3, 0xeb, 0x6d, 0xc0, 0x86, 0x58, 0xdf, 0xe5, 0xcb, 0xfa, 0x32,
-	0x5c, 0x45, 0xae, 0x6b, 0x13, 0xea, 0x62, 0x1b, 0x39, 0x0e, 0x6d, 0x91, 0xc8, 0xa6, 0x24, 0x38,
-	0xe1, 0xa5, 0x37, 0x65, 0xe9, 0xc8, 0x75, 0x9f, 0x51, 0x17, 0xaf, 0x88, 0xa5, 0x1d, 0x12, 0x9c,
-	0x98, 0x79, 0xb8, 0xd6, 0x9d, 0x85, 0x4a, 0xf0, 0x7b, 0x0d, 0x66, 0x54, 0x61, 0xa1, 0x06, 0x7e,
-	0xbb, 0xdb, 0xb1, 0x11, 0xdf, 0x0e, 0xd4, 0xc0, 0xb6, 0x4f, 0xf6, 0x29, 0xd7, 0x9f, 0xa9, 0x98,
-	0xa9, 0xc7, 0xcf, 0x83, 0xc9, 0x1a, 0x9f, 0xe6, 0xdc, 0x2d, 0xb2, 0x4f, 0xcd, 0x6b, 0xfc, 0x70,
-	0x95, 0x1a, 0x25, 0x73, 0x05, 0x66, 0x55, 0x51, 0x3c, 0xc5, 0x27, 0x1e, 0x26, 0x29, 0x42, 0xe7,
-	0xe1, 0x22, 0xbf, 0x99, 0x52, 0xa5, 0x78, 0x30, 0x17, 0xe0, 0x7a, 0x8f, 0x0b, 0xe5, 0xfd, 0x47,
-	0x0d, 0xfe, 0xcf, 0xcb, 0x80, 0xe1, 0x88, 0x57, 0xc1, 0x33, 0xde, 0xce, 0xdf, 0x6e, 0x2f, 0x96,
-	0x60, 0x56, 0x2c, 0xf1, 0x99, 0x60, 0x07, 0xf4, 0x88, 0x6f, 0xc8, 0xa4, 0x95, 0x75, 0x94, 0xeb,
-	0xcf, 0xe9, 0x91, 0x5e, 0x84, 0xb9, 0x4e, 0x5c, 0xdd, 0xf7, 0xea, 0xb2, 0x79, 0xe4, 0xda, 0xc0,
-	0x4d, 0xdf, 0xab, 0x9b, 0x37, 0x60, 0x71, 0x80, 0x3a, 0xa5, 0xfe, 0x17, 0x0d, 0x40, 0x6e, 0xda,
-	0x5e, 0xb5, 0x9a, 0x22, 0xfa, 0x06, 0x40, 0xc4, 0x58, 0x52, 0x65, 0xa2, 0x32, 0xa7, 0x23, 0xc6,
-	0x64, 0x5d, 0x3d, 0x00, 0xfd, 0x80, 0xef, 0x8b, 0x1d, 0x1f, 0x97, 0x2d, 0xfb, 0x99, 0xd0, 0x3e,
-	0x27, 0x56, 0x5e, 0xe0, 0x08, 0x6d, 0x8a, 0xce, 0xb6, 0x0e, 0x97, 0x58, 0x84, 0xa2, 0x16, 0x93,
-	0xad, 0xfc, 0xc1, 0x79, 0x9d, 0x4d, 0xce, 0x31, 0x0b, 0x3b, 0xd8, 0x3f, 0xc4, 0x55, 0xce, 0xb1,
-	0x24, 0xd7, 0xfc, 0xae, 0xdd, 0xa2, 0xf7, 0xaa, 0xd5, 0xff, 0xa6, 0x9f, 0xc5, 0x30, 0x99, 0x18,
-	0x6b, 0x39, 0x4e, 0x32, 0xa8, 0xa6, 0xac, 0xac, 0xb0, 0x56, 0x85, 0xd1, 0x3c, 0x82, 0xec, 0x36,
-	0xf3, 0x1e, 0x13, 0x54, 0x0b, 0xf0, 0xda, 0xda, 0xde, 0x97, 0x29, 0x3b, 0x79, 0x0b, 0xb2, 0x98,
-	0xe3, 0xb6, 0x48, 0x8d, 0xb6, 0x88, 0x8a, 0xdb, 0x65, 0xd4, 0x97, 0x20, 0x27, 0x0c, 0x3b, 0xad,
-	0x48, 0xc0, 0x44, 0xdc, 0x1e, 0xab, 0x79, 0x1d, 0xae, 0x76, 0x05, 0x56, 0x27, 0xfb, 0x8a, 0x37,
-	0x9f, 0x75, 0x9f, 0x8d, 0x20, 0x69, 0x09, 0x72, 0xae, 0x00, 0x76, 0x6b, 0xea, 0xb1, 0xea, 0x45,
-	0x98, 0x95, 0x96, 0x1e, 0x55, 0xbd, 0x66, 0xd9, 0x34, 0x3a, 0xa2, 0x2b, 0x5d, 0x3f, 0x6b, 0x50,
-	0x50, 0x77, 0x69, 0x03, 0xb1, 0xdd, 0xd0, 0x77, 0xf0, 0x16, 0x89, 0xa5, 0x30, 0xfc, 0x24, 0x7e,
-	0x23, 0x4b, 0x11, 0x4a, 0xe0, 0xaa, 0x37, 0x88, 0x22, 0xa7, 0x46, 0x25, 0xb5, 0x6d, 0x0c, 0x0c,
-	0x26, 0xdb, 0xc8, 0x60, 0xb7, 0x66, 0x11, 0x96, 0xd2, 0xb5, 0x26, 0x69, 0x55, 0x7e, 0xcd, 0xc0,
-	0xe4, 0x36, 0xf3, 0x74, 0x0a, 0x99, 0xce, 0x86, 0x7f, 0x3f, 0x55, 0x51, 0x77, 0x5f, 0x35, 0x1e,
-	0x8d, 0x01, 0x56, 0xe5, 0x7e, 0x0c, 0xb9, 0x9e, 0xb7, 0xb5, 0xd2, 0x30, 0x37, 0xdd, 0x78, 0xe3,
-	0x83, 0xf1, 0xf0, 0x2a, 0xf2, 0xb7, 0x1a, 0x5c, 0xe9, 0x1f, 0xf4, 0xcb, 0xa3, 0x79, 0xeb, 0xa0,
-	0x18, 0x1f, 0x8f, 0x4d, 0xe9, 0xd2, 0xd0, 0x3f, 0xce, 0x87, 0x6a, 0xe8, 0xa3, 0x0c, 0xd7, 0x70,
-	0xee, 0x9c, 0xd7, 0x7d, 0x98, 0x6e, 0x8f, 0xc0, 0xbb, 0xc3, 0xfc, 0x28, 0xa8, 0xb1, 0x3c, 0x32,
-	0x54, 0x85, 0x0a, 0x61, 0xa6, 0x6b, 0x8e, 0x3d, 0x18, 0x6d, 0xe7, 0x04, 0xda, 0x78, 0x7f, 0x1c,
-	0xb4, 0x8a, 0xf9, 0x35, 0xcc, 0xf6, 0xbe, 0x05, 0x97, 0x47, 0x53, 0xae, 0x08, 0xc6, 0x87, 0x63,
-	0x12, 0x54, 0xf0, 0x6f, 0x60, 0xae, 0x6f, 0xb2, 0x3e, 0x1c, 0x7e, 0x54, 0xdd, 0x0c, 0xe3, 0xa3,
-	0x71, 0x19, 0x2a, 0xbe, 0x03, 0x97, 0x93, 0xd9, 0x78, 0x67, 0x94, 0x1c, 0xf6, 0xaa, 0x55, 0xa3,
-	0x3c, 0x22, 0x50, 0x05, 0x09, 0x00, 0x3a, 0x26, 0xc7, 0xbd, 0x61, 0xf4, 0x36, 0xd6, 0xa8, 0x8c,
-	0x8e, 0x55, 0xd1, 0x28, 0x64, 0x3a, 0xa7, 0xc2, 0xd0, 0x0e, 0xd5, 0x01, 0x1e, 0xde, 0xa1, 0x06,
-	0x74, 0x7c, 0xfd, 0x27, 0x0d, 0x16, 0xd3, 0xda, 0xfd, 0xa7, 0xa3, 0x95, 0xe5, 0x40, 0xb2, 0xb1,
-	0xf6, 0x2f, 0xc8, 0x89, 0xc2, 0xd5, 0xc7, 0xaf, 0x4f, 0x0b, 0xda, 0x9b, 0xd3, 0x82, 0xf6, 0xd7,
-	0x69, 0x41, 0xfb, 0xe1, 0xac, 0x30, 0xf1, 0xe6, 0xac, 0x30, 0xf1, 0xc7, 0x59, 0x61, 0xe2, 0xc5,
-	0x7d, 0xcf, 0x8f, 0xea, 0xad, 0x5a, 0xc9, 0xa1, 0x0d, 0xfe, 0xf1, 0xfc, 0x9e, 0xf8, 0x8e, 0x8e,
-	0xdf, 0x9f, 0xcb, 0xc7, 0x1d, 0x1f, 0xe6, 0x27, 0x4d, 0xcc, 0x6a, 0x97, 0xf8, 0x37, 0xf4, 0xa3,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x65, 0x57, 0x89, 0x92, 0x02, 0x11, 0x00, 0x00,
+	// 1363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x5f, 0xe7, 0xc3, 0x1e, 0x5b, 0xb2, 0xc3, 0xd7, 0x49, 0x6c, 0xba, 0x51, 0x52, 0x22,
+	0x71, 0x94, 0x2f, 0x29, 0x96, 0x8b, 0xb4, 0x69, 0x7b, 0x71, 0x9c, 0xc4, 0x76, 0x13, 0x27, 0x01,
+	0xe5, 0x06, 0x45, 0x2e, 0xc4, 0x8a, 0x5c, 0x53, 0x8c, 0xe9, 0x5d, 0x81, 0x4b, 0xd9, 0x71, 0x1a,
+	0x14, 0xe8, 0xb1, 0x45, 0x81, 0xf6, 0x07, 0x14, 0xe8, 0xbd, 0xff, 0xa0, 0xc7, 0x02, 0x3d, 0xa4,
+	0xb7, 0x1c, 0x7b, 0x2a, 0x8a, 0xe4, 0xd4, 0x7f, 0x51, 0x70, 0x77, 0xb9, 0x26, 0x25, 0x99, 0x92,
+	0x5c, 0xf4, 0x64, 0x71, 0xf8, 0x3c, 0x33, 0xcf, 0xcc, 0xee, 0xce, 0xac, 0x09, 0x17, 0x5f, 0xe2,
+	0x08, 0x39, 0x4d, 0xe4, 0x93, 0x2a, 0xff, 0x45, 0x43, 0x5c, 0xa5, 0x0d, 0x86, 0xc3, 0x5d, 0x1c,
+	0x56, 0xa3, 0x17, 0x95, 0x56, 0x48, 0x23, 0xaa, 0xcf, 0x2b, 0x54, 0x25, 0x41, 0x55, 0x12, 0x94,
+	0x31, 0xe3, 0x51, 0x8f, 0x72, 0x5c, 0x35, 0xfe, 0x25, 0x28, 0xc6, 0xe5, 0x3c, 0xc7, 0x8d, 0x00,
+	0xed, 0x60, 0x09, 0xac, 0xe5, 0x01, 0x9d, 0x90, 0x32, 0xc6, 0x5f, 0xda, 0x5b, 0x01, 0xf2, 0x98,
+	0xe4, 0x5c, 0xcd, 0xe3, 0x24, 0x3f, 0x24, 0x76, 0x69, 0x10, 0xac, 0x1d, 0xf8, 0xbb, 0x98, 0x60,
+	0x96, 0x04, 0x28, 0xe7, 0x91, 0x5a, 0x28, 0x44, 0x3b, 0x09, 0xf2, 0x66, 0x2e, 0x12, 0x13, 0xd7,
+	0x27, 0x9e, 0x4d, 0x28, 0x71, 0x70, 0xc2, 0xb8, 0x94, 0x5b, 0x72, 0x96, 0x97, 0x63, 0x6b, 0xdb,
+	0xab, 0x72, 0x13, 0x93, 0x7f, 0xfa, 0x60, 0x5b, 0x21, 0xa5, 0x5b, 0x4c, 0xfe, 0x11, 0x58, 0xf3,
+	0x6f, 0x0d, 0x4e, 0x6d, 0x30, 0xef, 0xf3, 0x96, 0x8b, 0x22, 0xfc, 0x58, 0xc6, 0xd5, 0x67, 0xe1,
+	0xa4, 0x13, 0x62, 0x14, 0xd1, 0x70, 0x56, 0xbb, 0xa0, 0x95, 0xc7, 0xad, 0xe4, 0x51, 0xbf, 0x09,
+	0x33, 0x34, 0x70, 0x6d, 0x55, 0x29, 0xe4, 0xba, 0x21, 0x66, 0x6c, 0xf6, 0x7f, 0x1c, 0xa6, 0xd3,
+	0xc0, 0x4d, 0x9c, 0x2c, 0x8b, 0x37, 0x31, 0x83, 0xe0, 0xbd, 0x6e, 0xc6, 0xa8, 0x60, 0x10, 0xbc,
+	0xd7, 0xc9, 0x78, 0x0a, 0x85, 0x36, 0xd7, 0x63, 0x87, 0x18, 0x31, 0x4a, 0x66, 0x8f, 0x5d, 0xd0,
+	0xca, 0xc5, 0xda, 0x62, 0x25, 0x67, 0xdf, 0x55, 0x12, 0x27, 0x22, 0x13, 0x8b, 0x13, 0xad, 0xc9,
+	0x76, 0xea, 0xc9, 0x9c, 0x87, 0xb9, 0xae, 0x54, 0x2d, 0xcc, 0x5a, 0x94, 0x30, 0x6c, 0xfe, 0xae,
+	0x81, 0xbe, 0xc1, 0xbc, 0xa7, 0x34, 0xc2, 0x77, 0x02, 0xea, 0x6c, 0xaf, 0x61, 0xe4, 0xe6, 0x56,
+	0x62, 0x0e, 0xc6, 0xc4, 0x56, 0xf4, 0x5d, 0x9e, 0xfd, 0xa8, 0x75, 0x92, 0x3f, 0xaf, 0xbb, 0xfa,
+	0x39, 0x80, 0x46, 0xec, 0xc3, 0x6e, 0x22, 0xd6, 0xe4, 0x89, 0x4e, 0x5a, 0xe3, 0xdc, 0xb2, 0x86,
+	0x58, 0x53, 0x3f, 0x03, 0x27, 0x9a, 0xd8, 0xf7, 0x9a, 0x11, 0x4f, 0x6c, 0xd4, 0x92, 0x4f, 0xfa,
+	0x6a, 0x6c, 0x8f, 0xa3, 0xce, 0x1e, 0xbf, 0xa0, 0x95, 0x27, 0x6a, 0x57, 0x7a, 0x25, 0xdc, 0xda,
+	0xf6, 0x2a, 0x72, 0x05, 0x85, 0xc4, 0xbb, 0x28, 0x42, 0x77, 0x8e, 0xbd, 0xfe, 0xf3, 0xfc, 0x88,
+	0x25, 0xe9, 0xe6, 0x73, 0x30, 0xba, 0x53, 0x49, 0x32, 0xd5, 0x2f, 0x41, 0xb1, 0x81, 0x82, 0x80,
+	0x46, 0x36, 0x4f, 0x05, 0xbb, 0x3c, 0xb3, 0x31, 0xab, 0x20, 0xac, 0x2b, 0xc2, 0x18, 0xc3, 0x76,
+	0x69, 0x84, 0xed, 0x2d, 0x9f, 0xa0, 0xc0, 0x7f, 0x89, 0x45, 0x96, 0x63, 0x56, 0x21, 0xb6, 0xde,
+	0x4f, 0x8c, 0xe6, 0x2b, 0x98, 0x51, 0x45, 0x5d, 0x89, 0xa5, 0x3e, 0xe1, 0xe7, 0x21, 0xa7, 0x70,
+	0x9f, 0xc1, 0x84, 0x73, 0x00, 0xe4, 0x5e, 0x27, 0x6a, 0xe5, 0xdc, 0xc5, 0x4d, 0x39, 0xb6, 0xd2,
+	0x64, 0xb3, 0x04, 0xef, 0xf5, 0x8a, 0xae, 0x56, 0xf5, 0x01, 0x57, 0x67, 0xe1, 0x1d, 0xba, 0x3b,
+	0xa0, 0xba, 0xc3, 0x97, 0x55, 0x06, 0xeb, 0x72, 0xa6, 0x82, 0xfd, 0xa6, 0x41, 0x71, 0x83, 0x79,
+	0xcb, 0xae, 0x3b, 0xc0, 0x41, 0xba, 0x02, 0xd3, 0x87, 0x1c, 0xa2, 0x29, 0xda, 0x71, 0x1e, 0x3e,
+	0x86, 0x39, 0x5e, 0x92, 0xc0, 0xc7, 0x24, 0xb2, 0xbd, 0x10, 0x91, 0x08, 0x63, 0xbb, 0xd5, 0x6e,
+	0x6c, 0xe3, 0x7d, 0x79, 0x8c, 0xce, 0x1e, 0x00, 0x56, 0xc5, 0xfb, 0x27, 0xfc, 0xb5, 0xbe, 0x08,
+	0xa7, 0x91, 0xeb, 0xda, 0x84, 0xba, 0xd8, 0x46, 0x8e, 0x43, 0xdb, 0x24, 0xb2, 0x29, 0x09, 0xf6,
+	0xf9, 0xd6, 0x1b, 0xb3, 0x74, 0xe4, 0xba, 0x8f, 0xa8, 0x8b, 0x97, 0xc5, 0xab, 0xc7, 0x24, 0xd8,
+	0x37, 0x67, 0xe1, 0x4c, 0x36, 0x0b, 0x95, 0xe0, 0x77, 0x1a, 0x4c, 0xaa, 0x8d, 0x85, 0x76, 0xf0,
+	0xd1, 0x4e, 0xc7, 0x6a, 0x7c, 0x3a, 0xd0, 0x0e, 0xb6, 0x7d, 0xb2, 0x45, 0xb9, 0xfe, 0x89, 0x9a,
+	0x99, 0xbb, 0xfc, 0x3c, 0x98, 0xdc, 0xe3, 0xe3, 0x9c, 0xbb, 0x4e, 0xb6, 0xa8, 0x79, 0x06, 0x66,
+	0xd2, 0x6a, 0x94, 0xcc, 0x65, 0x98, 0x52, 0x9b, 0xe2, 0x01, 0xde, 0xf7, 0x30, 0xc9, 0x11, 0x3a,
+	0x03, 0xc7, 0xf9, 0xc9, 0x94, 0x2a, 0xc5, 0x83, 0x39, 0x07, 0x67, 0x3b, 0x5c, 0x28, 0xef, 0x3f,
+	0x6a, 0xf0, 0x7f, 0xbe, 0x0d, 0x18, 0x8e, 0xf8, 0x2e, 0x78, 0xc4, 0xdb, 0xf9, 0xd1, 0x6a, 0xb1,
+	0x00, 0x53, 0xe2, 0x15, 0x9f, 0x09, 0x76, 0x40, 0xf7, 0x78, 0x41, 0x46, 0xad, 0x82, 0xa3, 0x5c,
+	0x3f, 0xa4, 0x7b, 0x7a, 0x19, 0xa6, 0xd3, 0xb8, 0xa6, 0xef, 0x35, 0x65, 0xf3, 0x28, 0x1e, 0x00,
+	0xd7, 0x7c, 0xaf, 0x69, 0x9e, 0x83, 0xf9, 0x1e, 0xea, 0x94, 0xfa, 0x5f, 0x34, 0x00, 0x59, 0xb4,
+	0xcd, 0x7a, 0x3d, 0x47, 0xf4, 0x39, 0x80, 0x88, 0xb1, 0x64, 0x97, 0x89, 0x9d, 0x39, 0x1e, 0x31,
+	0x26, 0xf7, 0xd5, 0x75, 0xd0, 0xb7, 0x79, 0x5d, 0xec, 0x78, 0xb9, 0x6c, 0xd9, 0xcf, 0x84, 0xf6,
+	0x69, 0xf1, 0xe6, 0x19, 0x8e, 0xd0, 0x1a, 0xb7, 0xeb, 0x77, 0xe1, 0x04, 0x8b, 0x50, 0xd4, 0x66,
+	0xb2, 0x95, 0x5f, 0x3f, 0xac, 0xb3, 0xc9, 0x39, 0x66, 0x61, 0x07, 0xfb, 0xbb, 0xb8, 0xce, 0x39,
+	0x96, 0xe4, 0x9a, 0xdf, 0x1c, 0xb4, 0xe8, 0xcd, 0x7a, 0xfd, 0xbf, 0xe9, 0x67, 0x31, 0x4c, 0x26,
+	0xc6, 0xda, 0x8e, 0x93, 0x0c, 0xaa, 0x31, 0xab, 0x20, 0xac, 0x75, 0x61, 0x34, 0xf7, 0xa0, 0xb0,
+	0xc1, 0xbc, 0x7b, 0x04, 0x35, 0x02, 0xbc, 0xb2, 0xb2, 0xf9, 0x45, 0x4e, 0x25, 0x2f, 0x42, 0x01,
+	0x73, 0xdc, 0x3a, 0x69, 0xd0, 0x36, 0x51, 0x71, 0x33, 0x46, 0x7d, 0x01, 0x8a, 0xc2, 0xf0, 0xb8,
+	0x1d, 0x09, 0x98, 0x88, 0xdb, 0x61, 0x35, 0xcf, 0xc2, 0xe9, 0x4c, 0x60, 0xb5, 0xb2, 0xaf, 0x78,
+	0xf3, 0xb9, 0xeb, 0xb3, 0x01, 0x24, 0x2d, 0x40, 0xd1, 0xf5, 0x59, 0x2a, 0xbc, 0xd4, 0xd4, 0x61,
+	0xd5, 0xcb, 0x30, 0x25, 0x2d, 0x1d, 0xaa, 0x3a, 0xcd, 0xb2, 0x69, 0xa4, 0xa2, 0x2b, 0x5d, 0x3f,
+	0x6b, 0x50, 0x52, 0x67, 0x69, 0x15, 0xb1, 0x27, 0xa1, 0xef, 0xe0, 0x75, 0x12, 0x4b, 0x61, 0xf8,
+	0x7e, 0x7c, 0x8d, 0xcb, 0x11, 0x4a, 0xe0, 0xb4, 0xd7, 0x8b, 0x22, 0xa7, 0x46, 0x2d, 0xb7, 0x6d,
+	0xf4, 0x0c, 0x26, 0xdb, 0x48, 0x6f, 0xb7, 0x66, 0x19, 0x16, 0xf2, 0xb5, 0xaa, 0xb4, 0x6e, 0x88,
+	0x7b, 0x13, 0x79, 0x8e, 0xfc, 0xa0, 0x7f, 0xbb, 0x4f, 0xee, 0x1e, 0x19, 0xb8, 0xf2, 0xf5, 0xbd,
+	0x96, 0x6a, 0x37, 0x0f, 0xe5, 0xdd, 0xb3, 0xef, 0xa4, 0x7a, 0x06, 0x53, 0xc9, 0x3d, 0xd5, 0x6e,
+	0xa5, 0x67, 0xe9, 0xb5, 0xdc, 0xaa, 0x64, 0xfd, 0xcb, 0x72, 0x14, 0x83, 0x8c, 0xd5, 0x7c, 0x1f,
+	0xce, 0x1f, 0x22, 0x28, 0x11, 0x5d, 0xfb, 0xb5, 0x00, 0xa3, 0x1b, 0xcc, 0xd3, 0x29, 0x4c, 0xa4,
+	0x27, 0x5e, 0x7e, 0xf0, 0xec, 0x60, 0x31, 0x96, 0x86, 0x00, 0xab, 0xf3, 0xfe, 0x02, 0x8a, 0x1d,
+	0xd7, 0xd5, 0x4a, 0x3f, 0x37, 0x59, 0xbc, 0x71, 0x6b, 0x38, 0xbc, 0x8a, 0xfc, 0xb5, 0x06, 0xa7,
+	0xba, 0x6f, 0x3a, 0x8b, 0x83, 0x79, 0x4b, 0x51, 0x8c, 0xdb, 0x43, 0x53, 0x32, 0x1a, 0xba, 0xef,
+	0x33, 0x7d, 0x35, 0x74, 0x51, 0x8c, 0xdb, 0x43, 0x53, 0x94, 0x06, 0x1f, 0xc6, 0x0f, 0xee, 0x00,
+	0x57, 0xfa, 0xf9, 0x51, 0x50, 0x63, 0x71, 0x60, 0xa8, 0x0a, 0x15, 0xc2, 0x64, 0x66, 0x90, 0x5f,
+	0x1f, 0xac, 0x72, 0x02, 0x6d, 0x7c, 0x30, 0x0c, 0x5a, 0xc5, 0xfc, 0x12, 0xa6, 0x3a, 0xff, 0x0d,
+	0xa8, 0x0e, 0xa6, 0x5c, 0x11, 0x8c, 0x0f, 0x87, 0x24, 0xa8, 0xe0, 0x5f, 0xc1, 0x74, 0xd7, 0xd5,
+	0xe2, 0x66, 0xff, 0xa5, 0xca, 0x32, 0x8c, 0x8f, 0x86, 0x65, 0xa8, 0xf8, 0x0e, 0x9c, 0x4c, 0x2e,
+	0x07, 0x97, 0x07, 0xc9, 0x61, 0xb3, 0x5e, 0x37, 0xaa, 0x03, 0x02, 0x55, 0x90, 0x00, 0x20, 0x35,
+	0x3a, 0xaf, 0xf6, 0xa3, 0x1f, 0x60, 0x8d, 0xda, 0xe0, 0x58, 0x15, 0x8d, 0xc2, 0x44, 0x7a, 0x2c,
+	0xf6, 0xed, 0x50, 0x29, 0xb0, 0xb1, 0x34, 0x04, 0x58, 0x05, 0xfc, 0x49, 0x83, 0xf9, 0xbc, 0x79,
+	0xf7, 0xc9, 0x60, 0xdb, 0xb2, 0x27, 0xd9, 0x58, 0xf9, 0x17, 0xe4, 0x4c, 0x0f, 0xcd, 0x8e, 0xae,
+	0xfe, 0x3d, 0x34, 0x83, 0x37, 0x6e, 0x0d, 0x87, 0x57, 0x91, 0xbf, 0xd5, 0x60, 0xa6, 0xe7, 0xa0,
+	0x1b, 0xf0, 0xac, 0x66, 0x59, 0xc6, 0xa7, 0x47, 0x61, 0x25, 0x62, 0xee, 0xdc, 0x7b, 0xfd, 0xb6,
+	0xa4, 0xbd, 0x79, 0x5b, 0xd2, 0xfe, 0x7a, 0x5b, 0xd2, 0x7e, 0x78, 0x57, 0x1a, 0x79, 0xf3, 0xae,
+	0x34, 0xf2, 0xc7, 0xbb, 0xd2, 0xc8, 0xb3, 0x6b, 0x9e, 0x1f, 0x35, 0xdb, 0x8d, 0x8a, 0x43, 0x77,
+	0xf8, 0x47, 0x94, 0x1b, 0xe2, 0x7b, 0x4a, 0xfc, 0x7f, 0x54, 0xf5, 0x45, 0xea, 0x03, 0xcd, 0x7e,
+	0x0b, 0xb3, 0xc6, 0x09, 0xfe, 0x2d, 0x65, 0xe9, 0x9f, 0x01, 0x00, 0x3b, 0x4e, 0x1e, 0xd2, 0x3f,
+	0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1378,6 +1557,8 @@ type MsgClient interface {
 	EnableCCTX(ctx context.Context, in *MsgEnableCCTX, opts ...grpc.CallOption) (*MsgEnableCCTXResponse, error)
 	DisableCCTX(ctx context.Context, in *MsgDisableCCTX, opts ...grpc.CallOption) (*MsgDisableCCTXResponse, error)
 	UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpdateGasPriceIncreaseFlags, opts ...grpc.CallOption) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
+	UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error)
+	UpdateLivenessParams(ctx context.Context, in *MsgUpdateLivenessParams, opts ...grpc.CallOption) (*MsgUpdateLivenessParamsResponse, error)
 }
 
 type msgClient struct {
@@ -1496,6 +1677,24 @@ func (c *msgClient) UpdateGasPriceIncreaseFlags(ctx context.Context, in *MsgUpda
 	return out, nil
 }
 
+func (c *msgClient) UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error) {
+	out := new(MsgUnjailObserverResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UnjailObserver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateLivenessParams(ctx context.Context, in *MsgUpdateLivenessParams, opts ...grpc.CallOption) (*MsgUpdateLivenessParamsResponse, error) {
+	out := new(MsgUpdateLivenessParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateLivenessParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
@@ -1510,6 +1709,8 @@ type MsgServer interface {
 	EnableCCTX(context.Context, *MsgEnableCCTX) (*MsgEnableCCTXResponse, error)
 	DisableCCTX(context.Context, *MsgDisableCCTX) (*MsgDisableCCTXResponse, error)
 	UpdateGasPriceIncreaseFlags(context.Context, *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error)
+	UnjailObserver(context.Context, *MsgUnjailObserver) (*MsgUnjailObserverResponse, error)
+	UpdateLivenessParams(context.Context, *MsgUpdateLivenessParams) (*MsgUpdateLivenessParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1552,6 +1753,12 @@ func (*UnimplementedMsgServer) DisableCCTX(ctx context.Context, req *MsgDisableC
 func (*UnimplementedMsgServer) UpdateGasPriceIncreaseFlags(ctx context.Context, req *MsgUpdateGasPriceIncreaseFlags) (*MsgUpdateGasPriceIncreaseFlagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGasPriceIncreaseFlags not implemented")
 }
+func (*UnimplementedMsgServer) UnjailObserver(ctx context.Context, req *MsgUnjailObserver) (*MsgUnjailObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailObserver not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLivenessParams(ctx context.Context, req *MsgUpdateLivenessParams) (*MsgUpdateLivenessParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLivenessParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1773,6 +1980,42 @@ func _Msg_UpdateGasPriceIncreaseFlags_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UnjailObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailObserver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailObserver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UnjailObserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailObserver(ctx, req.(*MsgUnjailObserver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateLivenessParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLivenessParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLivenessParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateLivenessParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLivenessParams(ctx, req.(*MsgUpdateLivenessParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.observer.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1825,6 +2068,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateGasPriceIncreaseFlags",
 			Handler:    _Msg_UpdateGasPriceIncreaseFlags_Handler,
 		},
+		{
+			MethodName: "UnjailObserver",
+			Handler:    _Msg_UnjailObserver_Handler,
+		},
+		{
+			MethodName: "UpdateLivenessParams",
+			Handler:    _Msg_UpdateLivenessParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "zetachain/zetacore/observer/tx.proto",
@@ -2705,6 +2956,122 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUnjailObserver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailObserverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLivenessParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLivenessParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLivenessParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LivenessParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLivenessParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLivenessParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLivenessParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3077,14 +3444,60 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+func (m *MsgUnjailObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateLivenessParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LivenessParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateLivenessParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -5405,6 +5818,303 @@ func (m *MsgUpdateGasPriceIncreaseFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUnjailObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailObserver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailObserverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateLivenessParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLivenessParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLivenessParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LivenessParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateLivenessParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLivenessParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLivenessParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -70,6 +70,9 @@ type Observer struct {
 	// headerCache is the cache for headers
 	headerCache *lru.Cache
 
+	// pendingInbounds are the inbounds held until their amount tier is met, indexed by ballot
+	pendingInbounds map[string]PendingInbound
+
 	// db is the database to persist data
 	db *db.DB
 
@@ -110,6 +113,7 @@ func NewObserver(
 		lastBlockScanned: 0,
 		lastTxScanned:    "",
 		rpcAlertLatency:  time.Duration(rpcAlertLatency) * time.Second,
+		pendingInbounds:  make(map[string]PendingInbound),
 		ts:               ts,
 		db:               database,
 		mu:               &sync.Mutex{},
@@ -364,8 +368,13 @@ func (ob *Observer) LoadLastBlockScanned(logger zerolog.Logger) error {
 }
 
 // SaveLastBlockScanned saves the last scanned block to memory and database.
+// The block saved to database stays below the pending inbounds, so they are scanned again after a restart.
 func (ob *Observer) SaveLastBlockScanned(blockNumber uint64) error {
 	ob.WithLastBlockScanned(blockNumber)
+
+	if lowest, found := ob.LowestPendingInboundBlock(); found && lowest > 0 && lowest <= blockNumber {
+		blockNumber = lowest - 1
+	}
 	return ob.WriteLastBlockScannedToDB(blockNumber)
 }
 
@@ -409,6 +418,7 @@ func (ob *Observer) LoadLastTxScanned() {
 }
 
 // SaveLastTxScanned saves the last scanned tx hash to memory and database.
+// The tx is only saved to memory while inbounds are pending, so they are scanned again after a restart.
 func (ob *Observer) SaveLastTxScanned(txHash string, slot uint64) error {
 	// save last scanned tx to memory
 	ob.WithLastTxScanned(txHash)
@@ -416,6 +426,9 @@ func (ob *Observer) SaveLastTxScanned(txHash string, slot uint64) error {
 	// update last_scanned_block_number metrics
 	ob.WithLastBlockScanned(slot)
 
+	if _, found := ob.LowestPendingInboundBlock(); found {
+		return nil
+	}
 	return ob.WriteLastTxScannedToDB(txHash)
 }
 
@@ -435,10 +448,25 @@ func (ob *Observer) ReadLastTxScannedFromDB() (string, error) {
 }
 
 // PostVoteInbound posts a vote for the given vote message
+// The vote is held in the pending inbounds if the inbound block doesn't have the confirmations required by its amount
 func (ob *Observer) PostVoteInbound(
 	ctx context.Context,
 	msg *crosschaintypes.MsgVoteInbound,
 	retryGasLimit uint64,
+) (string, error) {
+	// hold the inbound until its amount tier is met
+	if ob.holdInbound(msg, retryGasLimit) {
+		return msg.Digest(), nil
+	}
+
+	return ob.postVoteInbound(ctx, msg, retryGasLimit)
+}
+
+// postVoteInbound posts a vote for the given vote message to zetacore
+func (ob *Observer) postVoteInbound(
+	ctx context.Context,
+	msg *crosschaintypes.MsgVoteInbound,
+	retryGasLimit uint64,
 ) (string, error) {
 	txHash := msg.InboundHash
 	coinType := msg.CoinType
@@ -0,0 +1,122 @@
+package base
+
+import (
+	"context"
+	"sort"
+
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+)
+
+// PendingInbound is an inbound vote held until its block has the confirmations required by its amount tier
+type PendingInbound struct {
+	// Msg is the inbound vote message
+	Msg *crosschaintypes.MsgVoteInbound
+
+	// ConfirmationCount is the number of confirmations required by the amount tier of the inbound
+	ConfirmationCount uint64
+
+	// RetryGasLimit is the gas limit to retry the inbound execution
+	RetryGasLimit uint64
+}
+
+// IsConfirmed returns true if the inbound block has enough confirmations given the last block of the chain
+func (p PendingInbound) IsConfirmed(lastBlock uint64) bool {
+	return lastBlock >= p.Msg.InboundBlockHeight+p.ConfirmationCount
+}
+
+// PendingInbounds returns the inbounds held until their amount tier is met, sorted by block height
+func (ob *Observer) PendingInbounds() []PendingInbound {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+
+	pendings := make([]PendingInbound, 0, len(ob.pendingInbounds))
+	for _, pending := range ob.pendingInbounds {
+		pendings = append(pendings, pending)
+	}
+	sort.SliceStable(pendings, func(i, j int) bool {
+		if pendings[i].Msg.InboundBlockHeight == pendings[j].Msg.InboundBlockHeight {
+			return pendings[i].Msg.Digest() < pendings[j].Msg.Digest()
+		}
+		return pendings[i].Msg.InboundBlockHeight < pendings[j].Msg.InboundBlockHeight
+	})
+
+	return pendings
+}
+
+// LowestPendingInboundBlock returns the lowest block height of the pending inbounds
+// It returns false if there is no pending inbound
+func (ob *Observer) LowestPendingInboundBlock() (uint64, bool) {
+	pendings := ob.PendingInbounds()
+	if len(pendings) == 0 {
+		return 0, false
+	}
+	return pendings[0].Msg.InboundBlockHeight, true
+}
+
+// AddPendingInbound adds an inbound to the pending set, a pending inbound is added only once
+func (ob *Observer) AddPendingInbound(pending PendingInbound) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+
+	ballot := pending.Msg.Digest()
+	if _, found := ob.pendingInbounds[ballot]; found {
+		return
+	}
+	ob.pendingInbounds[ballot] = pending
+
+	ob.logger.Inbound.Info().
+		Str("inbound", pending.Msg.InboundHash).
+		Str("ballot", ballot).
+		Uint64("block", pending.Msg.InboundBlockHeight).
+		Uint64("confirmations", pending.ConfirmationCount).
+		Msgf("inbound held until its amount tier is met for chain %d", ob.chain.ChainId)
+}
+
+// RemovePendingInbound removes an inbound from the pending set
+func (ob *Observer) RemovePendingInbound(ballot string) {
+	ob.mu.Lock()
+	defer ob.mu.Unlock()
+
+	delete(ob.pendingInbounds, ballot)
+}
+
+// ProcessPendingInbounds posts the votes of the pending inbounds whose amount tier is met given the last block
+// The inbounds failing to be voted are kept in the pending set to be retried later
+func (ob *Observer) ProcessPendingInbounds(ctx context.Context) {
+	lastBlock := ob.LastBlock()
+	for _, pending := range ob.PendingInbounds() {
+		if !pending.IsConfirmed(lastBlock) {
+			continue
+		}
+
+		if _, err := ob.postVoteInbound(ctx, pending.Msg, pending.RetryGasLimit); err != nil {
+			continue
+		}
+		ob.RemovePendingInbound(pending.Msg.Digest())
+	}
+}
+
+// holdInbound adds the inbound to the pending set if its block doesn't have the confirmations required by its
+// amount tier. It returns true if the inbound is held.
+func (ob *Observer) holdInbound(msg *crosschaintypes.MsgVoteInbound, retryGasLimit uint64) bool {
+	ob.mu.Lock()
+	chainParams := ob.chainParams
+	ob.mu.Unlock()
+
+	confirmationCount, found := chainParams.ConfirmationCountForAmount(msg.CoinType, msg.Asset, msg.Amount.BigInt())
+	if !found {
+		return false
+	}
+
+	pending := PendingInbound{
+		Msg:               msg,
+		ConfirmationCount: confirmationCount,
+		RetryGasLimit:     retryGasLimit,
+	}
+	if pending.IsConfirmed(ob.LastBlock()) {
+		return false
+	}
+
+	ob.AddPendingInbound(pending)
+	return true
+}
@@ -0,0 +1,201 @@
+package base_test
+
+import (
+	"context"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+// createObserverWithTiers creates a new observer for testing with a gas confirmation tier from 1000 units
+func createObserverWithTiers(t *testing.T, tierCount uint64) (*base.Observer, *mocks.ZetacoreClient) {
+	ob := createObserver(t, chains.Ethereum, defaultAlertLatency)
+
+	chainParams := ob.ChainParams()
+	chainParams.ConfirmationCount = 2
+	chainParams.ConfirmationTiers = []observertypes.ConfirmationTier{
+		{
+			CoinType:          coin.CoinType_Gas,
+			MinAmount:         sdkmath.NewUint(1000),
+			ConfirmationCount: tierCount,
+		},
+	}
+	ob.WithChainParams(chainParams)
+
+	zetacoreClient := mocks.NewZetacoreClient(t)
+	ob.WithZetacoreClient(zetacoreClient)
+
+	return ob, zetacoreClient
+}
+
+// inboundVote creates a gas inbound vote with the given amount and block height
+func inboundVote(amount uint64, blockHeight uint64) *crosschaintypes.MsgVoteInbound {
+	msg := sample.InboundVote(coin.CoinType_Gas, chains.Ethereum.ChainId, chains.ZetaChainMainnet.ChainId)
+	msg.Amount = sdkmath.NewUint(amount)
+	msg.InboundBlockHeight = blockHeight
+	return &msg
+}
+
+func TestPostVoteInbound_ConfirmationTiers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should post vote if no tier is reached", func(t *testing.T) {
+		// ARRANGE
+		ob, zetacoreClient := createObserverWithTiers(t, 10)
+		zetacoreClient.WithPostVoteInbound("", "sampleBallotIndex")
+		ob.WithLastBlock(100)
+
+		// ACT
+		ballot, err := ob.PostVoteInbound(ctx, inboundVote(999, 99), 100000)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, "sampleBallotIndex", ballot)
+		require.Empty(t, ob.PendingInbounds())
+	})
+
+	t.Run("should post vote if tier is met", func(t *testing.T) {
+		// ARRANGE
+		ob, zetacoreClient := createObserverWithTiers(t, 10)
+		zetacoreClient.WithPostVoteInbound("", "sampleBallotIndex")
+		ob.WithLastBlock(100)
+
+		// ACT
+		ballot, err := ob.PostVoteInbound(ctx, inboundVote(1000, 90), 100000)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, "sampleBallotIndex", ballot)
+		require.Empty(t, ob.PendingInbounds())
+	})
+
+	t.Run("should hold inbound until tier is met", func(t *testing.T) {
+		// ARRANGE
+		ob, zetacoreClient := createObserverWithTiers(t, 10)
+		ob.WithLastBlock(100)
+		msg := inboundVote(1000, 95)
+
+		// ACT
+		ballot, err := ob.PostVoteInbound(ctx, msg, 100000)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, msg.Digest(), ballot)
+		zetacoreClient.AssertNotCalled(t, "PostVoteInbound", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+		pendings := ob.PendingInbounds()
+		require.Len(t, pendings, 1)
+		require.Equal(t, msg, pendings[0].Msg)
+		require.EqualValues(t, 10, pendings[0].ConfirmationCount)
+		require.EqualValues(t, 100000, pendings[0].RetryGasLimit)
+
+		lowest, found := ob.LowestPendingInboundBlock()
+		require.True(t, found)
+		require.EqualValues(t, 95, lowest)
+	})
+}
+
+func TestProcessPendingInbounds(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should post votes of the inbounds whose tier is met", func(t *testing.T) {
+		// ARRANGE
+		ob, zetacoreClient := createObserverWithTiers(t, 10)
+		ob.WithLastBlock(100)
+
+		confirmed := inboundVote(1000, 95)
+		pending := inboundVote(1000, 98)
+		_, err := ob.PostVoteInbound(ctx, confirmed, 100000)
+		require.NoError(t, err)
+		_, err = ob.PostVoteInbound(ctx, pending, 100000)
+		require.NoError(t, err)
+		require.Len(t, ob.PendingInbounds(), 2)
+
+		zetacoreClient.On("PostVoteInbound", mock.Anything, mock.Anything, mock.Anything, confirmed).
+			Return("zetaTxHash", confirmed.Digest(), nil).
+			Once()
+
+		// ACT
+		ob.WithLastBlock(105)
+		ob.ProcessPendingInbounds(ctx)
+
+		// ASSERT
+		pendings := ob.PendingInbounds()
+		require.Len(t, pendings, 1)
+		require.Equal(t, pending, pendings[0].Msg)
+	})
+
+	t.Run("should keep inbound pending if vote fails", func(t *testing.T) {
+		// ARRANGE
+		ob, zetacoreClient := createObserverWithTiers(t, 10)
+		ob.WithLastBlock(100)
+
+		msg := inboundVote(1000, 95)
+		_, err := ob.PostVoteInbound(ctx, msg, 100000)
+		require.NoError(t, err)
+
+		zetacoreClient.On("PostVoteInbound", mock.Anything, mock.Anything, mock.Anything, msg).
+			Return("", "", context.DeadlineExceeded).
+			Once()
+
+		// ACT
+		ob.WithLastBlock(105)
+		ob.ProcessPendingInbounds(ctx)
+
+		// ASSERT
+		require.Len(t, ob.PendingInbounds(), 1)
+	})
+}
+
+func TestSaveLastScanned_PendingInbounds(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should not save last block scanned beyond pending inbounds to db", func(t *testing.T) {
+		// ARRANGE
+		ob, _ := createObserverWithTiers(t, 10)
+		ob.WithLastBlock(100)
+		_, err := ob.PostVoteInbound(ctx, inboundVote(1000, 95), 100000)
+		require.NoError(t, err)
+
+		// ACT
+		err = ob.SaveLastBlockScanned(98)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.EqualValues(t, 98, ob.LastBlockScanned())
+
+		lastBlockScanned, err := ob.ReadLastBlockScannedFromDB()
+		require.NoError(t, err)
+		require.EqualValues(t, 94, lastBlockScanned)
+	})
+
+	t.Run("should not save last tx scanned to db while inbounds are pending", func(t *testing.T) {
+		// ARRANGE
+		ob, _ := createObserverWithTiers(t, 10)
+		ob.WithLastBlock(100)
+		require.NoError(t, ob.SaveLastTxScanned("tx1", 90))
+		_, err := ob.PostVoteInbound(ctx, inboundVote(1000, 95), 100000)
+		require.NoError(t, err)
+
+		// ACT
+		err = ob.SaveLastTxScanned("tx2", 98)
+
+		// ASSERT
+		require.NoError(t, err)
+		require.Equal(t, "tx2", ob.LastTxScanned())
+
+		lastTxScanned, err := ob.ReadLastTxScannedFromDB()
+		require.NoError(t, err)
+		require.Equal(t, "tx1", lastTxScanned)
+	})
+}
@@ -36,6 +36,9 @@ const (
 	OutboundBytesMax     = uint64(1543) // 1543v == EstimateSegWitTxSize(21, 2, toP2TR)
 	OutboundBytesAvg     = uint64(245)  // 245vB is a suggested gas limit for zetacore
 
+	// MaxOutboundBatchSize is the maximum number of CCTXs paid by a single outbound
+	MaxOutboundBatchSize = 10
+
 	// defaultDepositorFeeRate is the default fee rate for depositor fee, 20 sat/vB
 	defaultDepositorFeeRate = 20
 
@@ -80,7 +83,8 @@ func WiredTxSize(numInputs uint64, numOutputs uint64) uint64 {
 	return uint64(8 + wire.VarIntSerializeSize(numInputs) + wire.VarIntSerializeSize(numOutputs))
 }
 
-// EstimateOutboundSize estimates the size of an outbound in vBytes
+// EstimateOutboundSize estimates the size of an outbound in vBytes.
+// The outbound has one output per payee on top of the nonce-mark and the change outputs
 func EstimateOutboundSize(numInputs uint64, payees []btcutil.Address) (uint64, error) {
 	if numInputs == 0 {
 		return 0, nil
@@ -109,6 +113,15 @@ func EstimateOutboundSize(numInputs uint64, payees []btcutil.Address) (uint64, e
 	return bytesWiredTx + bytesInput + bytesOutput + bytesToPayees + bytesWitness/blockchain.WitnessScaleFactor, nil
 }
 
+// OutboundSizeMax returns the max size of an outbound paying the given number of payees in vBytes.
+// Each payee beyond the first one adds the size of the largest output type to OutboundBytesMax
+func OutboundSizeMax(numPayees uint64) uint64 {
+	if numPayees <= 1 {
+		return OutboundBytesMax
+	}
+	return OutboundBytesMax + (numPayees-1)*bytesPerOutputP2TR
+}
+
 // GetOutputSizeByAddress returns the size of a tx output in bytes by the given address
 func GetOutputSizeByAddress(to btcutil.Address) (uint64, error) {
 	switch addr := to.(type) {
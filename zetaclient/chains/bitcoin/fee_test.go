@@ -456,6 +456,16 @@ func TestOutboundSizeMinMaxError(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, OutboundBytesMin, sizeMin)
 
+	// Estimate the largest size of a batched outbound in vByte
+	payees := make([]btcutil.Address, MaxOutboundBatchSize)
+	for i := range payees {
+		payees[i] = toP2TR
+	}
+	sizeMaxBatch, err := EstimateOutboundSize(21, payees)
+	require.NoError(t, err)
+	require.Equal(t, OutboundSizeMax(MaxOutboundBatchSize), sizeMaxBatch)
+	require.Equal(t, OutboundBytesMax, OutboundSizeMax(1))
+
 	// Estimate unknown address type
 	nilP2PK := (*btcutil.AddressPubKey)(nil)
 	size, err := EstimateOutboundSize(1, []btcutil.Address{nilP2PK})
@@ -49,23 +49,11 @@ func (ob *Observer) WatchInbound(ctx context.Context) error {
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsInboundObservationEnabled() {
-				sampledLogger.Info().
-					Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.Chain().ChainId)
-				continue
-			}
-			err := ob.ObserveInbound(ctx)
-			if err != nil {
-				// skip showing log for block number 0 as it means Bitcoin node is not enabled
-				// TODO: prevent this routine from running if Bitcoin node is not enabled
-				// https://github.com/zeta-chain/node/issues/2790
-				if !errors.Is(err, bitcoin.ErrBitcoinNotEnabled) {
-					ob.logger.Inbound.Error().Err(err).Msg("WatchInbound error observing in tx")
-				} else {
-					ob.logger.Inbound.Debug().Err(err).Msg("WatchInbound: Bitcoin node is not enabled")
-				}
-			}
+			ob.observeInbound(ctx, app, sampledLogger)
 			ticker.UpdateInterval(ob.GetChainParams().InboundTicker, ob.logger.Inbound)
+		case <-ob.mempool.newBlocks:
+			// observe the new block seen by the mempool polling without waiting for the ticker
+			ob.observeInbound(ctx, app, sampledLogger)
 		case <-ob.StopChannel():
 			ob.logger.Inbound.Info().Msgf("WatchInbound stopped for chain %d", ob.Chain().ChainId)
 			return nil
@@ -73,11 +61,29 @@ func (ob *Observer) WatchInbound(ctx context.Context) error {
 	}
 }
 
+// observeInbound observes the inbounds if the inbound observation is enabled
+func (ob *Observer) observeInbound(ctx context.Context, app *zctx.AppContext, sampledLogger zerolog.Logger) {
+	if !app.IsInboundObservationEnabled() {
+		sampledLogger.Info().
+			Msgf("WatchInbound: inbound observation is disabled for chain %d", ob.Chain().ChainId)
+		return
+	}
+	err := ob.ObserveInbound(ctx)
+	if err != nil {
+		// skip showing log for block number 0 as it means Bitcoin node is not enabled
+		// TODO: prevent this routine from running if Bitcoin node is not enabled
+		// https://github.com/zeta-chain/node/issues/2790
+		if !errors.Is(err, bitcoin.ErrBitcoinNotEnabled) {
+			ob.logger.Inbound.Error().Err(err).Msg("WatchInbound error observing in tx")
+		} else {
+			ob.logger.Inbound.Debug().Err(err).Msg("WatchInbound: Bitcoin node is not enabled")
+		}
+	}
+}
+
 // ObserveInbound observes the Bitcoin chain for inbounds and post votes to zetacore
 // TODO(revamp): simplify this function into smaller functions
 func (ob *Observer) ObserveInbound(ctx context.Context) error {
-	zetaCoreClient := ob.ZetacoreClient()
-
 	// get and update latest block height
 	currentBlock, err := ob.btcClient.GetBlockCount()
 	if err != nil {
@@ -104,6 +110,9 @@ func (ob *Observer) ObserveInbound(ctx context.Context) error {
 	}
 	ob.WithLastBlock(lastBlock)
 
+	// post the votes of the pending inbounds whose amount tier is met
+	ob.ProcessPendingInbounds(ctx)
+
 	// skip if current height is too low
 	if lastBlock < ob.GetChainParams().ConfirmationCount {
 		return fmt.Errorf("observeInboundBTC: skipping observer, current block number %d is too low", currentBlock)
@@ -155,20 +164,12 @@ func (ob *Observer) ObserveInbound(ctx context.Context) error {
 		for _, inbound := range inbounds {
 			msg := ob.GetInboundVoteMessageFromBtcEvent(inbound)
 			if msg != nil {
-				zetaHash, ballot, err := zetaCoreClient.PostVoteInbound(
-					ctx,
-					zetacore.PostVoteInboundGasLimit,
-					zetacore.PostVoteInboundExecutionGasLimit,
-					msg,
-				)
+				_, err := ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 				if err != nil {
 					ob.logger.Inbound.Error().
 						Err(err).
 						Msgf("observeInboundBTC: error posting to zetacore for tx %s", inbound.TxHash)
 					return err // we have to re-scan this block next time
-				} else if zetaHash != "" {
-					ob.logger.Inbound.Info().Msgf("observeInboundBTC: PostVoteInbound zeta tx hash: %s inbound %s ballot %s fee %v",
-						zetaHash, inbound.TxHash, ballot, depositorFee)
 				}
 			}
 		}
@@ -305,18 +306,10 @@ func (ob *Observer) CheckReceiptForBtcTxHash(ctx context.Context, txHash string,
 		return msg.Digest(), nil
 	}
 
-	zetaHash, ballot, err := ob.ZetacoreClient().PostVoteInbound(
-		ctx,
-		zetacore.PostVoteInboundGasLimit,
-		zetacore.PostVoteInboundExecutionGasLimit,
-		msg,
-	)
+	_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
 	if err != nil {
 		ob.logger.Inbound.Error().Err(err).Msg("error posting to zetacore")
 		return "", err
-	} else if zetaHash != "" {
-		ob.logger.Inbound.Info().Msgf("BTC deposit detected and reported: PostVoteInbound zeta tx hash: %s inbound %s ballot %s fee %v",
-			zetaHash, txHash, ballot, depositorFee)
 	}
 
 	return msg.Digest(), nil
@@ -0,0 +1,237 @@
+package observer
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/types"
+)
+
+// mempoolMaxTxsPerPoll is the maximum number of new mempool transactions fetched per poll,
+// the remaining ones are fetched on the next polls
+const mempoolMaxTxsPerPoll = 500
+
+// mempool is the state of the mempool polling
+type mempool struct {
+	mu sync.Mutex
+
+	// pollInterval is the interval to poll the mempool, the mempool isn't polled if it's 0
+	pollInterval time.Duration
+
+	// lastBlock is the last block number seen by the mempool polling
+	lastBlock int64
+
+	// seenTxs are the mempool transactions already fetched
+	seenTxs map[string]bool
+
+	// inbounds are the unconfirmed inbounds to TSS in the mempool, indexed by tx hash
+	inbounds map[string]types.MempoolInbound
+
+	// newBlocks signals a new block to the inbound observation
+	newBlocks chan struct{}
+}
+
+// newMempool creates a new mempool polling state
+func newMempool() *mempool {
+	return &mempool{
+		seenTxs:   make(map[string]bool),
+		inbounds:  make(map[string]types.MempoolInbound),
+		newBlocks: make(chan struct{}, 1),
+	}
+}
+
+// WithMempoolPolling makes the observer poll the mempool for unconfirmed inbounds and new blocks on the interval
+func (ob *Observer) WithMempoolPolling(interval time.Duration) {
+	ob.mempool.mu.Lock()
+	defer ob.mempool.mu.Unlock()
+	ob.mempool.pollInterval = interval
+}
+
+// MempoolInbounds returns the unconfirmed inbounds to TSS seen in the mempool, sorted by the time they're seen
+func (ob *Observer) MempoolInbounds() []types.MempoolInbound {
+	ob.mempool.mu.Lock()
+	defer ob.mempool.mu.Unlock()
+
+	inbounds := make([]types.MempoolInbound, 0, len(ob.mempool.inbounds))
+	for _, inbound := range ob.mempool.inbounds {
+		inbounds = append(inbounds, inbound)
+	}
+	sort.SliceStable(inbounds, func(i, j int) bool {
+		if inbounds[i].SeenAt.Equal(inbounds[j].SeenAt) {
+			return inbounds[i].TxHash < inbounds[j].TxHash
+		}
+		return inbounds[i].SeenAt.Before(inbounds[j].SeenAt)
+	})
+
+	return inbounds
+}
+
+// mempoolPollInterval returns the interval to poll the mempool
+func (ob *Observer) mempoolPollInterval() time.Duration {
+	ob.mempool.mu.Lock()
+	defer ob.mempool.mu.Unlock()
+	return ob.mempool.pollInterval
+}
+
+// WatchMempool polls the mempool for the unconfirmed inbounds to TSS and the new blocks.
+// A new block triggers the inbound observation without waiting for the inbound ticker
+func (ob *Observer) WatchMempool(ctx context.Context) error {
+	ticker := time.NewTicker(ob.mempoolPollInterval())
+	defer ticker.Stop()
+
+	ob.logger.Inbound.Info().Msgf("WatchMempool started for chain %d", ob.Chain().ChainId)
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := ob.ObserveMempool(ctx); err != nil {
+				ob.logger.Inbound.Error().Err(err).Msg("WatchMempool: error observing mempool")
+			}
+		case <-ob.StopChannel():
+			ob.logger.Inbound.Info().Msgf("WatchMempool stopped for chain %d", ob.Chain().ChainId)
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ObserveMempool records the unconfirmed inbounds to TSS in the mempool and signals a new block
+func (ob *Observer) ObserveMempool(ctx context.Context) error {
+	// signal a new block to the inbound observation
+	blockNumber, err := ob.btcClient.GetBlockCount()
+	if err != nil {
+		return errors.Wrap(err, "unable to get block count")
+	}
+	ob.mempool.mu.Lock()
+	newBlock := blockNumber > ob.mempool.lastBlock
+	ob.mempool.lastBlock = blockNumber
+	ob.mempool.mu.Unlock()
+	if newBlock {
+		select {
+		case ob.mempool.newBlocks <- struct{}{}:
+		default:
+		}
+	}
+
+	hashes, err := ob.btcClient.GetRawMempool()
+	if err != nil {
+		return errors.Wrap(err, "unable to get raw mempool")
+	}
+
+	// forget the transactions that left the mempool, they're confirmed or evicted
+	inMempool := make(map[string]bool, len(hashes))
+	for _, hash := range hashes {
+		inMempool[hash.String()] = true
+	}
+	ob.mempool.mu.Lock()
+	for txHash := range ob.mempool.seenTxs {
+		if !inMempool[txHash] {
+			delete(ob.mempool.seenTxs, txHash)
+			delete(ob.mempool.inbounds, txHash)
+		}
+	}
+	ob.mempool.mu.Unlock()
+
+	// fetch the new transactions and record the inbounds to TSS
+	fetched := 0
+	for _, hash := range hashes {
+		if fetched >= mempoolMaxTxsPerPoll {
+			break
+		}
+		if ob.isMempoolTxSeen(hash.String()) {
+			continue
+		}
+		fetched++
+
+		// #nosec G115 always positive
+		inbound, err := ob.getMempoolInbound(ctx, hash, uint64(blockNumber+1))
+		if err != nil {
+			// the transaction may have left the mempool, try again on the next poll
+			ob.logger.Inbound.Debug().Err(err).Msgf("ObserveMempool: error getting mempool tx %s", hash)
+			continue
+		}
+
+		ob.mempool.mu.Lock()
+		ob.mempool.seenTxs[hash.String()] = true
+		if inbound != nil {
+			ob.mempool.inbounds[inbound.TxHash] = *inbound
+			ob.logger.Inbound.Info().
+				Str("inbound.hash", inbound.TxHash).
+				Float64("inbound.amount", inbound.Amount).
+				Uint64("inbound.confirmations", inbound.RequiredConfirmations).
+				Msg("ObserveMempool: unconfirmed inbound seen in mempool")
+		}
+		ob.mempool.mu.Unlock()
+	}
+
+	if ts := ob.TelemetryServer(); ts != nil {
+		ts.SetMempoolInbounds(ob.Chain(), ob.MempoolInbounds())
+	}
+
+	return nil
+}
+
+// isMempoolTxSeen returns true if the mempool transaction is already fetched
+func (ob *Observer) isMempoolTxSeen(txHash string) bool {
+	ob.mempool.mu.Lock()
+	defer ob.mempool.mu.Unlock()
+	return ob.mempool.seenTxs[txHash]
+}
+
+// getMempoolInbound returns the inbound to TSS of the mempool transaction, it's nil for an irrelevant transaction
+func (ob *Observer) getMempoolInbound(
+	_ context.Context,
+	hash *chainhash.Hash,
+	nextBlock uint64,
+) (*types.MempoolInbound, error) {
+	tx, err := ob.btcClient.GetRawTransactionVerbose(hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get raw transaction %s", hash)
+	}
+
+	// the depositor fee of the block is unknown yet, so the default one is used to filter out the dust
+	event, err := GetBtcEvent(
+		ob.btcClient,
+		*tx,
+		ob.TSS().BTCAddress(),
+		nextBlock,
+		ob.logger.Inbound,
+		ob.netParams,
+		bitcoin.DefaultDepositorFee,
+	)
+	if err != nil || event == nil {
+		return nil, err
+	}
+
+	amount, err := btcutil.NewAmount(event.Value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid inbound amount %v", event.Value)
+	}
+	chainParams := ob.GetChainParams()
+	confirmationCount := chainParams.ConfirmationCount
+	if tierCount, found := chainParams.ConfirmationCountForAmount(
+		coin.CoinType_Gas,
+		"",
+		big.NewInt(int64(amount)),
+	); found {
+		confirmationCount = tierCount
+	}
+
+	return &types.MempoolInbound{
+		TxHash:                event.TxHash,
+		Sender:                event.FromAddress,
+		Amount:                event.Value,
+		RequiredConfirmations: confirmationCount,
+		SeenAt:                time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,115 @@
+package observer
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/db"
+	"github.com/zeta-chain/node/zetaclient/testutils"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func TestObserveMempool(t *testing.T) {
+	ctx := context.Background()
+	chain := chains.BitcoinMainnet
+	params := mocks.MockChainParams(chain.ChainId, 10)
+
+	// archived inbound to TSS
+	// https://mempool.space/tx/847139aa65aa4a5ee896375951cbf7417cfc8a4d6f277ec11f40cd87319f04aa
+	txHash := "847139aa65aa4a5ee896375951cbf7417cfc8a4d6f277ec11f40cd87319f04aa"
+	tx := testutils.LoadBTCInboundRawResult(t, TestDataDir, chain.ChainId, txHash, false)
+	hash, err := chainhash.NewHashFromStr(txHash)
+	require.NoError(t, err)
+
+	// previous tx to get the sender address
+	// https://mempool.space/tx/c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697
+	preHash := "c5d224963832fc0b9a597251c2342a17b25e481a88cc9119008e8f8296652697"
+	tx.Vin[0].Txid = preHash
+	tx.Vin[0].Vout = 2
+	var preMsgTx wire.MsgTx
+	testutils.LoadObjectFromJSONFile(
+		t,
+		&preMsgTx,
+		path.Join(TestDataDir, testutils.TestDataPathBTC, testutils.FileNameBTCMsgTx(chain.ChainId, preHash)),
+	)
+
+	// unrelated tx in the mempool
+	otherHash := chainhash.Hash{1}
+
+	newObserver := func(t *testing.T, btcClient *mocks.MockBTCRPCClient) *Observer {
+		database, err := db.NewFromSqliteInMemory(true)
+		require.NoError(t, err)
+		ob, err := NewObserver(chain, btcClient, params, nil, mocks.NewTSSMainnet(), 60, database, base.Logger{}, nil)
+		require.NoError(t, err)
+		return ob
+	}
+
+	t.Run("should record unconfirmed inbound to TSS", func(t *testing.T) {
+		btcClient := mocks.NewMockBTCRPCClient().
+			WithBlockCount(835639).
+			WithRawMempool([]*chainhash.Hash{hash}).
+			WithRawTransactionVerbose(tx).
+			WithRawTransaction(btcutil.NewTx(&preMsgTx))
+		ob := newObserver(t, btcClient)
+
+		err := ob.ObserveMempool(ctx)
+		require.NoError(t, err)
+
+		inbounds := ob.MempoolInbounds()
+		require.Len(t, inbounds, 1)
+		require.Equal(t, txHash, inbounds[0].TxHash)
+		require.Equal(t, "bc1q68kxnq52ahz5vd6c8czevsawu0ux9nfrzzrh6e", inbounds[0].Sender)
+		require.Equal(t, tx.Vout[0].Value-bitcoin.DefaultDepositorFee, inbounds[0].Amount)
+		require.EqualValues(t, params.ConfirmationCount, inbounds[0].RequiredConfirmations)
+
+		// the inbound is forgotten once it leaves the mempool
+		btcClient.WithRawMempool(nil)
+		err = ob.ObserveMempool(ctx)
+		require.NoError(t, err)
+		require.Empty(t, ob.MempoolInbounds())
+	})
+
+	t.Run("should skip a transaction not sent to TSS", func(t *testing.T) {
+		other := *tx
+		other.Txid = otherHash.String()
+		other.Vout = tx.Vout[1:]
+		btcClient := mocks.NewMockBTCRPCClient().
+			WithBlockCount(835639).
+			WithRawMempool([]*chainhash.Hash{&otherHash}).
+			WithRawTransactionVerbose(&other)
+		ob := newObserver(t, btcClient)
+
+		err := ob.ObserveMempool(ctx)
+		require.NoError(t, err)
+		require.Empty(t, ob.MempoolInbounds())
+		require.True(t, ob.isMempoolTxSeen(otherHash.String()))
+	})
+
+	t.Run("should signal new blocks only", func(t *testing.T) {
+		btcClient := mocks.NewMockBTCRPCClient().WithBlockCount(835639).WithRawMempool(nil)
+		ob := newObserver(t, btcClient)
+
+		// a new block is signaled
+		require.NoError(t, ob.ObserveMempool(ctx))
+		require.Len(t, ob.mempool.newBlocks, 1)
+		<-ob.mempool.newBlocks
+
+		// the same block is not signaled again
+		require.NoError(t, ob.ObserveMempool(ctx))
+		require.Len(t, ob.mempool.newBlocks, 0)
+
+		// the next block is signaled
+		btcClient.WithBlockCount(835640)
+		require.NoError(t, ob.ObserveMempool(ctx))
+		require.Len(t, ob.mempool.newBlocks, 1)
+	})
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/zeta-chain/node/pkg/bg"
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
@@ -103,6 +104,9 @@ type Observer struct {
 	// broadcastedTx indexes the outbound hash with the outbound tx identifier
 	broadcastedTx map[string]string
 
+	// mempool is the state of the mempool polling for unconfirmed inbounds
+	mempool *mempool
+
 	// logger contains the loggers used by the bitcoin observer
 	logger Logger
 }
@@ -152,6 +156,7 @@ func NewObserver(
 		includedTxHashes:  make(map[string]bool),
 		includedTxResults: make(map[string]*btcjson.GetTransactionResult),
 		broadcastedTx:     make(map[string]string),
+		mempool:           newMempool(),
 		logger: Logger{
 			ObserverLogger: *baseObserver.Logger(),
 			UTXOs:          baseObserver.Logger().Chain.With().Str("module", "utxos").Logger(),
@@ -223,6 +228,11 @@ func (ob *Observer) Start(ctx context.Context) {
 
 	// watch the RPC status of the bitcoin chain
 	bg.Work(ctx, ob.watchRPCStatus, bg.WithName("watchRPCStatus"), bg.WithLogger(ob.Logger().Chain))
+
+	// watch bitcoin mempool for unconfirmed inbounds and new blocks
+	if ob.mempoolPollInterval() > 0 {
+		bg.Work(ctx, ob.WatchMempool, bg.WithName("WatchMempool"), bg.WithLogger(ob.Logger().Inbound))
+	}
 }
 
 // GetPendingNonce returns the artificial pending nonce
@@ -234,7 +244,18 @@ func (ob *Observer) GetPendingNonce() uint64 {
 }
 
 // ConfirmationsThreshold returns number of required Bitcoin confirmations depending on sent BTC amount.
+// The confirmation tiers of the chain params are used if set, the default thresholds otherwise.
 func (ob *Observer) ConfirmationsThreshold(amount *big.Int) int64 {
+	chainParams := ob.GetChainParams()
+	if len(chainParams.ConfirmationTiers) > 0 {
+		confirmationCount := chainParams.ConfirmationCount
+		if tierCount, found := chainParams.ConfirmationCountForAmount(coin.CoinType_Gas, "", amount); found {
+			confirmationCount = tierCount
+		}
+		// #nosec G115 always in range
+		return int64(confirmationCount)
+	}
+
 	if amount.Cmp(big.NewInt(BigValueSats)) >= 0 {
 		return BigValueConfirmationCount
 	}
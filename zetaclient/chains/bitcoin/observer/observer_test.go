@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/wire"
 	lru "github.com/hashicorp/golang-lru"
@@ -15,6 +16,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/testutil/sample"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
@@ -318,6 +320,18 @@ func TestConfirmationThreshold(t *testing.T) {
 		ob.SetChainParams(observertypes.ChainParams{ConfirmationCount: observer.BigValueConfirmationCount + 1})
 		require.Equal(t, int64(observer.BigValueConfirmationCount), ob.ConfirmationsThreshold(big.NewInt(1000)))
 	})
+
+	t.Run("should use confirmation tiers if set", func(t *testing.T) {
+		ob.SetChainParams(observertypes.ChainParams{
+			ConfirmationCount: 3,
+			ConfirmationTiers: []observertypes.ConfirmationTier{
+				{CoinType: coin.CoinType_Gas, MinAmount: sdkmath.NewUint(1000), ConfirmationCount: 10},
+			},
+		})
+		require.Equal(t, int64(3), ob.ConfirmationsThreshold(big.NewInt(999)))
+		require.Equal(t, int64(10), ob.ConfirmationsThreshold(big.NewInt(1000)))
+		require.Equal(t, int64(10), ob.ConfirmationsThreshold(big.NewInt(observer.BigValueSats)))
+	})
 }
 
 func TestSubmittedTx(t *testing.T) {
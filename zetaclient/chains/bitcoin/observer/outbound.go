@@ -115,6 +115,7 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 }
 
 // VoteOutboundIfConfirmed checks outbound status and returns (continueKeysign, error)
+// A batched outbound pays a range of nonces, each CCTX in the range is voted with the same outbound hash
 func (ob *Observer) VoteOutboundIfConfirmed(
 	ctx context.Context,
 	cctx *crosschaintypes.CrossChainTx,
@@ -526,13 +527,33 @@ func (ob *Observer) checkTssOutboundResult(
 	if err != nil {
 		return errors.Wrapf(err, "checkTssOutboundResult: error GetRawTxResultByHash %s", hash.String())
 	}
-	err = ob.checkTSSVin(ctx, rawResult.Vin, nonce)
+
+	// get the range of nonces paid by the outbound
+	first, last, err := ob.outboundNonceRange(&rawResult)
+	if err != nil {
+		return errors.Wrapf(err, "checkTssOutboundResult: invalid nonce range in outbound %s", hash)
+	}
+	if nonce < first || nonce > last {
+		return fmt.Errorf(
+			"checkTssOutboundResult: outbound %s pays nonces %d to %d, not nonce %d",
+			hash,
+			first,
+			last,
+			nonce,
+		)
+	}
+
+	err = ob.checkTSSVin(ctx, rawResult.Vin, first)
 	if err != nil {
 		return errors.Wrapf(err, "checkTssOutboundResult: invalid TSS Vin in outbound %s nonce %d", hash, nonce)
 	}
 
 	// differentiate between normal and restricted cctx
 	if compliance.IsCctxRestricted(cctx) {
+		// a restricted cctx is never batched
+		if first != last {
+			return fmt.Errorf("checkTssOutboundResult: cancelled outbound %s nonce %d is batched", hash, nonce)
+		}
 		err = ob.checkTSSVoutCancelled(params, rawResult.Vout)
 		if err != nil {
 			return errors.Wrapf(
@@ -543,7 +564,7 @@ func (ob *Observer) checkTssOutboundResult(
 			)
 		}
 	} else {
-		err = ob.checkTSSVout(params, rawResult.Vout)
+		err = ob.checkTSSVout(params, rawResult.Vout, first, last)
 		if err != nil {
 			return errors.Wrapf(err, "checkTssOutboundResult: invalid TSS Vout in outbound %s nonce %d", hash, nonce)
 		}
@@ -551,8 +572,65 @@ func (ob *Observer) checkTssOutboundResult(
 	return nil
 }
 
+// outboundNonceRange returns the range [first, last] of nonces paid by the outbound:
+//   - the last nonce is marked by the 1st output
+//   - the first nonce follows the nonce marked by the prior nonce-mark, spent by the 1st input
+//
+// Note: nonce 0 is never batched as it doesn't spend a prior nonce-mark
+func (ob *Observer) outboundNonceRange(rawResult *btcjson.TxRawResult) (uint64, uint64, error) {
+	if len(rawResult.Vout) == 0 {
+		return 0, 0, errors.New("outboundNonceRange: no vout")
+	}
+	last, err := nonceFromMark(rawResult.Vout[0].Value)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "outboundNonceRange: invalid nonce-mark output")
+	}
+	if last == 0 {
+		return 0, 0, nil
+	}
+	if len(rawResult.Vin) == 0 {
+		return 0, 0, errors.New("outboundNonceRange: no vin")
+	}
+
+	// get the prior nonce-mark spent by the 1st input
+	vin := rawResult.Vin[0]
+	prevTx, err := rpc.GetRawTxByHash(ob.btcClient, vin.Txid)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "outboundNonceRange: error getting prior nonce-mark tx %s", vin.Txid)
+	}
+	prevOuts := prevTx.MsgTx().TxOut
+	if int(vin.Vout) >= len(prevOuts) {
+		return 0, 0, fmt.Errorf("outboundNonceRange: invalid prior nonce-mark vout %d in tx %s", vin.Vout, vin.Txid)
+	}
+	prevMark := prevOuts[vin.Vout].Value - chains.BtcNonceMarkOffset()
+	if prevMark < 0 {
+		return 0, 0, fmt.Errorf("outboundNonceRange: invalid prior nonce-mark amount %d", prevOuts[vin.Vout].Value)
+	}
+
+	// #nosec G115 always positive
+	first := uint64(prevMark) + 1
+	if first > last || last-first >= bitcoin.MaxOutboundBatchSize {
+		return 0, 0, fmt.Errorf("outboundNonceRange: invalid nonce range %d to %d", first, last)
+	}
+	return first, last, nil
+}
+
+// nonceFromMark returns the nonce marked by the nonce-mark amount in BTC
+func nonceFromMark(amount float64) (uint64, error) {
+	sats, err := bitcoin.GetSatoshis(amount)
+	if err != nil {
+		return 0, err
+	}
+	nonce := sats - chains.BtcNonceMarkOffset()
+	if nonce < 0 {
+		return 0, fmt.Errorf("nonce-mark amount %d is less than offset %d", sats, chains.BtcNonceMarkOffset())
+	}
+	// #nosec G115 always positive
+	return uint64(nonce), nil
+}
+
 // checkTSSVin checks vin is valid if:
-//   - The first input is the nonce-mark
+//   - The first input is the nonce-mark of the nonce preceding the first nonce paid by the outbound
 //   - All inputs are from TSS address
 func (ob *Observer) checkTSSVin(ctx context.Context, vins []btcjson.Vin, nonce uint64) error {
 	// vins: [nonce-mark, UTXO1, UTXO2, ...]
@@ -589,22 +667,38 @@ func (ob *Observer) checkTSSVin(ctx context.Context, vins []btcjson.Vin, nonce u
 }
 
 // checkTSSVout vout is valid if:
-//   - The first output is the nonce-mark
-//   - The second output is the correct payment to recipient
-//   - The third output is the change to TSS (optional)
-func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts []btcjson.Vout) error {
-	// vouts: [nonce-mark, payment to recipient, change to TSS (optional)]
-	if !(len(vouts) == 2 || len(vouts) == 3) {
+//   - The first output is the nonce-mark of the last nonce
+//   - The outputs following the nonce-mark pay the nonces from first to last in order,
+//     the one of the cctx is the correct payment to recipient
+//   - The last output is the change to TSS (optional)
+func (ob *Observer) checkTSSVout(
+	params *crosschaintypes.OutboundParams,
+	vouts []btcjson.Vout,
+	first, last uint64,
+) error {
+	// vouts: [nonce-mark, payment to recipient 1, ..., payment to recipient N, change to TSS (optional)]
+	// #nosec G115 always in range
+	numPayments := int(last - first + 1)
+	if !(len(vouts) == numPayments+1 || len(vouts) == numPayments+2) {
 		return fmt.Errorf("checkTSSVout: invalid number of vouts: %d", len(vouts))
 	}
 
 	nonce := params.TssNonce
+	// #nosec G115 always in range
+	paymentN := uint32(nonce-first) + 1
+	// #nosec G115 always in range
+	changeN := uint32(numPayments) + 1
 	tssAddress := ob.TSS().BTCAddress()
 	for _, vout := range vouts {
+		// the payments of the other nonces are checked against their own cctx
+		if vout.N != 0 && vout.N != paymentN && vout.N != changeN {
+			continue
+		}
+
 		// decode receiver and amount from vout
 		receiverExpected := tssAddress
-		if vout.N == 1 {
-			// the 2nd output is the payment to recipient
+		if vout.N == paymentN {
+			// the payment to recipient
 			receiverExpected = params.Receiver
 		}
 		receiverVout, amount, err := bitcoin.DecodeTSSVout(vout, receiverExpected, ob.Chain())
@@ -620,14 +714,14 @@ func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts [
 					tssAddress,
 				)
 			}
-			if amount != chains.NonceMarkAmount(nonce) {
+			if amount != chains.NonceMarkAmount(last) {
 				return fmt.Errorf(
 					"checkTSSVout: nonce-mark amount %d not match nonce-mark amount %d",
 					amount,
-					chains.NonceMarkAmount(nonce),
+					chains.NonceMarkAmount(last),
 				)
 			}
-		case 1: // 2nd vout: payment to recipient
+		case paymentN: // payment to recipient
 			if receiverVout != params.Receiver {
 				return fmt.Errorf(
 					"checkTSSVout: output address %s not match params receiver %s",
@@ -639,7 +733,7 @@ func (ob *Observer) checkTSSVout(params *crosschaintypes.OutboundParams, vouts [
 			if uint64(amount) != params.Amount.Uint64() {
 				return fmt.Errorf("checkTSSVout: output amount %d not match params amount %d", amount, params.Amount)
 			}
-		case 2: // 3rd vout: change to TSS (optional)
+		case changeN: // last vout: change to TSS (optional)
 			if receiverVout != tssAddress {
 				return fmt.Errorf("checkTSSVout: change address %s not match TSS address %s", receiverVout, tssAddress)
 			}
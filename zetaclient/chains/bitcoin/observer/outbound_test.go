@@ -7,12 +7,16 @@ import (
 	"testing"
 
 	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/node/zetaclient/db"
 
 	"github.com/zeta-chain/node/pkg/chains"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
 	"github.com/zeta-chain/node/zetaclient/testutils"
 	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
 )
@@ -105,17 +109,17 @@ func TestCheckTSSVout(t *testing.T) {
 	t.Run("valid TSS vout should pass", func(t *testing.T) {
 		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
 		params := cctx.GetCurrentOutboundParam()
-		err := ob.checkTSSVout(params, rawResult.Vout)
+		err := ob.checkTSSVout(params, rawResult.Vout, nonce, nonce)
 		require.NoError(t, err)
 	})
 	t.Run("should fail if vout length < 2 or > 3", func(t *testing.T) {
 		_, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
 		params := cctx.GetCurrentOutboundParam()
 
-		err := ob.checkTSSVout(params, []btcjson.Vout{{}})
+		err := ob.checkTSSVout(params, []btcjson.Vout{{}}, nonce, nonce)
 		require.ErrorContains(t, err, "invalid number of vouts")
 
-		err = ob.checkTSSVout(params, []btcjson.Vout{{}, {}, {}, {}})
+		err = ob.checkTSSVout(params, []btcjson.Vout{{}, {}, {}, {}}, nonce, nonce)
 		require.ErrorContains(t, err, "invalid number of vouts")
 	})
 	t.Run("should fail on invalid TSS vout", func(t *testing.T) {
@@ -124,7 +128,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// invalid TSS vout
 		rawResult.Vout[0].ScriptPubKey.Hex = "invalid script"
-		err := ob.checkTSSVout(params, rawResult.Vout)
+		err := ob.checkTSSVout(params, rawResult.Vout, nonce, nonce)
 		require.Error(t, err)
 	})
 	t.Run("should fail if vout 0 is not to the TSS address", func(t *testing.T) {
@@ -133,7 +137,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not TSS address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
 		rawResult.Vout[0].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
-		err := ob.checkTSSVout(params, rawResult.Vout)
+		err := ob.checkTSSVout(params, rawResult.Vout, nonce, nonce)
 		require.ErrorContains(t, err, "not match TSS address")
 	})
 	t.Run("should fail if vout 0 not match nonce mark", func(t *testing.T) {
@@ -142,7 +146,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not match nonce mark
 		rawResult.Vout[0].Value = 0.00000147
-		err := ob.checkTSSVout(params, rawResult.Vout)
+		err := ob.checkTSSVout(params, rawResult.Vout, nonce, nonce)
 		require.ErrorContains(t, err, "not match nonce-mark amount")
 	})
 	t.Run("should fail if vout 1 is not to the receiver address", func(t *testing.T) {
@@ -151,7 +155,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not receiver address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
 		rawResult.Vout[1].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
-		err := ob.checkTSSVout(params, rawResult.Vout)
+		err := ob.checkTSSVout(params, rawResult.Vout, nonce, nonce)
 		require.ErrorContains(t, err, "not match params receiver")
 	})
 	t.Run("should fail if vout 1 not match payment amount", func(t *testing.T) {
@@ -160,7 +164,7 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not match payment amount
 		rawResult.Vout[1].Value = 0.00011000
-		err := ob.checkTSSVout(params, rawResult.Vout)
+		err := ob.checkTSSVout(params, rawResult.Vout, nonce, nonce)
 		require.ErrorContains(t, err, "not match params amount")
 	})
 	t.Run("should fail if vout 2 is not to the TSS address", func(t *testing.T) {
@@ -169,11 +173,156 @@ func TestCheckTSSVout(t *testing.T) {
 
 		// not TSS address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
 		rawResult.Vout[2].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
-		err := ob.checkTSSVout(params, rawResult.Vout)
+		err := ob.checkTSSVout(params, rawResult.Vout, nonce, nonce)
 		require.ErrorContains(t, err, "not match TSS address")
 	})
 }
 
+func TestCheckTSSVoutBatch(t *testing.T) {
+	// the archived outbound raw result file and cctx file
+	// https://blockstream.info/tx/030cd813443f7b70cc6d8a544d320c6d8465e4528fc0f3410b599dc0b26753a0
+	chain := chains.BitcoinMainnet
+	chainID := chain.ChainId
+	nonce := uint64(148)
+
+	// create mainnet mock client
+	ob := MockBTCObserverMainnet(t)
+
+	// batchVouts simulates a batch paying nonces 147 to 149 from the archived outbound of nonce 148
+	batchVouts := func(t *testing.T) ([]btcjson.Vout, *crosschaintypes.OutboundParams) {
+		rawResult, cctx := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
+
+		// not TSS address, bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus
+		other := rawResult.Vout[1]
+		other.ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
+
+		vouts := []btcjson.Vout{rawResult.Vout[0], other, rawResult.Vout[1], other, rawResult.Vout[2]}
+		vouts[0].Value = float64(chains.NonceMarkAmount(149)) * 1e-8
+		for i := range vouts {
+			// #nosec G115 test only
+			vouts[i].N = uint32(i)
+		}
+		return vouts, cctx.GetCurrentOutboundParam()
+	}
+
+	t.Run("valid batch TSS vout should pass", func(t *testing.T) {
+		vouts, params := batchVouts(t)
+		err := ob.checkTSSVout(params, vouts, 147, 149)
+		require.NoError(t, err)
+
+		// without change
+		err = ob.checkTSSVout(params, vouts[:4], 147, 149)
+		require.NoError(t, err)
+	})
+	t.Run("should fail on invalid number of vouts", func(t *testing.T) {
+		vouts, params := batchVouts(t)
+		err := ob.checkTSSVout(params, vouts[:3], 147, 149)
+		require.ErrorContains(t, err, "invalid number of vouts")
+	})
+	t.Run("should fail if vout 0 not match the nonce mark of the last nonce", func(t *testing.T) {
+		vouts, params := batchVouts(t)
+		vouts[0].Value = float64(chains.NonceMarkAmount(nonce)) * 1e-8
+		err := ob.checkTSSVout(params, vouts, 147, 149)
+		require.ErrorContains(t, err, "not match nonce-mark amount")
+	})
+	t.Run("should fail if the payment is not in nonce order", func(t *testing.T) {
+		vouts, params := batchVouts(t)
+		vouts[0].Value = float64(chains.NonceMarkAmount(150)) * 1e-8
+		err := ob.checkTSSVout(params, vouts, 148, 150)
+		require.ErrorContains(t, err, "not match params receiver")
+	})
+	t.Run("should fail if the change is not to the TSS address", func(t *testing.T) {
+		vouts, params := batchVouts(t)
+		vouts[4].ScriptPubKey.Hex = "0014ba8be635673034d4d0ddc9447409b594385ec4aa"
+		err := ob.checkTSSVout(params, vouts, 147, 149)
+		require.ErrorContains(t, err, "not match TSS address")
+	})
+}
+
+func TestOutboundNonceRange(t *testing.T) {
+	// the archived outbound raw result file and cctx file
+	// https://blockstream.info/tx/030cd813443f7b70cc6d8a544d320c6d8465e4528fc0f3410b599dc0b26753a0
+	chainID := chains.BitcoinMainnet.ChainId
+	nonce := uint64(148)
+
+	// priorTx returns a prior outbound with the nonce-mark of the given nonce
+	priorTx := func(nonce uint64) *btcutil.Tx {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		tx.AddTxOut(wire.NewTxOut(chains.NonceMarkAmount(nonce), nil))
+		return btcutil.NewTx(tx)
+	}
+
+	tests := []struct {
+		name          string
+		lastNonce     uint64
+		priorTx       *btcutil.Tx
+		first         uint64
+		last          uint64
+		errorContains string
+	}{
+		{
+			name:      "should return the nonce of a single outbound",
+			lastNonce: nonce,
+			priorTx:   priorTx(nonce - 1),
+			first:     nonce,
+			last:      nonce,
+		},
+		{
+			name:      "should return the nonces of a batched outbound",
+			lastNonce: nonce + 2,
+			priorTx:   priorTx(nonce - 1),
+			first:     nonce,
+			last:      nonce + 2,
+		},
+		{
+			name:      "should return nonce 0 without prior nonce-mark",
+			lastNonce: 0,
+			first:     0,
+			last:      0,
+		},
+		{
+			name:          "should fail if the batch is too large",
+			lastNonce:     nonce + bitcoin.MaxOutboundBatchSize,
+			priorTx:       priorTx(nonce - 1),
+			errorContains: "invalid nonce range",
+		},
+		{
+			name:          "should fail if the prior nonce-mark is not lower",
+			lastNonce:     nonce,
+			priorTx:       priorTx(nonce),
+			errorContains: "invalid nonce range",
+		},
+		{
+			name:          "should fail if the prior nonce-mark is not found",
+			lastNonce:     nonce,
+			errorContains: "error getting prior nonce-mark tx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ob := MockBTCObserverMainnet(t)
+			client := mocks.NewMockBTCRPCClient()
+			if tt.priorTx != nil {
+				client.WithRawTransaction(tt.priorTx)
+			}
+			ob.btcClient = client
+
+			rawResult, _ := testutils.LoadBTCTxRawResultNCctx(t, TestDataDir, chainID, nonce)
+			rawResult.Vout[0].Value = float64(chains.NonceMarkAmount(tt.lastNonce)) * 1e-8
+
+			first, last, err := ob.outboundNonceRange(rawResult)
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.first, first)
+			require.Equal(t, tt.last, last)
+		})
+	}
+}
+
 func TestCheckTSSVoutCancelled(t *testing.T) {
 	// the archived outbound raw result file and cctx file
 	// https://blockstream.info/tx/030cd813443f7b70cc6d8a544d320c6d8465e4528fc0f3410b599dc0b26753a0
@@ -0,0 +1,231 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	zetarpc "github.com/zeta-chain/node/pkg/rpc"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	"github.com/zeta-chain/node/zetaclient/compliance"
+)
+
+// Payment is the payment of a CCTX to its receiver
+type Payment struct {
+	To     btcutil.Address
+	Amount float64
+}
+
+// OutboundBatch is a contiguous range of pending nonces paid by a single outbound
+type OutboundBatch struct {
+	// FirstNonce is the nonce following the prior nonce-mark spent by the outbound
+	FirstNonce uint64
+
+	// LastNonce is the nonce marked by the nonce-mark of the outbound
+	LastNonce uint64
+
+	// Payments are the payments of the CCTXs in nonce order, it's empty for a cancelled CCTX
+	Payments []Payment
+
+	// GasPrice is the highest gas price of the CCTXs in sat/vB
+	GasPrice *big.Int
+
+	// SizeLimit is the sum of the gas limits of the CCTXs in vB
+	SizeLimit uint64
+}
+
+// NewOutboundBatch creates a batch starting with the given CCTX, the payment is nil for a cancelled CCTX
+func NewOutboundBatch(params *types.OutboundParams, payment *Payment, gasPrice *big.Int) *OutboundBatch {
+	batch := &OutboundBatch{
+		FirstNonce: params.TssNonce,
+		LastNonce:  params.TssNonce,
+		GasPrice:   gasPrice,
+		SizeLimit:  params.GasLimit,
+	}
+	if payment != nil {
+		batch.Payments = append(batch.Payments, *payment)
+	}
+	return batch
+}
+
+// Add appends the CCTX with the next nonce to the batch
+func (b *OutboundBatch) Add(params *types.OutboundParams, payment Payment, gasPrice *big.Int) {
+	b.LastNonce = params.TssNonce
+	b.Payments = append(b.Payments, payment)
+	b.SizeLimit += params.GasLimit
+	if gasPrice.Cmp(b.GasPrice) > 0 {
+		b.GasPrice = gasPrice
+	}
+}
+
+// Payees returns the receivers of the payments
+func (b *OutboundBatch) Payees() []btcutil.Address {
+	payees := make([]btcutil.Address, 0, len(b.Payments))
+	for _, payment := range b.Payments {
+		payees = append(payees, payment.To)
+	}
+	return payees
+}
+
+// Amount returns the total amount of the payments in BTC
+func (b *OutboundBatch) Amount() float64 {
+	amount := 0.0
+	for _, payment := range b.Payments {
+		amount += payment.Amount
+	}
+	return amount
+}
+
+// Size returns the number of CCTXs in the batch
+func (b *OutboundBatch) Size() uint64 {
+	return b.LastNonce - b.FirstNonce + 1
+}
+
+// batchOutbound appends the pending CCTXs following the first CCTX of the batch until one can't be paid along.
+// Nonce 0 and cancelled CCTXs are never batched as the observer can't tell their nonce range apart.
+//
+// Note: all TSS signers must build the same batch to agree on the keysign, so the pending CCTXs are read from the
+// zetacore state at the keysign height rather than the latest state. A query error aborts the outbound instead of
+// signing a shorter batch
+func (signer *Signer) batchOutbound(
+	ctx context.Context,
+	batch *OutboundBatch,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
+	logger zerolog.Logger,
+) error {
+	if batch.FirstNonce == 0 || len(batch.Payments) == 0 {
+		return nil
+	}
+
+	chainID := signer.Chain().ChainId
+	ctx = zetarpc.ContextWithHeight(ctx, height)
+
+	// only the nonces assigned at the keysign height are batched
+	pendingNonces, err := zetacoreClient.GetPendingNoncesByChain(ctx, chainID)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get pending nonces at height %d", height)
+	}
+	// #nosec G115 always positive
+	endNonce := min(batch.FirstNonce+bitcoin.MaxOutboundBatchSize, uint64(pendingNonces.NonceHigh))
+
+	for nonce := batch.FirstNonce + 1; nonce < endNonce; nonce++ {
+		cctx, err := zetacoreClient.GetCctxByNonce(ctx, chainID, nonce)
+		if err != nil {
+			return errors.Wrapf(err, "unable to get cctx of nonce %d at height %d", nonce, height)
+		}
+
+		params := cctx.GetCurrentOutboundParam()
+		switch {
+		case cctx.CctxStatus.Status != types.CctxStatus_PendingOutbound,
+			params.TssNonce != nonce,
+			params.ReceiverChainId != chainID,
+			cctx.InboundParams.CoinType != coin.CoinType_Gas,
+			compliance.IsCctxRestricted(cctx):
+			return nil
+		}
+
+		payment, err := outboundPayment(params)
+		if err != nil {
+			logger.Warn().Err(err).Uint64("batch.nonce", nonce).Msg("unable to batch outbound")
+			return nil
+		}
+		gasPrice, ok := new(big.Int).SetString(params.GasPrice, 10)
+		if !ok || gasPrice.Sign() < 0 {
+			logger.Warn().Uint64("batch.nonce", nonce).Msgf("unable to batch outbound: gas price %s", params.GasPrice)
+			return nil
+		}
+
+		batch.Add(params, payment, gasPrice)
+	}
+
+	return nil
+}
+
+// outboundPayment returns the payment of the outbound to the receiver
+func outboundPayment(params *types.OutboundParams) (Payment, error) {
+	to, err := chains.DecodeBtcAddress(params.Receiver, params.ReceiverChainId)
+	if err != nil {
+		return Payment{}, errors.Wrapf(err, "cannot decode address %s", params.Receiver)
+	}
+	if !chains.IsBtcAddressSupported(to) {
+		return Payment{}, fmt.Errorf("unsupported address %s", params.Receiver)
+	}
+
+	return Payment{
+		To:     to,
+		Amount: float64(params.Amount.Uint64()) / 1e8,
+	}, nil
+}
+
+// AddBatchWithdrawTxOutputs adds the outputs of the batch to the withdraw tx
+// 1st output: the nonce-mark btc of the last nonce to TSS itself
+// 2nd to (N+1)th outputs: the payments to the recipients in nonce order
+// last output: the remaining btc to TSS itself
+func (signer *Signer) AddBatchWithdrawTxOutputs(
+	tx *wire.MsgTx,
+	payments []Payment,
+	total float64,
+	nonceMark int64,
+	fees *big.Int,
+) error {
+	// convert payment amounts to satoshis
+	remainingSats, err := bitcoin.GetSatoshis(total)
+	if err != nil {
+		return err
+	}
+	paymentOuts := make([]*wire.TxOut, 0, len(payments))
+	for _, payment := range payments {
+		amountSatoshis, err := bitcoin.GetSatoshis(payment.Amount)
+		if err != nil {
+			return err
+		}
+		pkScript, err := bitcoin.PayToAddrScript(payment.To)
+		if err != nil {
+			return err
+		}
+		paymentOuts = append(paymentOuts, wire.NewTxOut(amountSatoshis, pkScript))
+		remainingSats -= amountSatoshis
+	}
+	if remainingSats < 0 {
+		return fmt.Errorf("total value %v is less than payments", total)
+	}
+
+	// calculate remaining btc (the change) to TSS self
+	remainingSats -= fees.Int64()
+	remainingSats -= nonceMark
+	if remainingSats < 0 {
+		return fmt.Errorf("remainder value is negative: %d", remainingSats)
+	} else if remainingSats == nonceMark {
+		signer.Logger().Std.Info().Msgf("adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
+		remainingSats--
+	}
+
+	// 1st output: the nonce-mark btc to TSS self
+	tssAddrP2WPKH := signer.TSS().BTCAddressWitnessPubkeyHash()
+	payToSelfScript, err := bitcoin.PayToAddrScript(tssAddrP2WPKH)
+	if err != nil {
+		return err
+	}
+	tx.AddTxOut(wire.NewTxOut(nonceMark, payToSelfScript))
+
+	// 2nd to (N+1)th outputs: the payments to the recipients
+	for _, txOut := range paymentOuts {
+		tx.AddTxOut(txOut)
+	}
+
+	// last output: the remaining btc to TSS self
+	if remainingSats > 0 {
+		tx.AddTxOut(wire.NewTxOut(remainingSats, payToSelfScript))
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/wire"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/pkg/coin"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/chains/bitcoin"
+	"github.com/zeta-chain/node/zetaclient/config"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+// makeBatchCctx creates a pending bitcoin withdraw cctx with the given nonce
+func makeBatchCctx(nonce uint64, receiver string, amount uint64, gasPrice string) *types.CrossChainTx {
+	return &types.CrossChainTx{
+		CctxStatus: &types.Status{Status: types.CctxStatus_PendingOutbound},
+		InboundParams: &types.InboundParams{
+			CoinType: coin.CoinType_Gas,
+		},
+		OutboundParams: []*types.OutboundParams{{
+			Receiver:        receiver,
+			ReceiverChainId: chains.BitcoinMainnet.ChainId,
+			Amount:          sdkmath.NewUint(amount),
+			TssNonce:        nonce,
+			GasPrice:        gasPrice,
+			GasLimit:        254,
+		}},
+	}
+}
+
+func TestAddBatchWithdrawTxOutputs(t *testing.T) {
+	signer, err := NewSigner(chains.Chain{}, mocks.NewTSSMainnet(), nil, base.DefaultLogger(), config.BTCConfig{})
+	require.NoError(t, err)
+
+	// tss address and script
+	tssScript, err := bitcoin.PayToAddrScript(signer.TSS().BTCAddressWitnessPubkeyHash())
+	require.NoError(t, err)
+
+	// receiver addresses
+	to1, err := chains.DecodeBtcAddress("bc1qaxf82vyzy8y80v000e7t64gpten7gawewzu42y", chains.BitcoinMainnet.ChainId)
+	require.NoError(t, err)
+	to1Script, err := bitcoin.PayToAddrScript(to1)
+	require.NoError(t, err)
+	to2, err := chains.DecodeBtcAddress("bc1qh297vdt8xq6df5xae9z8gzd4jsu9a392mp0dus", chains.BitcoinMainnet.ChainId)
+	require.NoError(t, err)
+	to2Script, err := bitcoin.PayToAddrScript(to2)
+	require.NoError(t, err)
+
+	payments := []Payment{{To: to1, Amount: 0.2}, {To: to2, Amount: 0.3}}
+
+	t.Run("should add outputs of the batch in order", func(t *testing.T) {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		err := signer.AddBatchWithdrawTxOutputs(tx, payments, 1.00012000, 10002, big.NewInt(2000))
+		require.NoError(t, err)
+		require.Equal(t, []*wire.TxOut{
+			{Value: 10002, PkScript: tssScript},
+			{Value: 20000000, PkScript: to1Script},
+			{Value: 30000000, PkScript: to2Script},
+			{Value: 49999998, PkScript: tssScript},
+		}, tx.TxOut)
+	})
+
+	t.Run("should fail when total < payments", func(t *testing.T) {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		err := signer.AddBatchWithdrawTxOutputs(tx, payments, 0.4, 10002, big.NewInt(2000))
+		require.ErrorContains(t, err, "is less than payments")
+	})
+
+	t.Run("should fail when total < fees + payments + nonce", func(t *testing.T) {
+		tx := wire.NewMsgTx(wire.TxVersion)
+		err := signer.AddBatchWithdrawTxOutputs(tx, payments, 0.50011000, 10002, big.NewInt(2000))
+		require.ErrorContains(t, err, "remainder value is negative")
+	})
+}
+
+func TestSigner_batchOutbound(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+	chainID := chains.BitcoinMainnet.ChainId
+	receiver := "bc1qaxf82vyzy8y80v000e7t64gpten7gawewzu42y"
+	height := uint64(1000)
+
+	// atHeight matches the contexts querying the zetacore state at the keysign height
+	atHeight := func(ctx context.Context) bool {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		return ok && slices.Equal(md.Get(grpctypes.GRPCBlockHeightHeader), []string{"1000"})
+	}
+
+	signer, err := NewSigner(
+		chains.BitcoinMainnet,
+		mocks.NewTSSMainnet(),
+		nil,
+		base.DefaultLogger(),
+		config.BTCConfig{},
+	)
+	require.NoError(t, err)
+
+	newBatch := func(t *testing.T, nonce uint64) *OutboundBatch {
+		params := makeBatchCctx(nonce, receiver, 10000, "10").GetCurrentOutboundParam()
+		payment, err := outboundPayment(params)
+		require.NoError(t, err)
+		return NewOutboundBatch(params, &payment, big.NewInt(10))
+	}
+
+	// newClient returns a zetacore client with the pending nonces at the keysign height
+	newClient := func(t *testing.T, nonceHigh int64) *mocks.ZetacoreClient {
+		client := mocks.NewZetacoreClient(t)
+		client.On("GetPendingNoncesByChain", mock.MatchedBy(atHeight), chainID).
+			Return(observertypes.PendingNonces{NonceLow: 148, NonceHigh: nonceHigh, ChainId: chainID}, nil)
+		return client
+	}
+
+	t.Run("should batch the following pending cctxs", func(t *testing.T) {
+		client := newClient(t, 151)
+		client.On("GetCctxByNonce", mock.MatchedBy(atHeight), chainID, uint64(149)).
+			Return(makeBatchCctx(149, receiver, 20000, "12"), nil)
+		client.On("GetCctxByNonce", mock.MatchedBy(atHeight), chainID, uint64(150)).
+			Return(makeBatchCctx(150, receiver, 30000, "11"), nil)
+
+		batch := newBatch(t, 148)
+		require.NoError(t, signer.batchOutbound(ctx, batch, client, height, logger))
+
+		require.EqualValues(t, 148, batch.FirstNonce)
+		require.EqualValues(t, 150, batch.LastNonce)
+		require.EqualValues(t, 3, batch.Size())
+		require.Len(t, batch.Payees(), 3)
+		require.InDelta(t, 0.0006, batch.Amount(), 1e-12)
+		require.EqualValues(t, 12, batch.GasPrice.Int64())
+		require.EqualValues(t, 3*254, batch.SizeLimit)
+	})
+
+	t.Run("should stop at a cctx not pending outbound", func(t *testing.T) {
+		mined := makeBatchCctx(150, receiver, 30000, "11")
+		mined.CctxStatus.Status = types.CctxStatus_OutboundMined
+
+		client := newClient(t, 160)
+		client.On("GetCctxByNonce", mock.Anything, chainID, uint64(149)).
+			Return(makeBatchCctx(149, receiver, 20000, "12"), nil)
+		client.On("GetCctxByNonce", mock.Anything, chainID, uint64(150)).Return(mined, nil)
+
+		batch := newBatch(t, 148)
+		require.NoError(t, signer.batchOutbound(ctx, batch, client, height, logger))
+
+		require.EqualValues(t, 149, batch.LastNonce)
+	})
+
+	t.Run("should stop at max batch size", func(t *testing.T) {
+		client := newClient(t, 1000)
+		client.On("GetCctxByNonce", mock.Anything, chainID, mock.Anything).
+			Return(func(_ context.Context, _ int64, nonce uint64) (*types.CrossChainTx, error) {
+				return makeBatchCctx(nonce, receiver, 20000, "10"), nil
+			})
+
+		batch := newBatch(t, 148)
+		require.NoError(t, signer.batchOutbound(ctx, batch, client, height, logger))
+
+		require.EqualValues(t, bitcoin.MaxOutboundBatchSize, batch.Size())
+		require.Len(t, batch.Payments, bitcoin.MaxOutboundBatchSize)
+	})
+
+	t.Run("should fail if a pending cctx can't be queried", func(t *testing.T) {
+		client := newClient(t, 151)
+		client.On("GetCctxByNonce", mock.Anything, chainID, uint64(149)).
+			Return(makeBatchCctx(149, receiver, 20000, "12"), nil)
+		client.On("GetCctxByNonce", mock.Anything, chainID, uint64(150)).Return(nil, errors.New("rpc error"))
+
+		err := signer.batchOutbound(ctx, newBatch(t, 148), client, height, logger)
+		require.ErrorContains(t, err, "unable to get cctx of nonce 150")
+	})
+
+	t.Run("should fail if the pending nonces can't be queried", func(t *testing.T) {
+		client := mocks.NewZetacoreClient(t)
+		client.On("GetPendingNoncesByChain", mock.Anything, chainID).
+			Return(observertypes.PendingNonces{}, errors.New("rpc error"))
+
+		err := signer.batchOutbound(ctx, newBatch(t, 148), client, height, logger)
+		require.ErrorContains(t, err, "unable to get pending nonces")
+	})
+
+	t.Run("should not batch nonce 0", func(t *testing.T) {
+		batch := newBatch(t, 0)
+		require.NoError(t, signer.batchOutbound(ctx, batch, mocks.NewZetacoreClient(t), height, logger))
+
+		require.EqualValues(t, 0, batch.LastNonce)
+	})
+
+	t.Run("should not batch a cancelled cctx", func(t *testing.T) {
+		params := makeBatchCctx(148, receiver, 10000, "10").GetCurrentOutboundParam()
+		batch := NewOutboundBatch(params, nil, big.NewInt(10))
+		require.NoError(t, signer.batchOutbound(ctx, batch, mocks.NewZetacoreClient(t), height, logger))
+
+		require.EqualValues(t, 148, batch.LastNonce)
+		require.Empty(t, batch.Payments)
+	})
+}
@@ -131,73 +131,31 @@ func (signer *Signer) AddWithdrawTxOutputs(
 	fees *big.Int,
 	cancelTx bool,
 ) error {
-	// convert withdraw amount to satoshis
-	amountSatoshis, err := bitcoin.GetSatoshis(amount)
-	if err != nil {
-		return err
-	}
-
-	// calculate remaining btc (the change) to TSS self
-	remaining := total - amount
-	remainingSats, err := bitcoin.GetSatoshis(remaining)
-	if err != nil {
-		return err
-	}
-	remainingSats -= fees.Int64()
-	remainingSats -= nonceMark
-	if remainingSats < 0 {
-		return fmt.Errorf("remainder value is negative: %d", remainingSats)
-	} else if remainingSats == nonceMark {
-		signer.Logger().Std.Info().Msgf("adjust remainder value to avoid duplicate nonce-mark: %d", remainingSats)
-		remainingSats--
-	}
-
-	// 1st output: the nonce-mark btc to TSS self
-	tssAddrP2WPKH := signer.TSS().BTCAddressWitnessPubkeyHash()
-	payToSelfScript, err := bitcoin.PayToAddrScript(tssAddrP2WPKH)
-	if err != nil {
-		return err
-	}
-	txOut1 := wire.NewTxOut(nonceMark, payToSelfScript)
-	tx.AddTxOut(txOut1)
-
-	// 2nd output: the payment to the recipient
+	// send the amount to TSS self if tx is cancelled
+	var payments []Payment
 	if !cancelTx {
-		pkScript, err := bitcoin.PayToAddrScript(to)
-		if err != nil {
-			return err
-		}
-		txOut2 := wire.NewTxOut(amountSatoshis, pkScript)
-		tx.AddTxOut(txOut2)
-	} else {
-		// send the amount to TSS self if tx is cancelled
-		remainingSats += amountSatoshis
-	}
-
-	// 3rd output: the remaining btc to TSS self
-	if remainingSats > 0 {
-		txOut3 := wire.NewTxOut(remainingSats, payToSelfScript)
-		tx.AddTxOut(txOut3)
+		payments = []Payment{{To: to, Amount: amount}}
 	}
-	return nil
+	return signer.AddBatchWithdrawTxOutputs(tx, payments, total, nonceMark, fees)
 }
 
-// SignWithdrawTx receives utxos sorted by value, amount in BTC, feeRate in BTC per Kb
+// SignWithdrawTx signs the outbound paying the batch, receives utxos sorted by value, gas price in sat/vB
 // TODO(revamp): simplify the function
 func (signer *Signer) SignWithdrawTx(
 	ctx context.Context,
-	to btcutil.Address,
-	amount float64,
-	gasPrice *big.Int,
-	sizeLimit uint64,
+	batch *OutboundBatch,
 	observer *observer.Observer,
 	height uint64,
-	nonce uint64,
 	chain chains.Chain,
-	cancelTx bool,
 ) (*wire.MsgTx, error) {
-	estimateFee := float64(gasPrice.Uint64()*bitcoin.OutboundBytesMax) / 1e8
-	nonceMark := chains.NonceMarkAmount(nonce)
+	gasPrice := batch.GasPrice
+	sizeLimit := batch.SizeLimit
+	nonce := batch.FirstNonce
+	payees := batch.Payees()
+	// #nosec G115 always positive
+	outboundBytesMax := bitcoin.OutboundSizeMax(uint64(len(payees)))
+	estimateFee := float64(gasPrice.Uint64()*outboundBytesMax) / 1e8
+	nonceMark := chains.NonceMarkAmount(batch.LastNonce)
 
 	// refresh unspent UTXOs and continue with keysign regardless of error
 	err := observer.FetchUTXOs(ctx)
@@ -211,7 +169,7 @@ func (signer *Signer) SignWithdrawTx(
 	// select N UTXOs to cover the total expense
 	prevOuts, total, consolidatedUtxo, consolidatedValue, err := observer.SelectUTXOs(
 		ctx,
-		amount+estimateFee+float64(nonceMark)*1e-8,
+		batch.Amount()+estimateFee+float64(nonceMark)*1e-8,
 		MaxNoOfInputsPerTx,
 		nonce,
 		consolidationRank,
@@ -235,7 +193,7 @@ func (signer *Signer) SignWithdrawTx(
 
 	// size checking
 	// #nosec G115 always positive
-	txSize, err := bitcoin.EstimateOutboundSize(uint64(len(prevOuts)), []btcutil.Address{to})
+	txSize, err := bitcoin.EstimateOutboundSize(uint64(len(prevOuts)), payees)
 	if err != nil {
 		return nil, err
 	}
@@ -248,10 +206,10 @@ func (signer *Signer) SignWithdrawTx(
 			Msgf("txSize %d is less than outboundBytesMin %d; use outboundBytesMin", txSize, bitcoin.OutboundBytesMin)
 		txSize = bitcoin.OutboundBytesMin
 	}
-	if txSize > bitcoin.OutboundBytesMax { // in case of accident
+	if txSize > outboundBytesMax { // in case of accident
 		signer.Logger().Std.Warn().
-			Msgf("txSize %d is greater than outboundBytesMax %d; use outboundBytesMax", txSize, bitcoin.OutboundBytesMax)
-		txSize = bitcoin.OutboundBytesMax
+			Msgf("txSize %d is greater than outboundBytesMax %d; use outboundBytesMax", txSize, outboundBytesMax)
+		txSize = outboundBytesMax
 	}
 
 	// fee calculation
@@ -259,11 +217,11 @@ func (signer *Signer) SignWithdrawTx(
 	fees := new(big.Int).Mul(big.NewInt(int64(txSize)), gasPrice)
 	signer.Logger().
 		Std.Info().
-		Msgf("bitcoin outbound nonce %d gasPrice %s size %d fees %s consolidated %d utxos of value %v",
-			nonce, gasPrice.String(), txSize, fees.String(), consolidatedUtxo, consolidatedValue)
+		Msgf("bitcoin outbound nonces %d to %d gasPrice %s size %d fees %s consolidated %d utxos of value %v",
+			nonce, batch.LastNonce, gasPrice.String(), txSize, fees.String(), consolidatedUtxo, consolidatedValue)
 
 	// add tx outputs
-	err = signer.AddWithdrawTxOutputs(tx, to, total, amount, nonceMark, fees, cancelTx)
+	err = signer.AddBatchWithdrawTxOutputs(tx, batch.Payments, total, nonceMark, fees)
 	if err != nil {
 		return nil, err
 	}
@@ -380,8 +338,7 @@ func (signer *Signer) TryProcessOutbound(
 		return
 	}
 
-	// get size limit and gas price
-	sizelimit := params.GasLimit
+	// get gas price
 	gasprice, ok := new(big.Int).SetString(params.GasPrice, 10)
 	if !ok || gasprice.Cmp(big.NewInt(0)) < 0 {
 		logger.Error().Msgf("cannot convert gas price  %s ", params.GasPrice)
@@ -389,16 +346,29 @@ func (signer *Signer) TryProcessOutbound(
 	}
 
 	// Check receiver P2WPKH address
-	to, err := chains.DecodeBtcAddress(params.Receiver, params.ReceiverChainId)
+	payment, err := outboundPayment(params)
 	if err != nil {
-		logger.Error().Err(err).Msgf("cannot decode address %s ", params.Receiver)
+		logger.Error().Err(err).Msg("cannot get outbound payment")
 		return
 	}
-	if !chains.IsBtcAddressSupported(to) {
-		logger.Error().Msgf("unsupported address %s", params.Receiver)
-		return
+
+	// compliance check
+	var batch *OutboundBatch
+	cancelTx := compliance.IsCctxRestricted(cctx)
+	if cancelTx {
+		compliance.PrintComplianceLog(logger, signer.Logger().Compliance,
+			true, chain.ChainId, cctx.Index, cctx.InboundParams.Sender, params.Receiver, "BTC")
+		batch = NewOutboundBatch(params, nil, gasprice) // no payment to cancel the tx
+	} else {
+		batch = NewOutboundBatch(params, &payment, gasprice)
+		if err := signer.batchOutbound(ctx, batch, zetacoreClient, height, logger); err != nil {
+			logger.Error().Err(err).Msg("cannot batch outbound")
+			return
+		}
 	}
-	amount := float64(params.Amount.Uint64()) / 1e8
+	logger.Info().
+		Msgf("SignGasWithdraw: to %s, value %d sats, batch of nonces %d to %d",
+			payment.To.EncodeAddress(), params.Amount.Uint64(), batch.FirstNonce, batch.LastNonce)
 
 	// Add 1 satoshi/byte to gasPrice to avoid minRelayTxFee issue
 	networkInfo, err := signer.client.GetNetworkInfo()
@@ -407,30 +377,10 @@ func (signer *Signer) TryProcessOutbound(
 		return
 	}
 	satPerByte := bitcoin.FeeRateToSatPerByte(networkInfo.RelayFee)
-	gasprice.Add(gasprice, satPerByte)
-
-	// compliance check
-	cancelTx := compliance.IsCctxRestricted(cctx)
-	if cancelTx {
-		compliance.PrintComplianceLog(logger, signer.Logger().Compliance,
-			true, chain.ChainId, cctx.Index, cctx.InboundParams.Sender, params.Receiver, "BTC")
-		amount = 0.0 // zero out the amount to cancel the tx
-	}
-	logger.Info().Msgf("SignGasWithdraw: to %s, value %d sats", to.EncodeAddress(), params.Amount.Uint64())
+	batch.GasPrice = new(big.Int).Add(batch.GasPrice, satPerByte)
 
 	// sign withdraw tx
-	tx, err := signer.SignWithdrawTx(
-		ctx,
-		to,
-		amount,
-		gasprice,
-		sizelimit,
-		btcObserver,
-		height,
-		outboundTssNonce,
-		chain,
-		cancelTx,
-	)
+	tx, err := signer.SignWithdrawTx(ctx, batch, btcObserver, height, chain)
 	if err != nil {
 		logger.Warn().
 			Err(err).
@@ -466,23 +416,27 @@ func (signer *Signer) TryProcessOutbound(
 			}
 			logger.Info().
 				Msgf("Broadcast success: nonce %d to chain %s outboundHash %s", outboundTssNonce, chain.String(), outboundHash)
-			zetaHash, err := zetacoreClient.AddOutboundTracker(
-				ctx,
-				chain.ChainId,
-				outboundTssNonce,
-				outboundHash,
-				nil,
-				"",
-				-1,
-			)
-			if err != nil {
-				logger.Err(err).
-					Msgf("Unable to add to tracker on zetacore: nonce %d chain %s outboundHash %s", outboundTssNonce, chain.Name, outboundHash)
-			}
-			logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
 
-			// Save successfully broadcasted transaction to btc chain observer
-			btcObserver.SaveBroadcastedTx(outboundHash, outboundTssNonce)
+			// every nonce of the batch is paid by the same outbound
+			for nonce := batch.FirstNonce; nonce <= batch.LastNonce; nonce++ {
+				zetaHash, err := zetacoreClient.AddOutboundTracker(
+					ctx,
+					chain.ChainId,
+					nonce,
+					outboundHash,
+					nil,
+					"",
+					-1,
+				)
+				if err != nil {
+					logger.Err(err).
+						Msgf("Unable to add to tracker on zetacore: nonce %d chain %s outboundHash %s", nonce, chain.Name, outboundHash)
+				}
+				logger.Info().Msgf("Broadcast to core successful %s", zetaHash)
+
+				// Save successfully broadcasted transaction to btc chain observer
+				btcObserver.SaveBroadcastedTx(outboundHash, nonce)
+			}
 
 			break // successful broadcast; no need to retry
 		}
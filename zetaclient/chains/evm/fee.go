@@ -0,0 +1,98 @@
+package evm
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum"
+
+	"github.com/zeta-chain/node/pkg/chains"
+)
+
+// FeeHistoryBlocks is the number of blocks of fee history used to compute the priority fee
+const FeeHistoryBlocks = 10
+
+// FeeStrategy describes how the fees of the EVM outbounds of a chain are computed.
+// The observers vote the priority fee computed from the latest fee history. The signers compute the outbound fees
+// from the fee history ending at the block of the median gas price vote, so all TSS signers sign the same transaction
+type FeeStrategy struct {
+	// Legacy signs pre EIP-1559 transactions with the gas price of the CCTX.
+	// It is meant for chains ordering transactions by gas price without a base fee market, such as BSC
+	Legacy bool
+
+	// RewardPercentile is the percentile of the priority fees paid in the recent blocks
+	RewardPercentile float64
+
+	// BaseFeeMultiplier keeps the transaction includable if the base fee rises after signing
+	BaseFeeMultiplier int64
+}
+
+var (
+	// defaultFeeStrategy is the fee strategy of the chains without override
+	defaultFeeStrategy = FeeStrategy{
+		RewardPercentile:  50,
+		BaseFeeMultiplier: 2,
+	}
+
+	// feeStrategies are the per-chain overrides of the default fee strategy.
+	// The L1 data fee of the OP-stack chains is charged on top of the L2 gas fee, outside the max fee of the
+	// transaction, so these chains use the default strategy
+	feeStrategies = map[int64]FeeStrategy{
+		chains.BscMainnet.ChainId: {Legacy: true},
+		chains.BscTestnet.ChainId: {Legacy: true},
+	}
+)
+
+// FeeStrategyForChain returns the fee strategy of the chain
+func FeeStrategyForChain(chainID int64) FeeStrategy {
+	if strategy, ok := feeStrategies[chainID]; ok {
+		return strategy
+	}
+	return defaultFeeStrategy
+}
+
+// PriorityFeeFromRewards returns the median of the priority fees paid in the blocks of a fee history,
+// the blocks without reward are skipped
+func (s FeeStrategy) PriorityFeeFromRewards(rewards [][]*big.Int) *big.Int {
+	fees := make([]*big.Int, 0, len(rewards))
+	for _, reward := range rewards {
+		if len(reward) > 0 && reward[0] != nil {
+			fees = append(fees, reward[0])
+		}
+	}
+	if len(fees) == 0 {
+		return big.NewInt(0)
+	}
+
+	sort.Slice(fees, func(i, j int) bool { return fees[i].Cmp(fees[j]) < 0 })
+
+	return new(big.Int).Set(fees[len(fees)/2])
+}
+
+// FeesFromHistory returns the max fee and the priority fee of an EIP-1559 outbound from a fee history,
+// bounded by the gas price of the CCTX
+func (s FeeStrategy) FeesFromHistory(history *ethereum.FeeHistory, cctxGasPrice *big.Int) (*big.Int, *big.Int, error) {
+	if history == nil || len(history.BaseFee) == 0 {
+		return nil, nil, fmt.Errorf("empty fee history")
+	}
+
+	// the last base fee is the one of the block following the last block of the history
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+	if nextBaseFee == nil {
+		return nil, nil, fmt.Errorf("nil base fee")
+	}
+
+	// maxFee = nextBaseFee * multiplier + priorityFee, the outbound doesn't pay more than the CCTX paid for
+	priorityFee := s.PriorityFeeFromRewards(history.Reward)
+	maxFee := new(big.Int).Mul(nextBaseFee, big.NewInt(s.BaseFeeMultiplier))
+	maxFee.Add(maxFee, priorityFee)
+	if maxFee.Cmp(cctxGasPrice) > 0 {
+		maxFee = new(big.Int).Set(cctxGasPrice)
+	}
+	if priorityFee.Cmp(maxFee) > 0 {
+		priorityFee = new(big.Int).Set(maxFee)
+	}
+
+	return maxFee, priorityFee, nil
+}
@@ -0,0 +1,99 @@
+package evm_test
+
+import (
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/zetaclient/chains/evm"
+)
+
+func TestFeeStrategyForChain(t *testing.T) {
+	require.True(t, evm.FeeStrategyForChain(chains.BscMainnet.ChainId).Legacy)
+	require.False(t, evm.FeeStrategyForChain(chains.Ethereum.ChainId).Legacy)
+	require.Equal(t, evm.FeeStrategyForChain(chains.Ethereum.ChainId), evm.FeeStrategyForChain(chains.BaseMainnet.ChainId))
+}
+
+func TestFeeStrategy_PriorityFeeFromRewards(t *testing.T) {
+	strategy := evm.FeeStrategyForChain(chains.Ethereum.ChainId)
+
+	t.Run("should return the median reward", func(t *testing.T) {
+		rewards := [][]*big.Int{{big.NewInt(1)}, {big.NewInt(3)}, {}, {big.NewInt(2)}, {nil}}
+		require.EqualValues(t, 2, strategy.PriorityFeeFromRewards(rewards).Int64())
+	})
+
+	t.Run("should return zero without rewards", func(t *testing.T) {
+		require.EqualValues(t, 0, strategy.PriorityFeeFromRewards(nil).Int64())
+	})
+}
+
+func TestFeeStrategy_FeesFromHistory(t *testing.T) {
+	strategy := evm.FeeStrategy{RewardPercentile: 50, BaseFeeMultiplier: 2}
+
+	// history returns a fee history with the base fee of the next block and the rewards of the blocks
+	history := func(nextBaseFee int64, rewards ...int64) *ethereum.FeeHistory {
+		h := &ethereum.FeeHistory{BaseFee: []*big.Int{big.NewInt(1), big.NewInt(nextBaseFee)}}
+		for _, reward := range rewards {
+			h.Reward = append(h.Reward, []*big.Int{big.NewInt(reward)})
+		}
+		return h
+	}
+
+	for _, tt := range []struct {
+		name                string
+		history             *ethereum.FeeHistory
+		cctxGasPrice        int64
+		expectedMaxFee      int64
+		expectedPriorityFee int64
+		errorContains       string
+	}{
+		{
+			name:                "should compute the fees from the history",
+			history:             history(10, 1, 3, 2),
+			cctxGasPrice:        100,
+			expectedMaxFee:      22,
+			expectedPriorityFee: 2,
+		},
+		{
+			name:                "should cap the max fee to the CCTX gas price",
+			history:             history(10, 1, 3, 2),
+			cctxGasPrice:        15,
+			expectedMaxFee:      15,
+			expectedPriorityFee: 2,
+		},
+		{
+			name:                "should cap the priority fee to the max fee",
+			history:             history(10, 30),
+			cctxGasPrice:        20,
+			expectedMaxFee:      20,
+			expectedPriorityFee: 20,
+		},
+		{
+			name:          "should error on an empty history",
+			history:       &ethereum.FeeHistory{},
+			cctxGasPrice:  20,
+			errorContains: "empty fee history",
+		},
+		{
+			name:          "should error on a nil base fee",
+			history:       &ethereum.FeeHistory{BaseFee: []*big.Int{nil}},
+			cctxGasPrice:  20,
+			errorContains: "nil base fee",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			maxFee, priorityFee, err := strategy.FeesFromHistory(tt.history, big.NewInt(tt.cctxGasPrice))
+			if tt.errorContains != "" {
+				require.ErrorContains(t, err, tt.errorContains)
+				return
+			}
+
+			require.NoError(t, err)
+			require.EqualValues(t, tt.expectedMaxFee, maxFee.Int64())
+			require.EqualValues(t, tt.expectedPriorityFee, priorityFee.Int64())
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
+)
+
+// LastBlockConfirmed returns the last block confirmed by the safe or finalized block tag
+func (ob *Observer) LastBlockConfirmed() uint64 {
+	return atomic.LoadUint64(&ob.lastBlockConfirmed)
+}
+
+// WithLastBlockConfirmed sets the last block confirmed by the safe or finalized block tag
+func (ob *Observer) WithLastBlockConfirmed(blockNumber uint64) *Observer {
+	atomic.StoreUint64(&ob.lastBlockConfirmed, blockNumber)
+	return ob
+}
+
+// GetConfirmedBlockNumber returns the last confirmed block number of the chain given the latest block number
+//
+// For the SafeTag and FinalizedTag confirmation modes, it is the number of the safe or finalized head of the chain.
+// It falls back to counting ConfirmationCount blocks if the RPC doesn't support the block tags.
+func (ob *Observer) GetConfirmedBlockNumber(ctx context.Context, latestBlock uint64) (uint64, error) {
+	chainParams := ob.GetChainParams()
+
+	if tag := blockTagNumber(chainParams.ConfirmationMode); tag != nil {
+		header, err := ob.evmClient.HeaderByNumber(ctx, tag)
+		if err == nil {
+			// the tagged head can't be ahead of the latest block, but RPC nodes behind a load balancer might be
+			confirmed := min(header.Number.Uint64(), latestBlock)
+			ob.WithLastBlockConfirmed(confirmed)
+			return confirmed, nil
+		}
+
+		ob.WithLastBlockConfirmed(0)
+		ob.Logger().Chain.Warn().
+			Err(err).
+			Msgf("unable to get %s header for chain %d, falling back to confirmation count",
+				chainParams.ConfirmationMode, ob.Chain().ChainId)
+	}
+
+	if latestBlock < chainParams.ConfirmationCount {
+		return 0, fmt.Errorf(
+			"latest block number %d is lower than confirmation count %d",
+			latestBlock,
+			chainParams.ConfirmationCount,
+		)
+	}
+	return latestBlock - chainParams.ConfirmationCount, nil
+}
+
+// HasEnoughConfirmations checks if the given receipt has enough confirmations
+//
+// In the SafeTag and FinalizedTag confirmation modes, the receipt is confirmed once its block is reached by
+// the last confirmed block. It falls back to counting ConfirmationCount blocks from the given last height.
+func (ob *Observer) HasEnoughConfirmations(receipt *ethtypes.Receipt, lastHeight uint64) bool {
+	return ob.isBlockConfirmed(receipt.BlockNumber.Uint64(), lastHeight)
+}
+
+// isBlockConfirmed checks if the given block has enough confirmations given the last height
+func (ob *Observer) isBlockConfirmed(blockNumber uint64, lastHeight uint64) bool {
+	chainParams := ob.GetChainParams()
+	if blockTagNumber(chainParams.ConfirmationMode) != nil {
+		if confirmed := ob.LastBlockConfirmed(); confirmed > 0 {
+			return blockNumber <= confirmed
+		}
+	}
+
+	confHeight := blockNumber + chainParams.ConfirmationCount
+	return lastHeight >= confHeight
+}
+
+// blockTagNumber returns the block number of the block tag used by the confirmation mode, if any
+func blockTagNumber(mode observertypes.ConfirmationMode) *big.Int {
+	switch mode {
+	case observertypes.ConfirmationMode_SafeTag:
+		return rpc.SafeBlockNumber
+	case observertypes.ConfirmationMode_FinalizedTag:
+		return rpc.FinalizedBlockNumber
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,149 @@
+package observer_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	observertypes "github.com/zeta-chain/node/x/observer/types"
+	"github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_GetConfirmedBlockNumber(t *testing.T) {
+	ctx := context.Background()
+	chain := chains.Ethereum
+	confirmation := uint64(10)
+
+	tests := []struct {
+		name          string
+		mode          observertypes.ConfirmationMode
+		tag           *big.Int
+		tagHeader     *ethtypes.Header
+		tagErr        error
+		latestBlock   uint64
+		expected      uint64
+		expectedCache uint64
+		errMsg        string
+	}{
+		{
+			name:        "should count confirmations in fixed count mode",
+			mode:        observertypes.ConfirmationMode_FixedCount,
+			latestBlock: 100,
+			expected:    90,
+		},
+		{
+			name:        "should fail if latest block is lower than confirmation count",
+			mode:        observertypes.ConfirmationMode_FixedCount,
+			latestBlock: 5,
+			errMsg:      "lower than confirmation count",
+		},
+		{
+			name:          "should use safe head in safe tag mode",
+			mode:          observertypes.ConfirmationMode_SafeTag,
+			tag:           rpc.SafeBlockNumber,
+			tagHeader:     &ethtypes.Header{Number: big.NewInt(95)},
+			latestBlock:   100,
+			expected:      95,
+			expectedCache: 95,
+		},
+		{
+			name:          "should use finalized head in finalized tag mode",
+			mode:          observertypes.ConfirmationMode_FinalizedTag,
+			tag:           rpc.FinalizedBlockNumber,
+			tagHeader:     &ethtypes.Header{Number: big.NewInt(70)},
+			latestBlock:   100,
+			expected:      70,
+			expectedCache: 70,
+		},
+		{
+			name:          "should not exceed latest block",
+			mode:          observertypes.ConfirmationMode_FinalizedTag,
+			tag:           rpc.FinalizedBlockNumber,
+			tagHeader:     &ethtypes.Header{Number: big.NewInt(120)},
+			latestBlock:   100,
+			expected:      100,
+			expectedCache: 100,
+		},
+		{
+			name:        "should fall back to confirmation count if block tag not supported",
+			mode:        observertypes.ConfirmationMode_FinalizedTag,
+			tag:         rpc.FinalizedBlockNumber,
+			tagErr:      errors.New("invalid block number"),
+			latestBlock: 100,
+			expected:    90,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			evmClient := mocks.NewEVMRPCClient(t)
+			evmClient.On("BlockNumber", mock.Anything).Return(tt.latestBlock, nil)
+			if tt.tag != nil {
+				evmClient.On("HeaderByNumber", mock.Anything, tt.tag).Return(tt.tagHeader, tt.tagErr)
+			}
+
+			params := mocks.MockChainParams(chain.ChainId, confirmation)
+			params.ConfirmationMode = tt.mode
+			ob, _ := MockEVMObserver(t, chain, evmClient, nil, nil, nil, tt.latestBlock, params)
+			ob.WithLastBlockConfirmed(1)
+
+			// ACT
+			confirmed, err := ob.GetConfirmedBlockNumber(ctx, tt.latestBlock)
+
+			// ASSERT
+			if tt.errMsg != "" {
+				require.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, confirmed)
+			if tt.tag != nil {
+				require.Equal(t, tt.expectedCache, ob.LastBlockConfirmed())
+			}
+		})
+	}
+}
+
+func Test_HasEnoughConfirmations(t *testing.T) {
+	chain := chains.Ethereum
+	confirmation := uint64(10)
+	receipt := &ethtypes.Receipt{BlockNumber: big.NewInt(100)}
+
+	t.Run("should count confirmations in fixed count mode", func(t *testing.T) {
+		params := mocks.MockChainParams(chain.ChainId, confirmation)
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 110, params)
+		ob.WithLastBlockConfirmed(50)
+
+		require.True(t, ob.HasEnoughConfirmations(receipt, 110))
+		require.False(t, ob.HasEnoughConfirmations(receipt, 109))
+	})
+
+	t.Run("should use last confirmed block in block tag mode", func(t *testing.T) {
+		params := mocks.MockChainParams(chain.ChainId, confirmation)
+		params.ConfirmationMode = observertypes.ConfirmationMode_FinalizedTag
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 200, params)
+
+		ob.WithLastBlockConfirmed(99)
+		require.False(t, ob.HasEnoughConfirmations(receipt, 200))
+
+		ob.WithLastBlockConfirmed(100)
+		require.True(t, ob.HasEnoughConfirmations(receipt, 200))
+	})
+
+	t.Run("should count confirmations if last confirmed block is unknown", func(t *testing.T) {
+		params := mocks.MockChainParams(chain.ChainId, confirmation)
+		params.ConfirmationMode = observertypes.ConfirmationMode_SafeTag
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 110, params)
+
+		require.True(t, ob.HasEnoughConfirmations(receipt, 110))
+		require.False(t, ob.HasEnoughConfirmations(receipt, 109))
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/zeta-chain/node/pkg/ticker"
 	"github.com/zeta-chain/node/x/crosschain/types"
 	"github.com/zeta-chain/node/zetaclient/chains/evm"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/compliance"
 	"github.com/zeta-chain/node/zetaclient/config"
 	zctx "github.com/zeta-chain/node/zetaclient/context"
@@ -53,6 +54,12 @@ func (ob *Observer) WatchInbound(ctx context.Context) error {
 		return nil
 	})
 
+	if ob.evmSubscriber != nil {
+		bg.Work(ctx, func(ctx context.Context) error {
+			return ob.watchInboundHeads(ctx, sampledLogger)
+		}, bg.WithName("watchInboundHeads"), bg.WithLogger(ob.Logger().Inbound))
+	}
+
 	ob.Logger().Inbound.Info().Msgf("WatchInbound started")
 
 	return t.Run(ctx)
@@ -64,15 +71,12 @@ func (ob *Observer) watchInboundOnce(ctx context.Context, t *ticker.Ticker, samp
 		return err
 	}
 
-	// noop
-	if !app.IsInboundObservationEnabled() {
-		ob.Logger().Inbound.Warn().Msg("WatchInbound: inbound observation is disabled")
+	// the new heads of the subscription trigger the observation, poll only while the subscription is down
+	if ob.IsSubscribed() {
 		return nil
 	}
 
-	if err := ob.ObserveInbound(ctx, sampledLogger); err != nil {
-		ob.Logger().Inbound.Err(err).Msg("WatchInbound: observeInbound error")
-	}
+	ob.observeInbound(ctx, app, sampledLogger)
 
 	newInterval := ticker.SecondsFromUint64(ob.GetChainParams().InboundTicker)
 	t.SetInterval(newInterval)
@@ -80,6 +84,41 @@ func (ob *Observer) watchInboundOnce(ctx context.Context, t *ticker.Ticker, samp
 	return nil
 }
 
+// watchInboundHeads observes the inbounds on each new head received by the subscription
+func (ob *Observer) watchInboundHeads(ctx context.Context, sampledLogger zerolog.Logger) error {
+	app, err := zctx.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ob.subscription.inboundHeads:
+			ob.observeInbound(ctx, app, sampledLogger)
+		case <-ob.StopChannel():
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// observeInbound observes the inbounds once, the observations triggered by the ticker and the new heads are serialized
+func (ob *Observer) observeInbound(ctx context.Context, app *zctx.AppContext, sampledLogger zerolog.Logger) {
+	ob.inboundMu.Lock()
+	defer ob.inboundMu.Unlock()
+
+	// noop
+	if !app.IsInboundObservationEnabled() {
+		ob.Logger().Inbound.Warn().Msg("WatchInbound: inbound observation is disabled")
+		return
+	}
+
+	if err := ob.ObserveInbound(ctx, sampledLogger); err != nil {
+		ob.Logger().Inbound.Err(err).Msg("WatchInbound: observeInbound error")
+	}
+}
+
 // WatchInboundTracker gets a list of Inbound tracker suggestions from zeta-core at each tick and tries to check if the in-tx was confirmed.
 // If it was, it tries to broadcast the confirmation vote. If this zeta client has previously broadcast the vote, the tx would be rejected
 // TODO(revamp): move inbound tracker function to a separate file
@@ -187,14 +226,22 @@ func (ob *Observer) ObserveInbound(ctx context.Context, sampledLogger zerolog.Lo
 	}
 	ob.WithLastBlock(blockNumber)
 
+	// check the recently voted blocks against the chain, a detected reorg rolls back the last scanned block
+	if err := ob.DetectReorg(ctx); err != nil {
+		ob.Logger().Inbound.Warn().Err(err).Msgf("observeInbound: unable to check chain reorg")
+	}
+
+	// post the votes of the pending inbounds whose amount tier is met
+	ob.ProcessPendingInbounds(ctx)
+
 	// increment prom counter
 	metrics.GetBlockByNumberPerChain.WithLabelValues(ob.Chain().Name).Inc()
 
-	// skip if current height is too low
-	if blockNumber < ob.GetChainParams().ConfirmationCount {
-		return fmt.Errorf("observeInbound: skipping observer, current block number %d is too low", blockNumber)
+	// get the last confirmed block, skip if current height is too low
+	confirmedBlockNum, err := ob.GetConfirmedBlockNumber(ctx, blockNumber)
+	if err != nil {
+		return errors.Wrap(err, "observeInbound: skipping observer")
 	}
-	confirmedBlockNum := blockNumber - ob.GetChainParams().ConfirmationCount
 
 	// skip if no new block is confirmed
 	lastScanned := ob.LastBlockScanned()
@@ -269,6 +316,7 @@ func (ob *Observer) ObserveInbound(ctx context.Context, sampledLogger zerolog.Lo
 				Err(err).
 				Msgf("observeInbound: error saving lastScannedLowest %d to db", lastScannedLowest)
 		}
+		ob.pruneSubscribedLogs(lastScannedLowest + 1)
 	}
 	return nil
 }
@@ -288,6 +336,12 @@ func (ob *Observer) ObserveZetaSent(ctx context.Context, startBlock, toBlock uin
 		// lastScanned
 		return startBlock - 1, err
 	}
+
+	// skip querying the logs if the subscription received none in the range
+	if ob.noSubscribedLogs(startBlock, toBlock) {
+		return toBlock, nil
+	}
+
 	iter, err := connector.FilterZetaSent(&bind.FilterOpts{
 		Start:   startBlock,
 		End:     &toBlock,
@@ -349,7 +403,7 @@ func (ob *Observer) ObserveZetaSent(ctx context.Context, startBlock, toBlock uin
 		}
 
 		const gasLimit = zetacore.PostVoteInboundMessagePassingExecutionGasLimit
-		if _, err = ob.PostVoteInbound(ctx, msg, gasLimit); err != nil {
+		if _, err = ob.voteInbound(ctx, msg, event.Raw.BlockHash, gasLimit); err != nil {
 			// we have to re-scan from this block next time
 			return beingScanned - 1, err
 		}
@@ -369,6 +423,11 @@ func (ob *Observer) ObserveERC20Deposited(ctx context.Context, startBlock, toBlo
 		return startBlock - 1 // lastScanned
 	}
 
+	// skip querying the logs if the subscription received none in the range
+	if ob.noSubscribedLogs(startBlock, toBlock) {
+		return toBlock
+	}
+
 	iter, err := erc20custodyContract.FilterDeposited(&bind.FilterOpts{
 		Start:   startBlock,
 		End:     &toBlock,
@@ -433,7 +492,7 @@ func (ob *Observer) ObserveERC20Deposited(ctx context.Context, startBlock, toBlo
 
 		msg := ob.BuildInboundVoteMsgForDepositedEvent(event, sender)
 		if msg != nil {
-			_, err = ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
+			_, err = ob.voteInbound(ctx, msg, event.Raw.BlockHash, zetacore.PostVoteInboundExecutionGasLimit)
 			if err != nil {
 				return beingScanned - 1 // we have to re-scan from this block next time
 			}
@@ -448,6 +507,11 @@ func (ob *Observer) ObserveERC20Deposited(ctx context.Context, startBlock, toBlo
 func (ob *Observer) ObserverTSSReceive(ctx context.Context, startBlock, toBlock uint64) (uint64, error) {
 	chainID := ob.Chain().ChainId
 
+	// find the calls to TSS in the whole range with call traces instead of scanning every block
+	if tracer := ob.evmCallTracer(); tracer != nil {
+		return ob.ObserveTSSReceiveByTraces(ctx, tracer, startBlock, toBlock), nil
+	}
+
 	// query incoming gas asset
 	for bn := startBlock; bn <= toBlock; bn++ {
 		// observe TSS received gas token in block 'bn'
@@ -468,6 +532,49 @@ func (ob *Observer) ObserverTSSReceive(ctx context.Context, startBlock, toBlock
 	return toBlock, nil
 }
 
+// ObserveTSSReceiveByTraces finds the native token transfers to TSS in the block range with call traces and posts votes
+// The transfers made by contracts are observed along with the top-level ones, it returns the last block successfully scanned
+func (ob *Observer) ObserveTSSReceiveByTraces(
+	ctx context.Context,
+	tracer interfaces.EVMCallTracer,
+	startBlock, toBlock uint64,
+) uint64 {
+	calls, err := tracer.TraceCallsTo(ctx, ob.TSS().EVMAddress(), startBlock, toBlock)
+	if err != nil {
+		ob.Logger().Inbound.Error().
+			Err(err).
+			Msgf("ObserveTSSReceiveByTraces: error tracing calls to TSS from block %d to %d for chain %d",
+				startBlock, toBlock, ob.Chain().ChainId)
+		return startBlock - 1
+	}
+
+	// the transfers to TSS of a same tx are distinguished by their index
+	eventIndexes := make(map[ethcommon.Hash]uint)
+	for _, call := range calls {
+		if call.Value.Sign() <= 0 {
+			continue
+		}
+		eventIndex := eventIndexes[call.TxHash]
+		eventIndexes[call.TxHash]++
+
+		msg := ob.BuildInboundVoteMsgForCallToTSS(call, eventIndex)
+		if msg == nil {
+			continue
+		}
+		if _, err := ob.voteInbound(ctx, msg, call.BlockHash, zetacore.PostVoteInboundExecutionGasLimit); err != nil {
+			ob.Logger().Inbound.Error().
+				Err(err).
+				Msgf("ObserveTSSReceiveByTraces: error posting vote for inbound %s chain %d", call.TxHash, ob.Chain().ChainId)
+
+			// we have to re-scan from this block next time
+			return call.BlockNumber - 1
+		}
+	}
+
+	// successful processed all gas asset deposits in [startBlock, toBlock]
+	return toBlock
+}
+
 // CheckAndVoteInboundTokenZeta checks and votes on the given inbound Zeta token
 func (ob *Observer) CheckAndVoteInboundTokenZeta(
 	ctx context.Context,
@@ -517,7 +624,7 @@ func (ob *Observer) CheckAndVoteInboundTokenZeta(
 		return "", nil
 	}
 	if vote {
-		return ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundMessagePassingExecutionGasLimit)
+		return ob.voteInbound(ctx, msg, receipt.BlockHash, zetacore.PostVoteInboundMessagePassingExecutionGasLimit)
 	}
 
 	return msg.Digest(), nil
@@ -568,7 +675,7 @@ func (ob *Observer) CheckAndVoteInboundTokenERC20(
 		return "", nil
 	}
 	if vote {
-		return ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
+		return ob.voteInbound(ctx, msg, receipt.BlockHash, zetacore.PostVoteInboundExecutionGasLimit)
 	}
 
 	return msg.Digest(), nil
@@ -607,18 +714,12 @@ func (ob *Observer) CheckAndVoteInboundTokenGas(
 		return "", nil
 	}
 	if vote {
-		return ob.PostVoteInbound(ctx, msg, zetacore.PostVoteInboundExecutionGasLimit)
+		return ob.voteInbound(ctx, msg, receipt.BlockHash, zetacore.PostVoteInboundExecutionGasLimit)
 	}
 
 	return msg.Digest(), nil
 }
 
-// HasEnoughConfirmations checks if the given receipt has enough confirmations
-func (ob *Observer) HasEnoughConfirmations(receipt *ethtypes.Receipt, lastHeight uint64) bool {
-	confHeight := receipt.BlockNumber.Uint64() + ob.GetChainParams().ConfirmationCount
-	return lastHeight >= confHeight
-}
-
 // BuildInboundVoteMsgForDepositedEvent builds a inbound vote message for a Deposited event
 func (ob *Observer) BuildInboundVoteMsgForDepositedEvent(
 	event *erc20custody.ERC20CustodyDeposited,
@@ -732,8 +833,31 @@ func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
 	sender ethcommon.Address,
 	blockNumber uint64,
 ) *types.MsgVoteInbound {
-	message := tx.Input
+	return ob.buildInboundVoteMsgForGasDeposit(tx.Hash, sender, &tx.Value, tx.Input, blockNumber, 0)
+}
 
+// BuildInboundVoteMsgForCallToTSS builds a inbound vote message for a native token transfer to TSS found in call traces
+// The event index distinguishes the transfers to TSS of a same tx, it is 0 for the first one
+func (ob *Observer) BuildInboundVoteMsgForCallToTSS(call clienttypes.EVMCall, eventIndex uint) *types.MsgVoteInbound {
+	return ob.buildInboundVoteMsgForGasDeposit(
+		call.TxHash.Hex(),
+		call.From,
+		call.Value,
+		hex.EncodeToString(call.Input),
+		call.BlockNumber,
+		eventIndex,
+	)
+}
+
+// buildInboundVoteMsgForGasDeposit builds a inbound vote message for a native token deposit to TSS
+func (ob *Observer) buildInboundVoteMsgForGasDeposit(
+	txHash string,
+	sender ethcommon.Address,
+	value *big.Int,
+	message string,
+	blockNumber uint64,
+	eventIndex uint,
+) *types.MsgVoteInbound {
 	// compliance check
 	maybeReceiver := ""
 	parsedAddress, _, err := chains.ParseAddressAndData(message)
@@ -742,7 +866,7 @@ func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
 	}
 	if config.ContainRestrictedAddress(sender.Hex(), maybeReceiver) {
 		compliance.PrintComplianceLog(ob.Logger().Inbound, ob.Logger().Compliance,
-			false, ob.Chain().ChainId, tx.Hash, sender.Hex(), sender.Hex(), "Gas")
+			false, ob.Chain().ChainId, txHash, sender.Hex(), sender.Hex(), "Gas")
 		return nil
 	}
 
@@ -751,11 +875,11 @@ func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
 	data, _ := hex.DecodeString(message)
 	if bytes.Equal(data, []byte(constant.DonationMessage)) {
 		ob.Logger().Inbound.Info().
-			Msgf("thank you rich folk for your donation! tx %s chain %d", tx.Hash, ob.Chain().ChainId)
+			Msgf("thank you rich folk for your donation! tx %s chain %d", txHash, ob.Chain().ChainId)
 		return nil
 	}
 	ob.Logger().Inbound.Info().Msgf("TSS inbound detected on chain %d tx %s block %d from %s value %s message %s",
-		ob.Chain().ChainId, tx.Hash, blockNumber, sender.Hex(), tx.Value.String(), message)
+		ob.Chain().ChainId, txHash, blockNumber, sender.Hex(), value.String(), message)
 
 	return zetacore.GetInboundVoteMessage(
 		sender.Hex(),
@@ -763,15 +887,15 @@ func (ob *Observer) BuildInboundVoteMsgForTokenSentToTSS(
 		sender.Hex(),
 		sender.Hex(),
 		ob.ZetacoreClient().Chain().ChainId,
-		sdkmath.NewUintFromBigInt(&tx.Value),
+		sdkmath.NewUintFromBigInt(value),
 		message,
-		tx.Hash,
+		txHash,
 		blockNumber,
 		90_000,
 		coin.CoinType_Gas,
 		"",
 		ob.ZetacoreClient().GetKeys().GetOperatorAddress().String(),
-		0, // not a smart contract call
+		eventIndex,
 	)
 }
 
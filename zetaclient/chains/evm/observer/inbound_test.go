@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
@@ -19,6 +20,7 @@ import (
 	"github.com/zeta-chain/node/pkg/coin"
 	"github.com/zeta-chain/node/pkg/constant"
 	"github.com/zeta-chain/node/zetaclient/chains/evm"
+	"github.com/zeta-chain/node/zetaclient/chains/evm/observer"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	"github.com/zeta-chain/node/zetaclient/config"
 	"github.com/zeta-chain/node/zetaclient/testutils"
@@ -530,6 +532,68 @@ func Test_ObserveTSSReceiveInBlock(t *testing.T) {
 	}
 }
 
+func Test_ObserveTSSReceiveByTraces(t *testing.T) {
+	// https://etherscan.io/tx/0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532
+	chain := chains.Ethereum
+	chainID := chain.ChainId
+	confirmation := uint64(1)
+	chainParam := mocks.MockChainParams(chain.ChainId, confirmation)
+	inboundHash := "0xeaec67d5dd5d85f27b21bef83e01cbdf59154fd793ea7a22c297f7c3a722c532"
+
+	// load archived tx, receipt and cctx
+	tx, receipt := testutils.LoadEVMInboundNReceipt(t, TestDataDir, chainID, inboundHash, coin.CoinType_Gas)
+	require.NoError(t, evm.ValidateEvmTransaction(tx))
+	cctx := testutils.LoadCctxByInbound(t, chainID, coin.CoinType_Gas, inboundHash)
+
+	// the traced call of the archived gas token transfer to TSS
+	tss := mocks.NewTSSMainnet()
+	blockNumber := receipt.BlockNumber.Uint64()
+	call := clienttypes.EVMCall{
+		BlockNumber: blockNumber,
+		BlockHash:   receipt.BlockHash,
+		TxHash:      ethcommon.HexToHash(tx.Hash),
+		From:        ethcommon.HexToAddress(tx.From),
+		To:          tss.EVMAddress(),
+		Value:       &tx.Value,
+	}
+	lastBlock := blockNumber + confirmation
+
+	t.Run("should build the same vote msg as the block scan", func(t *testing.T) {
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, tss, lastBlock, chainParam)
+		msg := ob.BuildInboundVoteMsgForCallToTSS(call, 0)
+		require.NotNil(t, msg)
+		require.Equal(t, cctx.InboundParams.BallotIndex, msg.Digest())
+	})
+
+	t.Run("should vote on the traced calls to TSS", func(t *testing.T) {
+		zetacoreClient := mocks.NewZetacoreClient(t).
+			WithKeys(&keys.Keys{}).
+			WithZetaChain().
+			WithPostVoteInbound("", "")
+		ob, _ := MockEVMObserver(t, chain, nil, nil, zetacoreClient, tss, lastBlock, chainParam)
+
+		// a zero-value call is ignored
+		noValue := call
+		noValue.Value = new(big.Int)
+		tracer := mocks.NewEVMCallTracer(t)
+		tracer.On("TraceCallsTo", mock.Anything, tss.EVMAddress(), blockNumber, blockNumber).
+			Return([]clienttypes.EVMCall{noValue, call}, nil)
+		scanned := ob.ObserveTSSReceiveByTraces(context.Background(), tracer, blockNumber, blockNumber)
+		require.Equal(t, blockNumber, scanned)
+		require.Equal(t, []observer.VotedBlock{{Number: blockNumber, Hash: receipt.BlockHash}}, ob.VotedBlocks())
+	})
+
+	t.Run("should re-scan the range on trace error", func(t *testing.T) {
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, tss, lastBlock, chainParam)
+
+		tracer := mocks.NewEVMCallTracer(t)
+		tracer.On("TraceCallsTo", mock.Anything, tss.EVMAddress(), blockNumber, blockNumber).
+			Return(nil, errors.New("trace_filter not supported"))
+		scanned := ob.ObserveTSSReceiveByTraces(context.Background(), tracer, blockNumber, blockNumber)
+		require.Equal(t, blockNumber-1, scanned)
+	})
+}
+
 func makeAppContext(t *testing.T) (context.Context, *zctx.AppContext) {
 	var (
 		app = zctx.New(config.New(false), nil, zerolog.New(zerolog.NewTestWriter(t)))
@@ -7,6 +7,7 @@ import (
 	"math"
 	"math/big"
 	"strings"
+	"sync"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -44,11 +45,37 @@ type Observer struct {
 	// evmJSONRPC is the EVM JSON RPC client for the observed chain
 	evmJSONRPC interfaces.EVMJSONRPCClient
 
+	// evmSubscriber is the EVM WebSocket client subscribing to new heads and logs, nil if not configured
+	evmSubscriber interfaces.EVMSubscriber
+
+	// evmCallTracers find the calls from and to TSS through call traces, indexed by the trace mode using them
+	// the blocks are scanned if the trace mode of the chain params has no tracer
+	evmCallTracers map[observertypes.TraceMode]interfaces.EVMCallTracer
+
+	// subscription is the state of the new heads and logs subscription
+	subscription subscription
+
+	// inboundMu serializes the inbound observations triggered by the ticker and the new heads
+	inboundMu sync.Mutex
+
 	// outboundConfirmedReceipts is the map to index confirmed receipts by hash
 	outboundConfirmedReceipts map[string]*ethtypes.Receipt
 
 	// outboundConfirmedTransactions is the map to index confirmed transactions by hash
 	outboundConfirmedTransactions map[string]*ethtypes.Transaction
+
+	// lastBlockConfirmed is the last block confirmed by the safe or finalized block tag
+	// it is 0 if the chain uses a fixed confirmation count or the RPC doesn't support block tags
+	lastBlockConfirmed uint64
+
+	// votedBlocks is the ring of the recently voted inbound blocks, used to detect chain reorgs
+	votedBlocks []VotedBlock
+
+	// checkedBlocks caches the hashes of voted blocks found canonical, with the chain height of the last check
+	checkedBlocks map[ethcommon.Hash]uint64
+
+	// reorgedBlocks is the block range affected by the last detected chain reorg, nil if none
+	reorgedBlocks *BlockRange
 }
 
 // priorityFeeConfig is the configuration for priority fee
@@ -96,6 +123,9 @@ func NewObserver(
 		outboundConfirmedReceipts:     make(map[string]*ethtypes.Receipt),
 		outboundConfirmedTransactions: make(map[string]*ethtypes.Transaction),
 		priorityFeeConfig:             priorityFeeConfig{},
+		subscription:                  newSubscription(),
+		checkedBlocks:                 make(map[ethcommon.Hash]uint64),
+		evmCallTracers:                make(map[observertypes.TraceMode]interfaces.EVMCallTracer),
 	}
 
 	// load last block scanned
@@ -111,6 +141,22 @@ func (ob *Observer) WithEvmClient(client interfaces.EVMRPCClient) {
 	ob.evmClient = client
 }
 
+// WithEvmSubscriber attaches a new evm WebSocket client to the observer to subscribe to new heads and logs
+func (ob *Observer) WithEvmSubscriber(client interfaces.EVMSubscriber) {
+	ob.evmSubscriber = client
+}
+
+// WithEvmCallTracer attaches a new evm call tracer to the observer to find the calls from and to TSS
+// The tracer is used while the trace mode of the chain params is the given one
+func (ob *Observer) WithEvmCallTracer(mode observertypes.TraceMode, tracer interfaces.EVMCallTracer) {
+	ob.evmCallTracers[mode] = tracer
+}
+
+// evmCallTracer returns the call tracer of the trace mode set in chain params, nil if the blocks are scanned
+func (ob *Observer) evmCallTracer() interfaces.EVMCallTracer {
+	return ob.evmCallTracers[ob.GetChainParams().TraceMode]
+}
+
 // WithEvmJSONRPC attaches a new evm json rpc client to the observer
 func (ob *Observer) WithEvmJSONRPC(client interfaces.EVMJSONRPCClient) {
 	ob.evmJSONRPC = client
@@ -202,6 +248,10 @@ func (ob *Observer) Start(ctx context.Context) {
 	bg.Work(ctx, ob.WatchGasPrice, bg.WithName("WatchGasPrice"), bg.WithLogger(ob.Logger().GasPrice))
 	bg.Work(ctx, ob.WatchInboundTracker, bg.WithName("WatchInboundTracker"), bg.WithLogger(ob.Logger().Inbound))
 	bg.Work(ctx, ob.watchRPCStatus, bg.WithName("watchRPCStatus"), bg.WithLogger(ob.Logger().Chain))
+
+	if ob.evmSubscriber != nil {
+		bg.Work(ctx, ob.WatchSubscription, bg.WithName("WatchSubscription"), bg.WithLogger(ob.Logger().Chain))
+	}
 }
 
 // SetTxNReceipt sets the receipt and transaction in memory
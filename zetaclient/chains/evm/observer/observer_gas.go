@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/zeta-chain/node/zetaclient/chains/evm"
 	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 )
 
@@ -82,6 +83,9 @@ func (ob *Observer) PostGasPrice(ctx context.Context) error {
 
 // determinePriorityFee determines the chain priority fee.
 // Returns zero for non EIP-1559 (London fork) chains.
+//
+// The priority fee is computed from the fee history with the fee strategy of the chain.
+// It falls back to the suggested tip if the fee history can't be used.
 func (ob *Observer) determinePriorityFee(ctx context.Context) (*big.Int, error) {
 	supported, err := ob.supportsPriorityFee(ctx)
 	switch {
@@ -92,6 +96,17 @@ func (ob *Observer) determinePriorityFee(ctx context.Context) (*big.Int, error)
 		return big.NewInt(0), nil
 	}
 
+	strategy := evm.FeeStrategyForChain(ob.Chain().ChainId)
+	history, err := ob.evmClient.FeeHistory(ctx, evm.FeeHistoryBlocks, nil, []float64{strategy.RewardPercentile})
+	switch {
+	case err != nil:
+		ob.Logger().GasPrice.Warn().Err(err).Msg("unable to get fee history, using suggested gas tip cap")
+	case history == nil || len(history.Reward) == 0:
+		ob.Logger().GasPrice.Warn().Msg("empty fee history, using suggested gas tip cap")
+	default:
+		return strategy.PriorityFeeFromRewards(history.Reward), nil
+	}
+
 	fee, err := ob.evmClient.SuggestGasTipCap(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to suggest gas tip cap")
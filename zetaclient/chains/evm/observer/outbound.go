@@ -54,25 +54,33 @@ func (ob *Observer) WatchOutbound(ctx context.Context) error {
 	ob.Logger().Outbound.Info().Msgf("WatchOutbound started for chain %d", ob.Chain().ChainId)
 	sampledLogger := ob.Logger().Outbound.Sample(&zerolog.BasicSampler{N: 10})
 	defer ticker.Stop()
+	observeOutbound := func() {
+		if !app.IsOutboundObservationEnabled() {
+			sampledLogger.Info().
+				Msgf("WatchOutbound: outbound observation is disabled for chain %d", ob.Chain().ChainId)
+			return
+		}
+
+		// process outbound trackers
+		err := ob.ProcessOutboundTrackers(ctx)
+		if err != nil {
+			ob.Logger().
+				Outbound.Error().
+				Err(err).
+				Msgf("WatchOutbound: error ProcessOutboundTrackers for chain %d", chainID)
+		}
+	}
+
 	for {
 		select {
 		case <-ticker.C():
-			if !app.IsOutboundObservationEnabled() {
-				sampledLogger.Info().
-					Msgf("WatchOutbound: outbound observation is disabled for chain %d", ob.Chain().ChainId)
-				continue
+			// the new heads of the subscription trigger the observation, poll only while the subscription is down
+			if !ob.IsSubscribed() {
+				observeOutbound()
 			}
-
-			// process outbound trackers
-			err := ob.ProcessOutboundTrackers(ctx)
-			if err != nil {
-				ob.Logger().
-					Outbound.Error().
-					Err(err).
-					Msgf("WatchOutbound: error ProcessOutboundTrackers for chain %d", chainID)
-			}
-
 			ticker.UpdateInterval(ob.GetChainParams().OutboundTicker, ob.Logger().Outbound)
+		case <-ob.subscription.outboundHeads:
+			observeOutbound()
 		case <-ob.StopChannel():
 			ob.Logger().Outbound.Info().Msg("WatchOutbound: stopped")
 			return nil
@@ -429,12 +437,61 @@ func ParseAndCheckWithdrawnEvent(
 
 // FilterTSSOutbound filters the outbounds from TSS address to supplement outbound trackers
 func (ob *Observer) FilterTSSOutbound(ctx context.Context, startBlock, toBlock uint64) {
+	// find the outbounds from TSS address in the whole range with call traces instead of scanning every block
+	if tracer := ob.evmCallTracer(); tracer != nil {
+		ob.FilterTSSOutboundByTraces(ctx, tracer, startBlock, toBlock)
+		return
+	}
+
 	// filters the outbounds from TSS address block by block
 	for bn := startBlock; bn <= toBlock; bn++ {
 		ob.FilterTSSOutboundInBlock(ctx, bn)
 	}
 }
 
+// FilterTSSOutboundByTraces filters the outbounds in the block range with call traces to supplement outbound trackers
+func (ob *Observer) FilterTSSOutboundByTraces(
+	ctx context.Context,
+	tracer interfaces.EVMCallTracer,
+	startBlock, toBlock uint64,
+) {
+	// query calls and ignore error (we don't rescan as we are only supplementing outbound trackers)
+	calls, err := tracer.TraceCallsFrom(ctx, ob.TSS().EVMAddress(), startBlock, toBlock)
+	if err != nil {
+		ob.Logger().
+			Outbound.Error().
+			Err(err).
+			Msgf("error tracing calls from TSS from block %d to %d for chain %d", startBlock, toBlock, ob.Chain().ChainId)
+		return
+	}
+
+	for _, call := range calls {
+		// only the top-level calls are the txs sent by TSS
+		if call.Internal {
+			continue
+		}
+
+		tx, _, err := ob.evmClient.TransactionByHash(ctx, call.TxHash)
+		if err != nil {
+			ob.Logger().
+				Outbound.Error().
+				Err(err).
+				Msgf("error getting TSS outbound %s for chain %d", call.TxHash, ob.Chain().ChainId)
+			continue
+		}
+
+		nonce := tx.Nonce()
+		if !ob.IsTxConfirmed(nonce) {
+			if receipt, txx, ok := ob.checkConfirmedTx(ctx, call.TxHash.Hex(), nonce); ok {
+				ob.SetTxNReceipt(nonce, receipt, txx)
+				ob.Logger().
+					Outbound.Info().
+					Msgf("TSS outbound detected on chain %d nonce %d tx %s", ob.Chain().ChainId, nonce, call.TxHash)
+			}
+		}
+	}
+}
+
 // FilterTSSOutboundInBlock filters the outbounds in a single block to supplement outbound trackers
 func (ob *Observer) FilterTSSOutboundInBlock(ctx context.Context, blockNumber uint64) {
 	// query block and ignore error (we don't rescan as we are only supplementing outbound trackers)
@@ -547,9 +604,14 @@ func (ob *Observer) checkConfirmedTx(
 		logger.Error().Err(err).Msg("BlockNumber error")
 		return nil, nil, false
 	}
-	if !ob.HasEnoughConfirmations(receipt, lastHeight) {
+	confirmedHeight, err := ob.GetConfirmedBlockNumber(ctx, lastHeight)
+	if err != nil {
+		logger.Debug().Err(err).Msg("GetConfirmedBlockNumber error")
+		return nil, nil, false
+	}
+	if receipt.BlockNumber.Uint64() > confirmedHeight {
 		logger.Debug().
-			Msgf("tx included but not confirmed, receipt block %d current block %d", receipt.BlockNumber.Uint64(), lastHeight)
+			Msgf("tx included but not confirmed, receipt block %d confirmed block %d", receipt.BlockNumber.Uint64(), confirmedHeight)
 		return nil, nil, false
 	}
 
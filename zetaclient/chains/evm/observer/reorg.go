@@ -0,0 +1,281 @@
+package observer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/metrics"
+)
+
+const (
+	// VotedBlocksSize is the number of recently voted inbound blocks checked against the chain for reorgs
+	VotedBlocksSize = 50
+
+	// reorgCheckDepthFactor is the number of confirmation counts a voted block is checked for reorgs,
+	// the chains using a fixed confirmation count can still reorg a few blocks past it
+	reorgCheckDepthFactor = 2
+)
+
+// VotedBlock is a block in which the observer voted inbounds
+type VotedBlock struct {
+	Number uint64
+	Hash   ethcommon.Hash
+}
+
+// BlockRange is an inclusive range of blocks
+type BlockRange struct {
+	From uint64
+	To   uint64
+}
+
+// Contains returns true if the block is within the range
+func (r BlockRange) Contains(blockNumber uint64) bool {
+	return blockNumber >= r.From && blockNumber <= r.To
+}
+
+// VotedBlocks returns the recently voted inbound blocks, sorted by block number
+func (ob *Observer) VotedBlocks() []VotedBlock {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	blocks := make([]VotedBlock, len(ob.votedBlocks))
+	copy(blocks, ob.votedBlocks)
+	sort.SliceStable(blocks, func(i, j int) bool {
+		return blocks[i].Number < blocks[j].Number
+	})
+
+	return blocks
+}
+
+// ReorgedBlocks returns the block range affected by the last detected chain reorg
+// It returns false if no reorg is being recovered from
+func (ob *Observer) ReorgedBlocks() (BlockRange, bool) {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	if ob.reorgedBlocks == nil {
+		return BlockRange{}, false
+	}
+	return *ob.reorgedBlocks, true
+}
+
+// AddVotedBlock records the hash of a block in which the observer voted inbounds
+// The oldest block is dropped once the ring holds VotedBlocksSize blocks
+func (ob *Observer) AddVotedBlock(blockNumber uint64, blockHash ethcommon.Hash) {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	for i, voted := range ob.votedBlocks {
+		if voted.Number == blockNumber {
+			ob.votedBlocks[i].Hash = blockHash
+			return
+		}
+	}
+
+	ob.votedBlocks = append(ob.votedBlocks, VotedBlock{Number: blockNumber, Hash: blockHash})
+	if len(ob.votedBlocks) > VotedBlocksSize {
+		for _, dropped := range ob.votedBlocks[:len(ob.votedBlocks)-VotedBlocksSize] {
+			delete(ob.checkedBlocks, dropped.Hash)
+		}
+		ob.votedBlocks = ob.votedBlocks[len(ob.votedBlocks)-VotedBlocksSize:]
+	}
+}
+
+// PruneVotedBlocks forgets the voted blocks that are too deep to be reorged
+func (ob *Observer) PruneVotedBlocks() {
+	finalBlock, found := ob.lastFinalBlock()
+	if !found {
+		return
+	}
+
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	votedBlocks := make([]VotedBlock, 0, len(ob.votedBlocks))
+	for _, voted := range ob.votedBlocks {
+		if voted.Number <= finalBlock {
+			delete(ob.checkedBlocks, voted.Hash)
+			continue
+		}
+		votedBlocks = append(votedBlocks, voted)
+	}
+	ob.votedBlocks = votedBlocks
+}
+
+// lastFinalBlock returns the last block too deep to be reorged, the voted blocks up to it are no longer checked
+// With a block tag, it is the tagged block, otherwise the block with reorgCheckDepthFactor times the confirmation count
+func (ob *Observer) lastFinalBlock() (uint64, bool) {
+	chainParams := ob.GetChainParams()
+	if blockTagNumber(chainParams.ConfirmationMode) != nil {
+		if confirmed := ob.LastBlockConfirmed(); confirmed > 0 {
+			return confirmed, true
+		}
+	}
+
+	depth := reorgCheckDepthFactor * chainParams.ConfirmationCount
+	if lastBlock := ob.LastBlock(); lastBlock >= depth {
+		return lastBlock - depth, true
+	}
+	return 0, false
+}
+
+// isVotedBlockChecked returns true if the voted block hash was already found canonical at the given chain height
+func (ob *Observer) isVotedBlockChecked(voted VotedBlock, lastBlock uint64) bool {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	checkedAt, found := ob.checkedBlocks[voted.Hash]
+	return found && checkedAt >= lastBlock
+}
+
+// setVotedBlockChecked records the voted block hash was found canonical at the given chain height
+func (ob *Observer) setVotedBlockChecked(voted VotedBlock, lastBlock uint64) {
+	ob.Mu().Lock()
+	defer ob.Mu().Unlock()
+
+	ob.checkedBlocks[voted.Hash] = lastBlock
+}
+
+// IsReorgedBlock returns true if the block is within the blocks affected by the last detected chain reorg
+// The affected blocks are released once the last block of the range gets enough confirmations on the new chain
+func (ob *Observer) IsReorgedBlock(blockNumber uint64) bool {
+	reorged, found := ob.ReorgedBlocks()
+	if !found {
+		return false
+	}
+
+	if ob.isBlockConfirmed(reorged.To, ob.LastBlock()) {
+		ob.Mu().Lock()
+		ob.reorgedBlocks = nil
+		ob.Mu().Unlock()
+
+		ob.Logger().Inbound.Info().
+			Msgf("reorged blocks [%d, %d] are confirmed again for chain %d", reorged.From, reorged.To, ob.Chain().ChainId)
+		return false
+	}
+
+	return reorged.Contains(blockNumber)
+}
+
+// DetectReorg compares the hashes of the recently voted inbound blocks with the blocks of the chain
+// On divergence, it stops voting inbounds in the affected blocks and rolls back the last scanned block,
+// so the blocks of the new canonical chain are scanned again
+// The voted blocks too deep to be reorged are pruned, and the hashes are checked once per chain height
+func (ob *Observer) DetectReorg(ctx context.Context) error {
+	ob.PruneVotedBlocks()
+
+	lastBlock := ob.LastBlock()
+	for _, voted := range ob.VotedBlocks() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if ob.isVotedBlockChecked(voted, lastBlock) {
+			continue
+		}
+		if voted.Number > math.MaxInt {
+			return fmt.Errorf("block number %d is too large", voted.Number)
+		}
+
+		// the block is queried uncached as the cached one might be the reorged one
+		// #nosec G115 always in range, checked above
+		block, err := ob.evmJSONRPC.EthGetBlockByNumber(int(voted.Number), false)
+		if err != nil {
+			return errors.Wrapf(err, "error getting block %d for chain %d", voted.Number, ob.Chain().ChainId)
+		}
+		if block == nil {
+			return fmt.Errorf("block not found: %d", voted.Number)
+		}
+
+		if hash := ethcommon.HexToHash(block.Hash); hash != voted.Hash {
+			ob.handleReorg(voted, hash)
+			return nil
+		}
+		ob.setVotedBlockChecked(voted, lastBlock)
+	}
+
+	return nil
+}
+
+// handleReorg handles a chain reorg detected on the given voted block
+func (ob *Observer) handleReorg(voted VotedBlock, canonicalHash ethcommon.Hash) {
+	reorged := BlockRange{From: voted.Number, To: max(voted.Number, ob.LastBlock())}
+
+	metrics.ReorgDetectedPerChain.WithLabelValues(ob.Chain().Name).Inc()
+	ob.Logger().Inbound.Error().
+		Uint64("block", voted.Number).
+		Str("voted_hash", voted.Hash.Hex()).
+		Str("canonical_hash", canonicalHash.Hex()).
+		Msgf("chain reorg detected on voted block for chain %d, stop voting inbounds in blocks [%d, %d]",
+			ob.Chain().ChainId, reorged.From, reorged.To)
+
+	// forget the reorged blocks and stop voting inbounds in them until they are confirmed again
+	ob.Mu().Lock()
+	votedBlocks := make([]VotedBlock, 0, len(ob.votedBlocks))
+	for _, block := range ob.votedBlocks {
+		if block.Number < reorged.From {
+			votedBlocks = append(votedBlocks, block)
+			continue
+		}
+		delete(ob.checkedBlocks, block.Hash)
+	}
+	ob.votedBlocks = votedBlocks
+	ob.reorgedBlocks = &reorged
+	ob.Mu().Unlock()
+
+	// the cached blocks and headers might belong to the reorged chain
+	for _, cache := range []*lru.Cache{ob.BlockCache(), ob.HeaderCache()} {
+		for _, key := range cache.Keys() {
+			if blockNumber, ok := key.(uint64); ok && blockNumber >= reorged.From {
+				cache.Remove(key)
+			}
+		}
+	}
+
+	// the logs received by the subscription might belong to the reorged chain
+	ob.resetSubscribedLogs(reorged.To)
+
+	// the pending inbounds of the reorged blocks might not exist anymore, they are observed again on rescan
+	for _, pending := range ob.PendingInbounds() {
+		if pending.Msg.InboundBlockHeight >= reorged.From {
+			ob.RemovePendingInbound(pending.Msg.Digest())
+		}
+	}
+
+	// roll back the last scanned block to rescan the blocks of the new canonical chain
+	if ob.LastBlockScanned() >= reorged.From {
+		if err := ob.SaveLastBlockScanned(reorged.From - 1); err != nil {
+			ob.Logger().Inbound.Error().
+				Err(err).
+				Msgf("unable to roll back last scanned block to %d for chain %d", reorged.From-1, ob.Chain().ChainId)
+		}
+	}
+}
+
+// voteInbound posts the vote of an inbound observed in the given block and records the block hash
+// Inbounds in the blocks affected by a detected chain reorg are not voted until the blocks are confirmed again
+func (ob *Observer) voteInbound(
+	ctx context.Context,
+	msg *types.MsgVoteInbound,
+	blockHash ethcommon.Hash,
+	retryGasLimit uint64,
+) (string, error) {
+	if ob.IsReorgedBlock(msg.InboundBlockHeight) {
+		return "", fmt.Errorf(
+			"inbound %s in block %d is within reorged blocks for chain %d",
+			msg.InboundHash,
+			msg.InboundBlockHeight,
+			ob.Chain().ChainId,
+		)
+	}
+
+	ob.AddVotedBlock(msg.InboundBlockHeight, blockHash)
+
+	return ob.PostVoteInbound(ctx, msg, retryGasLimit)
+}
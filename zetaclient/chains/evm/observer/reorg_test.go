@@ -0,0 +1,141 @@
+package observer_test
+
+import (
+	"context"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/onrik/ethrpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
+	"github.com/zeta-chain/node/zetaclient/chains/evm/observer"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_AddVotedBlock(t *testing.T) {
+	chain := chains.Ethereum
+	params := mocks.MockChainParams(chain.ChainId, 10)
+
+	t.Run("should record voted blocks sorted by number", func(t *testing.T) {
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 1000, params)
+		hash1, hash2, hash3 := sample.Hash(), sample.Hash(), sample.Hash()
+
+		ob.AddVotedBlock(102, hash2)
+		ob.AddVotedBlock(101, hash1)
+		ob.AddVotedBlock(102, hash3)
+
+		require.Equal(t, []observer.VotedBlock{
+			{Number: 101, Hash: hash1},
+			{Number: 102, Hash: hash3},
+		}, ob.VotedBlocks())
+	})
+
+	t.Run("should drop the oldest voted blocks", func(t *testing.T) {
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 1000, params)
+
+		for i := uint64(0); i < observer.VotedBlocksSize+5; i++ {
+			ob.AddVotedBlock(100+i, sample.Hash())
+		}
+
+		voted := ob.VotedBlocks()
+		require.Len(t, voted, observer.VotedBlocksSize)
+		require.EqualValues(t, 105, voted[0].Number)
+	})
+}
+
+func Test_DetectReorg(t *testing.T) {
+	ctx := context.Background()
+	chain := chains.Ethereum
+	params := mocks.MockChainParams(chain.ChainId, 10)
+	hash100, hash101 := sample.Hash(), sample.Hash()
+
+	t.Run("should do nothing if voted blocks are canonical", func(t *testing.T) {
+		// mock client pops blocks from the end
+		evmJSONRPC := mocks.NewMockJSONRPCClient().WithBlocks([]*ethrpc.Block{
+			{Number: 101, Hash: hash101.Hex()},
+			{Number: 100, Hash: hash100.Hex()},
+		})
+		ob, _ := MockEVMObserver(t, chain, nil, evmJSONRPC, nil, nil, 110, params)
+		ob.WithLastBlockScanned(110)
+		ob.AddVotedBlock(100, hash100)
+		ob.AddVotedBlock(101, hash101)
+
+		require.NoError(t, ob.DetectReorg(ctx))
+
+		_, found := ob.ReorgedBlocks()
+		require.False(t, found)
+		require.Len(t, ob.VotedBlocks(), 2)
+		require.EqualValues(t, 110, ob.LastBlockScanned())
+
+		// the blocks already checked at this height are not queried again
+		require.NoError(t, ob.DetectReorg(ctx))
+
+		// the blocks are checked again on a new height
+		ob.WithLastBlock(111)
+		require.ErrorContains(t, ob.DetectReorg(ctx), "error getting block 100")
+	})
+
+	t.Run("should prune voted blocks too deep to be reorged", func(t *testing.T) {
+		evmJSONRPC := mocks.NewMockJSONRPCClient().WithBlock(&ethrpc.Block{Number: 101, Hash: hash101.Hex()})
+		ob, _ := MockEVMObserver(t, chain, nil, evmJSONRPC, nil, nil, 120, params)
+		ob.AddVotedBlock(100, hash100)
+		ob.AddVotedBlock(101, hash101)
+
+		require.NoError(t, ob.DetectReorg(ctx))
+		require.Equal(t, []observer.VotedBlock{{Number: 101, Hash: hash101}}, ob.VotedBlocks())
+	})
+
+	t.Run("should roll back last scanned block on reorg", func(t *testing.T) {
+		evmJSONRPC := mocks.NewMockJSONRPCClient().WithBlocks([]*ethrpc.Block{
+			{Number: 101, Hash: sample.Hash().Hex()},
+			{Number: 100, Hash: hash100.Hex()},
+		})
+		ob, _ := MockEVMObserver(t, chain, nil, evmJSONRPC, nil, nil, 1000, params)
+		ob.WithLastBlock(110)
+		ob.WithLastBlockScanned(100)
+		ob.AddVotedBlock(100, hash100)
+		ob.AddVotedBlock(101, hash101)
+		ob.BlockCache().Add(uint64(100), &ethrpc.Block{Number: 100})
+		ob.BlockCache().Add(uint64(101), &ethrpc.Block{Number: 101})
+
+		require.NoError(t, ob.DetectReorg(ctx))
+
+		reorged, found := ob.ReorgedBlocks()
+		require.True(t, found)
+		require.Equal(t, observer.BlockRange{From: 101, To: 110}, reorged)
+		require.Equal(t, []observer.VotedBlock{{Number: 100, Hash: hash100}}, ob.VotedBlocks())
+		require.EqualValues(t, 100, ob.LastBlockScanned())
+		require.True(t, ob.BlockCache().Contains(uint64(100)))
+		require.False(t, ob.BlockCache().Contains(uint64(101)))
+
+		// voting in reorged blocks is stopped until the range is confirmed again
+		require.True(t, ob.IsReorgedBlock(105))
+		require.False(t, ob.IsReorgedBlock(100))
+		ob.WithLastBlock(120)
+		require.False(t, ob.IsReorgedBlock(105))
+		_, found = ob.ReorgedBlocks()
+		require.False(t, found)
+	})
+
+	t.Run("should roll back last scanned block to before the reorged block", func(t *testing.T) {
+		evmJSONRPC := mocks.NewMockJSONRPCClient().WithBlock(&ethrpc.Block{
+			Number: 100,
+			Hash:   ethcommon.Hash{}.Hex(),
+		})
+		ob, _ := MockEVMObserver(t, chain, nil, evmJSONRPC, nil, nil, 105, params)
+		ob.WithLastBlockScanned(150)
+		ob.AddVotedBlock(100, hash100)
+
+		require.NoError(t, ob.DetectReorg(ctx))
+		require.EqualValues(t, 99, ob.LastBlockScanned())
+	})
+
+	t.Run("should fail if block cannot be queried", func(t *testing.T) {
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 105, params)
+		ob.AddVotedBlock(100, hash100)
+
+		require.ErrorContains(t, ob.DetectReorg(ctx), "error getting block 100")
+	})
+}
@@ -0,0 +1,294 @@
+package observer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SubscriptionRetryInterval is the interval to wait before subscribing again after the subscription drops
+	SubscriptionRetryInterval = 10 * time.Second
+
+	// subscriptionBufferSize is the buffer size of the new heads and logs channels
+	subscriptionBufferSize = 100
+
+	// subscriptionStaleTickers is the number of inbound ticker intervals, about a block time each,
+	// after which the subscription is considered stale if no new head is received
+	subscriptionStaleTickers = 3
+)
+
+// subscription is the state of the new heads and logs subscription
+type subscription struct {
+	mu sync.Mutex
+
+	// active is true while both the new heads and the logs subscriptions are alive
+	active bool
+
+	// lastHead is the time the last new head is received, or the subscription started
+	lastHead time.Time
+
+	// fromBlock is the first block whose logs are all received by the logs subscription
+	fromBlock uint64
+
+	// contracts are the contract addresses whose logs are received
+	contracts []ethcommon.Address
+
+	// logBlocks are the blocks in which logs of the contracts are received
+	logBlocks map[uint64]bool
+
+	// inboundHeads and outboundHeads signal a new head to the inbound and outbound observations
+	inboundHeads  chan struct{}
+	outboundHeads chan struct{}
+}
+
+// newSubscription creates a new inactive subscription state
+func newSubscription() subscription {
+	return subscription{
+		logBlocks:     make(map[uint64]bool),
+		inboundHeads:  make(chan struct{}, 1),
+		outboundHeads: make(chan struct{}, 1),
+	}
+}
+
+// IsSubscribed returns true if the observer receives the new heads and logs of the chain over WebSocket
+// A subscription which hasn't received a new head for a few block times is stale and not counted as subscribed,
+// the observations are polled again until new heads are received
+func (ob *Observer) IsSubscribed() bool {
+	staleTimeout := ob.subscriptionStaleTimeout()
+
+	ob.subscription.mu.Lock()
+	defer ob.subscription.mu.Unlock()
+
+	return ob.subscription.isLive(staleTimeout)
+}
+
+// isLive returns true if the subscription is active and received a new head within the stale timeout
+// The caller must hold the subscription lock
+func (sub *subscription) isLive(staleTimeout time.Duration) bool {
+	return sub.active && time.Since(sub.lastHead) <= staleTimeout
+}
+
+// subscriptionStaleTimeout returns the time without new heads after which the subscription is stale
+func (ob *Observer) subscriptionStaleTimeout() time.Duration {
+	// #nosec G115 ticker intervals are small
+	interval := time.Duration(ob.GetChainParams().InboundTicker) * time.Second
+	return subscriptionStaleTickers * interval
+}
+
+// HasSubscribedLogs checks the logs received by the subscription in the range [startBlock, toBlock]
+// It returns covered as false if the subscription doesn't guarantee all the logs of the watched contracts in the range
+func (ob *Observer) HasSubscribedLogs(startBlock, toBlock uint64) (hasLogs bool, covered bool) {
+	contracts := ob.watchedContracts()
+	staleTimeout := ob.subscriptionStaleTimeout()
+
+	ob.subscription.mu.Lock()
+	defer ob.subscription.mu.Unlock()
+
+	sub := &ob.subscription
+	if !sub.isLive(staleTimeout) || startBlock < sub.fromBlock || !equalAddresses(sub.contracts, contracts) {
+		return false, false
+	}
+
+	for blockNumber := range sub.logBlocks {
+		if blockNumber >= startBlock && blockNumber <= toBlock {
+			return true, true
+		}
+	}
+
+	return false, true
+}
+
+// noSubscribedLogs returns true if the subscription received all the logs in the range and there is none
+func (ob *Observer) noSubscribedLogs(startBlock, toBlock uint64) bool {
+	hasLogs, covered := ob.HasSubscribedLogs(startBlock, toBlock)
+	return covered && !hasLogs
+}
+
+// WatchSubscription subscribes to the new heads and the logs of the watched contracts over WebSocket
+// The new heads trigger the inbound and outbound observations, which are polled again while the subscription is down
+func (ob *Observer) WatchSubscription(ctx context.Context) error {
+	ob.Logger().Chain.Info().Msgf("WatchSubscription started for chain %d", ob.Chain().ChainId)
+
+	for {
+		err := ob.subscribe(ctx)
+		ob.stopSubscription()
+		if err != nil {
+			ob.Logger().Chain.Warn().
+				Err(err).
+				Msgf("WatchSubscription: subscription dropped for chain %d, falling back to polling", ob.Chain().ChainId)
+		}
+
+		select {
+		case <-ob.StopChannel():
+			ob.Logger().Chain.Info().Msgf("WatchSubscription stopped for chain %d", ob.Chain().ChainId)
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(SubscriptionRetryInterval):
+		}
+	}
+}
+
+// subscribe subscribes to the new heads and logs and processes them until the subscription drops
+func (ob *Observer) subscribe(ctx context.Context) error {
+	heads := make(chan *ethtypes.Header, subscriptionBufferSize)
+	headSub, err := ob.evmSubscriber.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return errors.Wrap(err, "unable to subscribe to new heads")
+	}
+	defer headSub.Unsubscribe()
+
+	contracts := ob.watchedContracts()
+	if len(contracts) == 0 {
+		return errors.New("no contract to subscribe to logs")
+	}
+	logs := make(chan ethtypes.Log, subscriptionBufferSize)
+	logSub, err := ob.evmSubscriber.SubscribeFilterLogs(ctx, ethereum.FilterQuery{Addresses: contracts}, logs)
+	if err != nil {
+		return errors.Wrap(err, "unable to subscribe to logs")
+	}
+	defer logSub.Unsubscribe()
+
+	// the logs are all received from the block following the latest block at subscription time
+	latest, err := ob.evmClient.BlockNumber(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get latest block number")
+	}
+	ob.startSubscription(latest+1, contracts)
+
+	ob.Logger().Chain.Info().
+		Msgf("WatchSubscription: subscribed to new heads and logs for chain %d from block %d", ob.Chain().ChainId, latest+1)
+
+	for {
+		select {
+		case header := <-heads:
+			if header != nil {
+				ob.receiveNewHead(header.Number.Uint64())
+				ob.notifyNewHead()
+			}
+		case log := <-logs:
+			// removed logs are kept, their blocks are scanned again anyway
+			ob.addLogBlock(log.BlockNumber)
+		case err := <-headSub.Err():
+			return errors.Wrap(err, "new heads subscription dropped")
+		case err := <-logSub.Err():
+			return errors.Wrap(err, "logs subscription dropped")
+		case <-ob.StopChannel():
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// startSubscription marks the subscription active from the given block for the given contracts
+func (ob *Observer) startSubscription(fromBlock uint64, contracts []ethcommon.Address) {
+	ob.subscription.mu.Lock()
+	defer ob.subscription.mu.Unlock()
+
+	ob.subscription.active = true
+	ob.subscription.lastHead = time.Now()
+	ob.subscription.fromBlock = fromBlock
+	ob.subscription.contracts = contracts
+	ob.subscription.logBlocks = make(map[uint64]bool)
+}
+
+// stopSubscription marks the subscription inactive, the observations are polled again
+func (ob *Observer) stopSubscription() {
+	ob.subscription.mu.Lock()
+	defer ob.subscription.mu.Unlock()
+
+	ob.subscription.active = false
+}
+
+// resetSubscribedLogs makes the received logs cover only the blocks following the given block
+func (ob *Observer) resetSubscribedLogs(blockNumber uint64) {
+	ob.subscription.mu.Lock()
+	defer ob.subscription.mu.Unlock()
+
+	ob.subscription.fromBlock = max(ob.subscription.fromBlock, blockNumber+1)
+	ob.subscription.logBlocks = make(map[uint64]bool)
+}
+
+// pruneSubscribedLogs forgets the blocks with logs below the given block
+func (ob *Observer) pruneSubscribedLogs(blockNumber uint64) {
+	ob.subscription.mu.Lock()
+	defer ob.subscription.mu.Unlock()
+
+	for logBlock := range ob.subscription.logBlocks {
+		if logBlock < blockNumber {
+			delete(ob.subscription.logBlocks, logBlock)
+		}
+	}
+}
+
+// receiveNewHead records the time a new head is received
+// The logs might have been missed while the subscription was stale, they are covered again from the next block
+func (ob *Observer) receiveNewHead(blockNumber uint64) {
+	staleTimeout := ob.subscriptionStaleTimeout()
+
+	ob.subscription.mu.Lock()
+	defer ob.subscription.mu.Unlock()
+
+	sub := &ob.subscription
+	if !sub.isLive(staleTimeout) {
+		sub.fromBlock = max(sub.fromBlock, blockNumber+1)
+		sub.logBlocks = make(map[uint64]bool)
+	}
+	sub.lastHead = time.Now()
+}
+
+// addLogBlock records a block in which logs of the watched contracts are received
+func (ob *Observer) addLogBlock(blockNumber uint64) {
+	ob.subscription.mu.Lock()
+	defer ob.subscription.mu.Unlock()
+
+	ob.subscription.logBlocks[blockNumber] = true
+}
+
+// notifyNewHead signals a new head to the inbound and outbound observations, pending signals are coalesced
+func (ob *Observer) notifyNewHead() {
+	for _, ch := range []chan struct{}{ob.subscription.inboundHeads, ob.subscription.outboundHeads} {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// watchedContracts returns the addresses of the connector, custody and gateway contracts set in chain params
+func (ob *Observer) watchedContracts() []ethcommon.Address {
+	chainParams := ob.GetChainParams()
+
+	contracts := make([]ethcommon.Address, 0, 3)
+	for _, address := range []string{
+		chainParams.ConnectorContractAddress,
+		chainParams.Erc20CustodyContractAddress,
+		chainParams.GatewayAddress,
+	} {
+		if addr := ethcommon.HexToAddress(address); addr != (ethcommon.Address{}) {
+			contracts = append(contracts, addr)
+		}
+	}
+
+	return contracts
+}
+
+// equalAddresses returns true if the two address lists are equal
+func equalAddresses(a, b []ethcommon.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,152 @@
+package observer_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func Test_WatchSubscription(t *testing.T) {
+	chain := chains.Ethereum
+	params := mocks.MockChainParams(chain.ChainId, 10)
+
+	t.Run("should track subscribed logs until the subscription drops", func(t *testing.T) {
+		// ARRANGE
+		evmClient := mocks.NewEVMRPCClient(t)
+		evmClient.On("BlockNumber", mock.Anything).Return(uint64(1000), nil)
+		ob, _ := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 1000, params)
+
+		logs := make(chan chan<- ethtypes.Log, 1)
+		dropped := make(chan error, 1)
+		subscriber := mocks.NewEVMSubscriber(t)
+		subscriber.On("SubscribeNewHead", mock.Anything, mock.Anything).
+			Return(event.NewSubscription(func(quit <-chan struct{}) error {
+				<-quit
+				return nil
+			}), nil)
+		subscriber.On("SubscribeFilterLogs", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { logs <- args.Get(2).(chan<- ethtypes.Log) }).
+			Return(event.NewSubscription(func(quit <-chan struct{}) error {
+				select {
+				case err := <-dropped:
+					return err
+				case <-quit:
+					return nil
+				}
+			}), nil)
+		ob.WithEvmSubscriber(subscriber)
+
+		// nothing is covered before subscribing
+		_, covered := ob.HasSubscribedLogs(1001, 1010)
+		require.False(t, covered)
+
+		// ACT
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go ob.WatchSubscription(ctx)
+		require.Eventually(t, ob.IsSubscribed, time.Second, 10*time.Millisecond)
+		(<-logs) <- ethtypes.Log{BlockNumber: 1005}
+
+		// ASSERT
+		require.Eventually(t, func() bool {
+			hasLogs, _ := ob.HasSubscribedLogs(1001, 1010)
+			return hasLogs
+		}, time.Second, 10*time.Millisecond)
+
+		hasLogs, covered := ob.HasSubscribedLogs(1001, 1004)
+		require.False(t, hasLogs)
+		require.True(t, covered)
+
+		// blocks before the subscription are not covered
+		_, covered = ob.HasSubscribedLogs(1000, 1004)
+		require.False(t, covered)
+
+		// changed contracts are not covered
+		otherParams := params
+		otherParams.GatewayAddress = "0x0000000000000000000000000000000000000001"
+		ob.SetChainParams(otherParams)
+		_, covered = ob.HasSubscribedLogs(1001, 1004)
+		require.False(t, covered)
+		ob.SetChainParams(params)
+
+		// the observer polls again once the subscription drops
+		dropped <- errors.New("connection closed")
+		require.Eventually(t, func() bool { return !ob.IsSubscribed() }, time.Second, 10*time.Millisecond)
+		_, covered = ob.HasSubscribedLogs(1001, 1004)
+		require.False(t, covered)
+	})
+
+	t.Run("should fall back to polling while no new head is received", func(t *testing.T) {
+		// ARRANGE
+		staleParams := params
+		staleParams.InboundTicker = 1
+		evmClient := mocks.NewEVMRPCClient(t)
+		evmClient.On("BlockNumber", mock.Anything).Return(uint64(1000), nil)
+		ob, _ := MockEVMObserver(t, chain, evmClient, nil, nil, nil, 1000, staleParams)
+
+		heads := make(chan chan<- *ethtypes.Header, 1)
+		subscriber := mocks.NewEVMSubscriber(t)
+		subscriber.On("SubscribeNewHead", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) { heads <- args.Get(1).(chan<- *ethtypes.Header) }).
+			Return(event.NewSubscription(func(quit <-chan struct{}) error {
+				<-quit
+				return nil
+			}), nil)
+		subscriber.On("SubscribeFilterLogs", mock.Anything, mock.Anything, mock.Anything).
+			Return(event.NewSubscription(func(quit <-chan struct{}) error {
+				<-quit
+				return nil
+			}), nil)
+		ob.WithEvmSubscriber(subscriber)
+
+		// ACT
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go ob.WatchSubscription(ctx)
+		headCh := <-heads
+		require.Eventually(t, ob.IsSubscribed, time.Second, 10*time.Millisecond)
+
+		// ASSERT
+		// the subscription is stale after a few block times without new heads
+		require.Eventually(t, func() bool { return !ob.IsSubscribed() }, 5*time.Second, 100*time.Millisecond)
+		_, covered := ob.HasSubscribedLogs(1001, 1010)
+		require.False(t, covered)
+
+		// a new head makes it live again, the logs are covered only after it
+		headCh <- &ethtypes.Header{Number: big.NewInt(1020)}
+		require.Eventually(t, ob.IsSubscribed, time.Second, 10*time.Millisecond)
+		_, covered = ob.HasSubscribedLogs(1001, 1010)
+		require.False(t, covered)
+		_, covered = ob.HasSubscribedLogs(1021, 1030)
+		require.True(t, covered)
+	})
+
+	t.Run("should not subscribe if new heads subscription fails", func(t *testing.T) {
+		ob, _ := MockEVMObserver(t, chain, nil, nil, nil, nil, 1000, params)
+
+		subscribed := make(chan struct{})
+		subscriber := mocks.NewEVMSubscriber(t)
+		subscriber.On("SubscribeNewHead", mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) { close(subscribed) }).
+			Return(nil, errors.New("notifications not supported")).
+			Once()
+		ob.WithEvmSubscriber(subscriber)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go ob.WatchSubscription(ctx)
+
+		<-subscribed
+		require.False(t, ob.IsSubscribed())
+	})
+}
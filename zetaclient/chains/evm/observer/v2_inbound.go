@@ -68,6 +68,11 @@ func (ob *Observer) ObserveGatewayDeposit(ctx context.Context, startBlock, toBlo
 		return startBlock - 1, errors.Wrap(err, "can't get gateway contract")
 	}
 
+	// skip querying the logs if the subscription received none in the range
+	if ob.noSubscribedLogs(startBlock, toBlock) {
+		return toBlock, nil
+	}
+
 	// get iterator for the events for the block range
 	eventIterator, err := gatewayContract.FilterDeposited(&bind.FilterOpts{
 		Start:   startBlock,
@@ -110,7 +115,7 @@ func (ob *Observer) ObserveGatewayDeposit(ctx context.Context, startBlock, toBlo
 				ob.Chain().
 					ChainId, event.Raw.TxHash.Hex(), event.Raw.BlockNumber, event.Sender.Hex(), event.Amount.String(), hex.EncodeToString(event.Payload))
 
-		_, err = ob.PostVoteInbound(ctx, &msg, zetacore.PostVoteInboundExecutionGasLimit)
+		_, err = ob.voteInbound(ctx, &msg, event.Raw.BlockHash, zetacore.PostVoteInboundExecutionGasLimit)
 		if err != nil {
 			// decrement the last scanned block so we have to re-scan from this block next time
 			return lastScanned - 1, errors.Wrap(err, "error posting vote inbound")
@@ -208,6 +213,11 @@ func (ob *Observer) ObserveGatewayCall(ctx context.Context, startBlock, toBlock
 		return startBlock - 1, errors.Wrap(err, "can't get gateway contract")
 	}
 
+	// skip querying the logs if the subscription received none in the range
+	if ob.noSubscribedLogs(startBlock, toBlock) {
+		return toBlock, nil
+	}
+
 	// get iterator for the events for the block range
 	eventIterator, err := gatewayContract.FilterCalled(&bind.FilterOpts{
 		Start:   startBlock,
@@ -250,7 +260,7 @@ func (ob *Observer) ObserveGatewayCall(ctx context.Context, startBlock, toBlock
 				ob.Chain().
 					ChainId, event.Raw.TxHash.Hex(), event.Raw.BlockNumber, event.Sender.Hex(), hex.EncodeToString(event.Payload))
 
-		_, err = ob.PostVoteInbound(ctx, &msg, zetacore.PostVoteInboundExecutionGasLimit)
+		_, err = ob.voteInbound(ctx, &msg, event.Raw.BlockHash, zetacore.PostVoteInboundExecutionGasLimit)
 		if err != nil {
 			// decrement the last scanned block so we have to re-scan from this block next time
 			return lastScanned - 1, errors.Wrap(err, "error posting vote inbound")
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	// SafeBlockNumber is the block number to query the safe head of the chain
+	SafeBlockNumber = big.NewInt(int64(ethrpc.SafeBlockNumber))
+
+	// FinalizedBlockNumber is the block number to query the finalized head of the chain
+	FinalizedBlockNumber = big.NewInt(int64(ethrpc.FinalizedBlockNumber))
+)
+
+// Client is the EVM RPC client used by zetaclient
+// It extends ethclient.Client to support the safe and finalized block tags
+type Client struct {
+	*ethclient.Client
+
+	rpcClient *ethrpc.Client
+}
+
+// NewClient creates a new EVM RPC client
+func NewClient(rpcClient *ethrpc.Client) *Client {
+	return &Client{
+		Client:    ethclient.NewClient(rpcClient),
+		rpcClient: rpcClient,
+	}
+}
+
+// HeaderByNumber returns a block header from the current canonical chain
+// If number is SafeBlockNumber or FinalizedBlockNumber, the header of the safe or finalized head is returned
+func (c *Client) HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error) {
+	tag, ok := blockTag(number)
+	if !ok {
+		return c.Client.HeaderByNumber(ctx, number)
+	}
+
+	var head *ethtypes.Header
+	err := c.rpcClient.CallContext(ctx, &head, "eth_getBlockByNumber", tag, false)
+	if err == nil && head == nil {
+		err = ethereum.NotFound
+	}
+	return head, err
+}
+
+// blockTag returns the block tag of the given block number, if any
+func blockTag(number *big.Int) (string, bool) {
+	switch {
+	case number == nil:
+		return "", false
+	case number.Cmp(SafeBlockNumber) == 0:
+		return "safe", true
+	case number.Cmp(FinalizedBlockNumber) == 0:
+		return "finalized", true
+	default:
+		return "", false
+	}
+}
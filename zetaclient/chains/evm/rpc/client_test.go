@@ -0,0 +1,81 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
+)
+
+// newTestServer creates a JSON RPC server returning a header for each eth_getBlockByNumber tag
+func newTestServer(t *testing.T, headers map[string]*ethtypes.Header) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+			Params []interface{}   `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_getBlockByNumber", req.Method)
+
+		tag, ok := req.Params[0].(string)
+		require.True(t, ok)
+
+		res := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  headers[tag],
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+}
+
+func Test_ClientHeaderByNumber(t *testing.T) {
+	ctx := context.Background()
+
+	headers := map[string]*ethtypes.Header{
+		"latest":    {Number: big.NewInt(100), Difficulty: big.NewInt(0)},
+		"safe":      {Number: big.NewInt(90), Difficulty: big.NewInt(0)},
+		"finalized": {Number: big.NewInt(80), Difficulty: big.NewInt(0)},
+		"0x32":      {Number: big.NewInt(50), Difficulty: big.NewInt(0)},
+	}
+	server := newTestServer(t, headers)
+	defer server.Close()
+
+	rpcClient, err := ethrpc.DialHTTP(server.URL)
+	require.NoError(t, err)
+	client := rpc.NewClient(rpcClient)
+
+	tests := []struct {
+		name     string
+		number   *big.Int
+		expected uint64
+	}{
+		{name: "latest", number: nil, expected: 100},
+		{name: "safe", number: rpc.SafeBlockNumber, expected: 90},
+		{name: "finalized", number: rpc.FinalizedBlockNumber, expected: 80},
+		{name: "block number", number: big.NewInt(50), expected: 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, err := client.HeaderByNumber(ctx, tt.number)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, header.Number.Uint64())
+		})
+	}
+
+	t.Run("should return not found if tag not supported", func(t *testing.T) {
+		delete(headers, "safe")
+		_, err := client.HeaderByNumber(ctx, rpc.SafeBlockNumber)
+		require.ErrorContains(t, err, "not found")
+	})
+}
@@ -0,0 +1,297 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
+)
+
+var (
+	_ interfaces.EVMCallTracer = (*TraceFilterClient)(nil)
+	_ interfaces.EVMCallTracer = (*DebugTraceClient)(nil)
+)
+
+// TraceFilterClient finds the calls of an address with the trace_filter method of Erigon, Nethermind and Reth nodes
+// A single request covers the whole block range
+type TraceFilterClient struct {
+	rpcClient *ethrpc.Client
+}
+
+// NewTraceFilterClient creates a new trace_filter client
+func NewTraceFilterClient(rpcClient *ethrpc.Client) *TraceFilterClient {
+	return &TraceFilterClient{rpcClient: rpcClient}
+}
+
+// parityTrace is a trace returned by the trace_filter and trace_transaction methods
+type parityTrace struct {
+	Action struct {
+		CallType      string            `json:"callType"`
+		From          ethcommon.Address `json:"from"`
+		To            ethcommon.Address `json:"to"`
+		Value         *hexutil.Big      `json:"value"`
+		Input         hexutil.Bytes     `json:"input"`
+		Address       ethcommon.Address `json:"address"`
+		RefundAddress ethcommon.Address `json:"refundAddress"`
+		Balance       *hexutil.Big      `json:"balance"`
+	} `json:"action"`
+	BlockHash       ethcommon.Hash `json:"blockHash"`
+	BlockNumber     uint64         `json:"blockNumber"`
+	TransactionHash ethcommon.Hash `json:"transactionHash"`
+	TraceAddress    []uint64       `json:"traceAddress"`
+	Type            string         `json:"type"`
+	Error           string         `json:"error"`
+}
+
+// TraceCallsFrom returns the successful calls made by the address in the block range
+func (c *TraceFilterClient) TraceCallsFrom(
+	ctx context.Context,
+	from ethcommon.Address,
+	startBlock, toBlock uint64,
+) ([]clienttypes.EVMCall, error) {
+	return c.traceFilter(ctx, map[string]interface{}{
+		"fromBlock":   hexutil.Uint64(startBlock),
+		"toBlock":     hexutil.Uint64(toBlock),
+		"fromAddress": []ethcommon.Address{from},
+	})
+}
+
+// TraceCallsTo returns the successful calls made to the address in the block range
+func (c *TraceFilterClient) TraceCallsTo(
+	ctx context.Context,
+	to ethcommon.Address,
+	startBlock, toBlock uint64,
+) ([]clienttypes.EVMCall, error) {
+	return c.traceFilter(ctx, map[string]interface{}{
+		"fromBlock": hexutil.Uint64(startBlock),
+		"toBlock":   hexutil.Uint64(toBlock),
+		"toAddress": []ethcommon.Address{to},
+	})
+}
+
+// traceFilter queries the traces matching the filter and returns the successful calls
+func (c *TraceFilterClient) traceFilter(ctx context.Context, filter map[string]interface{}) ([]clienttypes.EVMCall, error) {
+	var traces []parityTrace
+	if err := c.rpcClient.CallContext(ctx, &traces, "trace_filter", filter); err != nil {
+		return nil, errors.Wrap(err, "trace_filter failed")
+	}
+
+	// the traces of the reverted parent calls are not returned by the filter, they are checked per transaction
+	reverted := make(map[ethcommon.Hash]map[string]bool)
+
+	calls := make([]clienttypes.EVMCall, 0, len(traces))
+	for _, trace := range traces {
+		call, ok := trace.toCall()
+		if !ok {
+			continue
+		}
+
+		if call.Internal {
+			if _, found := reverted[trace.TransactionHash]; !found {
+				txReverted, err := c.revertedTraces(ctx, trace.TransactionHash)
+				if err != nil {
+					return nil, err
+				}
+				reverted[trace.TransactionHash] = txReverted
+			}
+			if hasRevertedParent(reverted[trace.TransactionHash], trace.TraceAddress) {
+				continue
+			}
+		}
+
+		calls = append(calls, call)
+	}
+
+	return calls, nil
+}
+
+// revertedTraces returns the trace addresses of the reverted traces of a transaction
+func (c *TraceFilterClient) revertedTraces(ctx context.Context, txHash ethcommon.Hash) (map[string]bool, error) {
+	var traces []parityTrace
+	if err := c.rpcClient.CallContext(ctx, &traces, "trace_transaction", txHash); err != nil {
+		return nil, errors.Wrapf(err, "trace_transaction failed for tx %s", txHash)
+	}
+
+	reverted := make(map[string]bool)
+	for _, trace := range traces {
+		if trace.Error != "" {
+			reverted[traceAddressKey(trace.TraceAddress)] = true
+		}
+	}
+	return reverted, nil
+}
+
+// toCall converts the trace into a call, it returns false if the trace is not a successful value-bearing call
+func (t parityTrace) toCall() (clienttypes.EVMCall, bool) {
+	if t.Error != "" {
+		return clienttypes.EVMCall{}, false
+	}
+
+	call := clienttypes.EVMCall{
+		BlockNumber: t.BlockNumber,
+		BlockHash:   t.BlockHash,
+		TxHash:      t.TransactionHash,
+		Internal:    len(t.TraceAddress) > 0,
+	}
+
+	switch {
+	// delegate and static calls don't move any value to the callee
+	case t.Type == "call" && t.Action.CallType == "call":
+		call.From = t.Action.From
+		call.To = t.Action.To
+		call.Value = t.Action.Value.ToInt()
+		call.Input = t.Action.Input
+	// the balance of a destroyed contract is sent to the refund address
+	case t.Type == "suicide":
+		call.From = t.Action.Address
+		call.To = t.Action.RefundAddress
+		call.Value = t.Action.Balance.ToInt()
+	default:
+		return clienttypes.EVMCall{}, false
+	}
+	if call.Value == nil {
+		call.Value = new(big.Int)
+	}
+
+	return call, true
+}
+
+// hasRevertedParent returns true if the trace or one of its parents is reverted
+func hasRevertedParent(reverted map[string]bool, traceAddress []uint64) bool {
+	for i := 0; i <= len(traceAddress); i++ {
+		if reverted[traceAddressKey(traceAddress[:i])] {
+			return true
+		}
+	}
+	return false
+}
+
+// traceAddressKey returns the map key of a trace address
+func traceAddressKey(traceAddress []uint64) string {
+	return fmt.Sprint(traceAddress)
+}
+
+// DebugTraceClient finds the calls of an address with the debug_traceBlockByNumber method and the call tracer of geth
+// One request is made per block of the range
+type DebugTraceClient struct {
+	rpcClient *ethrpc.Client
+}
+
+// NewDebugTraceClient creates a new debug_traceBlockByNumber client
+func NewDebugTraceClient(rpcClient *ethrpc.Client) *DebugTraceClient {
+	return &DebugTraceClient{rpcClient: rpcClient}
+}
+
+// callFrame is a call frame returned by the call tracer
+type callFrame struct {
+	Type  string            `json:"type"`
+	From  ethcommon.Address `json:"from"`
+	To    ethcommon.Address `json:"to"`
+	Value *hexutil.Big      `json:"value"`
+	Input hexutil.Bytes     `json:"input"`
+	Error string            `json:"error"`
+	Calls []callFrame       `json:"calls"`
+}
+
+// txTrace is the trace of a transaction returned by debug_traceBlockByNumber
+type txTrace struct {
+	TxHash ethcommon.Hash `json:"txHash"`
+	Result callFrame      `json:"result"`
+}
+
+// TraceCallsFrom returns the successful calls made by the address in the block range
+func (c *DebugTraceClient) TraceCallsFrom(
+	ctx context.Context,
+	from ethcommon.Address,
+	startBlock, toBlock uint64,
+) ([]clienttypes.EVMCall, error) {
+	return c.traceBlocks(ctx, startBlock, toBlock, func(call clienttypes.EVMCall) bool {
+		return call.From == from
+	})
+}
+
+// TraceCallsTo returns the successful calls made to the address in the block range
+func (c *DebugTraceClient) TraceCallsTo(
+	ctx context.Context,
+	to ethcommon.Address,
+	startBlock, toBlock uint64,
+) ([]clienttypes.EVMCall, error) {
+	return c.traceBlocks(ctx, startBlock, toBlock, func(call clienttypes.EVMCall) bool {
+		return call.To == to
+	})
+}
+
+// traceBlocks traces the blocks of the range and returns the successful calls matching the filter
+func (c *DebugTraceClient) traceBlocks(
+	ctx context.Context,
+	startBlock, toBlock uint64,
+	match func(clienttypes.EVMCall) bool,
+) ([]clienttypes.EVMCall, error) {
+	var calls []clienttypes.EVMCall
+	for bn := startBlock; bn <= toBlock; bn++ {
+		var block struct {
+			Hash ethcommon.Hash `json:"hash"`
+		}
+		if err := c.rpcClient.CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.Uint64(bn), false); err != nil {
+			return nil, errors.Wrapf(err, "unable to get block %d", bn)
+		}
+
+		var traces []txTrace
+		tracer := map[string]string{"tracer": "callTracer"}
+		if err := c.rpcClient.CallContext(ctx, &traces, "debug_traceBlockByNumber", hexutil.Uint64(bn), tracer); err != nil {
+			return nil, errors.Wrapf(err, "debug_traceBlockByNumber failed for block %d", bn)
+		}
+
+		for _, trace := range traces {
+			if trace.TxHash == (ethcommon.Hash{}) {
+				return nil, fmt.Errorf("tx hash missing from the traces of block %d", bn)
+			}
+			collectCalls(trace.Result, false, func(frame callFrame, internal bool) {
+				call := clienttypes.EVMCall{
+					BlockNumber: bn,
+					BlockHash:   block.Hash,
+					TxHash:      trace.TxHash,
+					From:        frame.From,
+					To:          frame.To,
+					Value:       frame.Value.ToInt(),
+					Input:       frame.Input,
+					Internal:    internal,
+				}
+				if call.Value == nil {
+					call.Value = new(big.Int)
+				}
+				if match(call) {
+					calls = append(calls, call)
+				}
+			})
+		}
+	}
+
+	return calls, nil
+}
+
+// collectCalls walks the call frames and visits the successful value-bearing calls
+// The frames of a reverted call are skipped along with all their sub-calls
+func collectCalls(frame callFrame, internal bool, visit func(callFrame, bool)) {
+	if frame.Error != "" {
+		return
+	}
+
+	// delegate and static calls don't move any value to the callee
+	switch strings.ToUpper(frame.Type) {
+	case "CALL", "SELFDESTRUCT":
+		visit(frame, internal)
+	}
+
+	for _, sub := range frame.Calls {
+		collectCalls(sub, true, visit)
+	}
+}
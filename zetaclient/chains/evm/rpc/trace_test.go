@@ -0,0 +1,159 @@
+package rpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
+)
+
+// newTraceServer creates a JSON RPC server returning the raw JSON result of each method given its first param
+func newTraceServer(t *testing.T, results map[string]func(param string) string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		result, ok := results[req.Method]
+		require.True(t, ok, "unexpected method %s", req.Method)
+
+		res := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  json.RawMessage(result(string(req.Params[0]))),
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	}))
+}
+
+var (
+	traceTSS    = ethcommon.HexToAddress("0x70e967acFcC17c3941E87562161406d41676FD83")
+	traceSender = ethcommon.HexToAddress("0x1111111111111111111111111111111111111111")
+	traceVault  = ethcommon.HexToAddress("0x2222222222222222222222222222222222222222")
+	traceTx1    = ethcommon.HexToHash("0x01")
+	traceTx2    = ethcommon.HexToHash("0x02")
+)
+
+func Test_TraceFilterClient(t *testing.T) {
+	ctx := context.Background()
+
+	// tx1 is a top-level transfer, tx2 has two internal transfers and the second one has a reverted parent
+	traces := `[
+		{"action":{"callType":"call","from":"` + traceSender.Hex() + `","to":"` + traceTSS.Hex() + `","value":"0x64","input":"0x"},
+		 "blockHash":"0x000000000000000000000000000000000000000000000000000000000000000a","blockNumber":10,"transactionHash":"` + traceTx1.Hex() + `","traceAddress":[],"type":"call"},
+		{"action":{"callType":"call","from":"` + traceVault.Hex() + `","to":"` + traceTSS.Hex() + `","value":"0x32","input":"0x"},
+		 "blockHash":"0x000000000000000000000000000000000000000000000000000000000000000b","blockNumber":11,"transactionHash":"` + traceTx2.Hex() + `","traceAddress":[0],"type":"call"},
+		{"action":{"callType":"call","from":"` + traceVault.Hex() + `","to":"` + traceTSS.Hex() + `","value":"0x32","input":"0x"},
+		 "blockHash":"0x000000000000000000000000000000000000000000000000000000000000000b","blockNumber":11,"transactionHash":"` + traceTx2.Hex() + `","traceAddress":[1,0],"type":"call"},
+		{"action":{"callType":"delegatecall","from":"` + traceVault.Hex() + `","to":"` + traceTSS.Hex() + `","value":"0x0","input":"0x"},
+		 "blockHash":"0x000000000000000000000000000000000000000000000000000000000000000b","blockNumber":11,"transactionHash":"` + traceTx2.Hex() + `","traceAddress":[2],"type":"call"},
+		{"action":{"callType":"call","from":"` + traceSender.Hex() + `","to":"` + traceTSS.Hex() + `","value":"0x64","input":"0x"},
+		 "blockHash":"0x000000000000000000000000000000000000000000000000000000000000000c","blockNumber":12,"transactionHash":"0x0000000000000000000000000000000000000000000000000000000000000003","traceAddress":[],"type":"call","error":"Reverted"}
+	]`
+	txTraces := `[
+		{"action":{"callType":"call"},"traceAddress":[],"type":"call"},
+		{"action":{"callType":"call"},"traceAddress":[0],"type":"call"},
+		{"action":{"callType":"call"},"traceAddress":[1],"type":"call","error":"Reverted"},
+		{"action":{"callType":"call"},"traceAddress":[1,0],"type":"call"}
+	]`
+
+	server := newTraceServer(t, map[string]func(string) string{
+		"trace_filter": func(param string) string {
+			require.Contains(t, param, `"toAddress":["`+strings.ToLower(traceTSS.Hex())+`"]`)
+			require.Contains(t, param, `"fromBlock":"0xa"`)
+			return traces
+		},
+		"trace_transaction": func(param string) string {
+			require.Equal(t, `"`+traceTx2.Hex()+`"`, param)
+			return txTraces
+		},
+	})
+	defer server.Close()
+
+	rpcClient, err := ethrpc.DialHTTP(server.URL)
+	require.NoError(t, err)
+	client := rpc.NewTraceFilterClient(rpcClient)
+
+	calls, err := client.TraceCallsTo(ctx, traceTSS, 10, 12)
+	require.NoError(t, err)
+	require.Len(t, calls, 2)
+
+	require.Equal(t, traceTx1, calls[0].TxHash)
+	require.Equal(t, traceSender, calls[0].From)
+	require.EqualValues(t, 100, calls[0].Value.Int64())
+	require.EqualValues(t, 10, calls[0].BlockNumber)
+	require.False(t, calls[0].Internal)
+
+	require.Equal(t, traceTx2, calls[1].TxHash)
+	require.Equal(t, traceVault, calls[1].From)
+	require.EqualValues(t, 50, calls[1].Value.Int64())
+	require.True(t, calls[1].Internal)
+}
+
+func Test_DebugTraceClient(t *testing.T) {
+	ctx := context.Background()
+
+	// tx1 is a top-level transfer, tx2 has an internal transfer and a reverted call with a transfer inside
+	blockTraces := `[
+		{"txHash":"` + traceTx1.Hex() + `","result":{"type":"CALL","from":"` + traceSender.Hex() + `","to":"` + traceTSS.Hex() + `","value":"0x64","input":"0x"}},
+		{"txHash":"` + traceTx2.Hex() + `","result":{"type":"CALL","from":"` + traceSender.Hex() + `","to":"` + traceVault.Hex() + `","value":"0x0","input":"0x01","calls":[
+			{"type":"CALL","from":"` + traceVault.Hex() + `","to":"` + traceTSS.Hex() + `","value":"0x32","input":"0x"},
+			{"type":"DELEGATECALL","from":"` + traceVault.Hex() + `","to":"` + traceTSS.Hex() + `","input":"0x"},
+			{"type":"CALL","from":"` + traceVault.Hex() + `","to":"` + traceSender.Hex() + `","value":"0x0","input":"0x","error":"execution reverted","calls":[
+				{"type":"CALL","from":"` + traceSender.Hex() + `","to":"` + traceTSS.Hex() + `","value":"0x32","input":"0x"}
+			]}
+		]}}
+	]`
+
+	server := newTraceServer(t, map[string]func(string) string{
+		"eth_getBlockByNumber": func(param string) string {
+			require.Equal(t, `"0xa"`, param)
+			return `{"hash":"` + ethcommon.HexToHash("0x0a").Hex() + `"}`
+		},
+		"debug_traceBlockByNumber": func(param string) string {
+			require.Equal(t, `"0xa"`, param)
+			return blockTraces
+		},
+	})
+	defer server.Close()
+
+	rpcClient, err := ethrpc.DialHTTP(server.URL)
+	require.NoError(t, err)
+	client := rpc.NewDebugTraceClient(rpcClient)
+
+	t.Run("should find the calls to an address", func(t *testing.T) {
+		calls, err := client.TraceCallsTo(ctx, traceTSS, 10, 10)
+		require.NoError(t, err)
+		require.Len(t, calls, 2)
+
+		require.Equal(t, traceTx1, calls[0].TxHash)
+		require.Equal(t, ethcommon.HexToHash("0x0a"), calls[0].BlockHash)
+		require.EqualValues(t, 100, calls[0].Value.Int64())
+		require.False(t, calls[0].Internal)
+
+		require.Equal(t, traceTx2, calls[1].TxHash)
+		require.Equal(t, traceVault, calls[1].From)
+		require.EqualValues(t, 50, calls[1].Value.Int64())
+		require.True(t, calls[1].Internal)
+	})
+
+	t.Run("should find the calls from an address", func(t *testing.T) {
+		calls, err := client.TraceCallsFrom(ctx, traceSender, 10, 10)
+		require.NoError(t, err)
+		require.Len(t, calls, 2)
+		require.Equal(t, traceTx1, calls[0].TxHash)
+		require.Equal(t, traceTx2, calls[1].TxHash)
+		require.Equal(t, []byte{0x01}, calls[1].Input)
+	})
+}
@@ -0,0 +1,94 @@
+package signer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+
+	zetarpc "github.com/zeta-chain/node/pkg/rpc"
+	"github.com/zeta-chain/node/zetaclient/chains/evm"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+)
+
+// adaptGas computes fresh fees for the outbound from the fee history of the chain, bounded by the CCTX gas price.
+//
+// The fee history ends at the block of the median gas price vote read from the zetacore state at the keysign height,
+// so all TSS signers query the same blocks and sign the same transaction. An error aborts the outbound rather than
+// falling back to other fees, a signer falling back alone would sign a different transaction
+func (signer *Signer) adaptGas(
+	ctx context.Context,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
+	gas Gas,
+	logger zerolog.Logger,
+) (Gas, error) {
+	strategy := evm.FeeStrategyForChain(signer.Chain().ChainId)
+
+	switch {
+	case strategy.Legacy:
+		return Gas{
+			Limit:       gas.Limit,
+			Price:       gas.Price,
+			PriorityFee: big.NewInt(0),
+		}, nil
+	case gas.isLegacy():
+		// the chain doesn't support EIP-1559
+		return gas, nil
+	}
+
+	anchor, err := signer.feeHistoryAnchor(ctx, zetacoreClient, height)
+	if err != nil {
+		return Gas{}, err
+	}
+
+	history, err := signer.client.FeeHistory(
+		ctx,
+		evm.FeeHistoryBlocks,
+		new(big.Int).SetUint64(anchor),
+		[]float64{strategy.RewardPercentile},
+	)
+	if err != nil {
+		return Gas{}, errors.Wrapf(err, "unable to get fee history at block %d", anchor)
+	}
+
+	maxFee, priorityFee, err := strategy.FeesFromHistory(history, gas.Price)
+	if err != nil {
+		return Gas{}, errors.Wrapf(err, "unable to compute fees at block %d", anchor)
+	}
+
+	logger.Info().
+		Uint64("outbound.fee_history_block", anchor).
+		Str("cctx.gas_price", gas.Price.String()).
+		Str("cctx.priority_fee", gas.PriorityFee.String()).
+		Str("outbound.gas_price", maxFee.String()).
+		Str("outbound.priority_fee", priorityFee.String()).
+		Msg("Computed fees from fee history")
+
+	return Gas{
+		Limit:       gas.Limit,
+		Price:       maxFee,
+		PriorityFee: priorityFee,
+	}, nil
+}
+
+// feeHistoryAnchor returns the last block of the fee history used to compute the outbound fees,
+// which is the block of the median gas price vote at the keysign height
+func (signer *Signer) feeHistoryAnchor(
+	ctx context.Context,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
+) (uint64, error) {
+	chainID := signer.Chain().ChainId
+
+	gasPrice, err := zetacoreClient.GetGasPrice(zetarpc.ContextWithHeight(ctx, height), chainID)
+	switch {
+	case err != nil:
+		return 0, errors.Wrapf(err, "unable to get gas price of chain %d at height %d", chainID, height)
+	case gasPrice.MedianIndex >= uint64(len(gasPrice.BlockNums)):
+		return 0, errors.Errorf("invalid median index %d of chain %d at height %d", gasPrice.MedianIndex, chainID, height)
+	}
+
+	return gasPrice.BlockNums[gasPrice.MedianIndex], nil
+}
@@ -0,0 +1,108 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zeta-chain/node/pkg/chains"
+	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/base"
+	"github.com/zeta-chain/node/zetaclient/testutils"
+	"github.com/zeta-chain/node/zetaclient/testutils/mocks"
+)
+
+func TestSigner_adaptGas(t *testing.T) {
+	ctx := context.Background()
+	logger := zerolog.New(zerolog.NewTestWriter(t))
+	height := uint64(1000)
+	cctxGas := Gas{Limit: 100_000, Price: gwei(30), PriorityFee: gwei(1)}
+
+	// atHeight matches the contexts querying the zetacore state at the keysign height
+	atHeight := func(ctx context.Context) bool {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		return ok && slices.Equal(md.Get(grpctypes.GRPCBlockHeightHeader), []string{"1000"})
+	}
+
+	// newSigner returns an Ethereum signer with a mocked EVM client
+	newSigner := func(t *testing.T) (*Signer, *mocks.EVMRPCClient) {
+		signer, err := NewSigner(
+			ctx,
+			chains.Ethereum,
+			mocks.NewTSSMainnet(),
+			nil,
+			base.Logger{},
+			testutils.MockEVMRPCEndpoint,
+			ConnectorAddress,
+			ERC20CustodyAddress,
+			sample.EthAddress(),
+		)
+		require.NoError(t, err)
+		client := mocks.NewEVMRPCClient(t)
+		signer.WithEvmClient(client)
+		return signer, client
+	}
+
+	// newZetacoreClient returns a zetacore client with the median gas price voted at block 200
+	newZetacoreClient := func(t *testing.T) *mocks.ZetacoreClient {
+		client := mocks.NewZetacoreClient(t)
+		client.On("GetGasPrice", mock.MatchedBy(atHeight), chains.Ethereum.ChainId).Return(crosschaintypes.GasPrice{
+			BlockNums:   []uint64{190, 200, 210},
+			Prices:      []uint64{10, 20, 30},
+			MedianIndex: 1,
+		}, nil)
+		return client
+	}
+
+	t.Run("should use the legacy pricing of BSC", func(t *testing.T) {
+		signer, err := getNewEvmSigner(nil)
+		require.NoError(t, err)
+
+		gas, err := signer.adaptGas(ctx, mocks.NewZetacoreClient(t), height, cctxGas, logger)
+		require.NoError(t, err)
+		require.True(t, gas.isLegacy())
+		assertGasEquals(t, Gas{Limit: 100_000, Price: gwei(30), PriorityFee: gwei(0)}, gas)
+	})
+
+	t.Run("should compute the fees from the fee history ending at the median vote block", func(t *testing.T) {
+		signer, client := newSigner(t)
+		client.On("FeeHistory", mock.Anything, uint64(10), big.NewInt(200), []float64{50}).Return(&ethereum.FeeHistory{
+			Reward:  [][]*big.Int{{gwei(1)}, {gwei(3)}, {gwei(2)}},
+			BaseFee: []*big.Int{gwei(5), gwei(5), gwei(5), gwei(10)},
+		}, nil)
+
+		gas, err := signer.adaptGas(ctx, newZetacoreClient(t), height, cctxGas, logger)
+		require.NoError(t, err)
+		require.NoError(t, gas.validate())
+		assertGasEquals(t, Gas{Limit: 100_000, Price: gwei(22), PriorityFee: gwei(2)}, gas)
+	})
+
+	t.Run("should error if the fee history is unavailable", func(t *testing.T) {
+		signer, client := newSigner(t)
+		client.On("FeeHistory", mock.Anything, uint64(10), big.NewInt(200), []float64{50}).
+			Return(nil, errors.New("rpc error"))
+
+		_, err := signer.adaptGas(ctx, newZetacoreClient(t), height, cctxGas, logger)
+		require.ErrorContains(t, err, "unable to get fee history at block 200")
+	})
+
+	t.Run("should error if the gas price has no vote", func(t *testing.T) {
+		signer, _ := newSigner(t)
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.On("GetGasPrice", mock.MatchedBy(atHeight), chains.Ethereum.ChainId).
+			Return(crosschaintypes.GasPrice{}, nil)
+
+		_, err := signer.adaptGas(ctx, zetacoreClient, height, cctxGas, logger)
+		require.ErrorContains(t, err, "invalid median index")
+	})
+}
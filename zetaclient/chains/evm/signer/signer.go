@@ -288,6 +288,13 @@ func (signer *Signer) TryProcessOutbound(
 		return
 	}
 
+	// the CCTX fees can be minutes old, compute fresh ones at signing time
+	txData.gas, err = signer.adaptGas(ctx, zetacoreClient, height, txData.gas, logger)
+	if err != nil {
+		logger.Error().Err(err).Msg("error computing outbound fees")
+		return
+	}
+
 	toChain, err := app.GetChain(txData.toChainID.Int64())
 	switch {
 	case err != nil:
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -27,6 +28,7 @@ import (
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	keyinterfaces "github.com/zeta-chain/node/zetaclient/keys/interfaces"
 	"github.com/zeta-chain/node/zetaclient/outboundprocessor"
+	clienttypes "github.com/zeta-chain/node/zetaclient/types"
 )
 
 type Order string
@@ -122,6 +124,7 @@ type ZetacoreClient interface {
 	GetPendingNoncesByChain(ctx context.Context, chainID int64) (observertypes.PendingNonces, error)
 
 	GetCctxByNonce(ctx context.Context, chainID int64, nonce uint64) (*crosschaintypes.CrossChainTx, error)
+	GetGasPrice(ctx context.Context, chainID int64) (crosschaintypes.GasPrice, error)
 	GetOutboundTracker(ctx context.Context, chain chains.Chain, nonce uint64) (*crosschaintypes.OutboundTracker, error)
 	GetAllOutboundTrackerByChain(
 		ctx context.Context,
@@ -131,6 +134,7 @@ type ZetacoreClient interface {
 	GetCrosschainFlags(ctx context.Context) (observertypes.CrosschainFlags, error)
 	GetRateLimiterFlags(ctx context.Context) (crosschaintypes.RateLimiterFlags, error)
 	GetObserverList(ctx context.Context) ([]string, error)
+	GetObserverLiveness(ctx context.Context, observerAddress string) (observertypes.ObserverLivenessInfo, error)
 	GetBTCTSSAddress(ctx context.Context, chainID int64) (string, error)
 	GetZetaHotKeyBalance(ctx context.Context) (sdkmath.Int, error)
 	GetInboundTrackersForChain(ctx context.Context, chainID int64) ([]crosschaintypes.InboundTracker, error)
@@ -164,6 +168,7 @@ type BTCRPCClient interface {
 	GetTransaction(txHash *chainhash.Hash) (*btcjson.GetTransactionResult, error)
 	GetRawTransaction(txHash *chainhash.Hash) (*btcutil.Tx, error)
 	GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error)
+	GetRawMempool() ([]*chainhash.Hash, error)
 	GetBlockCount() (int64, error)
 	GetBlockHash(blockHeight int64) (*chainhash.Hash, error)
 	GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error)
@@ -176,6 +181,12 @@ type EVMRPCClient interface {
 	bind.ContractBackend
 	SendTransaction(ctx context.Context, tx *ethtypes.Transaction) error
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	FeeHistory(
+		ctx context.Context,
+		blockCount uint64,
+		lastBlock *big.Int,
+		rewardPercentiles []float64,
+	) (*ethereum.FeeHistory, error)
 	BlockNumber(ctx context.Context) (uint64, error)
 	BlockByNumber(ctx context.Context, number *big.Int) (*ethtypes.Block, error)
 	HeaderByNumber(ctx context.Context, number *big.Int) (*ethtypes.Header, error)
@@ -189,6 +200,19 @@ type EVMRPCClient interface {
 	) (ethcommon.Address, error)
 }
 
+// EVMSubscriber is the interface for EVM WebSocket client subscribing to new heads and logs
+type EVMSubscriber interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *ethtypes.Header) (ethereum.Subscription, error)
+	SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- ethtypes.Log) (ethereum.Subscription, error)
+}
+
+// EVMCallTracer is the interface for EVM client finding the successful calls from or to an address in a block range,
+// including the internal calls made by contracts, through call traces or an address activity indexer
+type EVMCallTracer interface {
+	TraceCallsFrom(ctx context.Context, from ethcommon.Address, startBlock, toBlock uint64) ([]clienttypes.EVMCall, error)
+	TraceCallsTo(ctx context.Context, to ethcommon.Address, startBlock, toBlock uint64) ([]clienttypes.EVMCall, error)
+}
+
 // SolanaRPCClient is the interface for Solana RPC client
 type SolanaRPCClient interface {
 	GetVersion(ctx context.Context) (*solrpc.GetVersionResult, error)
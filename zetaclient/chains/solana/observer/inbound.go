@@ -73,6 +73,16 @@ func (ob *Observer) ObserveInbound(ctx context.Context) error {
 	chainID := ob.Chain().ChainId
 	pageLimit := solanarpc.DefaultPageLimit
 
+	// get and update latest finalized slot, the confirmations of the inbounds are counted in slots
+	slot, err := ob.solClient.GetSlot(ctx, rpc.CommitmentFinalized)
+	if err != nil {
+		return errors.Wrapf(err, "error GetSlot for chain %d", chainID)
+	}
+	ob.WithLastBlock(slot)
+
+	// post the votes of the pending inbounds whose amount tier is met
+	ob.ProcessPendingInbounds(ctx)
+
 	// scan from gateway 1st signature if last scanned tx is absent in the database
 	// the 1st gateway signature is typically the program initialization
 	if ob.LastTxScanned() == "" {
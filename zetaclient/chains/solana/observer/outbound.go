@@ -160,6 +160,11 @@ func (ob *Observer) VoteOutboundIfConfirmed(ctx context.Context, cctx *crosschai
 	// status was already verified as successful in CheckFinalizedTx
 	outboundStatus := chains.ReceiveStatus_success
 
+	// the gateway nonce is incremented without execution when the destination program fails, the outbound is reverted
+	if _, ok := inst.(*contracts.IncrementNonceInstructionParams); ok {
+		outboundStatus = chains.ReceiveStatus_failed
+	}
+
 	// compliance check, special handling the cancelled cctx
 	if compliance.IsCctxRestricted(cctx) {
 		// use cctx's amount to bypass the amount check in zetacore
@@ -331,11 +336,23 @@ func ParseGatewayInstruction(
 		return nil, errors.Wrap(err, "error unmarshaling transaction")
 	}
 
+	// the transaction signed with a durable nonce starts with the instruction advancing the nonce
+	instructions := tx.Message.Instructions
+	if len(instructions) == 2 {
+		programID, err := tx.Message.Program(instructions[0].ProgramIDIndex)
+		if err != nil {
+			return nil, errors.Wrap(err, "error getting program ID")
+		}
+		if programID.Equals(solana.SystemProgramID) {
+			instructions = instructions[1:]
+		}
+	}
+
 	// there should be only one single instruction ('withdraw' or 'withdraw_spl_token')
-	if len(tx.Message.Instructions) != 1 {
-		return nil, fmt.Errorf("want 1 instruction, got %d", len(tx.Message.Instructions))
+	if len(instructions) != 1 {
+		return nil, fmt.Errorf("want 1 instruction, got %d", len(instructions))
 	}
-	instruction := tx.Message.Instructions[0]
+	instruction := instructions[0]
 
 	// get the program ID
 	programID, err := tx.Message.Program(instruction.ProgramIDIndex)
@@ -348,11 +365,30 @@ func ParseGatewayInstruction(
 		return nil, fmt.Errorf("programID %s is not matching gatewayID %s", programID, gatewayID)
 	}
 
-	// parse the instruction as a 'withdraw' or 'withdraw_spl_token'
+	// parse the instruction as a 'withdraw', 'execute' or 'increment_nonce'
 	switch coinType {
-	case coin.CoinType_Gas:
-		return contracts.ParseInstructionWithdraw(instruction)
+	case coin.CoinType_Gas, coin.CoinType_NoAssetCall:
+		return parseGasInstruction(instruction)
 	default:
 		return nil, fmt.Errorf("unsupported outbound coin type %s", coinType)
 	}
 }
+
+// parseGasInstruction parses the instruction of a gas token or no-asset call outbound by its discriminator
+func parseGasInstruction(instruction solana.CompiledInstruction) (contracts.OutboundInstruction, error) {
+	if len(instruction.Data) < 8 {
+		return nil, fmt.Errorf("instruction data too short: %d bytes", len(instruction.Data))
+	}
+
+	var discriminator [8]byte
+	copy(discriminator[:], instruction.Data[:8])
+
+	switch discriminator {
+	case contracts.DiscriminatorExecute():
+		return contracts.ParseInstructionExecute(instruction)
+	case contracts.DiscriminatorIncrementNonce():
+		return contracts.ParseInstructionIncrementNonce(instruction)
+	default:
+		return contracts.ParseInstructionWithdraw(instruction)
+	}
+}
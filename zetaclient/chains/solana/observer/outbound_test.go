@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/gagliardetto/solana-go"
+	"github.com/near/borsh-go"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -171,6 +172,44 @@ func Test_ParseGatewayInstruction(t *testing.T) {
 		require.EqualValues(t, inst.TokenAmount(), txAmount)
 	})
 
+	t.Run("should skip the instruction advancing the durable nonce", func(t *testing.T) {
+		// load and unmarshal archived transaction
+		txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, txHash)
+		tx, err := txResult.Transaction.GetTransaction()
+		require.NoError(t, err)
+
+		// prepend a system program instruction
+		tx.Message.AccountKeys = append(tx.Message.AccountKeys, solana.SystemProgramID)
+		advanceNonce := solana.CompiledInstruction{ProgramIDIndex: uint16(len(tx.Message.AccountKeys) - 1)}
+		tx.Message.Instructions = append([]solana.CompiledInstruction{advanceNonce}, tx.Message.Instructions...)
+
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_Gas)
+		require.NoError(t, err)
+		require.EqualValues(t, txAmount, inst.TokenAmount())
+	})
+
+	t.Run("should parse instruction increment_nonce", func(t *testing.T) {
+		// load and unmarshal archived transaction
+		txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, txHash)
+		tx, err := txResult.Transaction.GetTransaction()
+		require.NoError(t, err)
+
+		// replace the withdraw with an increment_nonce
+		data, err := borsh.Serialize(contracts.IncrementNonceInstructionParams{
+			Discriminator: contracts.DiscriminatorIncrementNonce(),
+			Amount:        txAmount,
+			Nonce:         7,
+		})
+		require.NoError(t, err)
+		tx.Message.Instructions[0].Data = data
+
+		inst, err := observer.ParseGatewayInstruction(txResult, gatewayID, coin.CoinType_NoAssetCall)
+		require.NoError(t, err)
+		require.IsType(t, &contracts.IncrementNonceInstructionParams{}, inst)
+		require.EqualValues(t, 7, inst.GatewayNonce())
+		require.EqualValues(t, txAmount, inst.TokenAmount())
+	})
+
 	t.Run("should return error on invalid number of instructions", func(t *testing.T) {
 		// load and unmarshal archived transaction
 		txResult := testutils.LoadSolanaOutboundTxResult(t, TestDataDir, chain.ChainId, txHash)
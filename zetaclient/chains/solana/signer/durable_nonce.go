@@ -0,0 +1,113 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/near/borsh-go"
+)
+
+// nonceAccountInitialized is the state of an initialized nonce account
+const nonceAccountInitialized = 1
+
+// nonceAccount is the data of a system program nonce account
+type nonceAccount struct {
+	Version              uint32
+	State                uint32
+	Authority            solana.PublicKey
+	Nonce                solana.Hash
+	LamportsPerSignature uint64
+}
+
+// WithNonceAccount makes the signer use the given durable nonce account instead of a recent blockhash.
+// The nonce account must be owned by the relayer key
+func (signer *Signer) WithNonceAccount(account solana.PublicKey) {
+	signer.Lock()
+	defer signer.Unlock()
+	signer.nonceAccount = account
+}
+
+// NonceAccount returns the durable nonce account, it is zero if the signer uses recent blockhashes
+func (signer *Signer) NonceAccount() solana.PublicKey {
+	signer.Lock()
+	defer signer.Unlock()
+	return signer.nonceAccount
+}
+
+// UsesDurableNonce returns true if the signer uses a durable nonce account
+func (signer *Signer) UsesDurableNonce() bool {
+	return !signer.NonceAccount().IsZero()
+}
+
+// GetDurableNonce returns the current nonce value stored in the durable nonce account
+func (signer *Signer) GetDurableNonce(ctx context.Context) (solana.Hash, error) {
+	account := signer.NonceAccount()
+
+	info, err := signer.client.GetAccountInfo(ctx, account)
+	if err != nil {
+		return solana.Hash{}, errors.Wrapf(err, "GetAccountInfo error for nonce account %s", account)
+	}
+
+	var nonce nonceAccount
+	if err := borsh.Deserialize(&nonce, info.Bytes()); err != nil {
+		return solana.Hash{}, errors.Wrapf(err, "unable to deserialize nonce account %s", account)
+	}
+
+	switch {
+	case nonce.State != nonceAccountInitialized:
+		return solana.Hash{}, fmt.Errorf("nonce account %s is not initialized", account)
+	case !nonce.Authority.Equals(signer.relayerKey.PublicKey()):
+		return solana.Hash{}, fmt.Errorf(
+			"nonce account %s is not owned by relayer %s",
+			account,
+			signer.relayerKey.PublicKey(),
+		)
+	}
+
+	return nonce.Nonce, nil
+}
+
+// advanceNonceInstruction returns the instruction advancing the durable nonce,
+// it must be the first instruction of a transaction signed with the durable nonce
+func (signer *Signer) advanceNonceInstruction() solana.Instruction {
+	return system.NewAdvanceNonceAccountInstruction(
+		signer.NonceAccount(),
+		solana.SysVarRecentBlockHashesPubkey,
+		signer.relayerKey.PublicKey(),
+	).Build()
+}
+
+// signedTx is a transaction signed with the durable nonce for a gateway nonce
+type signedTx struct {
+	nonce uint64
+	tx    *solana.Transaction
+}
+
+// getSignedTx returns the transaction signed for the TSS message if it still uses the given durable nonce.
+// A transaction signed with the current durable nonce stays valid until used, so it's safe to rebroadcast it
+func (signer *Signer) getSignedTx(msgHash [32]byte, durableNonce solana.Hash) (*solana.Transaction, bool) {
+	signer.Lock()
+	defer signer.Unlock()
+
+	signed, found := signer.signedTxs[msgHash]
+	if !found || !signed.tx.Message.RecentBlockhash.Equals(durableNonce) {
+		return nil, false
+	}
+	return signed.tx, true
+}
+
+// addSignedTx keeps the transaction signed for the TSS message and forgets the ones of the processed nonces
+func (signer *Signer) addSignedTx(nonce uint64, msgHash [32]byte, tx *solana.Transaction) {
+	signer.Lock()
+	defer signer.Unlock()
+
+	for hash, signed := range signer.signedTxs {
+		if signed.nonce < nonce {
+			delete(signer.signedTxs, hash)
+		}
+	}
+	signer.signedTxs[msgHash] = signedTx{nonce: nonce, tx: tx}
+}
@@ -0,0 +1,247 @@
+package signer
+
+import (
+	"context"
+	"encoding/hex"
+
+	"cosmossdk.io/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/near/borsh-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
+	"github.com/zeta-chain/node/x/crosschain/types"
+	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
+)
+
+// IsExecuteOutbound returns true if the CCTX calls a Solana program through the gateway execute instruction
+func IsExecuteOutbound(cctx *types.CrossChainTx) bool {
+	return cctx.ProtocolContractVersion == types.ProtocolContractVersion_V2 &&
+		cctx.CctxStatus.Status == types.CctxStatus_PendingOutbound &&
+		cctx.RelayedMessage != ""
+}
+
+// SignMsgExecute signs an execute message (for gateway execute instruction) with TSS.
+func (signer *Signer) SignMsgExecute(
+	ctx context.Context,
+	cctx *types.CrossChainTx,
+	height uint64,
+) (*contracts.MsgExecute, error) {
+	chain := signer.Chain()
+	params := cctx.GetCurrentOutboundParam()
+	// #nosec G115 always positive
+	chainID := uint64(signer.Chain().ChainId)
+	nonce := params.TssNonce
+	amount := params.Amount.Uint64()
+
+	// check destination program address
+	to, err := solana.PublicKeyFromBase58(params.Receiver)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot decode destination program address %s", params.Receiver)
+	}
+
+	// decode the program payload and the remaining accounts from the hex encoded message
+	message, err := hex.DecodeString(cctx.RelayedMessage)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot decode relayed message %s", cctx.RelayedMessage)
+	}
+	executeMsg, err := contracts.DecodeExecuteMsg(message)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decode execute message")
+	}
+
+	// prepare execute msg and compute hash
+	sender := ethcommon.HexToAddress(cctx.InboundParams.Sender)
+	msg := contracts.NewMsgExecute(chainID, nonce, amount, to, sender, executeMsg.Data, executeMsg.AccountMetas())
+	msgHash := msg.Hash()
+
+	// sign the message with TSS to get an ECDSA signature.
+	// the produced signature is in the [R || S || V] format where V is 0 or 1.
+	signature, err := signer.TSS().Sign(ctx, msgHash[:], height, nonce, chain.ChainId, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "Key-sign failed")
+	}
+	signer.Logger().Std.Info().Msgf("Key-sign succeed for execute on chain %d nonce %d", chainID, nonce)
+
+	// attach the signature and return
+	return msg.SetSignature(signature), nil
+}
+
+// SignMsgIncrementNonce signs an increment_nonce message (for gateway increment_nonce instruction) with TSS.
+// The increment_nonce transaction is relayed instead of the execute once the execute failed on-chain.
+func (signer *Signer) SignMsgIncrementNonce(
+	ctx context.Context,
+	params *types.OutboundParams,
+	height uint64,
+) (*contracts.MsgIncrementNonce, error) {
+	chain := signer.Chain()
+	// #nosec G115 always positive
+	chainID := uint64(signer.Chain().ChainId)
+	nonce := params.TssNonce
+	amount := params.Amount.Uint64()
+
+	// prepare increment_nonce msg and compute hash
+	msg := contracts.NewMsgIncrementNonce(chainID, nonce, amount)
+	msgHash := msg.Hash()
+
+	// sign the message with TSS to get an ECDSA signature.
+	// the produced signature is in the [R || S || V] format where V is 0 or 1.
+	signature, err := signer.TSS().Sign(ctx, msgHash[:], height, nonce, chain.ChainId, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "Key-sign failed")
+	}
+	signer.Logger().Std.Info().Msgf("Key-sign succeed for increment_nonce on chain %d nonce %d", chainID, nonce)
+
+	// attach the signature and return
+	return msg.SetSignature(signature), nil
+}
+
+// SignExecuteTx wraps the execute 'msg' into a Solana transaction and signs it with the relayer key.
+func (signer *Signer) SignExecuteTx(ctx context.Context, msg contracts.MsgExecute) (*solana.Transaction, error) {
+	// create execute instruction with program call data
+	var err error
+	var inst solana.GenericInstruction
+	inst.DataBytes, err = borsh.Serialize(contracts.ExecuteInstructionParams{
+		Discriminator: contracts.DiscriminatorExecute(),
+		Amount:        msg.Amount(),
+		Sender:        msg.Sender(),
+		Data:          msg.Data(),
+		Signature:     msg.SigRS(),
+		RecoveryID:    msg.SigV(),
+		MessageHash:   msg.Hash(),
+		Nonce:         msg.Nonce(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot serialize execute instruction")
+	}
+
+	// attach required accounts to the instruction
+	privkey := signer.relayerKey
+	attachExecuteAccounts(&inst, privkey.PublicKey(), signer.pda, msg.To(), msg.RemainingAccounts(), signer.gatewayID)
+
+	return signer.signGatewayTx(ctx, &inst, msg.Nonce(), msg.Hash())
+}
+
+// SignIncrementNonceTx wraps the increment_nonce 'msg' into a Solana transaction and signs it with the relayer key.
+func (signer *Signer) SignIncrementNonceTx(
+	ctx context.Context,
+	msg contracts.MsgIncrementNonce,
+) (*solana.Transaction, error) {
+	// create increment_nonce instruction with program call data
+	var err error
+	var inst solana.GenericInstruction
+	inst.DataBytes, err = borsh.Serialize(contracts.IncrementNonceInstructionParams{
+		Discriminator: contracts.DiscriminatorIncrementNonce(),
+		Amount:        msg.Amount(),
+		Signature:     msg.SigRS(),
+		RecoveryID:    msg.SigV(),
+		MessageHash:   msg.Hash(),
+		Nonce:         msg.Nonce(),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot serialize increment_nonce instruction")
+	}
+
+	// attach required accounts to the instruction
+	privkey := signer.relayerKey
+	inst.ProgID = signer.gatewayID
+	inst.AccountValues = []*solana.AccountMeta{
+		solana.Meta(privkey.PublicKey()).WRITE().SIGNER(),
+		solana.Meta(signer.pda).WRITE(),
+	}
+
+	return signer.signGatewayTx(ctx, &inst, msg.Nonce(), msg.Hash())
+}
+
+// IsExecuteFailed returns true if an execute transaction of the outbound nonce failed on-chain.
+// The failed execute transactions are reported to the outbound tracker, so all the signers find the same failure
+// and sign the increment_nonce message to revert the outbound.
+func (signer *Signer) IsExecuteFailed(
+	ctx context.Context,
+	zetacoreClient interfaces.ZetacoreClient,
+	nonce uint64,
+) (bool, error) {
+	tracker, err := zetacoreClient.GetOutboundTracker(ctx, signer.Chain(), nonce)
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return false, nil
+	case err != nil:
+		return false, errors.Wrap(err, "GetOutboundTracker error")
+	}
+
+	for _, hash := range tracker.HashList {
+		txSig, err := solana.SignatureFromBase58(hash.TxHash)
+		if err != nil {
+			signer.Logger().Std.Warn().Err(err).Msgf("IsExecuteFailed: invalid tracker hash %s", hash.TxHash)
+			continue
+		}
+
+		// the failure must be finalized so that it can't be rolled back
+		txResult, err := signer.client.GetTransaction(ctx, txSig, &rpc.GetTransactionOpts{
+			Commitment: rpc.CommitmentFinalized,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "GetTransaction error for tx %s", hash.TxHash)
+		}
+		if txResult.Meta == nil || txResult.Meta.Err == nil {
+			continue
+		}
+
+		if signer.isExecuteTx(txResult, nonce) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isExecuteTx returns true if the transaction calls the gateway execute instruction of the nonce signed by TSS
+func (signer *Signer) isExecuteTx(txResult *rpc.GetTransactionResult, nonce uint64) bool {
+	tx, err := txResult.Transaction.GetTransaction()
+	if err != nil {
+		return false
+	}
+
+	for _, instruction := range tx.Message.Instructions {
+		programID, err := tx.Message.Program(instruction.ProgramIDIndex)
+		if err != nil || !programID.Equals(signer.gatewayID) {
+			continue
+		}
+
+		inst, err := contracts.ParseInstructionExecute(instruction)
+		if err != nil || inst.GatewayNonce() != nonce {
+			continue
+		}
+
+		signerECDSA, err := inst.Signer()
+		if err == nil && signerECDSA == signer.TSS().EVMAddress() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attachExecuteAccounts attaches the required accounts for the gateway execute instruction,
+// followed by the remaining accounts passed to the destination program.
+func attachExecuteAccounts(
+	inst *solana.GenericInstruction,
+	signer solana.PublicKey,
+	pda solana.PublicKey,
+	to solana.PublicKey,
+	remainingAccounts []*solana.AccountMeta,
+	gatewayID solana.PublicKey,
+) {
+	// attach required accounts to the instruction
+	var accountSlice []*solana.AccountMeta
+	accountSlice = append(accountSlice, solana.Meta(signer).WRITE().SIGNER())
+	accountSlice = append(accountSlice, solana.Meta(pda).WRITE())
+	accountSlice = append(accountSlice, solana.Meta(to).WRITE())
+	accountSlice = append(accountSlice, remainingAccounts...)
+	inst.ProgID = gatewayID
+
+	inst.AccountValues = accountSlice
+}
@@ -17,16 +17,22 @@ const (
 	// SolanaTransactionTimeout is the timeout for waiting for an outbound to be confirmed
 	// Transaction referencing a blockhash older than 150 blocks will expire and be rejected by Solana.
 	SolanaTransactionTimeout = 2 * time.Minute
+
+	// SolanaDurableNonceTransactionTimeout is the timeout for waiting for an outbound signed with a durable nonce.
+	// The transaction doesn't expire until the nonce is advanced, so it is tracked for longer.
+	SolanaDurableNonceTransactionTimeout = 20 * time.Minute
 )
 
 // reportToOutboundTracker launch a go routine with timeout to check for tx confirmation;
 // it reports tx to outbound tracker only if it's confirmed by the Solana network.
+// A failed execute tx is reported as well when 'reportFailed' is set, so that the signers revert the outbound.
 func (signer *Signer) reportToOutboundTracker(
 	ctx context.Context,
 	zetacoreClient interfaces.ZetacoreClient,
 	chainID int64,
 	nonce uint64,
 	txSig solana.Signature,
+	reportFailed bool,
 	logger zerolog.Logger,
 ) {
 	// prepare logger
@@ -50,13 +56,18 @@ func (signer *Signer) reportToOutboundTracker(
 			signer.Signer.ClearBeingReportedFlag(txSig.String())
 		}()
 
+		timeout := SolanaTransactionTimeout
+		if signer.UsesDurableNonce() {
+			timeout = SolanaDurableNonceTransactionTimeout
+		}
+
 		start := time.Now()
 		for {
 			// Solana block time is 0.4~0.8 seconds; wait 5 seconds between each check
 			time.Sleep(5 * time.Second)
 
 			// give up if we know the tx is too old and already expired
-			if time.Since(start) > SolanaTransactionTimeout {
+			if time.Since(start) > timeout {
 				logger.Info().Msg("outbound is expired")
 				return nil
 			}
@@ -72,13 +83,16 @@ func (signer *Signer) reportToOutboundTracker(
 			}
 
 			// exit goroutine if tx failed.
-			if tx.Meta.Err != nil {
+			if tx.Meta.Err != nil && !reportFailed {
 				// unlike Ethereum, Solana doesn't have protocol-level nonce; the nonce is enforced by the gateway program.
 				// a failed outbound (e.g. signature err, balance err) will never be able to increment the gateway program nonce.
 				// a good/valid candidate of outbound tracker hash must come with a successful tx.
 				logger.Warn().Any("Err", tx.Meta.Err).Msg("outbound is failed")
 				return nil
 			}
+			if tx.Meta.Err != nil {
+				logger.Warn().Any("Err", tx.Meta.Err).Msg("execute is failed, reporting it to revert the outbound")
+			}
 
 			// report outbound hash to zetacore
 			zetaHash, err := zetacoreClient.AddOutboundTracker(ctx, chainID, nonce, txSig.String(), nil, "", -1)
@@ -7,6 +7,7 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/rs/zerolog"
 
 	"github.com/zeta-chain/node/pkg/chains"
 	"github.com/zeta-chain/node/pkg/coin"
@@ -40,6 +41,13 @@ type Signer struct {
 
 	// pda is the program derived address of the gateway program
 	pda solana.PublicKey
+
+	// nonceAccount is the durable nonce account owned by the relayer key
+	// nonceAccount is optional, the signer uses recent blockhashes if it is not set
+	nonceAccount solana.PublicKey
+
+	// signedTxs are the transactions signed with the durable nonce, indexed by TSS message hash
+	signedTxs map[[32]byte]signedTx
 }
 
 // NewSigner creates a new Solana signer
@@ -67,6 +75,7 @@ func NewSigner(
 		client:    solClient,
 		gatewayID: gatewayID,
 		pda:       pda,
+		signedTxs: make(map[[32]byte]signedTx),
 	}
 
 	// construct Solana private key if present
@@ -117,13 +126,14 @@ func (signer *Signer) TryProcessOutbound(
 		Str("cctx", cctx.Index).
 		Logger()
 
-	// support gas token only for Solana outbound
+	// support gas token and no-asset call only for Solana outbound
 	chainID := signer.Chain().ChainId
 	nonce := params.TssNonce
 	coinType := cctx.InboundParams.CoinType
-	if coinType != coin.CoinType_Gas {
+	isExecute := IsExecuteOutbound(cctx)
+	if coinType != coin.CoinType_Gas && !(coinType == coin.CoinType_NoAssetCall && isExecute) {
 		logger.Error().
-			Msgf("TryProcessOutbound: can only send SOL to the Solana network for chain %d nonce %d", chainID, nonce)
+			Msgf("TryProcessOutbound: can only send SOL or call programs on Solana for chain %d nonce %d", chainID, nonce)
 		return
 	}
 
@@ -142,25 +152,17 @@ func (signer *Signer) TryProcessOutbound(
 		)
 	}
 
-	// sign gateway withdraw message by TSS
-	msg, err := signer.SignMsgWithdraw(ctx, params, height, cancelTx)
-	if err != nil {
-		logger.Error().Err(err).Msgf("TryProcessOutbound: SignMsgWithdraw error for chain %d nonce %d", chainID, nonce)
-		return
+	// sign the outbound by TSS and relayer key, a cancelled call is a withdrawal of 0 lamports
+	var (
+		tx        *solana.Transaction
+		executeTx bool
+	)
+	if isExecute && !cancelTx {
+		tx, executeTx = signer.signExecuteOutbound(ctx, cctx, zetacoreClient, height, logger)
+	} else {
+		tx = signer.signWithdrawOutbound(ctx, params, height, cancelTx, logger)
 	}
-
-	// skip relaying the transaction if this signer hasn't set the relayer key
-	if !signer.HasRelayerKey() {
-		return
-	}
-
-	// set relayer balance metrics
-	signer.SetRelayerBalanceMetrics(ctx)
-
-	// sign the withdraw transaction by relayer key
-	tx, err := signer.SignWithdrawTx(ctx, *msg)
-	if err != nil {
-		logger.Error().Err(err).Msgf("TryProcessOutbound: SignGasWithdraw error for chain %d nonce %d", chainID, nonce)
+	if tx == nil {
 		return
 	}
 
@@ -173,7 +175,9 @@ func (signer *Signer) TryProcessOutbound(
 		// Commitment "processed" will simulate tx against more recent state
 		// thus fails faster once a tx is already broadcasted and processed by the cluster.
 		// This reduces the number of "failed" txs due to repeated broadcast attempts.
-		rpc.TransactionOpts{PreflightCommitment: rpc.CommitmentProcessed},
+		// The preflight check is skipped for the execute so that a failing destination program fails on-chain,
+		// the signers revert the outbound only after the failure is finalized.
+		rpc.TransactionOpts{SkipPreflight: executeTx, PreflightCommitment: rpc.CommitmentProcessed},
 	)
 	if err != nil {
 		signer.Logger().
@@ -184,7 +188,197 @@ func (signer *Signer) TryProcessOutbound(
 	}
 
 	// report the outbound to the outbound tracker
-	signer.reportToOutboundTracker(ctx, zetacoreClient, chainID, nonce, txSig, logger)
+	signer.reportToOutboundTracker(ctx, zetacoreClient, chainID, nonce, txSig, executeTx, logger)
+}
+
+// signWithdrawOutbound signs the withdraw message by TSS and the withdraw transaction by relayer key.
+// It returns nil if the transaction can't be signed or if the signer doesn't relay transactions.
+func (signer *Signer) signWithdrawOutbound(
+	ctx context.Context,
+	params *types.OutboundParams,
+	height uint64,
+	cancelTx bool,
+	logger zerolog.Logger,
+) *solana.Transaction {
+	chainID := signer.Chain().ChainId
+	nonce := params.TssNonce
+
+	// sign gateway withdraw message by TSS
+	msg, err := signer.SignMsgWithdraw(ctx, params, height, cancelTx)
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: SignMsgWithdraw error for chain %d nonce %d", chainID, nonce)
+		return nil
+	}
+
+	// skip relaying the transaction if this signer hasn't set the relayer key
+	if !signer.HasRelayerKey() {
+		return nil
+	}
+
+	// set relayer balance metrics
+	signer.SetRelayerBalanceMetrics(ctx)
+
+	// sign the withdraw transaction by relayer key
+	tx, err := signer.SignWithdrawTx(ctx, *msg)
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: SignGasWithdraw error for chain %d nonce %d", chainID, nonce)
+		return nil
+	}
+
+	return tx
+}
+
+// signExecuteOutbound signs the execute message by TSS and the execute transaction by relayer key.
+// Once an execute transaction of the outbound failed on-chain, the increment_nonce message is signed instead
+// so that the outbound is reverted. The returned flag tells whether the transaction is an execute.
+// It returns nil if the transaction can't be signed or if the signer doesn't relay transactions.
+func (signer *Signer) signExecuteOutbound(
+	ctx context.Context,
+	cctx *types.CrossChainTx,
+	zetacoreClient interfaces.ZetacoreClient,
+	height uint64,
+	logger zerolog.Logger,
+) (*solana.Transaction, bool) {
+	params := cctx.GetCurrentOutboundParam()
+	chainID := signer.Chain().ChainId
+	nonce := params.TssNonce
+
+	// all the signers look up the failed executes reported to the outbound tracker to sign the same message
+	failed, err := signer.IsExecuteFailed(ctx, zetacoreClient, nonce)
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: IsExecuteFailed error for chain %d nonce %d", chainID, nonce)
+		return nil, false
+	}
+	if failed {
+		return signer.signIncrementNonceOutbound(ctx, params, height, logger), false
+	}
+
+	// sign gateway execute message by TSS
+	msg, err := signer.SignMsgExecute(ctx, cctx, height)
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: SignMsgExecute error for chain %d nonce %d", chainID, nonce)
+		return nil, false
+	}
+
+	// skip relaying the transaction if this signer hasn't set the relayer key
+	if !signer.HasRelayerKey() {
+		return nil, false
+	}
+
+	// set relayer balance metrics
+	signer.SetRelayerBalanceMetrics(ctx)
+
+	// sign the execute transaction by relayer key
+	tx, err := signer.SignExecuteTx(ctx, *msg)
+	if err != nil {
+		logger.Error().Err(err).Msgf("TryProcessOutbound: SignExecuteTx error for chain %d nonce %d", chainID, nonce)
+		return nil, false
+	}
+
+	return tx, true
+}
+
+// signIncrementNonceOutbound signs the increment_nonce message by TSS and the transaction by relayer key.
+// It returns nil if the transaction can't be signed or if the signer doesn't relay transactions.
+func (signer *Signer) signIncrementNonceOutbound(
+	ctx context.Context,
+	params *types.OutboundParams,
+	height uint64,
+	logger zerolog.Logger,
+) *solana.Transaction {
+	chainID := signer.Chain().ChainId
+	nonce := params.TssNonce
+
+	// sign gateway increment_nonce message by TSS
+	msg, err := signer.SignMsgIncrementNonce(ctx, params, height)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Msgf("TryProcessOutbound: SignMsgIncrementNonce error for chain %d nonce %d", chainID, nonce)
+		return nil
+	}
+
+	// skip relaying the transaction if this signer hasn't set the relayer key
+	if !signer.HasRelayerKey() {
+		return nil
+	}
+
+	// set relayer balance metrics
+	signer.SetRelayerBalanceMetrics(ctx)
+
+	// sign the increment_nonce transaction by relayer key
+	tx, err := signer.SignIncrementNonceTx(ctx, *msg)
+	if err != nil {
+		logger.Error().
+			Err(err).
+			Msgf("TryProcessOutbound: SignIncrementNonceTx error for chain %d nonce %d", chainID, nonce)
+		return nil
+	}
+
+	return tx
+}
+
+// signGatewayTx wraps the gateway instruction into a transaction signed with the relayer key.
+// The transaction uses the durable nonce if the signer has a nonce account, a recent blockhash otherwise
+func (signer *Signer) signGatewayTx(
+	ctx context.Context,
+	inst solana.Instruction,
+	nonce uint64,
+	msgHash [32]byte,
+) (*solana.Transaction, error) {
+	if !signer.UsesDurableNonce() {
+		// get a recent blockhash
+		recent, err := signer.client.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetLatestBlockhash error")
+		}
+
+		return signer.signTx([]solana.Instruction{inst}, recent.Value.Blockhash)
+	}
+
+	// the transaction signed with the current durable nonce is still valid, rebroadcast it
+	durableNonce, err := signer.GetDurableNonce(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetDurableNonce error")
+	}
+	if tx, found := signer.getSignedTx(msgHash, durableNonce); found {
+		return tx, nil
+	}
+
+	tx, err := signer.signTx([]solana.Instruction{signer.advanceNonceInstruction(), inst}, durableNonce)
+	if err != nil {
+		return nil, err
+	}
+	signer.addSignedTx(nonce, msgHash, tx)
+
+	return tx, nil
+}
+
+// signTx creates a transaction wrapping the instructions and signs it with the relayer key.
+func (signer *Signer) signTx(instructions []solana.Instruction, blockhash solana.Hash) (*solana.Transaction, error) {
+	// create a transaction that wraps the instructions
+	// TODO: outbound now uses 5K lamports as the fixed fee, we could explore priority fee and compute budget
+	// https://github.com/zeta-chain/node/issues/2599
+	// programs.ComputeBudgetSetComputeUnitLimit(computeUnitLimit),
+	// programs.ComputeBudgetSetComputeUnitPrice(computeUnitPrice),
+	privkey := signer.relayerKey
+	tx, err := solana.NewTransaction(instructions, blockhash, solana.TransactionPayer(privkey.PublicKey()))
+	if err != nil {
+		return nil, errors.Wrap(err, "NewTransaction error")
+	}
+
+	// relayer signs the transaction
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+		if key.Equals(privkey.PublicKey()) {
+			return privkey
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "signer unable to sign transaction")
+	}
+
+	return tx, nil
 }
 
 // SetGatewayAddress sets the gateway address
@@ -2,15 +2,25 @@ package signer_test
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/zeta-chain/node/pkg/chains"
+	contracts "github.com/zeta-chain/node/pkg/contracts/solana"
 	"github.com/zeta-chain/node/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/node/x/crosschain/types"
 	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
@@ -140,3 +150,266 @@ func Test_SetRelayerBalanceMetrics(t *testing.T) {
 	balance = testutil.ToFloat64(metrics.RelayerKeyBalance.WithLabelValues(chain.Name))
 	require.Equal(t, 0.1234, balance)
 }
+
+// nonceAccountInfo returns the account info of an initialized nonce account
+func nonceAccountInfo(authority solana.PublicKey, nonce solana.Hash) *rpc.GetAccountInfoResult {
+	data := make([]byte, 0, 80)
+	data = binary.LittleEndian.AppendUint32(data, 1) // version
+	data = binary.LittleEndian.AppendUint32(data, 1) // initialized
+	data = append(data, authority[:]...)
+	data = append(data, nonce[:]...)
+	data = binary.LittleEndian.AppendUint64(data, 5000)
+
+	return &rpc.GetAccountInfoResult{Value: &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(data)}}
+}
+
+func Test_SignWithdrawTxWithDurableNonce(t *testing.T) {
+	// test parameters
+	ctx := context.Background()
+	chain := chains.SolanaDevnet
+	chainParams := sample.ChainParams(chain.ChainId)
+	chainParams.GatewayAddress = testutils.GatewayAddresses[chain.ChainId]
+	relayerKey := &keys.RelayerKey{
+		PrivateKey: "3EMjCcCJg53fMEGVj13UPQpo6py9AKKyLE2qroR4yL1SvAN2tUznBvDKRYjntw7m6Jof1R2CSqjTddL27rEb6sFQ",
+	}
+	relayer := solana.MustPrivateKeyFromBase58(relayerKey.PrivateKey).PublicKey()
+	nonceAccount := solana.NewWallet().PublicKey()
+	nonce1 := solana.Hash(sample.Hash())
+	nonce2 := solana.Hash(sample.Hash())
+
+	// withdraw messages of the same gateway nonce signed by two TSS keysigns
+	to := solana.NewWallet().PublicKey()
+	msg := contracts.NewMsgWithdraw(uint64(chain.ChainId), 1, 100, to).SetSignature([65]byte{1})
+	msgResigned := contracts.NewMsgWithdraw(uint64(chain.ChainId), 1, 100, to).SetSignature([65]byte{2})
+
+	t.Run("should sign and rebroadcast the same tx until the durable nonce advances", func(t *testing.T) {
+		mckClient := mocks.NewSolanaRPCClient(t)
+		mckClient.On("GetAccountInfo", mock.Anything, nonceAccount).Return(nonceAccountInfo(relayer, nonce1), nil).Twice()
+		mckClient.On("GetAccountInfo", mock.Anything, nonceAccount).Return(nonceAccountInfo(relayer, nonce2), nil).Once()
+
+		s, err := signer.NewSigner(chain, *chainParams, mckClient, nil, relayerKey, nil, base.DefaultLogger())
+		require.NoError(t, err)
+		s.WithNonceAccount(nonceAccount)
+
+		// the durable nonce is advanced by the first instruction
+		tx, err := s.SignWithdrawTx(ctx, *msg)
+		require.NoError(t, err)
+		require.Equal(t, nonce1, tx.Message.RecentBlockhash)
+		require.Len(t, tx.Message.Instructions, 2)
+		program, err := tx.Message.Program(tx.Message.Instructions[0].ProgramIDIndex)
+		require.NoError(t, err)
+		require.Equal(t, solana.SystemProgramID, program)
+
+		// the same tx is returned while the durable nonce is unchanged
+		txAgain, err := s.SignWithdrawTx(ctx, *msgResigned)
+		require.NoError(t, err)
+		require.Equal(t, tx.Signatures[0], txAgain.Signatures[0])
+
+		// a new tx is signed once the durable nonce is advanced
+		txNew, err := s.SignWithdrawTx(ctx, *msgResigned)
+		require.NoError(t, err)
+		require.Equal(t, nonce2, txNew.Message.RecentBlockhash)
+		require.NotEqual(t, tx.Signatures[0], txNew.Signatures[0])
+	})
+
+	t.Run("should fail if the nonce account is not owned by the relayer", func(t *testing.T) {
+		mckClient := mocks.NewSolanaRPCClient(t)
+		mckClient.On("GetAccountInfo", mock.Anything, nonceAccount).
+			Return(nonceAccountInfo(solana.NewWallet().PublicKey(), nonce1), nil)
+
+		s, err := signer.NewSigner(chain, *chainParams, mckClient, nil, relayerKey, nil, base.DefaultLogger())
+		require.NoError(t, err)
+		s.WithNonceAccount(nonceAccount)
+
+		_, err = s.SignWithdrawTx(ctx, *msg)
+		require.ErrorContains(t, err, "is not owned by relayer")
+	})
+}
+
+func Test_SignExecuteTx(t *testing.T) {
+	// test parameters
+	ctx := context.Background()
+	chain := chains.SolanaDevnet
+	chainParams := sample.ChainParams(chain.ChainId)
+	chainParams.GatewayAddress = testutils.GatewayAddresses[chain.ChainId]
+	relayerKey := &keys.RelayerKey{
+		PrivateKey: "3EMjCcCJg53fMEGVj13UPQpo6py9AKKyLE2qroR4yL1SvAN2tUznBvDKRYjntw7m6Jof1R2CSqjTddL27rEb6sFQ",
+	}
+	gatewayID, pda, err := contracts.ParseGatewayIDAndPda(chainParams.GatewayAddress)
+	require.NoError(t, err)
+
+	// the execute message calling a program with two remaining accounts
+	program := solana.NewWallet().PublicKey()
+	account1 := solana.NewWallet().PublicKey()
+	account2 := solana.NewWallet().PublicKey()
+	remainingAccounts := []*solana.AccountMeta{solana.Meta(account1).WRITE(), solana.Meta(account2)}
+	msg := contracts.NewMsgExecute(
+		uint64(chain.ChainId),
+		1,
+		100,
+		program,
+		sample.EthAddress(),
+		[]byte("hello"),
+		remainingAccounts,
+	).SetSignature([65]byte{1})
+
+	mckClient := mocks.NewSolanaRPCClient(t)
+	mckClient.On("GetLatestBlockhash", mock.Anything, mock.Anything).
+		Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{Blockhash: solana.Hash(sample.Hash())}}, nil)
+
+	s, err := signer.NewSigner(chain, *chainParams, mckClient, nil, relayerKey, nil, base.DefaultLogger())
+	require.NoError(t, err)
+
+	t.Run("should attach the remaining accounts after the gateway accounts", func(t *testing.T) {
+		tx, err := s.SignExecuteTx(ctx, *msg)
+		require.NoError(t, err)
+		require.Len(t, tx.Message.Instructions, 1)
+
+		inst := tx.Message.Instructions[0]
+		programID, err := tx.Message.Program(inst.ProgramIDIndex)
+		require.NoError(t, err)
+		require.Equal(t, gatewayID, programID)
+
+		accounts, err := inst.ResolveInstructionAccounts(&tx.Message)
+		require.NoError(t, err)
+		require.Len(t, accounts, contracts.AccountsNumExecute+2)
+		require.Equal(t, pda, accounts[1].PublicKey)
+		require.Equal(t, program, accounts[2].PublicKey)
+		require.Equal(t, account1, accounts[3].PublicKey)
+		require.True(t, accounts[3].IsWritable)
+		require.Equal(t, account2, accounts[4].PublicKey)
+		require.False(t, accounts[4].IsWritable)
+
+		parsed, err := contracts.ParseInstructionExecute(inst)
+		require.NoError(t, err)
+		require.Equal(t, []byte("hello"), parsed.Data)
+		require.Equal(t, msg.Hash(), parsed.MessageHash)
+	})
+
+}
+
+func Test_IsExecuteFailed(t *testing.T) {
+	// test parameters
+	ctx := context.Background()
+	chain := chains.SolanaDevnet
+	chainParams := sample.ChainParams(chain.ChainId)
+	chainParams.GatewayAddress = testutils.GatewayAddresses[chain.ChainId]
+	relayerKey := &keys.RelayerKey{
+		PrivateKey: "3EMjCcCJg53fMEGVj13UPQpo6py9AKKyLE2qroR4yL1SvAN2tUznBvDKRYjntw7m6Jof1R2CSqjTddL27rEb6sFQ",
+	}
+	tss := mocks.NewMockTSS(chain, "", "")
+	nonce := uint64(7)
+
+	mckClient := mocks.NewSolanaRPCClient(t)
+	mckClient.On("GetLatestBlockhash", mock.Anything, mock.Anything).
+		Return(&rpc.GetLatestBlockhashResult{Value: &rpc.LatestBlockhashResult{Blockhash: solana.Hash(sample.Hash())}}, nil)
+
+	s, err := signer.NewSigner(chain, *chainParams, mckClient, tss, relayerKey, nil, base.DefaultLogger())
+	require.NoError(t, err)
+
+	// signExecuteTx returns an execute transaction of the nonce signed by TSS
+	signExecuteTx := func(nonce uint64) *solana.Transaction {
+		msg := contracts.NewMsgExecute(
+			uint64(chain.ChainId),
+			nonce,
+			100,
+			solana.NewWallet().PublicKey(),
+			sample.EthAddress(),
+			[]byte("hello"),
+			nil,
+		)
+		msgHash := msg.Hash()
+		signature, err := tss.Sign(ctx, msgHash[:], 0, nonce, chain.ChainId, "")
+		require.NoError(t, err)
+
+		tx, err := s.SignExecuteTx(ctx, *msg.SetSignature(signature))
+		require.NoError(t, err)
+		return tx
+	}
+
+	// txResult returns the result of the transaction, failed with a custom program error if 'failed' is set
+	txResult := func(tx *solana.Transaction, failed bool) *rpc.GetTransactionResult {
+		txBytes, err := tx.MarshalBinary()
+		require.NoError(t, err)
+
+		txErr := "null"
+		if failed {
+			txErr = `{"InstructionError":[0,{"Custom":1}]}`
+		}
+		raw := fmt.Sprintf(
+			`{"transaction":["%s","base64"],"meta":{"err":%s}}`,
+			base64.StdEncoding.EncodeToString(txBytes),
+			txErr,
+		)
+
+		result := &rpc.GetTransactionResult{}
+		require.NoError(t, json.Unmarshal([]byte(raw), result))
+		return result
+	}
+
+	// newZetacoreClient returns a zetacore client with the outbound tracker of the transactions
+	newZetacoreClient := func(txs ...*solana.Transaction) *mocks.ZetacoreClient {
+		tracker := &crosschaintypes.OutboundTracker{ChainId: chain.ChainId, Nonce: nonce}
+		for _, tx := range txs {
+			tracker.HashList = append(tracker.HashList, &crosschaintypes.TxHash{TxHash: tx.Signatures[0].String()})
+		}
+
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.On("GetOutboundTracker", mock.Anything, chain, nonce).Return(tracker, nil)
+		return zetacoreClient
+	}
+
+	t.Run("should not find a failed execute without outbound tracker", func(t *testing.T) {
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.On("GetOutboundTracker", mock.Anything, chain, nonce).
+			Return(nil, status.Error(codes.NotFound, "not found"))
+
+		failed, err := s.IsExecuteFailed(ctx, zetacoreClient, nonce)
+		require.NoError(t, err)
+		require.False(t, failed)
+	})
+
+	t.Run("should find the failed execute of the nonce", func(t *testing.T) {
+		succeededTx := signExecuteTx(nonce)
+		failedTx := signExecuteTx(nonce)
+		mckClient.On("GetTransaction", mock.Anything, succeededTx.Signatures[0], mock.Anything).
+			Return(txResult(succeededTx, false), nil).
+			Once()
+		mckClient.On("GetTransaction", mock.Anything, failedTx.Signatures[0], mock.Anything).
+			Return(txResult(failedTx, true), nil).
+			Once()
+
+		failed, err := s.IsExecuteFailed(ctx, newZetacoreClient(succeededTx, failedTx), nonce)
+		require.NoError(t, err)
+		require.True(t, failed)
+	})
+
+	t.Run("should ignore the failed execute of another nonce", func(t *testing.T) {
+		failedTx := signExecuteTx(nonce + 1)
+		mckClient.On("GetTransaction", mock.Anything, failedTx.Signatures[0], mock.Anything).
+			Return(txResult(failedTx, true), nil).
+			Once()
+
+		failed, err := s.IsExecuteFailed(ctx, newZetacoreClient(failedTx), nonce)
+		require.NoError(t, err)
+		require.False(t, failed)
+	})
+
+	t.Run("should return error if the outbound tracker can't be queried", func(t *testing.T) {
+		zetacoreClient := mocks.NewZetacoreClient(t)
+		zetacoreClient.On("GetOutboundTracker", mock.Anything, chain, nonce).Return(nil, errors.New("rpc error"))
+
+		_, err := s.IsExecuteFailed(ctx, zetacoreClient, nonce)
+		require.ErrorContains(t, err, "GetOutboundTracker error")
+	})
+
+	t.Run("should return error if the transaction is not finalized yet", func(t *testing.T) {
+		tx := signExecuteTx(nonce)
+		mckClient.On("GetTransaction", mock.Anything, tx.Signatures[0], mock.Anything).
+			Return(nil, errors.New("not found")).
+			Once()
+
+		_, err := s.IsExecuteFailed(ctx, newZetacoreClient(tx), nonce)
+		require.ErrorContains(t, err, "GetTransaction error")
+	})
+}
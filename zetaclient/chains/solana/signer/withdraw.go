@@ -5,7 +5,6 @@ import (
 
 	"cosmossdk.io/errors"
 	"github.com/gagliardetto/solana-go"
-	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/near/borsh-go"
 
 	"github.com/zeta-chain/node/pkg/chains"
@@ -74,39 +73,7 @@ func (signer *Signer) SignWithdrawTx(ctx context.Context, msg contracts.MsgWithd
 	privkey := signer.relayerKey
 	attachWithdrawAccounts(&inst, privkey.PublicKey(), signer.pda, msg.To(), signer.gatewayID)
 
-	// get a recent blockhash
-	recent, err := signer.client.GetLatestBlockhash(ctx, rpc.CommitmentFinalized)
-	if err != nil {
-		return nil, errors.Wrap(err, "GetLatestBlockhash error")
-	}
-
-	// create a transaction that wraps the instruction
-	tx, err := solana.NewTransaction(
-		[]solana.Instruction{
-			// TODO: outbound now uses 5K lamports as the fixed fee, we could explore priority fee and compute budget
-			// https://github.com/zeta-chain/node/issues/2599
-			// programs.ComputeBudgetSetComputeUnitLimit(computeUnitLimit),
-			// programs.ComputeBudgetSetComputeUnitPrice(computeUnitPrice),
-			&inst},
-		recent.Value.Blockhash,
-		solana.TransactionPayer(privkey.PublicKey()),
-	)
-	if err != nil {
-		return nil, errors.Wrap(err, "NewTransaction error")
-	}
-
-	// relayer signs the transaction
-	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
-		if key.Equals(privkey.PublicKey()) {
-			return privkey
-		}
-		return nil
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "signer unable to sign transaction")
-	}
-
-	return tx, nil
+	return signer.signGatewayTx(ctx, &inst, msg.Nonce(), msg.Hash())
 }
 
 // attachWithdrawAccounts attaches the required accounts for the gateway withdraw instruction.
@@ -39,6 +39,7 @@ type ClientConfiguration struct {
 type EVMConfig struct {
 	Chain           chains.Chain
 	Endpoint        string
+	WSEndpoint      string // optional, subscribes to new heads and logs instead of polling
 	RPCAlertLatency int64
 }
 
@@ -50,12 +51,17 @@ type BTCConfig struct {
 	RPCHost         string
 	RPCParams       string // "regtest", "mainnet", "testnet3" , "signet"
 	RPCAlertLatency int64
+
+	// MempoolPollInterval is the optional interval in seconds to poll the mempool for unconfirmed inbounds
+	// and new blocks, the inbounds are observed on the inbound ticker only if it's 0
+	MempoolPollInterval int64
 }
 
 // SolanaConfig is the config for Solana chain
 type SolanaConfig struct {
 	Endpoint        string
 	RPCAlertLatency int64
+	NonceAccount    string // optional durable nonce account owned by the relayer key, used to sign outbounds
 }
 
 // ComplianceConfig is the config for compliance
@@ -29,6 +29,13 @@ var (
 		Help:      "Number of pending transactions per chain",
 	}, []string{"chain"})
 
+	// MempoolInboundsPerChain is a gauge that contains the number of unconfirmed inbounds seen in the mempool per chain
+	MempoolInboundsPerChain = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "mempool_inbounds_total",
+		Help:      "Number of unconfirmed inbounds seen in the mempool per chain",
+	}, []string{"chain"})
+
 	// GetFilterLogsPerChain is a counter that contains the number of getLogs per chain
 	GetFilterLogsPerChain = promauto.NewCounterVec(prometheus.CounterOpts{
 		Namespace: ZetaClientNamespace,
@@ -64,6 +71,20 @@ var (
 		Help:      "Fee burn rate of the hotkey",
 	})
 
+	// ObserverMissedVoteRatio is a gauge that contains the ratio of votes missed by the observer in the liveness window
+	ObserverMissedVoteRatio = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "observer_missed_vote_ratio",
+		Help:      "Ratio of votes missed by the observer in the liveness window",
+	})
+
+	// ObserverJailed is a gauge that indicates whether the observer is jailed for missing votes
+	ObserverJailed = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "observer_jailed",
+		Help:      "Whether the observer is jailed for missing votes (1) or not (0)",
+	})
+
 	// NumberOfUTXO is a gauge that contains the number of UTXOs
 	NumberOfUTXO = promauto.NewGauge(prometheus.GaugeOpts{
 		Namespace: ZetaClientNamespace,
@@ -71,6 +92,13 @@ var (
 		Help:      "Number of UTXOs",
 	})
 
+	// ReorgDetectedPerChain is a counter that contains the number of chain reorgs detected on voted blocks per chain
+	ReorgDetectedPerChain = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: ZetaClientNamespace,
+		Name:      "reorg_detected_count",
+		Help:      "Count of chain reorgs detected on voted blocks per chain",
+	}, []string{"chain"})
+
 	// LastScannedBlockNumber is a gauge that contains the last scanned block number per chain
 	LastScannedBlockNumber = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: ZetaClientNamespace,
@@ -23,7 +23,8 @@ type TelemetryServer struct {
 	logger                 zerolog.Logger
 	s                      *http.Server
 	p2pid                  string
-	lastScannedBlockNumber map[int64]uint64 // chainID => block number
+	lastScannedBlockNumber map[int64]uint64                 // chainID => block number
+	mempoolInbounds        map[int64][]types.MempoolInbound // chainID => unconfirmed inbounds
 	lastCoreBlockNumber    int64
 	mu                     sync.Mutex
 	lastStartTimestamp     time.Time
@@ -37,6 +38,7 @@ func NewTelemetryServer() *TelemetryServer {
 	hs := &TelemetryServer{
 		logger:                 log.With().Str("module", "http").Logger(),
 		lastScannedBlockNumber: make(map[int64]uint64),
+		mempoolInbounds:        make(map[int64][]types.MempoolInbound),
 		lastStartTimestamp:     time.Now(),
 		HotKeyBurnRate:         NewBurnRate(100),
 	}
@@ -100,6 +102,21 @@ func (t *TelemetryServer) GetLastScannedBlockNumber(chainID int64) uint64 {
 	return t.lastScannedBlockNumber[chainID]
 }
 
+// SetMempoolInbounds sets the unconfirmed inbounds seen in the mempool of the chain
+func (t *TelemetryServer) SetMempoolInbounds(chain chains.Chain, inbounds []types.MempoolInbound) {
+	t.mu.Lock()
+	t.mempoolInbounds[chain.ChainId] = inbounds
+	t.mu.Unlock()
+	MempoolInboundsPerChain.WithLabelValues(chain.Name).Set(float64(len(inbounds)))
+}
+
+// GetMempoolInbounds gets the unconfirmed inbounds seen in the mempool of the chain
+func (t *TelemetryServer) GetMempoolInbounds(chainID int64) []types.MempoolInbound {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.mempoolInbounds[chainID]
+}
+
 // SetCoreBlockNumber sets core block number in telemetry and metrics
 func (t *TelemetryServer) SetCoreBlockNumber(blockNumber int64) {
 	t.mu.Lock()
@@ -145,6 +162,7 @@ func (t *TelemetryServer) Handlers() http.Handler {
 	router.Handle("/status", http.HandlerFunc(t.statusHandler)).Methods(http.MethodGet)
 	router.Handle("/ip", http.HandlerFunc(t.ipHandler)).Methods(http.MethodGet)
 	router.Handle("/hotkeyburnrate", http.HandlerFunc(t.hotKeyFeeBurnRate)).Methods(http.MethodGet)
+	router.Handle("/mempoolinbounds", http.HandlerFunc(t.mempoolInboundsHandler)).Methods(http.MethodGet)
 
 	router.Use(logMiddleware())
 
@@ -214,6 +232,22 @@ func (t *TelemetryServer) lastScannedBlockHandler(w http.ResponseWriter, _ *http
 	}
 }
 
+func (t *TelemetryServer) mempoolInboundsHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	jsonBytes, err := json.Marshal(t.mempoolInbounds)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	_, err = w.Write(jsonBytes)
+	if err != nil {
+		t.logger.Error().Err(err).Msg("Failed to write response")
+	}
+}
+
 func (t *TelemetryServer) lastCoreBlockHandler(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	t.mu.Lock()
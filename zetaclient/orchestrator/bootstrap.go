@@ -2,19 +2,23 @@ package orchestrator
 
 import (
 	"context"
+	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/gagliardetto/solana-go"
 	solrpc "github.com/gagliardetto/solana-go/rpc"
 	ethrpc2 "github.com/onrik/ethrpc"
 	"github.com/pkg/errors"
 
+	observertypes "github.com/zeta-chain/node/x/observer/types"
 	"github.com/zeta-chain/node/zetaclient/chains/base"
 	btcobserver "github.com/zeta-chain/node/zetaclient/chains/bitcoin/observer"
 	"github.com/zeta-chain/node/zetaclient/chains/bitcoin/rpc"
 	btcsigner "github.com/zeta-chain/node/zetaclient/chains/bitcoin/signer"
 	evmobserver "github.com/zeta-chain/node/zetaclient/chains/evm/observer"
+	evmrpc "github.com/zeta-chain/node/zetaclient/chains/evm/rpc"
 	evmsigner "github.com/zeta-chain/node/zetaclient/chains/evm/signer"
 	"github.com/zeta-chain/node/zetaclient/chains/interfaces"
 	solbserver "github.com/zeta-chain/node/zetaclient/chains/solana/observer"
@@ -180,6 +184,16 @@ func syncSignerMap(
 				continue
 			}
 
+			// use the durable nonce account if present
+			if cfg.NonceAccount != "" {
+				nonceAccount, err := solana.PublicKeyFromBase58(cfg.NonceAccount)
+				if err != nil {
+					logger.Std.Error().Err(err).Msgf("Unable to parse nonce account %s", cfg.NonceAccount)
+					continue
+				}
+				signer.WithNonceAccount(nonceAccount)
+			}
+
 			addSigner(chainID, signer)
 		default:
 			logger.Std.Warn().
@@ -291,7 +305,7 @@ func syncObserverMap(
 				logger.Std.Error().Err(err).Str("rpc.endpoint", cfg.Endpoint).Msgf("Unable to dial EVM RPC")
 				continue
 			}
-			evmClient := ethclient.NewClient(rpcClient)
+			evmClient := evmrpc.NewClient(rpcClient)
 
 			database, err := db.NewFromSqlite(dbpath, chainName, true)
 			if err != nil {
@@ -320,6 +334,20 @@ func syncObserverMap(
 				continue
 			}
 
+			// subscribe to new heads and logs if a WebSocket endpoint is configured, poll otherwise
+			if cfg.WSEndpoint != "" {
+				wsClient, err := ethclient.DialContext(ctx, cfg.WSEndpoint)
+				if err != nil {
+					logger.Std.Error().Err(err).Str("ws.endpoint", cfg.WSEndpoint).Msgf("Unable to dial EVM WebSocket")
+				} else {
+					observer.WithEvmSubscriber(wsClient)
+				}
+			}
+
+			// the TSS deposits and outbounds are found with the call traces of the trace mode set in chain params
+			observer.WithEvmCallTracer(observertypes.TraceMode_TraceFilter, evmrpc.NewTraceFilterClient(rpcClient))
+			observer.WithEvmCallTracer(observertypes.TraceMode_DebugTrace, evmrpc.NewDebugTraceClient(rpcClient))
+
 			addObserver(chainID, observer)
 		case chain.IsUTXO():
 			cfg, found := app.Config().GetBTCConfig()
@@ -356,6 +384,11 @@ func syncObserverMap(
 				continue
 			}
 
+			// poll the mempool for unconfirmed inbounds if configured
+			if cfg.MempoolPollInterval > 0 {
+				btcObserver.WithMempoolPolling(time.Duration(cfg.MempoolPollInterval) * time.Second)
+			}
+
 			addObserver(chainID, btcObserver)
 		case chain.IsSolana():
 			cfg, found := app.Config().GetSolanaConfig()
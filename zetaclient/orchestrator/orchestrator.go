@@ -354,6 +354,9 @@ func (oc *Orchestrator) runScheduler(ctx context.Context) error {
 					// set current hot key burn rate
 					metrics.HotKeyBurnRate.Set(float64(oc.ts.HotKeyBurnRate.GetBurnRate().Int64()))
 
+					// set current liveness of the observer
+					oc.updateObserverLivenessMetrics(ctx)
+
 					// get chain ids without zeta chain
 					chainIDs := lo.FilterMap(app.ListChains(), func(c zctx.Chain, _ int) (int64, bool) {
 						return c.ID(), !c.IsZeta()
@@ -429,6 +432,28 @@ func (oc *Orchestrator) runScheduler(ctx context.Context) error {
 	}
 }
 
+// updateObserverLivenessMetrics updates the missed vote ratio and jailing status metrics of the observer
+func (oc *Orchestrator) updateObserverLivenessMetrics(ctx context.Context) {
+	operatorAddress := oc.zetacoreClient.GetKeys().GetOperatorAddress().String()
+
+	liveness, err := oc.zetacoreClient.GetObserverLiveness(ctx, operatorAddress)
+	if err != nil {
+		oc.logger.Debug().Err(err).Msgf("couldn't get liveness of observer %s", operatorAddress)
+		return
+	}
+
+	if !liveness.MissedRatio.IsNil() {
+		metrics.ObserverMissedVoteRatio.Set(liveness.MissedRatio.MustFloat64())
+	}
+	if !liveness.Jailed {
+		metrics.ObserverJailed.Set(0)
+		return
+	}
+
+	metrics.ObserverJailed.Set(1)
+	oc.logger.Warn().Msgf("observer %s is jailed until zeta height %d", operatorAddress, liveness.JailedUntil)
+}
+
 // ScheduleCctxEVM schedules evm outbound keysign on each ZetaChain block (the ticker)
 func (oc *Orchestrator) ScheduleCctxEVM(
 	ctx context.Context,
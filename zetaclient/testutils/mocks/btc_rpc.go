@@ -23,6 +23,8 @@ type MockBTCRPCClient struct {
 	blockHeader    *wire.BlockHeader
 	blockVerboseTx *btcjson.GetBlockVerboseTxResult
 	Txs            []*btcutil.Tx
+	mempool        []*chainhash.Hash
+	txResults      map[string]*btcjson.TxRawResult
 }
 
 // NewMockBTCRPCClient creates a new mock BTC RPC client
@@ -38,6 +40,8 @@ func (c *MockBTCRPCClient) Reset() *MockBTCRPCClient {
 	}
 
 	c.Txs = []*btcutil.Tx{}
+	c.mempool = nil
+	c.txResults = make(map[string]*btcjson.TxRawResult)
 	return c
 }
 
@@ -99,10 +103,22 @@ func (c *MockBTCRPCClient) GetRawTransaction(_ *chainhash.Hash) (*btcutil.Tx, er
 	return nil, errors.New("no transaction found")
 }
 
-func (c *MockBTCRPCClient) GetRawTransactionVerbose(_ *chainhash.Hash) (*btcjson.TxRawResult, error) {
+// GetRawTransactionVerbose returns a pre-loaded transaction result
+func (c *MockBTCRPCClient) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	if result, found := c.txResults[txHash.String()]; found {
+		return result, nil
+	}
 	return nil, errors.New("not implemented")
 }
 
+// GetRawMempool returns the pre-loaded mempool
+func (c *MockBTCRPCClient) GetRawMempool() ([]*chainhash.Hash, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.mempool, nil
+}
+
 func (c *MockBTCRPCClient) GetBlockCount() (int64, error) {
 	if c.err != nil {
 		return 0, c.err
@@ -173,3 +189,13 @@ func (c *MockBTCRPCClient) WithRawTransactions(txs []*btcutil.Tx) *MockBTCRPCCli
 	c.Txs = append(c.Txs, txs...)
 	return c
 }
+
+func (c *MockBTCRPCClient) WithRawTransactionVerbose(result *btcjson.TxRawResult) *MockBTCRPCClient {
+	c.txResults[result.Txid] = result
+	return c
+}
+
+func (c *MockBTCRPCClient) WithRawMempool(hashes []*chainhash.Hash) *MockBTCRPCClient {
+	c.mempool = hashes
+	return c
+}
@@ -252,6 +252,34 @@ func (_m *ZetacoreClient) GetCrosschainFlags(ctx context.Context) (observertypes
 	return r0, r1
 }
 
+// GetGasPrice provides a mock function with given fields: ctx, chainID
+func (_m *ZetacoreClient) GetGasPrice(ctx context.Context, chainID int64) (types.GasPrice, error) {
+	ret := _m.Called(ctx, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGasPrice")
+	}
+
+	var r0 types.GasPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (types.GasPrice, error)); ok {
+		return rf(ctx, chainID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) types.GasPrice); ok {
+		r0 = rf(ctx, chainID)
+	} else {
+		r0 = ret.Get(0).(types.GasPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, chainID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInboundTrackersForChain provides a mock function with given fields: ctx, chainID
 func (_m *ZetacoreClient) GetInboundTrackersForChain(ctx context.Context, chainID int64) ([]types.InboundTracker, error) {
 	ret := _m.Called(ctx, chainID)
@@ -380,6 +408,34 @@ func (_m *ZetacoreClient) GetObserverList(ctx context.Context) ([]string, error)
 	return r0, r1
 }
 
+// GetObserverLiveness provides a mock function with given fields: ctx, observerAddress
+func (_m *ZetacoreClient) GetObserverLiveness(ctx context.Context, observerAddress string) (observertypes.ObserverLivenessInfo, error) {
+	ret := _m.Called(ctx, observerAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetObserverLiveness")
+	}
+
+	var r0 observertypes.ObserverLivenessInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (observertypes.ObserverLivenessInfo, error)); ok {
+		return rf(ctx, observerAddress)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) observertypes.ObserverLivenessInfo); ok {
+		r0 = rf(ctx, observerAddress)
+	} else {
+		r0 = ret.Get(0).(observertypes.ObserverLivenessInfo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, observerAddress)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOutboundTracker provides a mock function with given fields: ctx, chain, nonce
 func (_m *ZetacoreClient) GetOutboundTracker(ctx context.Context, chain chains.Chain, nonce uint64) (*types.OutboundTracker, error) {
 	ret := _m.Called(ctx, chain, nonce)
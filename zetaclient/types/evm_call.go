@@ -0,0 +1,21 @@
+package types
+
+import (
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// EVMCall is a successful call found in the call traces of an EVM transaction
+type EVMCall struct {
+	BlockNumber uint64
+	BlockHash   ethcommon.Hash
+	TxHash      ethcommon.Hash
+	From        ethcommon.Address
+	To          ethcommon.Address
+	Value       *big.Int
+	Input       []byte
+
+	// Internal is true if the call is made by a contract, false for the top-level call of the transaction
+	Internal bool
+}
@@ -1,6 +1,17 @@
 package types
 
+import "time"
+
 // Status type for telemetry. More fields can be added as needed
 type Status struct {
 	BTCNumberOfUTXOs int `json:"btc_number_of_utxos"`
 }
+
+// MempoolInbound is an unconfirmed inbound to TSS seen in the mempool of a chain
+type MempoolInbound struct {
+	TxHash                string    `json:"tx_hash"`
+	Sender                string    `json:"sender"`
+	Amount                float64   `json:"amount"`
+	RequiredConfirmations uint64    `json:"required_confirmations"`
+	SeenAt                time.Time `json:"seen_at"`
+}